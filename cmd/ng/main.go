@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 14
+// :: fileVersion: 20
 // :: description: A simple CLI tool to run NeuroScript files with slog-based logging.
-// :: latestChange: Fixed LLM provider registration to use ProviderRegistry and interpreter options.
+// :: latestChange: Reports possibly undefined variable reads before loading each script; they are errors under -strict or ':: strict: true'.
 // :: filename: cmd/ng/main.go
 // :: serialization: go
 
@@ -75,12 +75,20 @@ func (l *slogAdapter) Errorf(format string, args ...interface{}) {
 
 func main() {
 	// 1. Define and parse command-line arguments.
+	if len(os.Args) > 1 && os.Args[1] == "secret" {
+		os.Exit(runSecret(os.Args[2:], os.Stdin, os.Stdout, os.Stderr))
+	}
 	logLevelFlag := flag.String("loglevel", "error", "Set the log level: debug, info, warn, error")
+	ageIdentityFlag := flag.String("age-identity", "", "File holding the age identity used to decrypt 'age' secrets")
+	sealedBoxKeyFlag := flag.String("sealedbox-key", "", "File holding the private key used to decrypt 'sealedbox' secrets")
+	skipPolicyCheckFlag := flag.Bool("skip-policy-check", false, "Do not run the policy preflight before loading each script")
+	strictFlag := flag.Bool("strict", false, "Make reading an undefined variable an error (same as ':: strict: true' in a script)")
 	flag.Parse()
 	scriptFiles := flag.Args()
 
 	if len(scriptFiles) == 0 {
-		fmt.Fprintln(os.Stderr, "Usage: ng [-loglevel <level>] <file1.ns> [file2.ns] ...")
+		fmt.Fprintln(os.Stderr, "Usage: ng [-loglevel <level>] [-age-identity <file>] [-sealedbox-key <file>] [-skip-policy-check] [-strict] <file1.ns> [file2.ns] ...")
+		fmt.Fprintln(os.Stderr, "       ng secret keygen|seal ...")
 		os.Exit(1)
 	}
 
@@ -140,12 +148,30 @@ func main() {
 	// 5. Create a wildcard capability to grant all permissions.
 	allCaps := api.NewCapability("*", "*", "*")
 
-	// 6. Create a new NeuroScript interpreter instance, injecting the ProviderRegistry.
+	// 6. Create a new NeuroScript interpreter instance, injecting the ProviderRegistry
+	// and any secret decryption keys.
+	opts := []api.Option{
+		api.WithHostContext(hostCtx),
+		api.WithProviderRegistry(provReg), // Connect the providers!
+	}
+	if *strictFlag {
+		opts = append(opts, api.WithStrictVariables())
+	}
+	for enc, keyFile := range map[string]string{"age": *ageIdentityFlag, "sealedbox": *sealedBoxKeyFlag} {
+		if keyFile == "" {
+			continue
+		}
+		key, err := loadSecretKey(keyFile)
+		if err != nil {
+			logger.Errorf("%v", err)
+			os.Exit(1)
+		}
+		opts = append(opts, api.WithSecretKey(enc, key))
+	}
 	interp := api.NewConfigInterpreter(
 		[]string{"*"}, // Allow all tools
 		[]api.Capability{allCaps},
-		api.WithHostContext(hostCtx),
-		api.WithProviderRegistry(provReg), // Connect the providers!
+		opts...,
 	)
 	interp.SetTurnContext(context.Background())
 
@@ -163,6 +189,46 @@ func main() {
 			os.Exit(1)
 		}
 
+		if !*skipPolicyCheckFlag {
+			report, err := api.CheckScriptPolicy(tree, interp)
+			if err != nil {
+				logger.Errorf("Policy preflight could not run on %q: %v", filename, err)
+				os.Exit(1)
+			}
+			if !report.OK() {
+				for _, v := range report.Violations {
+					fmt.Fprintf(os.Stderr, "%s: %s\n", filename, v)
+				}
+				logger.Errorf("Policy preflight failed for %q: %d violation(s)", filename, len(report.Violations))
+				os.Exit(1)
+			}
+		}
+
+		// Reads of possibly undefined variables are warnings, or errors when
+		// the script runs strict.
+		diags, err := api.CheckUndefinedVariables(tree, *strictFlag)
+		if err != nil {
+			logger.Errorf("Undefined variable check could not run on %q: %v", filename, err)
+			os.Exit(1)
+		}
+		var undefinedErrs int
+		for _, d := range diags {
+			level := "warning"
+			if d.Severity == api.SeverityError {
+				level = "error"
+				undefinedErrs++
+			}
+			loc := filename
+			if d.Position != nil {
+				loc = fmt.Sprintf("%s:%d:%d", filename, d.Position.Line, d.Position.Column)
+			}
+			fmt.Fprintf(os.Stderr, "%s: %s: %s\n", loc, level, d.Message)
+		}
+		if undefinedErrs > 0 {
+			logger.Errorf("Undefined variable check failed for %q: %d error(s)", filename, undefinedErrs)
+			os.Exit(1)
+		}
+
 		if err := interp.AppendScript(tree); err != nil {
 			logger.Errorf("Failed to load definitions from %q: %v", filename, err)
 			os.Exit(1)
@@ -186,10 +252,11 @@ func main() {
 			os.Exit(1)
 		}
 		if unwrapped != nil {
+			// The result goes to stdout, so revealed secrets are scrubbed.
 			if str, ok := unwrapped.(string); ok {
-				fmt.Fprintln(os.Stdout, str)
+				fmt.Fprintln(os.Stdout, interp.RedactSecrets(str))
 			} else {
-				fmt.Fprintf(os.Stdout, "%v\n", unwrapped)
+				fmt.Fprintln(os.Stdout, interp.RedactSecrets(fmt.Sprintf("%v", unwrapped)))
 			}
 		}
 	}
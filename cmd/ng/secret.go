@@ -0,0 +1,129 @@
+// :: product: FDM/NS
+// :: majorVersion: 1
+// :: fileVersion: 1
+// :: description: The 'ng secret' subcommands for generating keys and sealing secret references.
+// :: latestChange: Initial implementation of 'keygen' and 'seal'.
+// :: filename: cmd/ng/secret.go
+// :: serialization: go
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"filippo.io/age"
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/secret"
+)
+
+const secretUsage = `Usage:
+  ng secret keygen [-enc age|sealedbox]
+  ng secret seal -enc age|sealedbox|none -to <recipient> -path <path> [-in <file>]
+
+'seal' reads the plaintext from -in (default stdin), strips one trailing newline,
+and prints a reference for tool.secret.Decode. Run scripts with -age-identity or
+-sealedbox-key pointing at the matching private key.`
+
+// runSecret dispatches the 'secret' subcommands and returns the exit code.
+func runSecret(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprintln(stderr, secretUsage)
+		return 1
+	}
+	switch args[0] {
+	case "keygen":
+		return runSecretKeygen(args[1:], stdout, stderr)
+	case "seal":
+		return runSecretSeal(args[1:], stdin, stdout, stderr)
+	default:
+		fmt.Fprintf(stderr, "unknown secret subcommand %q\n%s\n", args[0], secretUsage)
+		return 1
+	}
+}
+
+func runSecretKeygen(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("secret keygen", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	enc := fs.String("enc", secret.EncAge, "Key type: age or sealedbox")
+	if err := fs.Parse(args); err != nil {
+		return 1
+	}
+	switch *enc {
+	case secret.EncAge:
+		id, err := age.GenerateX25519Identity()
+		if err != nil {
+			fmt.Fprintf(stderr, "keygen failed: %v\n", err)
+			return 1
+		}
+		fmt.Fprintf(stdout, "# public key: %s\n%s\n", id.Recipient(), id)
+	case secret.EncSealedBox:
+		pub, priv, err := secret.GenerateSealedBoxKey()
+		if err != nil {
+			fmt.Fprintf(stderr, "keygen failed: %v\n", err)
+			return 1
+		}
+		fmt.Fprintf(stdout, "# public key: %s\n%s\n", pub, priv)
+	default:
+		fmt.Fprintf(stderr, "keygen: unsupported key type %q\n", *enc)
+		return 1
+	}
+	return 0
+}
+
+func runSecretSeal(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("secret seal", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	enc := fs.String("enc", secret.EncAge, "Encryption: age, sealedbox or none")
+	to := fs.String("to", "", "Recipient: an age1... key for age, a base64 public key for sealedbox")
+	path := fs.String("path", "", "Logical path of the secret, checked against 'secret:read:<path>' grants")
+	in := fs.String("in", "", "File holding the plaintext (default stdin)")
+	if err := fs.Parse(args); err != nil {
+		return 1
+	}
+	if *path == "" {
+		fmt.Fprintln(stderr, "seal: -path is required")
+		return 1
+	}
+	if *to == "" && *enc != secret.EncNone {
+		fmt.Fprintln(stderr, "seal: -to is required")
+		return 1
+	}
+
+	src := stdin
+	if *in != "" {
+		f, err := os.Open(*in)
+		if err != nil {
+			fmt.Fprintf(stderr, "seal: %v\n", err)
+			return 1
+		}
+		defer f.Close()
+		src = f
+	}
+	plain, err := io.ReadAll(src)
+	if err != nil {
+		fmt.Fprintf(stderr, "seal: reading plaintext: %v\n", err)
+		return 1
+	}
+	plain = []byte(strings.TrimSuffix(strings.TrimSuffix(string(plain), "\n"), "\r"))
+
+	raw, err := secret.Encrypt(*enc, *to, plain)
+	if err != nil {
+		fmt.Fprintf(stderr, "seal: %v\n", err)
+		return 1
+	}
+	fmt.Fprintln(stdout, secret.FormatRef(&ast.SecretRef{Path: *path, Enc: *enc, Raw: raw}))
+	return 0
+}
+
+// loadSecretKey reads a private key file for api.WithSecretKey.
+func loadSecretKey(path string) ([]byte, error) {
+	key, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading secret key %q: %w", path, err)
+	}
+	return key, nil
+}
@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.7.0
-// File version: 14
+// File version: 15
 // Purpose: Adds a public, thread-safe Exists(string) bool method to AgentModelStore.
 // filename: pkg/agentmodel/agentmodel_store.go
-// nlines: 159
+// nlines: 238
 // risk_rating: HIGH
 
 package agentmodel
@@ -206,6 +206,10 @@ func modelFromCfg(name types.AgentModelName, cfg map[string]any, base *types.Age
 		return types.AgentModel{}, fmt.Errorf("failed to decode agentmodel config: %w", err)
 	}
 
+	// Remember which sampling fields the config names, so that an explicit
+	// zero (e.g. temperature 0) is sent rather than taken as unset.
+	out.Generation.Explicit |= types.GenerationFieldsIn(cfg)
+
 	// --- Handle Deprecated Fields for backward compatibility ---
 	// Only overwrite nested fields if the deprecated top-level field was explicitly in the input map.
 	if val, ok := cfg["temperature"]; ok {
@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.7.0
-// File version: 7
-// Purpose: Updated admin/reader calls to use plain 'string' for model names instead of 'types.AgentModelName'.
+// File version: 9
+// Purpose: Covers the timeout and retry backoff fields and the explicit sampling fields in the full-config round trip.
 // filename: pkg/agentmodel/agentmodel_store_advanced_test.go
-// nlines: 221
+// nlines: 235
 // risk_rating: HIGH
 
 package agentmodel
@@ -22,31 +22,34 @@ import (
 func newFullConfig(name, provider, model string) (map[string]interface{}, types.AgentModel) {
 	seed := int64(12345)
 	cfg := map[string]interface{}{
-		"provider":            provider,
-		"model":               model,
-		"account_name":        "SOME_SECRET",
-		"base_url":            "https://api.example.com/v1",
-		"budget_currency":     "USD",
-		"notes":               "Full config test",
-		"disabled":            false,
-		"context_ktok":        128.0,
-		"max_turns":           10.0,
-		"max_retries":         2.0,
-		"temperature":         0.85,
-		"top_p":               0.95,
-		"top_k":               50.0,
-		"max_output_tokens":   4096.0,
-		"stop_sequences":      []interface{}{"stop1", "stop2"},
-		"presence_penalty":    0.1,
-		"frequency_penalty":   0.2,
-		"repetition_penalty":  1.1,
-		"seed":                float64(seed),
-		"log_probs":           true,
-		"response_format":     "json_object",
-		"tool_loop_permitted": true,
-		"auto_loop_enabled":   false,
-		"tool_choice":         "auto",
-		"safe_prompt":         true,
+		"provider":             provider,
+		"model":                model,
+		"account_name":         "SOME_SECRET",
+		"base_url":             "https://api.example.com/v1",
+		"budget_currency":      "USD",
+		"notes":                "Full config test",
+		"disabled":             false,
+		"context_ktok":         128.0,
+		"max_turns":            10.0,
+		"max_retries":          2.0,
+		"timeout_seconds":      90.0,
+		"retry_backoff_ms":     250.0,
+		"retry_max_backoff_ms": 8000.0,
+		"temperature":          0.85,
+		"top_p":                0.95,
+		"top_k":                50.0,
+		"max_output_tokens":    4096.0,
+		"stop_sequences":       []interface{}{"stop1", "stop2"},
+		"presence_penalty":     0.1,
+		"frequency_penalty":    0.2,
+		"repetition_penalty":   1.1,
+		"seed":                 float64(seed),
+		"log_probs":            true,
+		"response_format":      "json_object",
+		"tool_loop_permitted":  true,
+		"auto_loop_enabled":    false,
+		"tool_choice":          "auto",
+		"safe_prompt":          true,
 		"safety_settings": map[string]interface{}{
 			"HARM_CATEGORY_HARASSMENT": "BLOCK_LOW_AND_ABOVE",
 		},
@@ -64,6 +67,10 @@ func newFullConfig(name, provider, model string) (map[string]interface{}, types.
 		ContextKTok:    128,
 		MaxTurns:       10,
 		MaxRetries:     2,
+
+		TimeoutSeconds:    90,
+		RetryBackoffMs:    250,
+		RetryMaxBackoffMs: 8000,
 		Generation: types.GenerationConfig{
 			Temperature:       0.85,
 			TopP:              0.95,
@@ -76,6 +83,8 @@ func newFullConfig(name, provider, model string) (map[string]interface{}, types.
 			Seed:              &seed,
 			LogProbs:          true,
 			ResponseFormat:    types.ResponseFormatJSON,
+			Explicit: types.GenTemperature | types.GenTopP | types.GenTopK |
+				types.GenPresencePenalty | types.GenFrequencyPenalty | types.GenRepetitionPenalty,
 		},
 		Tools: types.ToolConfig{
 			ToolLoopPermitted: true,
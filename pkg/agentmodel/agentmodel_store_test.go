@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.7.0
-// File version: 3
+// File version: 4
 // Purpose: Updated admin/reader calls to use plain 'string' for model names instead of 'types.AgentModelName'.
 // filename: pkg/agentmodel/agentmodel_store_test.go
-// nlines: 198
+// nlines: 224
 // risk_rating: MEDIUM
 
 package agentmodel
@@ -212,3 +212,13 @@ func TestAgentModelStore_Get_List(t *testing.T) {
 		t.Errorf("List() len = %d, want %d", len(list), len(models))
 	}
 }
+
+func TestModelFromCfg_ExplicitZeros(t *testing.T) {
+	m, err := modelFromCfg("greedy", map[string]any{"provider": "p", "model": "m", "temperature": 0.0}, nil)
+	if err != nil {
+		t.Fatalf("modelFromCfg() failed: %v", err)
+	}
+	if !m.Generation.Explicit.Has(types.GenTemperature) || m.Generation.Explicit.Has(types.GenTopP) {
+		t.Errorf("Explicit = %b, want only temperature", m.Generation.Explicit)
+	}
+}
@@ -1,4 +1,4 @@
-// NeuroScript Version: 0.9.72 Allow double-bracket strings and AT symbol
+// NeuroScript Version: 0.9.80 Keyword argument names
 grammar NeuroScript;
 
 // --- LEXER RULES --- (Lexer rules are unchanged)
@@ -14,11 +14,14 @@ KW_ASK: 'ask';
 KW_ATAN: 'atan';
 KW_BREAK: 'break';
 KW_CALL: 'call';
+KW_CASE: 'case';
+KW_CATCH: 'catch';
 KW_CLEAR: 'clear';
 KW_CLEAR_ERROR: 'clear_error';
 KW_COMMAND: 'command';
 KW_CONTINUE: 'continue';
 KW_COS: 'cos';
+KW_DEFAULT: 'default';
 KW_DO: 'do';
 KW_EACH: 'each';
 KW_ELSE: 'else';
@@ -28,12 +31,15 @@ KW_ENDFOR: 'endfor';
 KW_ENDFUNC: 'endfunc';
 KW_ENDIF: 'endif';
 KW_ENDON: 'endon';
+KW_ENDSWITCH: 'endswitch';
+KW_ENDTRY: 'endtry';
 KW_ENDWHILE: 'endwhile';
 KW_ERROR: 'error';
 KW_EVAL: 'eval';
 KW_EVENT: 'event';
 KW_FAIL: 'fail';
 KW_FALSE: 'false';
+KW_FINALLY: 'finally';
 KW_FOR: 'for';
 KW_FUNC: 'func';
 KW_FUZZY: 'fuzzy';
@@ -56,16 +62,20 @@ KW_ON: 'on';
 KW_OPTIONAL: 'optional';
 KW_OR: 'or';
 KW_PROMPTUSER: 'promptuser';
+KW_RAISE: 'raise';
 KW_RETURN: 'return';
 KW_RETURNS: 'returns';
 KW_SET: 'set';
 KW_SIN: 'sin';
 KW_SOME: 'some';
+KW_SWITCH: 'switch';
 KW_TAN: 'tan';
 KW_TIMEDATE: 'timedate';
 KW_TOOL: 'tool';
 KW_TRUE: 'true';
+KW_TRY: 'try';
 KW_TYPEOF: 'typeof';
+KW_WHERE: 'where';
 KW_WHILE: 'while';
 KW_WHISPER: 'whisper';
 KW_WITH: 'with';
@@ -173,7 +183,8 @@ simple_command_statement:
 	| ask_stmt
 	| promptuser_stmt
 	| break_statement
-	| continue_statement;
+	| continue_statement
+	| raise_statement;
 
 procedure_definition:
 	KW_FUNC IDENTIFIER signature_part KW_MEANS NEWLINE metadata_block non_empty_statement_list
@@ -184,7 +195,7 @@ signature_part:
 	| /* empty */;
 
 needs_clause: KW_NEEDS param_list;
-optional_clause: KW_OPTIONAL param_list;
+optional_clause: KW_OPTIONAL optional_param_list;
 returns_clause: KW_RETURNS param_list;
 param_list: IDENTIFIER (COMMA IDENTIFIER)*;
 metadata_block: (METADATA_LINE NEWLINE)*;
@@ -210,7 +221,8 @@ simple_statement:
 	| ask_stmt
 	| promptuser_stmt
 	| break_statement
-	| continue_statement;
+	| continue_statement
+	| raise_statement;
 
 // FIX: 'expression_statement' is removed. Statements must be explicit keywords.
 // expression_statement: expression;
@@ -218,14 +230,17 @@ simple_statement:
 block_statement:
 	if_statement
 	| while_statement
-	| for_each_statement;
+	| for_each_statement
+	| switch_statement
+	| try_statement;
 
 on_stmt: KW_ON ( error_handler | event_handler);
 error_handler:
 	KW_ERROR KW_DO NEWLINE non_empty_statement_list KW_ENDON;
 event_handler:
-	KW_EVENT expression (KW_NAMED STRING_LIT)? (KW_AS IDENTIFIER)? KW_DO NEWLINE
-		non_empty_statement_list KW_ENDON;
+	KW_EVENT expression (KW_WHERE expression)? (KW_NAMED STRING_LIT)? (
+		KW_AS IDENTIFIER
+	)? KW_DO NEWLINE non_empty_statement_list KW_ENDON;
 
 clearEventStmt:
 	KW_CLEAR KW_EVENT (expression | KW_NAMED STRING_LIT);
@@ -249,9 +264,7 @@ promptuser_stmt: KW_PROMPTUSER expression KW_INTO lvalue;
 break_statement: KW_BREAK;
 continue_statement: KW_CONTINUE;
 if_statement:
-	KW_IF expression NEWLINE non_empty_statement_list (
-		KW_ELSE NEWLINE non_empty_statement_list
-	)? KW_ENDIF;
+	KW_IF expression NEWLINE non_empty_statement_list else_clause? KW_ENDIF;
 while_statement:
 	KW_WHILE expression NEWLINE non_empty_statement_list KW_ENDWHILE;
 for_each_statement:
@@ -278,7 +291,8 @@ unary_expr: (MINUS | KW_NOT | KW_NO | KW_SOME | TILDE) unary_expr
 	| KW_TYPEOF unary_expr
 	| power_expr;
 power_expr: accessor_expr (STAR_STAR power_expr)?;
-accessor_expr: primary ( LBRACK expression RBRACK)*;
+accessor_expr:
+	primary (LBRACK subscript RBRACK | DOT IDENTIFIER)*;
 primary:
 	literal
 	| placeholder
@@ -286,7 +300,8 @@ primary:
 	| KW_LAST
 	| callable_expr
 	| KW_EVAL LPAREN expression RPAREN
-	| LPAREN expression RPAREN;
+	| LPAREN expression RPAREN
+	| lambda_expr;
 callable_expr: (
 		call_target
 		| KW_LN
@@ -298,7 +313,7 @@ callable_expr: (
 		| KW_ACOS
 		| KW_ATAN
 		| KW_LEN
-	) LPAREN expression_list_opt RPAREN;
+	) LPAREN argument_list_opt RPAREN;
 // FIX: Changed placeholder to use two RBRACE tokens instead of a custom token.
 placeholder:
 	PLACEHOLDER_START (AT? IDENTIFIER | KW_LAST) RBRACE RBRACE;
@@ -321,4 +336,76 @@ expression_list: expression (COMMA expression)*;
 map_entry_list_opt: map_entry_list?;
 map_entry_list: map_entry (COMMA map_entry)*;
 map_entry:
-	expression COLON expression; // CHANGED: Allowed expression on LHS
\ No newline at end of file
+	expression COLON expression; // CHANGED: Allowed expression on LHS
+
+// --- Call arguments and parameter defaults ---
+// Positional arguments come first; 'name: value' arguments follow. A name
+// may be a keyword, so tool arguments such as 'default' or 'event' can be named.
+argument_list_opt: argument_list?;
+argument_list: argument (COMMA argument)*;
+argument: (
+		(
+			IDENTIFIER
+			| KW_ACOS | KW_AND | KW_AS | KW_ASIN | KW_ASK | KW_ATAN
+			| KW_BREAK | KW_CALL | KW_CASE | KW_CATCH | KW_CLEAR | KW_CLEAR_ERROR
+			| KW_COMMAND | KW_CONTINUE | KW_COS | KW_DEFAULT | KW_DO | KW_EACH
+			| KW_ELSE | KW_EMIT | KW_ENDCOMMAND | KW_ENDFOR | KW_ENDFUNC | KW_ENDIF
+			| KW_ENDON | KW_ENDSWITCH | KW_ENDTRY | KW_ENDWHILE | KW_ERROR | KW_EVAL
+			| KW_EVENT | KW_FAIL | KW_FALSE | KW_FINALLY | KW_FOR | KW_FUNC
+			| KW_FUZZY | KW_IF | KW_IN | KW_INTO | KW_LAST | KW_LEN
+			| KW_LN | KW_LOG | KW_MEANS | KW_MUST | KW_MUSTBE | KW_NAMED
+			| KW_NEEDS | KW_NIL | KW_NO | KW_NOT | KW_ON | KW_OPTIONAL
+			| KW_OR | KW_PROMPTUSER | KW_RAISE | KW_RETURN | KW_RETURNS | KW_SET
+			| KW_SIN | KW_SOME | KW_SWITCH | KW_TAN | KW_TIMEDATE | KW_TOOL
+			| KW_TRUE | KW_TRY | KW_TYPEOF | KW_WHERE | KW_WHILE | KW_WHISPER
+			| KW_WITH
+		) COLON
+	)? expression;
+optional_param_list: optional_param (COMMA optional_param)*;
+optional_param: IDENTIFIER (ASSIGN expression)?;
+
+// --- Function literals ---
+// 'func(x) means x * 2', or a statement body ending in 'endfunc'.
+lambda_expr:
+	KW_FUNC LPAREN param_list? RPAREN KW_MEANS (
+		expression
+		| NEWLINE non_empty_statement_list KW_ENDFUNC
+	);
+
+// --- Else-if chains and switch ---
+// 'else if' nests another if in the else branch; one 'endif' closes the chain.
+else_clause:
+	KW_ELSE (
+		KW_IF expression NEWLINE non_empty_statement_list else_clause?
+		| NEWLINE non_empty_statement_list
+	);
+switch_statement:
+	KW_SWITCH expression NEWLINE+ switch_case* (
+		KW_DEFAULT NEWLINE non_empty_statement_list
+	)? KW_ENDSWITCH;
+// 'case 1, 2' compares values, 'case in list' tests membership and
+// 'case typeof "string"' compares the subject's type.
+switch_case:
+	KW_CASE (
+		KW_IN expression
+		| KW_TYPEOF expression_list
+		| expression_list
+	) NEWLINE non_empty_statement_list;
+
+// --- Structured error handling ---
+// 'catch err' binds the caught error; the finally block always runs. At least
+// one of catch and finally is required (checked by the AST builder).
+try_statement:
+	KW_TRY NEWLINE non_empty_statement_list (
+		KW_CATCH IDENTIFIER? NEWLINE non_empty_statement_list
+	)? (KW_FINALLY NEWLINE non_empty_statement_list)? KW_ENDTRY;
+
+// --- Subscripts ---
+// 'a[i]' indexes; 'a[i:j]', 'a[:j]' and 'a[i:]' slice. Negative bounds count
+// from the end.
+subscript: expression (COLON expression?)? | COLON expression?;
+
+// --- Raised Events ---
+// 'raise event "name" with payload' delivers an event to the 'on event'
+// handlers registered in the same interpreter.
+raise_statement: KW_RAISE KW_EVENT expression (KW_WITH expression)?;
@@ -461,3 +461,75 @@ func (s *BaseNeuroScriptListener) EnterMap_entry(ctx *Map_entryContext) {}
 
 // ExitMap_entry is called when production map_entry is exited.
 func (s *BaseNeuroScriptListener) ExitMap_entry(ctx *Map_entryContext) {}
+
+// EnterArgument_list_opt is called when production argument_list_opt is entered.
+func (s *BaseNeuroScriptListener) EnterArgument_list_opt(ctx *Argument_list_optContext) {}
+
+// ExitArgument_list_opt is called when production argument_list_opt is exited.
+func (s *BaseNeuroScriptListener) ExitArgument_list_opt(ctx *Argument_list_optContext) {}
+
+// EnterArgument_list is called when production argument_list is entered.
+func (s *BaseNeuroScriptListener) EnterArgument_list(ctx *Argument_listContext) {}
+
+// ExitArgument_list is called when production argument_list is exited.
+func (s *BaseNeuroScriptListener) ExitArgument_list(ctx *Argument_listContext) {}
+
+// EnterArgument is called when production argument is entered.
+func (s *BaseNeuroScriptListener) EnterArgument(ctx *ArgumentContext) {}
+
+// ExitArgument is called when production argument is exited.
+func (s *BaseNeuroScriptListener) ExitArgument(ctx *ArgumentContext) {}
+
+// EnterOptional_param_list is called when production optional_param_list is entered.
+func (s *BaseNeuroScriptListener) EnterOptional_param_list(ctx *Optional_param_listContext) {}
+
+// ExitOptional_param_list is called when production optional_param_list is exited.
+func (s *BaseNeuroScriptListener) ExitOptional_param_list(ctx *Optional_param_listContext) {}
+
+// EnterOptional_param is called when production optional_param is entered.
+func (s *BaseNeuroScriptListener) EnterOptional_param(ctx *Optional_paramContext) {}
+
+// ExitOptional_param is called when production optional_param is exited.
+func (s *BaseNeuroScriptListener) ExitOptional_param(ctx *Optional_paramContext) {}
+
+// EnterLambda_expr is called when production lambda_expr is entered.
+func (s *BaseNeuroScriptListener) EnterLambda_expr(ctx *Lambda_exprContext) {}
+
+// ExitLambda_expr is called when production lambda_expr is exited.
+func (s *BaseNeuroScriptListener) ExitLambda_expr(ctx *Lambda_exprContext) {}
+
+// EnterElse_clause is called when production else_clause is entered.
+func (s *BaseNeuroScriptListener) EnterElse_clause(ctx *Else_clauseContext) {}
+
+// ExitElse_clause is called when production else_clause is exited.
+func (s *BaseNeuroScriptListener) ExitElse_clause(ctx *Else_clauseContext) {}
+
+// EnterSwitch_statement is called when production switch_statement is entered.
+func (s *BaseNeuroScriptListener) EnterSwitch_statement(ctx *Switch_statementContext) {}
+
+// ExitSwitch_statement is called when production switch_statement is exited.
+func (s *BaseNeuroScriptListener) ExitSwitch_statement(ctx *Switch_statementContext) {}
+
+// EnterSwitch_case is called when production switch_case is entered.
+func (s *BaseNeuroScriptListener) EnterSwitch_case(ctx *Switch_caseContext) {}
+
+// ExitSwitch_case is called when production switch_case is exited.
+func (s *BaseNeuroScriptListener) ExitSwitch_case(ctx *Switch_caseContext) {}
+
+// EnterTry_statement is called when production try_statement is entered.
+func (s *BaseNeuroScriptListener) EnterTry_statement(ctx *Try_statementContext) {}
+
+// ExitTry_statement is called when production try_statement is exited.
+func (s *BaseNeuroScriptListener) ExitTry_statement(ctx *Try_statementContext) {}
+
+// EnterSubscript is called when production subscript is entered.
+func (s *BaseNeuroScriptListener) EnterSubscript(ctx *SubscriptContext) {}
+
+// ExitSubscript is called when production subscript is exited.
+func (s *BaseNeuroScriptListener) ExitSubscript(ctx *SubscriptContext) {}
+
+// EnterRaise_statement is called when production raise_statement is entered.
+func (s *BaseNeuroScriptListener) EnterRaise_statement(ctx *Raise_statementContext) {}
+
+// ExitRaise_statement is called when production raise_statement is exited.
+func (s *BaseNeuroScriptListener) ExitRaise_statement(ctx *Raise_statementContext) {}
@@ -298,3 +298,51 @@ func (v *BaseNeuroScriptVisitor) VisitMap_entry_list(ctx *Map_entry_listContext)
 func (v *BaseNeuroScriptVisitor) VisitMap_entry(ctx *Map_entryContext) interface{} {
 	return v.VisitChildren(ctx)
 }
+
+func (v *BaseNeuroScriptVisitor) VisitArgument_list_opt(ctx *Argument_list_optContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseNeuroScriptVisitor) VisitArgument_list(ctx *Argument_listContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseNeuroScriptVisitor) VisitArgument(ctx *ArgumentContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseNeuroScriptVisitor) VisitOptional_param_list(ctx *Optional_param_listContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseNeuroScriptVisitor) VisitOptional_param(ctx *Optional_paramContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseNeuroScriptVisitor) VisitLambda_expr(ctx *Lambda_exprContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseNeuroScriptVisitor) VisitElse_clause(ctx *Else_clauseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseNeuroScriptVisitor) VisitSwitch_statement(ctx *Switch_statementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseNeuroScriptVisitor) VisitSwitch_case(ctx *Switch_caseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseNeuroScriptVisitor) VisitTry_statement(ctx *Try_statementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseNeuroScriptVisitor) VisitSubscript(ctx *SubscriptContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseNeuroScriptVisitor) VisitRaise_statement(ctx *Raise_statementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
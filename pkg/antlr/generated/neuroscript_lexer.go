@@ -44,50 +44,56 @@ func neuroscriptlexerLexerInit() {
 	}
 	staticData.LiteralNames = []string{
 		"", "", "'acos'", "'and'", "'as'", "'asin'", "'ask'", "'atan'", "'break'",
-		"'call'", "'clear'", "'clear_error'", "'command'", "'continue'", "'cos'",
-		"'do'", "'each'", "'else'", "'emit'", "'endcommand'", "'endfor'", "'endfunc'",
-		"'endif'", "'endon'", "'endwhile'", "'error'", "'eval'", "'event'",
-		"'fail'", "'false'", "'for'", "'func'", "'fuzzy'", "'if'", "'in'", "'into'",
-		"'last'", "'len'", "'ln'", "'log'", "'means'", "'must'", "'mustbe'",
+		"'call'", "'case'", "'catch'", "'clear'", "'clear_error'", "'command'",
+		"'continue'", "'cos'", "'default'", "'do'", "'each'", "'else'", "'emit'",
+		"'endcommand'", "'endfor'", "'endfunc'", "'endif'", "'endon'", "'endswitch'",
+		"'endtry'", "'endwhile'", "'error'", "'eval'", "'event'", "'fail'",
+		"'false'", "'finally'", "'for'", "'func'", "'fuzzy'", "'if'", "'in'",
+		"'into'", "'last'", "'len'", "'ln'", "'log'", "'means'", "'must'", "'mustbe'",
 		"'named'", "'needs'", "'nil'", "'no'", "'not'", "'on'", "'optional'",
-		"'or'", "'promptuser'", "'return'", "'returns'", "'set'", "'sin'", "'some'",
-		"'tan'", "'timedate'", "'tool'", "'true'", "'typeof'", "'while'", "'whisper'",
-		"'with'", "'@'", "", "", "", "", "", "", "", "'='", "'+'", "'-'", "'*'",
-		"'/'", "'%'", "'**'", "'&'", "'|'", "'^'", "'~'", "'('", "')'", "','",
-		"'['", "']'", "'{'", "'}'", "':'", "'.'", "'{{'", "'=='", "'!='", "'>'",
-		"'<'", "'>='", "'<='",
+		"'or'", "'promptuser'", "'raise'", "'return'", "'returns'", "'set'",
+		"'sin'", "'some'", "'switch'", "'tan'", "'timedate'", "'tool'", "'true'",
+		"'try'", "'typeof'", "'where'", "'while'", "'whisper'", "'with'", "'@'",
+		"", "", "", "", "", "", "", "'='", "'+'", "'-'", "'*'", "'/'", "'%'",
+		"'**'", "'&'", "'|'", "'^'", "'~'", "'('", "')'", "','", "'['", "']'",
+		"'{'", "'}'", "':'", "'.'", "'{{'", "'=='", "'!='", "'>'", "'<'", "'>='",
+		"'<='",
 	}
 	staticData.SymbolicNames = []string{
 		"", "LINE_ESCAPE_GLOBAL", "KW_ACOS", "KW_AND", "KW_AS", "KW_ASIN", "KW_ASK",
-		"KW_ATAN", "KW_BREAK", "KW_CALL", "KW_CLEAR", "KW_CLEAR_ERROR", "KW_COMMAND",
-		"KW_CONTINUE", "KW_COS", "KW_DO", "KW_EACH", "KW_ELSE", "KW_EMIT", "KW_ENDCOMMAND",
-		"KW_ENDFOR", "KW_ENDFUNC", "KW_ENDIF", "KW_ENDON", "KW_ENDWHILE", "KW_ERROR",
-		"KW_EVAL", "KW_EVENT", "KW_FAIL", "KW_FALSE", "KW_FOR", "KW_FUNC", "KW_FUZZY",
-		"KW_IF", "KW_IN", "KW_INTO", "KW_LAST", "KW_LEN", "KW_LN", "KW_LOG",
-		"KW_MEANS", "KW_MUST", "KW_MUSTBE", "KW_NAMED", "KW_NEEDS", "KW_NIL",
-		"KW_NO", "KW_NOT", "KW_ON", "KW_OPTIONAL", "KW_OR", "KW_PROMPTUSER",
-		"KW_RETURN", "KW_RETURNS", "KW_SET", "KW_SIN", "KW_SOME", "KW_TAN",
-		"KW_TIMEDATE", "KW_TOOL", "KW_TRUE", "KW_TYPEOF", "KW_WHILE", "KW_WHISPER",
-		"KW_WITH", "AT", "STRING_LIT", "TRIPLE_BACKTICK_STRING", "TRIPLE_SQ_STRING",
-		"DOUBLE_BRACKET_STRING", "METADATA_LINE", "NUMBER_LIT", "IDENTIFIER",
-		"ASSIGN", "PLUS", "MINUS", "STAR", "SLASH", "PERCENT", "STAR_STAR",
-		"AMPERSAND", "PIPE", "CARET", "TILDE", "LPAREN", "RPAREN", "COMMA",
-		"LBRACK", "RBRACK", "LBRACE", "RBRACE", "COLON", "DOT", "PLACEHOLDER_START",
-		"EQ", "NEQ", "GT", "LT", "GTE", "LTE", "LINE_COMMENT", "NEWLINE", "WS",
+		"KW_ATAN", "KW_BREAK", "KW_CALL", "KW_CASE", "KW_CATCH", "KW_CLEAR",
+		"KW_CLEAR_ERROR", "KW_COMMAND", "KW_CONTINUE", "KW_COS", "KW_DEFAULT",
+		"KW_DO", "KW_EACH", "KW_ELSE", "KW_EMIT", "KW_ENDCOMMAND", "KW_ENDFOR",
+		"KW_ENDFUNC", "KW_ENDIF", "KW_ENDON", "KW_ENDSWITCH", "KW_ENDTRY", "KW_ENDWHILE",
+		"KW_ERROR", "KW_EVAL", "KW_EVENT", "KW_FAIL", "KW_FALSE", "KW_FINALLY",
+		"KW_FOR", "KW_FUNC", "KW_FUZZY", "KW_IF", "KW_IN", "KW_INTO", "KW_LAST",
+		"KW_LEN", "KW_LN", "KW_LOG", "KW_MEANS", "KW_MUST", "KW_MUSTBE", "KW_NAMED",
+		"KW_NEEDS", "KW_NIL", "KW_NO", "KW_NOT", "KW_ON", "KW_OPTIONAL", "KW_OR",
+		"KW_PROMPTUSER", "KW_RAISE", "KW_RETURN", "KW_RETURNS", "KW_SET", "KW_SIN",
+		"KW_SOME", "KW_SWITCH", "KW_TAN", "KW_TIMEDATE", "KW_TOOL", "KW_TRUE",
+		"KW_TRY", "KW_TYPEOF", "KW_WHERE", "KW_WHILE", "KW_WHISPER", "KW_WITH",
+		"AT", "STRING_LIT", "TRIPLE_BACKTICK_STRING", "TRIPLE_SQ_STRING", "DOUBLE_BRACKET_STRING",
+		"METADATA_LINE", "NUMBER_LIT", "IDENTIFIER", "ASSIGN", "PLUS", "MINUS",
+		"STAR", "SLASH", "PERCENT", "STAR_STAR", "AMPERSAND", "PIPE", "CARET",
+		"TILDE", "LPAREN", "RPAREN", "COMMA", "LBRACK", "RBRACK", "LBRACE",
+		"RBRACE", "COLON", "DOT", "PLACEHOLDER_START", "EQ", "NEQ", "GT", "LT",
+		"GTE", "LTE", "LINE_COMMENT", "NEWLINE", "WS",
 	}
 	staticData.RuleNames = []string{
 		"LINE_ESCAPE_GLOBAL", "KW_ACOS", "KW_AND", "KW_AS", "KW_ASIN", "KW_ASK",
-		"KW_ATAN", "KW_BREAK", "KW_CALL", "KW_CLEAR", "KW_CLEAR_ERROR", "KW_COMMAND",
-		"KW_CONTINUE", "KW_COS", "KW_DO", "KW_EACH", "KW_ELSE", "KW_EMIT", "KW_ENDCOMMAND",
-		"KW_ENDFOR", "KW_ENDFUNC", "KW_ENDIF", "KW_ENDON", "KW_ENDWHILE", "KW_ERROR",
-		"KW_EVAL", "KW_EVENT", "KW_FAIL", "KW_FALSE", "KW_FOR", "KW_FUNC", "KW_FUZZY",
-		"KW_IF", "KW_IN", "KW_INTO", "KW_LAST", "KW_LEN", "KW_LN", "KW_LOG",
-		"KW_MEANS", "KW_MUST", "KW_MUSTBE", "KW_NAMED", "KW_NEEDS", "KW_NIL",
-		"KW_NO", "KW_NOT", "KW_ON", "KW_OPTIONAL", "KW_OR", "KW_PROMPTUSER",
-		"KW_RETURN", "KW_RETURNS", "KW_SET", "KW_SIN", "KW_SOME", "KW_TAN",
-		"KW_TIMEDATE", "KW_TOOL", "KW_TRUE", "KW_TYPEOF", "KW_WHILE", "KW_WHISPER",
-		"KW_WITH", "AT", "CONTINUED_LINE", "STRING_DQ_ATOM", "STRING_SQ_ATOM",
-		"STRING_LIT", "TRIPLE_BACKTICK_STRING", "TRIPLE_SQ_STRING", "DOUBLE_BRACKET_STRING",
+		"KW_ATAN", "KW_BREAK", "KW_CALL", "KW_CASE", "KW_CATCH", "KW_CLEAR",
+		"KW_CLEAR_ERROR", "KW_COMMAND", "KW_CONTINUE", "KW_COS", "KW_DEFAULT",
+		"KW_DO", "KW_EACH", "KW_ELSE", "KW_EMIT", "KW_ENDCOMMAND", "KW_ENDFOR",
+		"KW_ENDFUNC", "KW_ENDIF", "KW_ENDON", "KW_ENDSWITCH", "KW_ENDTRY", "KW_ENDWHILE",
+		"KW_ERROR", "KW_EVAL", "KW_EVENT", "KW_FAIL", "KW_FALSE", "KW_FINALLY",
+		"KW_FOR", "KW_FUNC", "KW_FUZZY", "KW_IF", "KW_IN", "KW_INTO", "KW_LAST",
+		"KW_LEN", "KW_LN", "KW_LOG", "KW_MEANS", "KW_MUST", "KW_MUSTBE", "KW_NAMED",
+		"KW_NEEDS", "KW_NIL", "KW_NO", "KW_NOT", "KW_ON", "KW_OPTIONAL", "KW_OR",
+		"KW_PROMPTUSER", "KW_RAISE", "KW_RETURN", "KW_RETURNS", "KW_SET", "KW_SIN",
+		"KW_SOME", "KW_SWITCH", "KW_TAN", "KW_TIMEDATE", "KW_TOOL", "KW_TRUE",
+		"KW_TRY", "KW_TYPEOF", "KW_WHERE", "KW_WHILE", "KW_WHISPER", "KW_WITH",
+		"AT", "CONTINUED_LINE", "STRING_DQ_ATOM", "STRING_SQ_ATOM", "STRING_LIT",
+		"TRIPLE_BACKTICK_STRING", "TRIPLE_SQ_STRING", "DOUBLE_BRACKET_STRING",
 		"METADATA_CONTENT_ATOM", "METADATA_LINE", "NUMBER_LIT", "IDENTIFIER",
 		"ASSIGN", "PLUS", "MINUS", "STAR", "SLASH", "PERCENT", "STAR_STAR",
 		"AMPERSAND", "PIPE", "CARET", "TILDE", "LPAREN", "RPAREN", "COMMA",
@@ -98,7 +104,7 @@ func neuroscriptlexerLexerInit() {
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 0, 102, 840, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3,
+		4, 0, 112, 927, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3,
 		2, 4, 7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9,
 		2, 10, 7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2,
 		15, 7, 15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20,
@@ -119,70 +125,79 @@ func neuroscriptlexerLexerInit() {
 		2, 94, 7, 94, 2, 95, 7, 95, 2, 96, 7, 96, 2, 97, 7, 97, 2, 98, 7, 98, 2,
 		99, 7, 99, 2, 100, 7, 100, 2, 101, 7, 101, 2, 102, 7, 102, 2, 103, 7, 103,
 		2, 104, 7, 104, 2, 105, 7, 105, 2, 106, 7, 106, 2, 107, 7, 107, 2, 108,
-		7, 108, 2, 109, 7, 109, 2, 110, 7, 110, 2, 111, 7, 111, 1, 0, 1, 0, 3,
-		0, 228, 8, 0, 1, 0, 1, 0, 3, 0, 232, 8, 0, 1, 0, 1, 0, 1, 1, 1, 1, 1, 1,
-		1, 1, 1, 1, 1, 2, 1, 2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 3, 1, 4, 1, 4, 1, 4,
-		1, 4, 1, 4, 1, 5, 1, 5, 1, 5, 1, 5, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 7,
-		1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 9, 1, 9,
-		1, 9, 1, 9, 1, 9, 1, 9, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10,
-		1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1,
-		11, 1, 11, 1, 11, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12,
-		1, 12, 1, 13, 1, 13, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 15, 1, 15, 1,
-		15, 1, 15, 1, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 17, 1, 17, 1, 17,
-		1, 17, 1, 17, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1,
-		18, 1, 18, 1, 18, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 20,
-		1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 21, 1, 21, 1, 21, 1,
-		21, 1, 21, 1, 21, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 23, 1, 23,
-		1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 24, 1, 24, 1, 24, 1,
-		24, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 26, 1, 26, 1, 26,
-		1, 26, 1, 26, 1, 26, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 28, 1, 28, 1,
-		28, 1, 28, 1, 28, 1, 28, 1, 29, 1, 29, 1, 29, 1, 29, 1, 30, 1, 30, 1, 30,
-		1, 30, 1, 30, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 32, 1, 32, 1,
-		32, 1, 33, 1, 33, 1, 33, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 35, 1, 35,
-		1, 35, 1, 35, 1, 35, 1, 36, 1, 36, 1, 36, 1, 36, 1, 37, 1, 37, 1, 37, 1,
-		38, 1, 38, 1, 38, 1, 38, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 40,
-		1, 40, 1, 40, 1, 40, 1, 40, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1,
-		41, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 43, 1, 43, 1, 43, 1, 43,
-		1, 43, 1, 43, 1, 44, 1, 44, 1, 44, 1, 44, 1, 45, 1, 45, 1, 45, 1, 46, 1,
-		46, 1, 46, 1, 46, 1, 47, 1, 47, 1, 47, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48,
-		1, 48, 1, 48, 1, 48, 1, 48, 1, 49, 1, 49, 1, 49, 1, 50, 1, 50, 1, 50, 1,
-		50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 51, 1, 51, 1, 51,
-		1, 51, 1, 51, 1, 51, 1, 51, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1,
-		52, 1, 52, 1, 53, 1, 53, 1, 53, 1, 53, 1, 54, 1, 54, 1, 54, 1, 54, 1, 55,
-		1, 55, 1, 55, 1, 55, 1, 55, 1, 56, 1, 56, 1, 56, 1, 56, 1, 57, 1, 57, 1,
-		57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 58, 1, 58, 1, 58, 1, 58,
-		1, 58, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 60, 1, 60, 1, 60, 1, 60, 1,
-		60, 1, 60, 1, 60, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 62, 1, 62,
-		1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 63, 1, 63, 1, 63, 1, 63, 1,
-		63, 1, 64, 1, 64, 1, 65, 1, 65, 3, 65, 595, 8, 65, 1, 65, 1, 65, 3, 65,
-		599, 8, 65, 1, 66, 1, 66, 1, 66, 3, 66, 604, 8, 66, 1, 67, 1, 67, 1, 67,
-		3, 67, 609, 8, 67, 1, 68, 1, 68, 5, 68, 613, 8, 68, 10, 68, 12, 68, 616,
-		9, 68, 1, 68, 1, 68, 1, 68, 5, 68, 621, 8, 68, 10, 68, 12, 68, 624, 9,
-		68, 1, 68, 3, 68, 627, 8, 68, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 5, 69,
-		634, 8, 69, 10, 69, 12, 69, 637, 9, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1,
-		70, 1, 70, 1, 70, 1, 70, 1, 70, 5, 70, 648, 8, 70, 10, 70, 12, 70, 651,
-		9, 70, 1, 70, 1, 70, 1, 70, 1, 70, 1, 71, 1, 71, 1, 71, 1, 71, 5, 71, 661,
-		8, 71, 10, 71, 12, 71, 664, 9, 71, 1, 71, 1, 71, 1, 71, 1, 72, 1, 72, 1,
-		72, 3, 72, 672, 8, 72, 1, 73, 5, 73, 675, 8, 73, 10, 73, 12, 73, 678, 9,
-		73, 1, 73, 1, 73, 1, 73, 1, 73, 4, 73, 684, 8, 73, 11, 73, 12, 73, 685,
-		1, 73, 5, 73, 689, 8, 73, 10, 73, 12, 73, 692, 9, 73, 1, 74, 4, 74, 695,
-		8, 74, 11, 74, 12, 74, 696, 1, 74, 1, 74, 4, 74, 701, 8, 74, 11, 74, 12,
-		74, 702, 3, 74, 705, 8, 74, 1, 74, 1, 74, 3, 74, 709, 8, 74, 1, 74, 4,
-		74, 712, 8, 74, 11, 74, 12, 74, 713, 3, 74, 716, 8, 74, 1, 75, 1, 75, 5,
-		75, 720, 8, 75, 10, 75, 12, 75, 723, 9, 75, 1, 76, 1, 76, 1, 77, 1, 77,
-		1, 78, 1, 78, 1, 79, 1, 79, 1, 80, 1, 80, 1, 81, 1, 81, 1, 82, 1, 82, 1,
-		82, 1, 83, 1, 83, 1, 84, 1, 84, 1, 85, 1, 85, 1, 86, 1, 86, 1, 87, 1, 87,
-		1, 88, 1, 88, 1, 89, 1, 89, 1, 90, 1, 90, 1, 91, 1, 91, 1, 92, 1, 92, 1,
-		93, 1, 93, 1, 94, 1, 94, 1, 95, 1, 95, 1, 96, 1, 96, 1, 96, 1, 97, 1, 97,
-		1, 97, 1, 98, 1, 98, 1, 98, 1, 99, 1, 99, 1, 100, 1, 100, 1, 101, 1, 101,
-		1, 101, 1, 102, 1, 102, 1, 102, 1, 103, 1, 103, 1, 103, 1, 103, 1, 103,
-		3, 103, 790, 8, 103, 1, 103, 5, 103, 793, 8, 103, 10, 103, 12, 103, 796,
-		9, 103, 1, 103, 1, 103, 1, 104, 3, 104, 801, 8, 104, 1, 104, 1, 104, 3,
-		104, 805, 8, 104, 1, 105, 4, 105, 808, 8, 105, 11, 105, 12, 105, 809, 1,
-		105, 1, 105, 1, 106, 1, 106, 1, 106, 1, 106, 1, 106, 3, 106, 819, 8, 106,
-		1, 107, 1, 107, 1, 108, 1, 108, 1, 108, 1, 108, 1, 108, 1, 108, 1, 109,
-		1, 109, 1, 109, 1, 109, 1, 110, 3, 110, 834, 8, 110, 1, 110, 1, 110, 1,
-		110, 1, 111, 1, 111, 5, 614, 622, 635, 649, 662, 0, 112, 1, 1, 3, 2, 5,
+		7, 108, 2, 109, 7, 109, 2, 110, 7, 110, 2, 111, 7, 111, 2, 112, 7, 112,
+		2, 113, 7, 113, 2, 114, 7, 114, 2, 115, 7, 115, 2, 116, 7, 116, 2, 117,
+		7, 117, 2, 118, 7, 118, 2, 119, 7, 119, 2, 120, 7, 120, 2, 121, 7, 121,
+		1, 0, 1, 0, 3, 0, 248, 8, 0, 1, 0, 1, 0, 3, 0, 252, 8, 0, 1, 0, 1, 0, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 1, 2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 3, 1,
+		4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 5, 1, 5, 1, 5, 1, 5, 1, 6, 1, 6, 1, 6, 1,
+		6, 1, 6, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 1, 8, 1, 8, 1,
+		8, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1,
+		10, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 12, 1, 12, 1, 12, 1, 12,
+		1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 13, 1, 13, 1,
+		13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14,
+		1, 14, 1, 14, 1, 14, 1, 14, 1, 15, 1, 15, 1, 15, 1, 15, 1, 16, 1, 16, 1,
+		16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 17, 1, 17, 1, 17, 1, 18, 1, 18,
+		1, 18, 1, 18, 1, 18, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 20, 1, 20, 1,
+		20, 1, 20, 1, 20, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21,
+		1, 21, 1, 21, 1, 21, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1,
+		23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 24, 1, 24, 1, 24,
+		1, 24, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 26, 1,
+		26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 27, 1, 27,
+		1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1,
+		28, 1, 28, 1, 28, 1, 28, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 30,
+		1, 30, 1, 30, 1, 30, 1, 30, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1,
+		32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33,
+		1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 35, 1, 35, 1,
+		35, 1, 35, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 37, 1, 37, 1, 37, 1, 37,
+		1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 1, 39, 1, 39, 1, 39, 1, 40, 1, 40, 1,
+		40, 1, 40, 1, 40, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 42, 1, 42, 1, 42,
+		1, 42, 1, 43, 1, 43, 1, 43, 1, 44, 1, 44, 1, 44, 1, 44, 1, 45, 1, 45, 1,
+		45, 1, 45, 1, 45, 1, 45, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 47, 1, 47,
+		1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1,
+		48, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 50, 1, 50, 1, 50, 1, 50,
+		1, 51, 1, 51, 1, 51, 1, 52, 1, 52, 1, 52, 1, 52, 1, 53, 1, 53, 1, 53, 1,
+		54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 55, 1, 55,
+		1, 55, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1,
+		56, 1, 56, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 58, 1, 58, 1, 58,
+		1, 58, 1, 58, 1, 58, 1, 58, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1,
+		59, 1, 59, 1, 60, 1, 60, 1, 60, 1, 60, 1, 61, 1, 61, 1, 61, 1, 61, 1, 62,
+		1, 62, 1, 62, 1, 62, 1, 62, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1,
+		63, 1, 64, 1, 64, 1, 64, 1, 64, 1, 65, 1, 65, 1, 65, 1, 65, 1, 65, 1, 65,
+		1, 65, 1, 65, 1, 65, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 67, 1, 67, 1,
+		67, 1, 67, 1, 67, 1, 68, 1, 68, 1, 68, 1, 68, 1, 69, 1, 69, 1, 69, 1, 69,
+		1, 69, 1, 69, 1, 69, 1, 70, 1, 70, 1, 70, 1, 70, 1, 70, 1, 70, 1, 71, 1,
+		71, 1, 71, 1, 71, 1, 71, 1, 71, 1, 72, 1, 72, 1, 72, 1, 72, 1, 72, 1, 72,
+		1, 72, 1, 72, 1, 73, 1, 73, 1, 73, 1, 73, 1, 73, 1, 74, 1, 74, 1, 75, 1,
+		75, 3, 75, 682, 8, 75, 1, 75, 1, 75, 3, 75, 686, 8, 75, 1, 76, 1, 76, 1,
+		76, 3, 76, 691, 8, 76, 1, 77, 1, 77, 1, 77, 3, 77, 696, 8, 77, 1, 78, 1,
+		78, 5, 78, 700, 8, 78, 10, 78, 12, 78, 703, 9, 78, 1, 78, 1, 78, 1, 78,
+		5, 78, 708, 8, 78, 10, 78, 12, 78, 711, 9, 78, 1, 78, 3, 78, 714, 8, 78,
+		1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 5, 79, 721, 8, 79, 10, 79, 12, 79, 724,
+		9, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 5,
+		80, 735, 8, 80, 10, 80, 12, 80, 738, 9, 80, 1, 80, 1, 80, 1, 80, 1, 80,
+		1, 81, 1, 81, 1, 81, 1, 81, 5, 81, 748, 8, 81, 10, 81, 12, 81, 751, 9,
+		81, 1, 81, 1, 81, 1, 81, 1, 82, 1, 82, 1, 82, 3, 82, 759, 8, 82, 1, 83,
+		5, 83, 762, 8, 83, 10, 83, 12, 83, 765, 9, 83, 1, 83, 1, 83, 1, 83, 1,
+		83, 4, 83, 771, 8, 83, 11, 83, 12, 83, 772, 1, 83, 5, 83, 776, 8, 83, 10,
+		83, 12, 83, 779, 9, 83, 1, 84, 4, 84, 782, 8, 84, 11, 84, 12, 84, 783,
+		1, 84, 1, 84, 4, 84, 788, 8, 84, 11, 84, 12, 84, 789, 3, 84, 792, 8, 84,
+		1, 84, 1, 84, 3, 84, 796, 8, 84, 1, 84, 4, 84, 799, 8, 84, 11, 84, 12,
+		84, 800, 3, 84, 803, 8, 84, 1, 85, 1, 85, 5, 85, 807, 8, 85, 10, 85, 12,
+		85, 810, 9, 85, 1, 86, 1, 86, 1, 87, 1, 87, 1, 88, 1, 88, 1, 89, 1, 89,
+		1, 90, 1, 90, 1, 91, 1, 91, 1, 92, 1, 92, 1, 92, 1, 93, 1, 93, 1, 94, 1,
+		94, 1, 95, 1, 95, 1, 96, 1, 96, 1, 97, 1, 97, 1, 98, 1, 98, 1, 99, 1, 99,
+		1, 100, 1, 100, 1, 101, 1, 101, 1, 102, 1, 102, 1, 103, 1, 103, 1, 104,
+		1, 104, 1, 105, 1, 105, 1, 106, 1, 106, 1, 106, 1, 107, 1, 107, 1, 107,
+		1, 108, 1, 108, 1, 108, 1, 109, 1, 109, 1, 110, 1, 110, 1, 111, 1, 111,
+		1, 111, 1, 112, 1, 112, 1, 112, 1, 113, 1, 113, 1, 113, 1, 113, 1, 113,
+		3, 113, 877, 8, 113, 1, 113, 5, 113, 880, 8, 113, 10, 113, 12, 113, 883,
+		9, 113, 1, 113, 1, 113, 1, 114, 3, 114, 888, 8, 114, 1, 114, 1, 114, 3,
+		114, 892, 8, 114, 1, 115, 4, 115, 895, 8, 115, 11, 115, 12, 115, 896, 1,
+		115, 1, 115, 1, 116, 1, 116, 1, 116, 1, 116, 1, 116, 3, 116, 906, 8, 116,
+		1, 117, 1, 117, 1, 118, 1, 118, 1, 118, 1, 118, 1, 118, 1, 118, 1, 119,
+		1, 119, 1, 119, 1, 119, 1, 120, 3, 120, 921, 8, 120, 1, 120, 1, 120, 1,
+		120, 1, 121, 1, 121, 5, 701, 709, 722, 736, 749, 0, 122, 1, 1, 3, 2, 5,
 		3, 7, 4, 9, 5, 11, 6, 13, 7, 15, 8, 17, 9, 19, 10, 21, 11, 23, 12, 25,
 		13, 27, 14, 29, 15, 31, 16, 33, 17, 35, 18, 37, 19, 39, 20, 41, 21, 43,
 		22, 45, 23, 47, 24, 49, 25, 51, 26, 53, 27, 55, 28, 57, 29, 59, 30, 61,
@@ -190,289 +205,318 @@ func neuroscriptlexerLexerInit() {
 		40, 81, 41, 83, 42, 85, 43, 87, 44, 89, 45, 91, 46, 93, 47, 95, 48, 97,
 		49, 99, 50, 101, 51, 103, 52, 105, 53, 107, 54, 109, 55, 111, 56, 113,
 		57, 115, 58, 117, 59, 119, 60, 121, 61, 123, 62, 125, 63, 127, 64, 129,
-		65, 131, 0, 133, 0, 135, 0, 137, 66, 139, 67, 141, 68, 143, 69, 145, 0,
-		147, 70, 149, 71, 151, 72, 153, 73, 155, 74, 157, 75, 159, 76, 161, 77,
-		163, 78, 165, 79, 167, 80, 169, 81, 171, 82, 173, 83, 175, 84, 177, 85,
+		65, 131, 66, 133, 67, 135, 68, 137, 69, 139, 70, 141, 71, 143, 72, 145,
+		73, 147, 74, 149, 75, 151, 0, 153, 0, 155, 0, 157, 76, 159, 77, 161, 78,
+		163, 79, 165, 0, 167, 80, 169, 81, 171, 82, 173, 83, 175, 84, 177, 85,
 		179, 86, 181, 87, 183, 88, 185, 89, 187, 90, 189, 91, 191, 92, 193, 93,
 		195, 94, 197, 95, 199, 96, 201, 97, 203, 98, 205, 99, 207, 100, 209, 101,
-		211, 102, 213, 0, 215, 0, 217, 0, 219, 0, 221, 0, 223, 0, 1, 0, 14, 4,
-		0, 10, 10, 13, 13, 34, 34, 92, 92, 4, 0, 10, 10, 13, 13, 39, 39, 92, 92,
-		3, 0, 10, 10, 13, 13, 92, 92, 2, 0, 9, 9, 32, 32, 1, 0, 48, 57, 2, 0, 69,
-		69, 101, 101, 2, 0, 43, 43, 45, 45, 3, 0, 65, 90, 95, 95, 97, 122, 4, 0,
-		48, 57, 65, 90, 95, 95, 97, 122, 2, 0, 10, 10, 13, 13, 11, 0, 34, 34, 39,
-		39, 92, 92, 96, 96, 98, 98, 102, 102, 110, 110, 114, 114, 116, 116, 118,
-		118, 126, 126, 1, 0, 48, 51, 1, 0, 48, 55, 3, 0, 48, 57, 65, 70, 97, 102,
-		865, 0, 1, 1, 0, 0, 0, 0, 3, 1, 0, 0, 0, 0, 5, 1, 0, 0, 0, 0, 7, 1, 0,
-		0, 0, 0, 9, 1, 0, 0, 0, 0, 11, 1, 0, 0, 0, 0, 13, 1, 0, 0, 0, 0, 15, 1,
-		0, 0, 0, 0, 17, 1, 0, 0, 0, 0, 19, 1, 0, 0, 0, 0, 21, 1, 0, 0, 0, 0, 23,
-		1, 0, 0, 0, 0, 25, 1, 0, 0, 0, 0, 27, 1, 0, 0, 0, 0, 29, 1, 0, 0, 0, 0,
-		31, 1, 0, 0, 0, 0, 33, 1, 0, 0, 0, 0, 35, 1, 0, 0, 0, 0, 37, 1, 0, 0, 0,
-		0, 39, 1, 0, 0, 0, 0, 41, 1, 0, 0, 0, 0, 43, 1, 0, 0, 0, 0, 45, 1, 0, 0,
-		0, 0, 47, 1, 0, 0, 0, 0, 49, 1, 0, 0, 0, 0, 51, 1, 0, 0, 0, 0, 53, 1, 0,
-		0, 0, 0, 55, 1, 0, 0, 0, 0, 57, 1, 0, 0, 0, 0, 59, 1, 0, 0, 0, 0, 61, 1,
-		0, 0, 0, 0, 63, 1, 0, 0, 0, 0, 65, 1, 0, 0, 0, 0, 67, 1, 0, 0, 0, 0, 69,
-		1, 0, 0, 0, 0, 71, 1, 0, 0, 0, 0, 73, 1, 0, 0, 0, 0, 75, 1, 0, 0, 0, 0,
-		77, 1, 0, 0, 0, 0, 79, 1, 0, 0, 0, 0, 81, 1, 0, 0, 0, 0, 83, 1, 0, 0, 0,
-		0, 85, 1, 0, 0, 0, 0, 87, 1, 0, 0, 0, 0, 89, 1, 0, 0, 0, 0, 91, 1, 0, 0,
-		0, 0, 93, 1, 0, 0, 0, 0, 95, 1, 0, 0, 0, 0, 97, 1, 0, 0, 0, 0, 99, 1, 0,
-		0, 0, 0, 101, 1, 0, 0, 0, 0, 103, 1, 0, 0, 0, 0, 105, 1, 0, 0, 0, 0, 107,
-		1, 0, 0, 0, 0, 109, 1, 0, 0, 0, 0, 111, 1, 0, 0, 0, 0, 113, 1, 0, 0, 0,
-		0, 115, 1, 0, 0, 0, 0, 117, 1, 0, 0, 0, 0, 119, 1, 0, 0, 0, 0, 121, 1,
-		0, 0, 0, 0, 123, 1, 0, 0, 0, 0, 125, 1, 0, 0, 0, 0, 127, 1, 0, 0, 0, 0,
-		129, 1, 0, 0, 0, 0, 137, 1, 0, 0, 0, 0, 139, 1, 0, 0, 0, 0, 141, 1, 0,
-		0, 0, 0, 143, 1, 0, 0, 0, 0, 147, 1, 0, 0, 0, 0, 149, 1, 0, 0, 0, 0, 151,
-		1, 0, 0, 0, 0, 153, 1, 0, 0, 0, 0, 155, 1, 0, 0, 0, 0, 157, 1, 0, 0, 0,
-		0, 159, 1, 0, 0, 0, 0, 161, 1, 0, 0, 0, 0, 163, 1, 0, 0, 0, 0, 165, 1,
-		0, 0, 0, 0, 167, 1, 0, 0, 0, 0, 169, 1, 0, 0, 0, 0, 171, 1, 0, 0, 0, 0,
-		173, 1, 0, 0, 0, 0, 175, 1, 0, 0, 0, 0, 177, 1, 0, 0, 0, 0, 179, 1, 0,
-		0, 0, 0, 181, 1, 0, 0, 0, 0, 183, 1, 0, 0, 0, 0, 185, 1, 0, 0, 0, 0, 187,
-		1, 0, 0, 0, 0, 189, 1, 0, 0, 0, 0, 191, 1, 0, 0, 0, 0, 193, 1, 0, 0, 0,
-		0, 195, 1, 0, 0, 0, 0, 197, 1, 0, 0, 0, 0, 199, 1, 0, 0, 0, 0, 201, 1,
-		0, 0, 0, 0, 203, 1, 0, 0, 0, 0, 205, 1, 0, 0, 0, 0, 207, 1, 0, 0, 0, 0,
-		209, 1, 0, 0, 0, 0, 211, 1, 0, 0, 0, 1, 225, 1, 0, 0, 0, 3, 235, 1, 0,
-		0, 0, 5, 240, 1, 0, 0, 0, 7, 244, 1, 0, 0, 0, 9, 247, 1, 0, 0, 0, 11, 252,
-		1, 0, 0, 0, 13, 256, 1, 0, 0, 0, 15, 261, 1, 0, 0, 0, 17, 267, 1, 0, 0,
-		0, 19, 272, 1, 0, 0, 0, 21, 278, 1, 0, 0, 0, 23, 290, 1, 0, 0, 0, 25, 298,
-		1, 0, 0, 0, 27, 307, 1, 0, 0, 0, 29, 311, 1, 0, 0, 0, 31, 314, 1, 0, 0,
-		0, 33, 319, 1, 0, 0, 0, 35, 324, 1, 0, 0, 0, 37, 329, 1, 0, 0, 0, 39, 340,
-		1, 0, 0, 0, 41, 347, 1, 0, 0, 0, 43, 355, 1, 0, 0, 0, 45, 361, 1, 0, 0,
-		0, 47, 367, 1, 0, 0, 0, 49, 376, 1, 0, 0, 0, 51, 382, 1, 0, 0, 0, 53, 387,
-		1, 0, 0, 0, 55, 393, 1, 0, 0, 0, 57, 398, 1, 0, 0, 0, 59, 404, 1, 0, 0,
-		0, 61, 408, 1, 0, 0, 0, 63, 413, 1, 0, 0, 0, 65, 419, 1, 0, 0, 0, 67, 422,
-		1, 0, 0, 0, 69, 425, 1, 0, 0, 0, 71, 430, 1, 0, 0, 0, 73, 435, 1, 0, 0,
-		0, 75, 439, 1, 0, 0, 0, 77, 442, 1, 0, 0, 0, 79, 446, 1, 0, 0, 0, 81, 452,
-		1, 0, 0, 0, 83, 457, 1, 0, 0, 0, 85, 464, 1, 0, 0, 0, 87, 470, 1, 0, 0,
-		0, 89, 476, 1, 0, 0, 0, 91, 480, 1, 0, 0, 0, 93, 483, 1, 0, 0, 0, 95, 487,
-		1, 0, 0, 0, 97, 490, 1, 0, 0, 0, 99, 499, 1, 0, 0, 0, 101, 502, 1, 0, 0,
-		0, 103, 513, 1, 0, 0, 0, 105, 520, 1, 0, 0, 0, 107, 528, 1, 0, 0, 0, 109,
-		532, 1, 0, 0, 0, 111, 536, 1, 0, 0, 0, 113, 541, 1, 0, 0, 0, 115, 545,
-		1, 0, 0, 0, 117, 554, 1, 0, 0, 0, 119, 559, 1, 0, 0, 0, 121, 564, 1, 0,
-		0, 0, 123, 571, 1, 0, 0, 0, 125, 577, 1, 0, 0, 0, 127, 585, 1, 0, 0, 0,
-		129, 590, 1, 0, 0, 0, 131, 592, 1, 0, 0, 0, 133, 603, 1, 0, 0, 0, 135,
-		608, 1, 0, 0, 0, 137, 626, 1, 0, 0, 0, 139, 628, 1, 0, 0, 0, 141, 642,
-		1, 0, 0, 0, 143, 656, 1, 0, 0, 0, 145, 671, 1, 0, 0, 0, 147, 676, 1, 0,
-		0, 0, 149, 694, 1, 0, 0, 0, 151, 717, 1, 0, 0, 0, 153, 724, 1, 0, 0, 0,
-		155, 726, 1, 0, 0, 0, 157, 728, 1, 0, 0, 0, 159, 730, 1, 0, 0, 0, 161,
-		732, 1, 0, 0, 0, 163, 734, 1, 0, 0, 0, 165, 736, 1, 0, 0, 0, 167, 739,
-		1, 0, 0, 0, 169, 741, 1, 0, 0, 0, 171, 743, 1, 0, 0, 0, 173, 745, 1, 0,
-		0, 0, 175, 747, 1, 0, 0, 0, 177, 749, 1, 0, 0, 0, 179, 751, 1, 0, 0, 0,
-		181, 753, 1, 0, 0, 0, 183, 755, 1, 0, 0, 0, 185, 757, 1, 0, 0, 0, 187,
-		759, 1, 0, 0, 0, 189, 761, 1, 0, 0, 0, 191, 763, 1, 0, 0, 0, 193, 765,
-		1, 0, 0, 0, 195, 768, 1, 0, 0, 0, 197, 771, 1, 0, 0, 0, 199, 774, 1, 0,
-		0, 0, 201, 776, 1, 0, 0, 0, 203, 778, 1, 0, 0, 0, 205, 781, 1, 0, 0, 0,
-		207, 789, 1, 0, 0, 0, 209, 804, 1, 0, 0, 0, 211, 807, 1, 0, 0, 0, 213,
-		813, 1, 0, 0, 0, 215, 820, 1, 0, 0, 0, 217, 822, 1, 0, 0, 0, 219, 828,
-		1, 0, 0, 0, 221, 833, 1, 0, 0, 0, 223, 838, 1, 0, 0, 0, 225, 231, 5, 92,
-		0, 0, 226, 228, 5, 13, 0, 0, 227, 226, 1, 0, 0, 0, 227, 228, 1, 0, 0, 0,
-		228, 229, 1, 0, 0, 0, 229, 232, 5, 10, 0, 0, 230, 232, 5, 13, 0, 0, 231,
-		227, 1, 0, 0, 0, 231, 230, 1, 0, 0, 0, 232, 233, 1, 0, 0, 0, 233, 234,
-		6, 0, 0, 0, 234, 2, 1, 0, 0, 0, 235, 236, 5, 97, 0, 0, 236, 237, 5, 99,
-		0, 0, 237, 238, 5, 111, 0, 0, 238, 239, 5, 115, 0, 0, 239, 4, 1, 0, 0,
-		0, 240, 241, 5, 97, 0, 0, 241, 242, 5, 110, 0, 0, 242, 243, 5, 100, 0,
-		0, 243, 6, 1, 0, 0, 0, 244, 245, 5, 97, 0, 0, 245, 246, 5, 115, 0, 0, 246,
-		8, 1, 0, 0, 0, 247, 248, 5, 97, 0, 0, 248, 249, 5, 115, 0, 0, 249, 250,
-		5, 105, 0, 0, 250, 251, 5, 110, 0, 0, 251, 10, 1, 0, 0, 0, 252, 253, 5,
-		97, 0, 0, 253, 254, 5, 115, 0, 0, 254, 255, 5, 107, 0, 0, 255, 12, 1, 0,
-		0, 0, 256, 257, 5, 97, 0, 0, 257, 258, 5, 116, 0, 0, 258, 259, 5, 97, 0,
-		0, 259, 260, 5, 110, 0, 0, 260, 14, 1, 0, 0, 0, 261, 262, 5, 98, 0, 0,
-		262, 263, 5, 114, 0, 0, 263, 264, 5, 101, 0, 0, 264, 265, 5, 97, 0, 0,
-		265, 266, 5, 107, 0, 0, 266, 16, 1, 0, 0, 0, 267, 268, 5, 99, 0, 0, 268,
-		269, 5, 97, 0, 0, 269, 270, 5, 108, 0, 0, 270, 271, 5, 108, 0, 0, 271,
-		18, 1, 0, 0, 0, 272, 273, 5, 99, 0, 0, 273, 274, 5, 108, 0, 0, 274, 275,
-		5, 101, 0, 0, 275, 276, 5, 97, 0, 0, 276, 277, 5, 114, 0, 0, 277, 20, 1,
-		0, 0, 0, 278, 279, 5, 99, 0, 0, 279, 280, 5, 108, 0, 0, 280, 281, 5, 101,
-		0, 0, 281, 282, 5, 97, 0, 0, 282, 283, 5, 114, 0, 0, 283, 284, 5, 95, 0,
-		0, 284, 285, 5, 101, 0, 0, 285, 286, 5, 114, 0, 0, 286, 287, 5, 114, 0,
-		0, 287, 288, 5, 111, 0, 0, 288, 289, 5, 114, 0, 0, 289, 22, 1, 0, 0, 0,
-		290, 291, 5, 99, 0, 0, 291, 292, 5, 111, 0, 0, 292, 293, 5, 109, 0, 0,
-		293, 294, 5, 109, 0, 0, 294, 295, 5, 97, 0, 0, 295, 296, 5, 110, 0, 0,
-		296, 297, 5, 100, 0, 0, 297, 24, 1, 0, 0, 0, 298, 299, 5, 99, 0, 0, 299,
-		300, 5, 111, 0, 0, 300, 301, 5, 110, 0, 0, 301, 302, 5, 116, 0, 0, 302,
-		303, 5, 105, 0, 0, 303, 304, 5, 110, 0, 0, 304, 305, 5, 117, 0, 0, 305,
-		306, 5, 101, 0, 0, 306, 26, 1, 0, 0, 0, 307, 308, 5, 99, 0, 0, 308, 309,
-		5, 111, 0, 0, 309, 310, 5, 115, 0, 0, 310, 28, 1, 0, 0, 0, 311, 312, 5,
-		100, 0, 0, 312, 313, 5, 111, 0, 0, 313, 30, 1, 0, 0, 0, 314, 315, 5, 101,
-		0, 0, 315, 316, 5, 97, 0, 0, 316, 317, 5, 99, 0, 0, 317, 318, 5, 104, 0,
-		0, 318, 32, 1, 0, 0, 0, 319, 320, 5, 101, 0, 0, 320, 321, 5, 108, 0, 0,
-		321, 322, 5, 115, 0, 0, 322, 323, 5, 101, 0, 0, 323, 34, 1, 0, 0, 0, 324,
-		325, 5, 101, 0, 0, 325, 326, 5, 109, 0, 0, 326, 327, 5, 105, 0, 0, 327,
-		328, 5, 116, 0, 0, 328, 36, 1, 0, 0, 0, 329, 330, 5, 101, 0, 0, 330, 331,
-		5, 110, 0, 0, 331, 332, 5, 100, 0, 0, 332, 333, 5, 99, 0, 0, 333, 334,
-		5, 111, 0, 0, 334, 335, 5, 109, 0, 0, 335, 336, 5, 109, 0, 0, 336, 337,
-		5, 97, 0, 0, 337, 338, 5, 110, 0, 0, 338, 339, 5, 100, 0, 0, 339, 38, 1,
-		0, 0, 0, 340, 341, 5, 101, 0, 0, 341, 342, 5, 110, 0, 0, 342, 343, 5, 100,
-		0, 0, 343, 344, 5, 102, 0, 0, 344, 345, 5, 111, 0, 0, 345, 346, 5, 114,
-		0, 0, 346, 40, 1, 0, 0, 0, 347, 348, 5, 101, 0, 0, 348, 349, 5, 110, 0,
-		0, 349, 350, 5, 100, 0, 0, 350, 351, 5, 102, 0, 0, 351, 352, 5, 117, 0,
-		0, 352, 353, 5, 110, 0, 0, 353, 354, 5, 99, 0, 0, 354, 42, 1, 0, 0, 0,
-		355, 356, 5, 101, 0, 0, 356, 357, 5, 110, 0, 0, 357, 358, 5, 100, 0, 0,
-		358, 359, 5, 105, 0, 0, 359, 360, 5, 102, 0, 0, 360, 44, 1, 0, 0, 0, 361,
-		362, 5, 101, 0, 0, 362, 363, 5, 110, 0, 0, 363, 364, 5, 100, 0, 0, 364,
-		365, 5, 111, 0, 0, 365, 366, 5, 110, 0, 0, 366, 46, 1, 0, 0, 0, 367, 368,
-		5, 101, 0, 0, 368, 369, 5, 110, 0, 0, 369, 370, 5, 100, 0, 0, 370, 371,
-		5, 119, 0, 0, 371, 372, 5, 104, 0, 0, 372, 373, 5, 105, 0, 0, 373, 374,
-		5, 108, 0, 0, 374, 375, 5, 101, 0, 0, 375, 48, 1, 0, 0, 0, 376, 377, 5,
-		101, 0, 0, 377, 378, 5, 114, 0, 0, 378, 379, 5, 114, 0, 0, 379, 380, 5,
-		111, 0, 0, 380, 381, 5, 114, 0, 0, 381, 50, 1, 0, 0, 0, 382, 383, 5, 101,
-		0, 0, 383, 384, 5, 118, 0, 0, 384, 385, 5, 97, 0, 0, 385, 386, 5, 108,
-		0, 0, 386, 52, 1, 0, 0, 0, 387, 388, 5, 101, 0, 0, 388, 389, 5, 118, 0,
-		0, 389, 390, 5, 101, 0, 0, 390, 391, 5, 110, 0, 0, 391, 392, 5, 116, 0,
-		0, 392, 54, 1, 0, 0, 0, 393, 394, 5, 102, 0, 0, 394, 395, 5, 97, 0, 0,
-		395, 396, 5, 105, 0, 0, 396, 397, 5, 108, 0, 0, 397, 56, 1, 0, 0, 0, 398,
-		399, 5, 102, 0, 0, 399, 400, 5, 97, 0, 0, 400, 401, 5, 108, 0, 0, 401,
-		402, 5, 115, 0, 0, 402, 403, 5, 101, 0, 0, 403, 58, 1, 0, 0, 0, 404, 405,
-		5, 102, 0, 0, 405, 406, 5, 111, 0, 0, 406, 407, 5, 114, 0, 0, 407, 60,
-		1, 0, 0, 0, 408, 409, 5, 102, 0, 0, 409, 410, 5, 117, 0, 0, 410, 411, 5,
-		110, 0, 0, 411, 412, 5, 99, 0, 0, 412, 62, 1, 0, 0, 0, 413, 414, 5, 102,
-		0, 0, 414, 415, 5, 117, 0, 0, 415, 416, 5, 122, 0, 0, 416, 417, 5, 122,
-		0, 0, 417, 418, 5, 121, 0, 0, 418, 64, 1, 0, 0, 0, 419, 420, 5, 105, 0,
-		0, 420, 421, 5, 102, 0, 0, 421, 66, 1, 0, 0, 0, 422, 423, 5, 105, 0, 0,
-		423, 424, 5, 110, 0, 0, 424, 68, 1, 0, 0, 0, 425, 426, 5, 105, 0, 0, 426,
-		427, 5, 110, 0, 0, 427, 428, 5, 116, 0, 0, 428, 429, 5, 111, 0, 0, 429,
-		70, 1, 0, 0, 0, 430, 431, 5, 108, 0, 0, 431, 432, 5, 97, 0, 0, 432, 433,
-		5, 115, 0, 0, 433, 434, 5, 116, 0, 0, 434, 72, 1, 0, 0, 0, 435, 436, 5,
-		108, 0, 0, 436, 437, 5, 101, 0, 0, 437, 438, 5, 110, 0, 0, 438, 74, 1,
-		0, 0, 0, 439, 440, 5, 108, 0, 0, 440, 441, 5, 110, 0, 0, 441, 76, 1, 0,
-		0, 0, 442, 443, 5, 108, 0, 0, 443, 444, 5, 111, 0, 0, 444, 445, 5, 103,
-		0, 0, 445, 78, 1, 0, 0, 0, 446, 447, 5, 109, 0, 0, 447, 448, 5, 101, 0,
-		0, 448, 449, 5, 97, 0, 0, 449, 450, 5, 110, 0, 0, 450, 451, 5, 115, 0,
-		0, 451, 80, 1, 0, 0, 0, 452, 453, 5, 109, 0, 0, 453, 454, 5, 117, 0, 0,
-		454, 455, 5, 115, 0, 0, 455, 456, 5, 116, 0, 0, 456, 82, 1, 0, 0, 0, 457,
-		458, 5, 109, 0, 0, 458, 459, 5, 117, 0, 0, 459, 460, 5, 115, 0, 0, 460,
-		461, 5, 116, 0, 0, 461, 462, 5, 98, 0, 0, 462, 463, 5, 101, 0, 0, 463,
-		84, 1, 0, 0, 0, 464, 465, 5, 110, 0, 0, 465, 466, 5, 97, 0, 0, 466, 467,
-		5, 109, 0, 0, 467, 468, 5, 101, 0, 0, 468, 469, 5, 100, 0, 0, 469, 86,
-		1, 0, 0, 0, 470, 471, 5, 110, 0, 0, 471, 472, 5, 101, 0, 0, 472, 473, 5,
-		101, 0, 0, 473, 474, 5, 100, 0, 0, 474, 475, 5, 115, 0, 0, 475, 88, 1,
-		0, 0, 0, 476, 477, 5, 110, 0, 0, 477, 478, 5, 105, 0, 0, 478, 479, 5, 108,
-		0, 0, 479, 90, 1, 0, 0, 0, 480, 481, 5, 110, 0, 0, 481, 482, 5, 111, 0,
-		0, 482, 92, 1, 0, 0, 0, 483, 484, 5, 110, 0, 0, 484, 485, 5, 111, 0, 0,
-		485, 486, 5, 116, 0, 0, 486, 94, 1, 0, 0, 0, 487, 488, 5, 111, 0, 0, 488,
-		489, 5, 110, 0, 0, 489, 96, 1, 0, 0, 0, 490, 491, 5, 111, 0, 0, 491, 492,
-		5, 112, 0, 0, 492, 493, 5, 116, 0, 0, 493, 494, 5, 105, 0, 0, 494, 495,
-		5, 111, 0, 0, 495, 496, 5, 110, 0, 0, 496, 497, 5, 97, 0, 0, 497, 498,
-		5, 108, 0, 0, 498, 98, 1, 0, 0, 0, 499, 500, 5, 111, 0, 0, 500, 501, 5,
-		114, 0, 0, 501, 100, 1, 0, 0, 0, 502, 503, 5, 112, 0, 0, 503, 504, 5, 114,
-		0, 0, 504, 505, 5, 111, 0, 0, 505, 506, 5, 109, 0, 0, 506, 507, 5, 112,
-		0, 0, 507, 508, 5, 116, 0, 0, 508, 509, 5, 117, 0, 0, 509, 510, 5, 115,
-		0, 0, 510, 511, 5, 101, 0, 0, 511, 512, 5, 114, 0, 0, 512, 102, 1, 0, 0,
-		0, 513, 514, 5, 114, 0, 0, 514, 515, 5, 101, 0, 0, 515, 516, 5, 116, 0,
-		0, 516, 517, 5, 117, 0, 0, 517, 518, 5, 114, 0, 0, 518, 519, 5, 110, 0,
-		0, 519, 104, 1, 0, 0, 0, 520, 521, 5, 114, 0, 0, 521, 522, 5, 101, 0, 0,
-		522, 523, 5, 116, 0, 0, 523, 524, 5, 117, 0, 0, 524, 525, 5, 114, 0, 0,
-		525, 526, 5, 110, 0, 0, 526, 527, 5, 115, 0, 0, 527, 106, 1, 0, 0, 0, 528,
-		529, 5, 115, 0, 0, 529, 530, 5, 101, 0, 0, 530, 531, 5, 116, 0, 0, 531,
-		108, 1, 0, 0, 0, 532, 533, 5, 115, 0, 0, 533, 534, 5, 105, 0, 0, 534, 535,
-		5, 110, 0, 0, 535, 110, 1, 0, 0, 0, 536, 537, 5, 115, 0, 0, 537, 538, 5,
-		111, 0, 0, 538, 539, 5, 109, 0, 0, 539, 540, 5, 101, 0, 0, 540, 112, 1,
-		0, 0, 0, 541, 542, 5, 116, 0, 0, 542, 543, 5, 97, 0, 0, 543, 544, 5, 110,
-		0, 0, 544, 114, 1, 0, 0, 0, 545, 546, 5, 116, 0, 0, 546, 547, 5, 105, 0,
-		0, 547, 548, 5, 109, 0, 0, 548, 549, 5, 101, 0, 0, 549, 550, 5, 100, 0,
-		0, 550, 551, 5, 97, 0, 0, 551, 552, 5, 116, 0, 0, 552, 553, 5, 101, 0,
-		0, 553, 116, 1, 0, 0, 0, 554, 555, 5, 116, 0, 0, 555, 556, 5, 111, 0, 0,
-		556, 557, 5, 111, 0, 0, 557, 558, 5, 108, 0, 0, 558, 118, 1, 0, 0, 0, 559,
-		560, 5, 116, 0, 0, 560, 561, 5, 114, 0, 0, 561, 562, 5, 117, 0, 0, 562,
-		563, 5, 101, 0, 0, 563, 120, 1, 0, 0, 0, 564, 565, 5, 116, 0, 0, 565, 566,
-		5, 121, 0, 0, 566, 567, 5, 112, 0, 0, 567, 568, 5, 101, 0, 0, 568, 569,
-		5, 111, 0, 0, 569, 570, 5, 102, 0, 0, 570, 122, 1, 0, 0, 0, 571, 572, 5,
-		119, 0, 0, 572, 573, 5, 104, 0, 0, 573, 574, 5, 105, 0, 0, 574, 575, 5,
-		108, 0, 0, 575, 576, 5, 101, 0, 0, 576, 124, 1, 0, 0, 0, 577, 578, 5, 119,
-		0, 0, 578, 579, 5, 104, 0, 0, 579, 580, 5, 105, 0, 0, 580, 581, 5, 115,
-		0, 0, 581, 582, 5, 112, 0, 0, 582, 583, 5, 101, 0, 0, 583, 584, 5, 114,
-		0, 0, 584, 126, 1, 0, 0, 0, 585, 586, 5, 119, 0, 0, 586, 587, 5, 105, 0,
-		0, 587, 588, 5, 116, 0, 0, 588, 589, 5, 104, 0, 0, 589, 128, 1, 0, 0, 0,
-		590, 591, 5, 64, 0, 0, 591, 130, 1, 0, 0, 0, 592, 598, 5, 92, 0, 0, 593,
-		595, 5, 13, 0, 0, 594, 593, 1, 0, 0, 0, 594, 595, 1, 0, 0, 0, 595, 596,
-		1, 0, 0, 0, 596, 599, 5, 10, 0, 0, 597, 599, 5, 13, 0, 0, 598, 594, 1,
-		0, 0, 0, 598, 597, 1, 0, 0, 0, 599, 132, 1, 0, 0, 0, 600, 604, 3, 213,
-		106, 0, 601, 604, 3, 131, 65, 0, 602, 604, 8, 0, 0, 0, 603, 600, 1, 0,
-		0, 0, 603, 601, 1, 0, 0, 0, 603, 602, 1, 0, 0, 0, 604, 134, 1, 0, 0, 0,
-		605, 609, 3, 213, 106, 0, 606, 609, 3, 131, 65, 0, 607, 609, 8, 1, 0, 0,
-		608, 605, 1, 0, 0, 0, 608, 606, 1, 0, 0, 0, 608, 607, 1, 0, 0, 0, 609,
-		136, 1, 0, 0, 0, 610, 614, 5, 34, 0, 0, 611, 613, 3, 133, 66, 0, 612, 611,
-		1, 0, 0, 0, 613, 616, 1, 0, 0, 0, 614, 615, 1, 0, 0, 0, 614, 612, 1, 0,
-		0, 0, 615, 617, 1, 0, 0, 0, 616, 614, 1, 0, 0, 0, 617, 627, 5, 34, 0, 0,
-		618, 622, 5, 39, 0, 0, 619, 621, 3, 135, 67, 0, 620, 619, 1, 0, 0, 0, 621,
-		624, 1, 0, 0, 0, 622, 623, 1, 0, 0, 0, 622, 620, 1, 0, 0, 0, 623, 625,
-		1, 0, 0, 0, 624, 622, 1, 0, 0, 0, 625, 627, 5, 39, 0, 0, 626, 610, 1, 0,
-		0, 0, 626, 618, 1, 0, 0, 0, 627, 138, 1, 0, 0, 0, 628, 629, 5, 96, 0, 0,
-		629, 630, 5, 96, 0, 0, 630, 631, 5, 96, 0, 0, 631, 635, 1, 0, 0, 0, 632,
-		634, 9, 0, 0, 0, 633, 632, 1, 0, 0, 0, 634, 637, 1, 0, 0, 0, 635, 636,
-		1, 0, 0, 0, 635, 633, 1, 0, 0, 0, 636, 638, 1, 0, 0, 0, 637, 635, 1, 0,
-		0, 0, 638, 639, 5, 96, 0, 0, 639, 640, 5, 96, 0, 0, 640, 641, 5, 96, 0,
-		0, 641, 140, 1, 0, 0, 0, 642, 643, 5, 39, 0, 0, 643, 644, 5, 39, 0, 0,
-		644, 645, 5, 39, 0, 0, 645, 649, 1, 0, 0, 0, 646, 648, 9, 0, 0, 0, 647,
-		646, 1, 0, 0, 0, 648, 651, 1, 0, 0, 0, 649, 650, 1, 0, 0, 0, 649, 647,
-		1, 0, 0, 0, 650, 652, 1, 0, 0, 0, 651, 649, 1, 0, 0, 0, 652, 653, 5, 39,
-		0, 0, 653, 654, 5, 39, 0, 0, 654, 655, 5, 39, 0, 0, 655, 142, 1, 0, 0,
-		0, 656, 657, 5, 91, 0, 0, 657, 658, 5, 91, 0, 0, 658, 662, 1, 0, 0, 0,
-		659, 661, 9, 0, 0, 0, 660, 659, 1, 0, 0, 0, 661, 664, 1, 0, 0, 0, 662,
-		663, 1, 0, 0, 0, 662, 660, 1, 0, 0, 0, 663, 665, 1, 0, 0, 0, 664, 662,
-		1, 0, 0, 0, 665, 666, 5, 93, 0, 0, 666, 667, 5, 93, 0, 0, 667, 144, 1,
-		0, 0, 0, 668, 672, 3, 213, 106, 0, 669, 672, 3, 131, 65, 0, 670, 672, 8,
-		2, 0, 0, 671, 668, 1, 0, 0, 0, 671, 669, 1, 0, 0, 0, 671, 670, 1, 0, 0,
-		0, 672, 146, 1, 0, 0, 0, 673, 675, 7, 3, 0, 0, 674, 673, 1, 0, 0, 0, 675,
-		678, 1, 0, 0, 0, 676, 674, 1, 0, 0, 0, 676, 677, 1, 0, 0, 0, 677, 679,
-		1, 0, 0, 0, 678, 676, 1, 0, 0, 0, 679, 680, 5, 58, 0, 0, 680, 681, 5, 58,
-		0, 0, 681, 683, 1, 0, 0, 0, 682, 684, 7, 3, 0, 0, 683, 682, 1, 0, 0, 0,
-		684, 685, 1, 0, 0, 0, 685, 683, 1, 0, 0, 0, 685, 686, 1, 0, 0, 0, 686,
-		690, 1, 0, 0, 0, 687, 689, 3, 145, 72, 0, 688, 687, 1, 0, 0, 0, 689, 692,
-		1, 0, 0, 0, 690, 688, 1, 0, 0, 0, 690, 691, 1, 0, 0, 0, 691, 148, 1, 0,
-		0, 0, 692, 690, 1, 0, 0, 0, 693, 695, 7, 4, 0, 0, 694, 693, 1, 0, 0, 0,
-		695, 696, 1, 0, 0, 0, 696, 694, 1, 0, 0, 0, 696, 697, 1, 0, 0, 0, 697,
-		704, 1, 0, 0, 0, 698, 700, 5, 46, 0, 0, 699, 701, 7, 4, 0, 0, 700, 699,
-		1, 0, 0, 0, 701, 702, 1, 0, 0, 0, 702, 700, 1, 0, 0, 0, 702, 703, 1, 0,
-		0, 0, 703, 705, 1, 0, 0, 0, 704, 698, 1, 0, 0, 0, 704, 705, 1, 0, 0, 0,
-		705, 715, 1, 0, 0, 0, 706, 708, 7, 5, 0, 0, 707, 709, 7, 6, 0, 0, 708,
-		707, 1, 0, 0, 0, 708, 709, 1, 0, 0, 0, 709, 711, 1, 0, 0, 0, 710, 712,
-		7, 4, 0, 0, 711, 710, 1, 0, 0, 0, 712, 713, 1, 0, 0, 0, 713, 711, 1, 0,
-		0, 0, 713, 714, 1, 0, 0, 0, 714, 716, 1, 0, 0, 0, 715, 706, 1, 0, 0, 0,
-		715, 716, 1, 0, 0, 0, 716, 150, 1, 0, 0, 0, 717, 721, 7, 7, 0, 0, 718,
-		720, 7, 8, 0, 0, 719, 718, 1, 0, 0, 0, 720, 723, 1, 0, 0, 0, 721, 719,
-		1, 0, 0, 0, 721, 722, 1, 0, 0, 0, 722, 152, 1, 0, 0, 0, 723, 721, 1, 0,
-		0, 0, 724, 725, 5, 61, 0, 0, 725, 154, 1, 0, 0, 0, 726, 727, 5, 43, 0,
-		0, 727, 156, 1, 0, 0, 0, 728, 729, 5, 45, 0, 0, 729, 158, 1, 0, 0, 0, 730,
-		731, 5, 42, 0, 0, 731, 160, 1, 0, 0, 0, 732, 733, 5, 47, 0, 0, 733, 162,
-		1, 0, 0, 0, 734, 735, 5, 37, 0, 0, 735, 164, 1, 0, 0, 0, 736, 737, 5, 42,
-		0, 0, 737, 738, 5, 42, 0, 0, 738, 166, 1, 0, 0, 0, 739, 740, 5, 38, 0,
-		0, 740, 168, 1, 0, 0, 0, 741, 742, 5, 124, 0, 0, 742, 170, 1, 0, 0, 0,
-		743, 744, 5, 94, 0, 0, 744, 172, 1, 0, 0, 0, 745, 746, 5, 126, 0, 0, 746,
-		174, 1, 0, 0, 0, 747, 748, 5, 40, 0, 0, 748, 176, 1, 0, 0, 0, 749, 750,
-		5, 41, 0, 0, 750, 178, 1, 0, 0, 0, 751, 752, 5, 44, 0, 0, 752, 180, 1,
-		0, 0, 0, 753, 754, 5, 91, 0, 0, 754, 182, 1, 0, 0, 0, 755, 756, 5, 93,
-		0, 0, 756, 184, 1, 0, 0, 0, 757, 758, 5, 123, 0, 0, 758, 186, 1, 0, 0,
-		0, 759, 760, 5, 125, 0, 0, 760, 188, 1, 0, 0, 0, 761, 762, 5, 58, 0, 0,
-		762, 190, 1, 0, 0, 0, 763, 764, 5, 46, 0, 0, 764, 192, 1, 0, 0, 0, 765,
-		766, 5, 123, 0, 0, 766, 767, 5, 123, 0, 0, 767, 194, 1, 0, 0, 0, 768, 769,
-		5, 61, 0, 0, 769, 770, 5, 61, 0, 0, 770, 196, 1, 0, 0, 0, 771, 772, 5,
-		33, 0, 0, 772, 773, 5, 61, 0, 0, 773, 198, 1, 0, 0, 0, 774, 775, 5, 62,
-		0, 0, 775, 200, 1, 0, 0, 0, 776, 777, 5, 60, 0, 0, 777, 202, 1, 0, 0, 0,
-		778, 779, 5, 62, 0, 0, 779, 780, 5, 61, 0, 0, 780, 204, 1, 0, 0, 0, 781,
-		782, 5, 60, 0, 0, 782, 783, 5, 61, 0, 0, 783, 206, 1, 0, 0, 0, 784, 790,
-		5, 35, 0, 0, 785, 786, 5, 45, 0, 0, 786, 790, 5, 45, 0, 0, 787, 788, 5,
-		47, 0, 0, 788, 790, 5, 47, 0, 0, 789, 784, 1, 0, 0, 0, 789, 785, 1, 0,
-		0, 0, 789, 787, 1, 0, 0, 0, 790, 794, 1, 0, 0, 0, 791, 793, 8, 9, 0, 0,
-		792, 791, 1, 0, 0, 0, 793, 796, 1, 0, 0, 0, 794, 792, 1, 0, 0, 0, 794,
-		795, 1, 0, 0, 0, 795, 797, 1, 0, 0, 0, 796, 794, 1, 0, 0, 0, 797, 798,
-		6, 103, 0, 0, 798, 208, 1, 0, 0, 0, 799, 801, 5, 13, 0, 0, 800, 799, 1,
-		0, 0, 0, 800, 801, 1, 0, 0, 0, 801, 802, 1, 0, 0, 0, 802, 805, 5, 10, 0,
-		0, 803, 805, 5, 13, 0, 0, 804, 800, 1, 0, 0, 0, 804, 803, 1, 0, 0, 0, 805,
-		210, 1, 0, 0, 0, 806, 808, 7, 3, 0, 0, 807, 806, 1, 0, 0, 0, 808, 809,
-		1, 0, 0, 0, 809, 807, 1, 0, 0, 0, 809, 810, 1, 0, 0, 0, 810, 811, 1, 0,
-		0, 0, 811, 812, 6, 105, 0, 0, 812, 212, 1, 0, 0, 0, 813, 818, 5, 92, 0,
-		0, 814, 819, 3, 217, 108, 0, 815, 819, 3, 219, 109, 0, 816, 819, 3, 221,
-		110, 0, 817, 819, 3, 215, 107, 0, 818, 814, 1, 0, 0, 0, 818, 815, 1, 0,
-		0, 0, 818, 816, 1, 0, 0, 0, 818, 817, 1, 0, 0, 0, 819, 214, 1, 0, 0, 0,
-		820, 821, 7, 10, 0, 0, 821, 216, 1, 0, 0, 0, 822, 823, 5, 117, 0, 0, 823,
-		824, 3, 223, 111, 0, 824, 825, 3, 223, 111, 0, 825, 826, 3, 223, 111, 0,
-		826, 827, 3, 223, 111, 0, 827, 218, 1, 0, 0, 0, 828, 829, 5, 120, 0, 0,
-		829, 830, 3, 223, 111, 0, 830, 831, 3, 223, 111, 0, 831, 220, 1, 0, 0,
-		0, 832, 834, 7, 11, 0, 0, 833, 832, 1, 0, 0, 0, 833, 834, 1, 0, 0, 0, 834,
-		835, 1, 0, 0, 0, 835, 836, 7, 12, 0, 0, 836, 837, 7, 12, 0, 0, 837, 222,
-		1, 0, 0, 0, 838, 839, 7, 13, 0, 0, 839, 224, 1, 0, 0, 0, 31, 0, 227, 231,
-		594, 598, 603, 608, 614, 622, 626, 635, 649, 662, 671, 676, 685, 690, 696,
-		702, 704, 708, 713, 715, 721, 789, 794, 800, 804, 809, 818, 833, 1, 0,
-		1, 0,
+		211, 102, 213, 103, 215, 104, 217, 105, 219, 106, 221, 107, 223, 108, 225,
+		109, 227, 110, 229, 111, 231, 112, 233, 0, 235, 0, 237, 0, 239, 0, 241,
+		0, 243, 0, 1, 0, 14, 4, 0, 10, 10, 13, 13, 34, 34, 92, 92, 4, 0, 10, 10,
+		13, 13, 39, 39, 92, 92, 3, 0, 10, 10, 13, 13, 92, 92, 2, 0, 9, 9, 32, 32,
+		1, 0, 48, 57, 2, 0, 69, 69, 101, 101, 2, 0, 43, 43, 45, 45, 3, 0, 65, 90,
+		95, 95, 97, 122, 4, 0, 48, 57, 65, 90, 95, 95, 97, 122, 2, 0, 10, 10, 13,
+		13, 11, 0, 34, 34, 39, 39, 92, 92, 96, 96, 98, 98, 102, 102, 110, 110,
+		114, 114, 116, 116, 118, 118, 126, 126, 1, 0, 48, 51, 1, 0, 48, 55, 3,
+		0, 48, 57, 65, 70, 97, 102, 952, 0, 1, 1, 0, 0, 0, 0, 3, 1, 0, 0, 0, 0,
+		5, 1, 0, 0, 0, 0, 7, 1, 0, 0, 0, 0, 9, 1, 0, 0, 0, 0, 11, 1, 0, 0, 0, 0,
+		13, 1, 0, 0, 0, 0, 15, 1, 0, 0, 0, 0, 17, 1, 0, 0, 0, 0, 19, 1, 0, 0, 0,
+		0, 21, 1, 0, 0, 0, 0, 23, 1, 0, 0, 0, 0, 25, 1, 0, 0, 0, 0, 27, 1, 0, 0,
+		0, 0, 29, 1, 0, 0, 0, 0, 31, 1, 0, 0, 0, 0, 33, 1, 0, 0, 0, 0, 35, 1, 0,
+		0, 0, 0, 37, 1, 0, 0, 0, 0, 39, 1, 0, 0, 0, 0, 41, 1, 0, 0, 0, 0, 43, 1,
+		0, 0, 0, 0, 45, 1, 0, 0, 0, 0, 47, 1, 0, 0, 0, 0, 49, 1, 0, 0, 0, 0, 51,
+		1, 0, 0, 0, 0, 53, 1, 0, 0, 0, 0, 55, 1, 0, 0, 0, 0, 57, 1, 0, 0, 0, 0,
+		59, 1, 0, 0, 0, 0, 61, 1, 0, 0, 0, 0, 63, 1, 0, 0, 0, 0, 65, 1, 0, 0, 0,
+		0, 67, 1, 0, 0, 0, 0, 69, 1, 0, 0, 0, 0, 71, 1, 0, 0, 0, 0, 73, 1, 0, 0,
+		0, 0, 75, 1, 0, 0, 0, 0, 77, 1, 0, 0, 0, 0, 79, 1, 0, 0, 0, 0, 81, 1, 0,
+		0, 0, 0, 83, 1, 0, 0, 0, 0, 85, 1, 0, 0, 0, 0, 87, 1, 0, 0, 0, 0, 89, 1,
+		0, 0, 0, 0, 91, 1, 0, 0, 0, 0, 93, 1, 0, 0, 0, 0, 95, 1, 0, 0, 0, 0, 97,
+		1, 0, 0, 0, 0, 99, 1, 0, 0, 0, 0, 101, 1, 0, 0, 0, 0, 103, 1, 0, 0, 0,
+		0, 105, 1, 0, 0, 0, 0, 107, 1, 0, 0, 0, 0, 109, 1, 0, 0, 0, 0, 111, 1,
+		0, 0, 0, 0, 113, 1, 0, 0, 0, 0, 115, 1, 0, 0, 0, 0, 117, 1, 0, 0, 0, 0,
+		119, 1, 0, 0, 0, 0, 121, 1, 0, 0, 0, 0, 123, 1, 0, 0, 0, 0, 125, 1, 0,
+		0, 0, 0, 127, 1, 0, 0, 0, 0, 129, 1, 0, 0, 0, 0, 131, 1, 0, 0, 0, 0, 133,
+		1, 0, 0, 0, 0, 135, 1, 0, 0, 0, 0, 137, 1, 0, 0, 0, 0, 139, 1, 0, 0, 0,
+		0, 141, 1, 0, 0, 0, 0, 143, 1, 0, 0, 0, 0, 145, 1, 0, 0, 0, 0, 147, 1,
+		0, 0, 0, 0, 149, 1, 0, 0, 0, 0, 157, 1, 0, 0, 0, 0, 159, 1, 0, 0, 0, 0,
+		161, 1, 0, 0, 0, 0, 163, 1, 0, 0, 0, 0, 167, 1, 0, 0, 0, 0, 169, 1, 0,
+		0, 0, 0, 171, 1, 0, 0, 0, 0, 173, 1, 0, 0, 0, 0, 175, 1, 0, 0, 0, 0, 177,
+		1, 0, 0, 0, 0, 179, 1, 0, 0, 0, 0, 181, 1, 0, 0, 0, 0, 183, 1, 0, 0, 0,
+		0, 185, 1, 0, 0, 0, 0, 187, 1, 0, 0, 0, 0, 189, 1, 0, 0, 0, 0, 191, 1,
+		0, 0, 0, 0, 193, 1, 0, 0, 0, 0, 195, 1, 0, 0, 0, 0, 197, 1, 0, 0, 0, 0,
+		199, 1, 0, 0, 0, 0, 201, 1, 0, 0, 0, 0, 203, 1, 0, 0, 0, 0, 205, 1, 0,
+		0, 0, 0, 207, 1, 0, 0, 0, 0, 209, 1, 0, 0, 0, 0, 211, 1, 0, 0, 0, 0, 213,
+		1, 0, 0, 0, 0, 215, 1, 0, 0, 0, 0, 217, 1, 0, 0, 0, 0, 219, 1, 0, 0, 0,
+		0, 221, 1, 0, 0, 0, 0, 223, 1, 0, 0, 0, 0, 225, 1, 0, 0, 0, 0, 227, 1,
+		0, 0, 0, 0, 229, 1, 0, 0, 0, 0, 231, 1, 0, 0, 0, 1, 245, 1, 0, 0, 0, 3,
+		255, 1, 0, 0, 0, 5, 260, 1, 0, 0, 0, 7, 264, 1, 0, 0, 0, 9, 267, 1, 0,
+		0, 0, 11, 272, 1, 0, 0, 0, 13, 276, 1, 0, 0, 0, 15, 281, 1, 0, 0, 0, 17,
+		287, 1, 0, 0, 0, 19, 292, 1, 0, 0, 0, 21, 297, 1, 0, 0, 0, 23, 303, 1,
+		0, 0, 0, 25, 309, 1, 0, 0, 0, 27, 321, 1, 0, 0, 0, 29, 329, 1, 0, 0, 0,
+		31, 338, 1, 0, 0, 0, 33, 342, 1, 0, 0, 0, 35, 350, 1, 0, 0, 0, 37, 353,
+		1, 0, 0, 0, 39, 358, 1, 0, 0, 0, 41, 363, 1, 0, 0, 0, 43, 368, 1, 0, 0,
+		0, 45, 379, 1, 0, 0, 0, 47, 386, 1, 0, 0, 0, 49, 394, 1, 0, 0, 0, 51, 400,
+		1, 0, 0, 0, 53, 406, 1, 0, 0, 0, 55, 416, 1, 0, 0, 0, 57, 423, 1, 0, 0,
+		0, 59, 432, 1, 0, 0, 0, 61, 438, 1, 0, 0, 0, 63, 443, 1, 0, 0, 0, 65, 449,
+		1, 0, 0, 0, 67, 454, 1, 0, 0, 0, 69, 460, 1, 0, 0, 0, 71, 468, 1, 0, 0,
+		0, 73, 472, 1, 0, 0, 0, 75, 477, 1, 0, 0, 0, 77, 483, 1, 0, 0, 0, 79, 486,
+		1, 0, 0, 0, 81, 489, 1, 0, 0, 0, 83, 494, 1, 0, 0, 0, 85, 499, 1, 0, 0,
+		0, 87, 503, 1, 0, 0, 0, 89, 506, 1, 0, 0, 0, 91, 510, 1, 0, 0, 0, 93, 516,
+		1, 0, 0, 0, 95, 521, 1, 0, 0, 0, 97, 528, 1, 0, 0, 0, 99, 534, 1, 0, 0,
+		0, 101, 540, 1, 0, 0, 0, 103, 544, 1, 0, 0, 0, 105, 547, 1, 0, 0, 0, 107,
+		551, 1, 0, 0, 0, 109, 554, 1, 0, 0, 0, 111, 563, 1, 0, 0, 0, 113, 566,
+		1, 0, 0, 0, 115, 577, 1, 0, 0, 0, 117, 583, 1, 0, 0, 0, 119, 590, 1, 0,
+		0, 0, 121, 598, 1, 0, 0, 0, 123, 602, 1, 0, 0, 0, 125, 606, 1, 0, 0, 0,
+		127, 611, 1, 0, 0, 0, 129, 618, 1, 0, 0, 0, 131, 622, 1, 0, 0, 0, 133,
+		631, 1, 0, 0, 0, 135, 636, 1, 0, 0, 0, 137, 641, 1, 0, 0, 0, 139, 645,
+		1, 0, 0, 0, 141, 652, 1, 0, 0, 0, 143, 658, 1, 0, 0, 0, 145, 664, 1, 0,
+		0, 0, 147, 672, 1, 0, 0, 0, 149, 677, 1, 0, 0, 0, 151, 679, 1, 0, 0, 0,
+		153, 690, 1, 0, 0, 0, 155, 695, 1, 0, 0, 0, 157, 713, 1, 0, 0, 0, 159,
+		715, 1, 0, 0, 0, 161, 729, 1, 0, 0, 0, 163, 743, 1, 0, 0, 0, 165, 758,
+		1, 0, 0, 0, 167, 763, 1, 0, 0, 0, 169, 781, 1, 0, 0, 0, 171, 804, 1, 0,
+		0, 0, 173, 811, 1, 0, 0, 0, 175, 813, 1, 0, 0, 0, 177, 815, 1, 0, 0, 0,
+		179, 817, 1, 0, 0, 0, 181, 819, 1, 0, 0, 0, 183, 821, 1, 0, 0, 0, 185,
+		823, 1, 0, 0, 0, 187, 826, 1, 0, 0, 0, 189, 828, 1, 0, 0, 0, 191, 830,
+		1, 0, 0, 0, 193, 832, 1, 0, 0, 0, 195, 834, 1, 0, 0, 0, 197, 836, 1, 0,
+		0, 0, 199, 838, 1, 0, 0, 0, 201, 840, 1, 0, 0, 0, 203, 842, 1, 0, 0, 0,
+		205, 844, 1, 0, 0, 0, 207, 846, 1, 0, 0, 0, 209, 848, 1, 0, 0, 0, 211,
+		850, 1, 0, 0, 0, 213, 852, 1, 0, 0, 0, 215, 855, 1, 0, 0, 0, 217, 858,
+		1, 0, 0, 0, 219, 861, 1, 0, 0, 0, 221, 863, 1, 0, 0, 0, 223, 865, 1, 0,
+		0, 0, 225, 868, 1, 0, 0, 0, 227, 876, 1, 0, 0, 0, 229, 891, 1, 0, 0, 0,
+		231, 894, 1, 0, 0, 0, 233, 900, 1, 0, 0, 0, 235, 907, 1, 0, 0, 0, 237,
+		909, 1, 0, 0, 0, 239, 915, 1, 0, 0, 0, 241, 920, 1, 0, 0, 0, 243, 925,
+		1, 0, 0, 0, 245, 251, 5, 92, 0, 0, 246, 248, 5, 13, 0, 0, 247, 246, 1,
+		0, 0, 0, 247, 248, 1, 0, 0, 0, 248, 249, 1, 0, 0, 0, 249, 252, 5, 10, 0,
+		0, 250, 252, 5, 13, 0, 0, 251, 247, 1, 0, 0, 0, 251, 250, 1, 0, 0, 0, 252,
+		253, 1, 0, 0, 0, 253, 254, 6, 0, 0, 0, 254, 2, 1, 0, 0, 0, 255, 256, 5,
+		97, 0, 0, 256, 257, 5, 99, 0, 0, 257, 258, 5, 111, 0, 0, 258, 259, 5, 115,
+		0, 0, 259, 4, 1, 0, 0, 0, 260, 261, 5, 97, 0, 0, 261, 262, 5, 110, 0, 0,
+		262, 263, 5, 100, 0, 0, 263, 6, 1, 0, 0, 0, 264, 265, 5, 97, 0, 0, 265,
+		266, 5, 115, 0, 0, 266, 8, 1, 0, 0, 0, 267, 268, 5, 97, 0, 0, 268, 269,
+		5, 115, 0, 0, 269, 270, 5, 105, 0, 0, 270, 271, 5, 110, 0, 0, 271, 10,
+		1, 0, 0, 0, 272, 273, 5, 97, 0, 0, 273, 274, 5, 115, 0, 0, 274, 275, 5,
+		107, 0, 0, 275, 12, 1, 0, 0, 0, 276, 277, 5, 97, 0, 0, 277, 278, 5, 116,
+		0, 0, 278, 279, 5, 97, 0, 0, 279, 280, 5, 110, 0, 0, 280, 14, 1, 0, 0,
+		0, 281, 282, 5, 98, 0, 0, 282, 283, 5, 114, 0, 0, 283, 284, 5, 101, 0,
+		0, 284, 285, 5, 97, 0, 0, 285, 286, 5, 107, 0, 0, 286, 16, 1, 0, 0, 0,
+		287, 288, 5, 99, 0, 0, 288, 289, 5, 97, 0, 0, 289, 290, 5, 108, 0, 0, 290,
+		291, 5, 108, 0, 0, 291, 18, 1, 0, 0, 0, 292, 293, 5, 99, 0, 0, 293, 294,
+		5, 97, 0, 0, 294, 295, 5, 115, 0, 0, 295, 296, 5, 101, 0, 0, 296, 20, 1,
+		0, 0, 0, 297, 298, 5, 99, 0, 0, 298, 299, 5, 97, 0, 0, 299, 300, 5, 116,
+		0, 0, 300, 301, 5, 99, 0, 0, 301, 302, 5, 104, 0, 0, 302, 22, 1, 0, 0,
+		0, 303, 304, 5, 99, 0, 0, 304, 305, 5, 108, 0, 0, 305, 306, 5, 101, 0,
+		0, 306, 307, 5, 97, 0, 0, 307, 308, 5, 114, 0, 0, 308, 24, 1, 0, 0, 0,
+		309, 310, 5, 99, 0, 0, 310, 311, 5, 108, 0, 0, 311, 312, 5, 101, 0, 0,
+		312, 313, 5, 97, 0, 0, 313, 314, 5, 114, 0, 0, 314, 315, 5, 95, 0, 0, 315,
+		316, 5, 101, 0, 0, 316, 317, 5, 114, 0, 0, 317, 318, 5, 114, 0, 0, 318,
+		319, 5, 111, 0, 0, 319, 320, 5, 114, 0, 0, 320, 26, 1, 0, 0, 0, 321, 322,
+		5, 99, 0, 0, 322, 323, 5, 111, 0, 0, 323, 324, 5, 109, 0, 0, 324, 325,
+		5, 109, 0, 0, 325, 326, 5, 97, 0, 0, 326, 327, 5, 110, 0, 0, 327, 328,
+		5, 100, 0, 0, 328, 28, 1, 0, 0, 0, 329, 330, 5, 99, 0, 0, 330, 331, 5,
+		111, 0, 0, 331, 332, 5, 110, 0, 0, 332, 333, 5, 116, 0, 0, 333, 334, 5,
+		105, 0, 0, 334, 335, 5, 110, 0, 0, 335, 336, 5, 117, 0, 0, 336, 337, 5,
+		101, 0, 0, 337, 30, 1, 0, 0, 0, 338, 339, 5, 99, 0, 0, 339, 340, 5, 111,
+		0, 0, 340, 341, 5, 115, 0, 0, 341, 32, 1, 0, 0, 0, 342, 343, 5, 100, 0,
+		0, 343, 344, 5, 101, 0, 0, 344, 345, 5, 102, 0, 0, 345, 346, 5, 97, 0,
+		0, 346, 347, 5, 117, 0, 0, 347, 348, 5, 108, 0, 0, 348, 349, 5, 116, 0,
+		0, 349, 34, 1, 0, 0, 0, 350, 351, 5, 100, 0, 0, 351, 352, 5, 111, 0, 0,
+		352, 36, 1, 0, 0, 0, 353, 354, 5, 101, 0, 0, 354, 355, 5, 97, 0, 0, 355,
+		356, 5, 99, 0, 0, 356, 357, 5, 104, 0, 0, 357, 38, 1, 0, 0, 0, 358, 359,
+		5, 101, 0, 0, 359, 360, 5, 108, 0, 0, 360, 361, 5, 115, 0, 0, 361, 362,
+		5, 101, 0, 0, 362, 40, 1, 0, 0, 0, 363, 364, 5, 101, 0, 0, 364, 365, 5,
+		109, 0, 0, 365, 366, 5, 105, 0, 0, 366, 367, 5, 116, 0, 0, 367, 42, 1,
+		0, 0, 0, 368, 369, 5, 101, 0, 0, 369, 370, 5, 110, 0, 0, 370, 371, 5, 100,
+		0, 0, 371, 372, 5, 99, 0, 0, 372, 373, 5, 111, 0, 0, 373, 374, 5, 109,
+		0, 0, 374, 375, 5, 109, 0, 0, 375, 376, 5, 97, 0, 0, 376, 377, 5, 110,
+		0, 0, 377, 378, 5, 100, 0, 0, 378, 44, 1, 0, 0, 0, 379, 380, 5, 101, 0,
+		0, 380, 381, 5, 110, 0, 0, 381, 382, 5, 100, 0, 0, 382, 383, 5, 102, 0,
+		0, 383, 384, 5, 111, 0, 0, 384, 385, 5, 114, 0, 0, 385, 46, 1, 0, 0, 0,
+		386, 387, 5, 101, 0, 0, 387, 388, 5, 110, 0, 0, 388, 389, 5, 100, 0, 0,
+		389, 390, 5, 102, 0, 0, 390, 391, 5, 117, 0, 0, 391, 392, 5, 110, 0, 0,
+		392, 393, 5, 99, 0, 0, 393, 48, 1, 0, 0, 0, 394, 395, 5, 101, 0, 0, 395,
+		396, 5, 110, 0, 0, 396, 397, 5, 100, 0, 0, 397, 398, 5, 105, 0, 0, 398,
+		399, 5, 102, 0, 0, 399, 50, 1, 0, 0, 0, 400, 401, 5, 101, 0, 0, 401, 402,
+		5, 110, 0, 0, 402, 403, 5, 100, 0, 0, 403, 404, 5, 111, 0, 0, 404, 405,
+		5, 110, 0, 0, 405, 52, 1, 0, 0, 0, 406, 407, 5, 101, 0, 0, 407, 408, 5,
+		110, 0, 0, 408, 409, 5, 100, 0, 0, 409, 410, 5, 115, 0, 0, 410, 411, 5,
+		119, 0, 0, 411, 412, 5, 105, 0, 0, 412, 413, 5, 116, 0, 0, 413, 414, 5,
+		99, 0, 0, 414, 415, 5, 104, 0, 0, 415, 54, 1, 0, 0, 0, 416, 417, 5, 101,
+		0, 0, 417, 418, 5, 110, 0, 0, 418, 419, 5, 100, 0, 0, 419, 420, 5, 116,
+		0, 0, 420, 421, 5, 114, 0, 0, 421, 422, 5, 121, 0, 0, 422, 56, 1, 0, 0,
+		0, 423, 424, 5, 101, 0, 0, 424, 425, 5, 110, 0, 0, 425, 426, 5, 100, 0,
+		0, 426, 427, 5, 119, 0, 0, 427, 428, 5, 104, 0, 0, 428, 429, 5, 105, 0,
+		0, 429, 430, 5, 108, 0, 0, 430, 431, 5, 101, 0, 0, 431, 58, 1, 0, 0, 0,
+		432, 433, 5, 101, 0, 0, 433, 434, 5, 114, 0, 0, 434, 435, 5, 114, 0, 0,
+		435, 436, 5, 111, 0, 0, 436, 437, 5, 114, 0, 0, 437, 60, 1, 0, 0, 0, 438,
+		439, 5, 101, 0, 0, 439, 440, 5, 118, 0, 0, 440, 441, 5, 97, 0, 0, 441,
+		442, 5, 108, 0, 0, 442, 62, 1, 0, 0, 0, 443, 444, 5, 101, 0, 0, 444, 445,
+		5, 118, 0, 0, 445, 446, 5, 101, 0, 0, 446, 447, 5, 110, 0, 0, 447, 448,
+		5, 116, 0, 0, 448, 64, 1, 0, 0, 0, 449, 450, 5, 102, 0, 0, 450, 451, 5,
+		97, 0, 0, 451, 452, 5, 105, 0, 0, 452, 453, 5, 108, 0, 0, 453, 66, 1, 0,
+		0, 0, 454, 455, 5, 102, 0, 0, 455, 456, 5, 97, 0, 0, 456, 457, 5, 108,
+		0, 0, 457, 458, 5, 115, 0, 0, 458, 459, 5, 101, 0, 0, 459, 68, 1, 0, 0,
+		0, 460, 461, 5, 102, 0, 0, 461, 462, 5, 105, 0, 0, 462, 463, 5, 110, 0,
+		0, 463, 464, 5, 97, 0, 0, 464, 465, 5, 108, 0, 0, 465, 466, 5, 108, 0,
+		0, 466, 467, 5, 121, 0, 0, 467, 70, 1, 0, 0, 0, 468, 469, 5, 102, 0, 0,
+		469, 470, 5, 111, 0, 0, 470, 471, 5, 114, 0, 0, 471, 72, 1, 0, 0, 0, 472,
+		473, 5, 102, 0, 0, 473, 474, 5, 117, 0, 0, 474, 475, 5, 110, 0, 0, 475,
+		476, 5, 99, 0, 0, 476, 74, 1, 0, 0, 0, 477, 478, 5, 102, 0, 0, 478, 479,
+		5, 117, 0, 0, 479, 480, 5, 122, 0, 0, 480, 481, 5, 122, 0, 0, 481, 482,
+		5, 121, 0, 0, 482, 76, 1, 0, 0, 0, 483, 484, 5, 105, 0, 0, 484, 485, 5,
+		102, 0, 0, 485, 78, 1, 0, 0, 0, 486, 487, 5, 105, 0, 0, 487, 488, 5, 110,
+		0, 0, 488, 80, 1, 0, 0, 0, 489, 490, 5, 105, 0, 0, 490, 491, 5, 110, 0,
+		0, 491, 492, 5, 116, 0, 0, 492, 493, 5, 111, 0, 0, 493, 82, 1, 0, 0, 0,
+		494, 495, 5, 108, 0, 0, 495, 496, 5, 97, 0, 0, 496, 497, 5, 115, 0, 0,
+		497, 498, 5, 116, 0, 0, 498, 84, 1, 0, 0, 0, 499, 500, 5, 108, 0, 0, 500,
+		501, 5, 101, 0, 0, 501, 502, 5, 110, 0, 0, 502, 86, 1, 0, 0, 0, 503, 504,
+		5, 108, 0, 0, 504, 505, 5, 110, 0, 0, 505, 88, 1, 0, 0, 0, 506, 507, 5,
+		108, 0, 0, 507, 508, 5, 111, 0, 0, 508, 509, 5, 103, 0, 0, 509, 90, 1,
+		0, 0, 0, 510, 511, 5, 109, 0, 0, 511, 512, 5, 101, 0, 0, 512, 513, 5, 97,
+		0, 0, 513, 514, 5, 110, 0, 0, 514, 515, 5, 115, 0, 0, 515, 92, 1, 0, 0,
+		0, 516, 517, 5, 109, 0, 0, 517, 518, 5, 117, 0, 0, 518, 519, 5, 115, 0,
+		0, 519, 520, 5, 116, 0, 0, 520, 94, 1, 0, 0, 0, 521, 522, 5, 109, 0, 0,
+		522, 523, 5, 117, 0, 0, 523, 524, 5, 115, 0, 0, 524, 525, 5, 116, 0, 0,
+		525, 526, 5, 98, 0, 0, 526, 527, 5, 101, 0, 0, 527, 96, 1, 0, 0, 0, 528,
+		529, 5, 110, 0, 0, 529, 530, 5, 97, 0, 0, 530, 531, 5, 109, 0, 0, 531,
+		532, 5, 101, 0, 0, 532, 533, 5, 100, 0, 0, 533, 98, 1, 0, 0, 0, 534, 535,
+		5, 110, 0, 0, 535, 536, 5, 101, 0, 0, 536, 537, 5, 101, 0, 0, 537, 538,
+		5, 100, 0, 0, 538, 539, 5, 115, 0, 0, 539, 100, 1, 0, 0, 0, 540, 541, 5,
+		110, 0, 0, 541, 542, 5, 105, 0, 0, 542, 543, 5, 108, 0, 0, 543, 102, 1,
+		0, 0, 0, 544, 545, 5, 110, 0, 0, 545, 546, 5, 111, 0, 0, 546, 104, 1, 0,
+		0, 0, 547, 548, 5, 110, 0, 0, 548, 549, 5, 111, 0, 0, 549, 550, 5, 116,
+		0, 0, 550, 106, 1, 0, 0, 0, 551, 552, 5, 111, 0, 0, 552, 553, 5, 110, 0,
+		0, 553, 108, 1, 0, 0, 0, 554, 555, 5, 111, 0, 0, 555, 556, 5, 112, 0, 0,
+		556, 557, 5, 116, 0, 0, 557, 558, 5, 105, 0, 0, 558, 559, 5, 111, 0, 0,
+		559, 560, 5, 110, 0, 0, 560, 561, 5, 97, 0, 0, 561, 562, 5, 108, 0, 0,
+		562, 110, 1, 0, 0, 0, 563, 564, 5, 111, 0, 0, 564, 565, 5, 114, 0, 0, 565,
+		112, 1, 0, 0, 0, 566, 567, 5, 112, 0, 0, 567, 568, 5, 114, 0, 0, 568, 569,
+		5, 111, 0, 0, 569, 570, 5, 109, 0, 0, 570, 571, 5, 112, 0, 0, 571, 572,
+		5, 116, 0, 0, 572, 573, 5, 117, 0, 0, 573, 574, 5, 115, 0, 0, 574, 575,
+		5, 101, 0, 0, 575, 576, 5, 114, 0, 0, 576, 114, 1, 0, 0, 0, 577, 578, 5,
+		114, 0, 0, 578, 579, 5, 97, 0, 0, 579, 580, 5, 105, 0, 0, 580, 581, 5,
+		115, 0, 0, 581, 582, 5, 101, 0, 0, 582, 116, 1, 0, 0, 0, 583, 584, 5, 114,
+		0, 0, 584, 585, 5, 101, 0, 0, 585, 586, 5, 116, 0, 0, 586, 587, 5, 117,
+		0, 0, 587, 588, 5, 114, 0, 0, 588, 589, 5, 110, 0, 0, 589, 118, 1, 0, 0,
+		0, 590, 591, 5, 114, 0, 0, 591, 592, 5, 101, 0, 0, 592, 593, 5, 116, 0,
+		0, 593, 594, 5, 117, 0, 0, 594, 595, 5, 114, 0, 0, 595, 596, 5, 110, 0,
+		0, 596, 597, 5, 115, 0, 0, 597, 120, 1, 0, 0, 0, 598, 599, 5, 115, 0, 0,
+		599, 600, 5, 101, 0, 0, 600, 601, 5, 116, 0, 0, 601, 122, 1, 0, 0, 0, 602,
+		603, 5, 115, 0, 0, 603, 604, 5, 105, 0, 0, 604, 605, 5, 110, 0, 0, 605,
+		124, 1, 0, 0, 0, 606, 607, 5, 115, 0, 0, 607, 608, 5, 111, 0, 0, 608, 609,
+		5, 109, 0, 0, 609, 610, 5, 101, 0, 0, 610, 126, 1, 0, 0, 0, 611, 612, 5,
+		115, 0, 0, 612, 613, 5, 119, 0, 0, 613, 614, 5, 105, 0, 0, 614, 615, 5,
+		116, 0, 0, 615, 616, 5, 99, 0, 0, 616, 617, 5, 104, 0, 0, 617, 128, 1,
+		0, 0, 0, 618, 619, 5, 116, 0, 0, 619, 620, 5, 97, 0, 0, 620, 621, 5, 110,
+		0, 0, 621, 130, 1, 0, 0, 0, 622, 623, 5, 116, 0, 0, 623, 624, 5, 105, 0,
+		0, 624, 625, 5, 109, 0, 0, 625, 626, 5, 101, 0, 0, 626, 627, 5, 100, 0,
+		0, 627, 628, 5, 97, 0, 0, 628, 629, 5, 116, 0, 0, 629, 630, 5, 101, 0,
+		0, 630, 132, 1, 0, 0, 0, 631, 632, 5, 116, 0, 0, 632, 633, 5, 111, 0, 0,
+		633, 634, 5, 111, 0, 0, 634, 635, 5, 108, 0, 0, 635, 134, 1, 0, 0, 0, 636,
+		637, 5, 116, 0, 0, 637, 638, 5, 114, 0, 0, 638, 639, 5, 117, 0, 0, 639,
+		640, 5, 101, 0, 0, 640, 136, 1, 0, 0, 0, 641, 642, 5, 116, 0, 0, 642, 643,
+		5, 114, 0, 0, 643, 644, 5, 121, 0, 0, 644, 138, 1, 0, 0, 0, 645, 646, 5,
+		116, 0, 0, 646, 647, 5, 121, 0, 0, 647, 648, 5, 112, 0, 0, 648, 649, 5,
+		101, 0, 0, 649, 650, 5, 111, 0, 0, 650, 651, 5, 102, 0, 0, 651, 140, 1,
+		0, 0, 0, 652, 653, 5, 119, 0, 0, 653, 654, 5, 104, 0, 0, 654, 655, 5, 101,
+		0, 0, 655, 656, 5, 114, 0, 0, 656, 657, 5, 101, 0, 0, 657, 142, 1, 0, 0,
+		0, 658, 659, 5, 119, 0, 0, 659, 660, 5, 104, 0, 0, 660, 661, 5, 105, 0,
+		0, 661, 662, 5, 108, 0, 0, 662, 663, 5, 101, 0, 0, 663, 144, 1, 0, 0, 0,
+		664, 665, 5, 119, 0, 0, 665, 666, 5, 104, 0, 0, 666, 667, 5, 105, 0, 0,
+		667, 668, 5, 115, 0, 0, 668, 669, 5, 112, 0, 0, 669, 670, 5, 101, 0, 0,
+		670, 671, 5, 114, 0, 0, 671, 146, 1, 0, 0, 0, 672, 673, 5, 119, 0, 0, 673,
+		674, 5, 105, 0, 0, 674, 675, 5, 116, 0, 0, 675, 676, 5, 104, 0, 0, 676,
+		148, 1, 0, 0, 0, 677, 678, 5, 64, 0, 0, 678, 150, 1, 0, 0, 0, 679, 685,
+		5, 92, 0, 0, 680, 682, 5, 13, 0, 0, 681, 680, 1, 0, 0, 0, 681, 682, 1,
+		0, 0, 0, 682, 683, 1, 0, 0, 0, 683, 686, 5, 10, 0, 0, 684, 686, 5, 13,
+		0, 0, 685, 681, 1, 0, 0, 0, 685, 684, 1, 0, 0, 0, 686, 152, 1, 0, 0, 0,
+		687, 691, 3, 233, 116, 0, 688, 691, 3, 151, 75, 0, 689, 691, 8, 0, 0, 0,
+		690, 687, 1, 0, 0, 0, 690, 688, 1, 0, 0, 0, 690, 689, 1, 0, 0, 0, 691,
+		154, 1, 0, 0, 0, 692, 696, 3, 233, 116, 0, 693, 696, 3, 151, 75, 0, 694,
+		696, 8, 1, 0, 0, 695, 692, 1, 0, 0, 0, 695, 693, 1, 0, 0, 0, 695, 694,
+		1, 0, 0, 0, 696, 156, 1, 0, 0, 0, 697, 701, 5, 34, 0, 0, 698, 700, 3, 153,
+		76, 0, 699, 698, 1, 0, 0, 0, 700, 703, 1, 0, 0, 0, 701, 702, 1, 0, 0, 0,
+		701, 699, 1, 0, 0, 0, 702, 704, 1, 0, 0, 0, 703, 701, 1, 0, 0, 0, 704,
+		714, 5, 34, 0, 0, 705, 709, 5, 39, 0, 0, 706, 708, 3, 155, 77, 0, 707,
+		706, 1, 0, 0, 0, 708, 711, 1, 0, 0, 0, 709, 710, 1, 0, 0, 0, 709, 707,
+		1, 0, 0, 0, 710, 712, 1, 0, 0, 0, 711, 709, 1, 0, 0, 0, 712, 714, 5, 39,
+		0, 0, 713, 697, 1, 0, 0, 0, 713, 705, 1, 0, 0, 0, 714, 158, 1, 0, 0, 0,
+		715, 716, 5, 96, 0, 0, 716, 717, 5, 96, 0, 0, 717, 718, 5, 96, 0, 0, 718,
+		722, 1, 0, 0, 0, 719, 721, 9, 0, 0, 0, 720, 719, 1, 0, 0, 0, 721, 724,
+		1, 0, 0, 0, 722, 723, 1, 0, 0, 0, 722, 720, 1, 0, 0, 0, 723, 725, 1, 0,
+		0, 0, 724, 722, 1, 0, 0, 0, 725, 726, 5, 96, 0, 0, 726, 727, 5, 96, 0,
+		0, 727, 728, 5, 96, 0, 0, 728, 160, 1, 0, 0, 0, 729, 730, 5, 39, 0, 0,
+		730, 731, 5, 39, 0, 0, 731, 732, 5, 39, 0, 0, 732, 736, 1, 0, 0, 0, 733,
+		735, 9, 0, 0, 0, 734, 733, 1, 0, 0, 0, 735, 738, 1, 0, 0, 0, 736, 737,
+		1, 0, 0, 0, 736, 734, 1, 0, 0, 0, 737, 739, 1, 0, 0, 0, 738, 736, 1, 0,
+		0, 0, 739, 740, 5, 39, 0, 0, 740, 741, 5, 39, 0, 0, 741, 742, 5, 39, 0,
+		0, 742, 162, 1, 0, 0, 0, 743, 744, 5, 91, 0, 0, 744, 745, 5, 91, 0, 0,
+		745, 749, 1, 0, 0, 0, 746, 748, 9, 0, 0, 0, 747, 746, 1, 0, 0, 0, 748,
+		751, 1, 0, 0, 0, 749, 750, 1, 0, 0, 0, 749, 747, 1, 0, 0, 0, 750, 752,
+		1, 0, 0, 0, 751, 749, 1, 0, 0, 0, 752, 753, 5, 93, 0, 0, 753, 754, 5, 93,
+		0, 0, 754, 164, 1, 0, 0, 0, 755, 759, 3, 233, 116, 0, 756, 759, 3, 151,
+		75, 0, 757, 759, 8, 2, 0, 0, 758, 755, 1, 0, 0, 0, 758, 756, 1, 0, 0, 0,
+		758, 757, 1, 0, 0, 0, 759, 166, 1, 0, 0, 0, 760, 762, 7, 3, 0, 0, 761,
+		760, 1, 0, 0, 0, 762, 765, 1, 0, 0, 0, 763, 761, 1, 0, 0, 0, 763, 764,
+		1, 0, 0, 0, 764, 766, 1, 0, 0, 0, 765, 763, 1, 0, 0, 0, 766, 767, 5, 58,
+		0, 0, 767, 768, 5, 58, 0, 0, 768, 770, 1, 0, 0, 0, 769, 771, 7, 3, 0, 0,
+		770, 769, 1, 0, 0, 0, 771, 772, 1, 0, 0, 0, 772, 770, 1, 0, 0, 0, 772,
+		773, 1, 0, 0, 0, 773, 777, 1, 0, 0, 0, 774, 776, 3, 165, 82, 0, 775, 774,
+		1, 0, 0, 0, 776, 779, 1, 0, 0, 0, 777, 775, 1, 0, 0, 0, 777, 778, 1, 0,
+		0, 0, 778, 168, 1, 0, 0, 0, 779, 777, 1, 0, 0, 0, 780, 782, 7, 4, 0, 0,
+		781, 780, 1, 0, 0, 0, 782, 783, 1, 0, 0, 0, 783, 781, 1, 0, 0, 0, 783,
+		784, 1, 0, 0, 0, 784, 791, 1, 0, 0, 0, 785, 787, 5, 46, 0, 0, 786, 788,
+		7, 4, 0, 0, 787, 786, 1, 0, 0, 0, 788, 789, 1, 0, 0, 0, 789, 787, 1, 0,
+		0, 0, 789, 790, 1, 0, 0, 0, 790, 792, 1, 0, 0, 0, 791, 785, 1, 0, 0, 0,
+		791, 792, 1, 0, 0, 0, 792, 802, 1, 0, 0, 0, 793, 795, 7, 5, 0, 0, 794,
+		796, 7, 6, 0, 0, 795, 794, 1, 0, 0, 0, 795, 796, 1, 0, 0, 0, 796, 798,
+		1, 0, 0, 0, 797, 799, 7, 4, 0, 0, 798, 797, 1, 0, 0, 0, 799, 800, 1, 0,
+		0, 0, 800, 798, 1, 0, 0, 0, 800, 801, 1, 0, 0, 0, 801, 803, 1, 0, 0, 0,
+		802, 793, 1, 0, 0, 0, 802, 803, 1, 0, 0, 0, 803, 170, 1, 0, 0, 0, 804,
+		808, 7, 7, 0, 0, 805, 807, 7, 8, 0, 0, 806, 805, 1, 0, 0, 0, 807, 810,
+		1, 0, 0, 0, 808, 806, 1, 0, 0, 0, 808, 809, 1, 0, 0, 0, 809, 172, 1, 0,
+		0, 0, 810, 808, 1, 0, 0, 0, 811, 812, 5, 61, 0, 0, 812, 174, 1, 0, 0, 0,
+		813, 814, 5, 43, 0, 0, 814, 176, 1, 0, 0, 0, 815, 816, 5, 45, 0, 0, 816,
+		178, 1, 0, 0, 0, 817, 818, 5, 42, 0, 0, 818, 180, 1, 0, 0, 0, 819, 820,
+		5, 47, 0, 0, 820, 182, 1, 0, 0, 0, 821, 822, 5, 37, 0, 0, 822, 184, 1,
+		0, 0, 0, 823, 824, 5, 42, 0, 0, 824, 825, 5, 42, 0, 0, 825, 186, 1, 0,
+		0, 0, 826, 827, 5, 38, 0, 0, 827, 188, 1, 0, 0, 0, 828, 829, 5, 124, 0,
+		0, 829, 190, 1, 0, 0, 0, 830, 831, 5, 94, 0, 0, 831, 192, 1, 0, 0, 0, 832,
+		833, 5, 126, 0, 0, 833, 194, 1, 0, 0, 0, 834, 835, 5, 40, 0, 0, 835, 196,
+		1, 0, 0, 0, 836, 837, 5, 41, 0, 0, 837, 198, 1, 0, 0, 0, 838, 839, 5, 44,
+		0, 0, 839, 200, 1, 0, 0, 0, 840, 841, 5, 91, 0, 0, 841, 202, 1, 0, 0, 0,
+		842, 843, 5, 93, 0, 0, 843, 204, 1, 0, 0, 0, 844, 845, 5, 123, 0, 0, 845,
+		206, 1, 0, 0, 0, 846, 847, 5, 125, 0, 0, 847, 208, 1, 0, 0, 0, 848, 849,
+		5, 58, 0, 0, 849, 210, 1, 0, 0, 0, 850, 851, 5, 46, 0, 0, 851, 212, 1,
+		0, 0, 0, 852, 853, 5, 123, 0, 0, 853, 854, 5, 123, 0, 0, 854, 214, 1, 0,
+		0, 0, 855, 856, 5, 61, 0, 0, 856, 857, 5, 61, 0, 0, 857, 216, 1, 0, 0,
+		0, 858, 859, 5, 33, 0, 0, 859, 860, 5, 61, 0, 0, 860, 218, 1, 0, 0, 0,
+		861, 862, 5, 62, 0, 0, 862, 220, 1, 0, 0, 0, 863, 864, 5, 60, 0, 0, 864,
+		222, 1, 0, 0, 0, 865, 866, 5, 62, 0, 0, 866, 867, 5, 61, 0, 0, 867, 224,
+		1, 0, 0, 0, 868, 869, 5, 60, 0, 0, 869, 870, 5, 61, 0, 0, 870, 226, 1,
+		0, 0, 0, 871, 877, 5, 35, 0, 0, 872, 873, 5, 45, 0, 0, 873, 877, 5, 45,
+		0, 0, 874, 875, 5, 47, 0, 0, 875, 877, 5, 47, 0, 0, 876, 871, 1, 0, 0,
+		0, 876, 872, 1, 0, 0, 0, 876, 874, 1, 0, 0, 0, 877, 881, 1, 0, 0, 0, 878,
+		880, 8, 9, 0, 0, 879, 878, 1, 0, 0, 0, 880, 883, 1, 0, 0, 0, 881, 879,
+		1, 0, 0, 0, 881, 882, 1, 0, 0, 0, 882, 884, 1, 0, 0, 0, 883, 881, 1, 0,
+		0, 0, 884, 885, 6, 113, 0, 0, 885, 228, 1, 0, 0, 0, 886, 888, 5, 13, 0,
+		0, 887, 886, 1, 0, 0, 0, 887, 888, 1, 0, 0, 0, 888, 889, 1, 0, 0, 0, 889,
+		892, 5, 10, 0, 0, 890, 892, 5, 13, 0, 0, 891, 887, 1, 0, 0, 0, 891, 890,
+		1, 0, 0, 0, 892, 230, 1, 0, 0, 0, 893, 895, 7, 3, 0, 0, 894, 893, 1, 0,
+		0, 0, 895, 896, 1, 0, 0, 0, 896, 894, 1, 0, 0, 0, 896, 897, 1, 0, 0, 0,
+		897, 898, 1, 0, 0, 0, 898, 899, 6, 115, 0, 0, 899, 232, 1, 0, 0, 0, 900,
+		905, 5, 92, 0, 0, 901, 906, 3, 237, 118, 0, 902, 906, 3, 239, 119, 0, 903,
+		906, 3, 241, 120, 0, 904, 906, 3, 235, 117, 0, 905, 901, 1, 0, 0, 0, 905,
+		902, 1, 0, 0, 0, 905, 903, 1, 0, 0, 0, 905, 904, 1, 0, 0, 0, 906, 234,
+		1, 0, 0, 0, 907, 908, 7, 10, 0, 0, 908, 236, 1, 0, 0, 0, 909, 910, 5, 117,
+		0, 0, 910, 911, 3, 243, 121, 0, 911, 912, 3, 243, 121, 0, 912, 913, 3,
+		243, 121, 0, 913, 914, 3, 243, 121, 0, 914, 238, 1, 0, 0, 0, 915, 916,
+		5, 120, 0, 0, 916, 917, 3, 243, 121, 0, 917, 918, 3, 243, 121, 0, 918,
+		240, 1, 0, 0, 0, 919, 921, 7, 11, 0, 0, 920, 919, 1, 0, 0, 0, 920, 921,
+		1, 0, 0, 0, 921, 922, 1, 0, 0, 0, 922, 923, 7, 12, 0, 0, 923, 924, 7, 12,
+		0, 0, 924, 242, 1, 0, 0, 0, 925, 926, 7, 13, 0, 0, 926, 244, 1, 0, 0, 0,
+		31, 0, 247, 251, 681, 685, 690, 695, 701, 709, 713, 722, 736, 749, 758,
+		763, 772, 777, 783, 789, 791, 795, 800, 802, 808, 876, 881, 887, 891, 896,
+		905, 920, 1, 0, 1, 0,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -522,97 +566,107 @@ const (
 	NeuroScriptLexerKW_ATAN                = 7
 	NeuroScriptLexerKW_BREAK               = 8
 	NeuroScriptLexerKW_CALL                = 9
-	NeuroScriptLexerKW_CLEAR               = 10
-	NeuroScriptLexerKW_CLEAR_ERROR         = 11
-	NeuroScriptLexerKW_COMMAND             = 12
-	NeuroScriptLexerKW_CONTINUE            = 13
-	NeuroScriptLexerKW_COS                 = 14
-	NeuroScriptLexerKW_DO                  = 15
-	NeuroScriptLexerKW_EACH                = 16
-	NeuroScriptLexerKW_ELSE                = 17
-	NeuroScriptLexerKW_EMIT                = 18
-	NeuroScriptLexerKW_ENDCOMMAND          = 19
-	NeuroScriptLexerKW_ENDFOR              = 20
-	NeuroScriptLexerKW_ENDFUNC             = 21
-	NeuroScriptLexerKW_ENDIF               = 22
-	NeuroScriptLexerKW_ENDON               = 23
-	NeuroScriptLexerKW_ENDWHILE            = 24
-	NeuroScriptLexerKW_ERROR               = 25
-	NeuroScriptLexerKW_EVAL                = 26
-	NeuroScriptLexerKW_EVENT               = 27
-	NeuroScriptLexerKW_FAIL                = 28
-	NeuroScriptLexerKW_FALSE               = 29
-	NeuroScriptLexerKW_FOR                 = 30
-	NeuroScriptLexerKW_FUNC                = 31
-	NeuroScriptLexerKW_FUZZY               = 32
-	NeuroScriptLexerKW_IF                  = 33
-	NeuroScriptLexerKW_IN                  = 34
-	NeuroScriptLexerKW_INTO                = 35
-	NeuroScriptLexerKW_LAST                = 36
-	NeuroScriptLexerKW_LEN                 = 37
-	NeuroScriptLexerKW_LN                  = 38
-	NeuroScriptLexerKW_LOG                 = 39
-	NeuroScriptLexerKW_MEANS               = 40
-	NeuroScriptLexerKW_MUST                = 41
-	NeuroScriptLexerKW_MUSTBE              = 42
-	NeuroScriptLexerKW_NAMED               = 43
-	NeuroScriptLexerKW_NEEDS               = 44
-	NeuroScriptLexerKW_NIL                 = 45
-	NeuroScriptLexerKW_NO                  = 46
-	NeuroScriptLexerKW_NOT                 = 47
-	NeuroScriptLexerKW_ON                  = 48
-	NeuroScriptLexerKW_OPTIONAL            = 49
-	NeuroScriptLexerKW_OR                  = 50
-	NeuroScriptLexerKW_PROMPTUSER          = 51
-	NeuroScriptLexerKW_RETURN              = 52
-	NeuroScriptLexerKW_RETURNS             = 53
-	NeuroScriptLexerKW_SET                 = 54
-	NeuroScriptLexerKW_SIN                 = 55
-	NeuroScriptLexerKW_SOME                = 56
-	NeuroScriptLexerKW_TAN                 = 57
-	NeuroScriptLexerKW_TIMEDATE            = 58
-	NeuroScriptLexerKW_TOOL                = 59
-	NeuroScriptLexerKW_TRUE                = 60
-	NeuroScriptLexerKW_TYPEOF              = 61
-	NeuroScriptLexerKW_WHILE               = 62
-	NeuroScriptLexerKW_WHISPER             = 63
-	NeuroScriptLexerKW_WITH                = 64
-	NeuroScriptLexerAT                     = 65
-	NeuroScriptLexerSTRING_LIT             = 66
-	NeuroScriptLexerTRIPLE_BACKTICK_STRING = 67
-	NeuroScriptLexerTRIPLE_SQ_STRING       = 68
-	NeuroScriptLexerDOUBLE_BRACKET_STRING  = 69
-	NeuroScriptLexerMETADATA_LINE          = 70
-	NeuroScriptLexerNUMBER_LIT             = 71
-	NeuroScriptLexerIDENTIFIER             = 72
-	NeuroScriptLexerASSIGN                 = 73
-	NeuroScriptLexerPLUS                   = 74
-	NeuroScriptLexerMINUS                  = 75
-	NeuroScriptLexerSTAR                   = 76
-	NeuroScriptLexerSLASH                  = 77
-	NeuroScriptLexerPERCENT                = 78
-	NeuroScriptLexerSTAR_STAR              = 79
-	NeuroScriptLexerAMPERSAND              = 80
-	NeuroScriptLexerPIPE                   = 81
-	NeuroScriptLexerCARET                  = 82
-	NeuroScriptLexerTILDE                  = 83
-	NeuroScriptLexerLPAREN                 = 84
-	NeuroScriptLexerRPAREN                 = 85
-	NeuroScriptLexerCOMMA                  = 86
-	NeuroScriptLexerLBRACK                 = 87
-	NeuroScriptLexerRBRACK                 = 88
-	NeuroScriptLexerLBRACE                 = 89
-	NeuroScriptLexerRBRACE                 = 90
-	NeuroScriptLexerCOLON                  = 91
-	NeuroScriptLexerDOT                    = 92
-	NeuroScriptLexerPLACEHOLDER_START      = 93
-	NeuroScriptLexerEQ                     = 94
-	NeuroScriptLexerNEQ                    = 95
-	NeuroScriptLexerGT                     = 96
-	NeuroScriptLexerLT                     = 97
-	NeuroScriptLexerGTE                    = 98
-	NeuroScriptLexerLTE                    = 99
-	NeuroScriptLexerLINE_COMMENT           = 100
-	NeuroScriptLexerNEWLINE                = 101
-	NeuroScriptLexerWS                     = 102
+	NeuroScriptLexerKW_CASE                = 10
+	NeuroScriptLexerKW_CATCH               = 11
+	NeuroScriptLexerKW_CLEAR               = 12
+	NeuroScriptLexerKW_CLEAR_ERROR         = 13
+	NeuroScriptLexerKW_COMMAND             = 14
+	NeuroScriptLexerKW_CONTINUE            = 15
+	NeuroScriptLexerKW_COS                 = 16
+	NeuroScriptLexerKW_DEFAULT             = 17
+	NeuroScriptLexerKW_DO                  = 18
+	NeuroScriptLexerKW_EACH                = 19
+	NeuroScriptLexerKW_ELSE                = 20
+	NeuroScriptLexerKW_EMIT                = 21
+	NeuroScriptLexerKW_ENDCOMMAND          = 22
+	NeuroScriptLexerKW_ENDFOR              = 23
+	NeuroScriptLexerKW_ENDFUNC             = 24
+	NeuroScriptLexerKW_ENDIF               = 25
+	NeuroScriptLexerKW_ENDON               = 26
+	NeuroScriptLexerKW_ENDSWITCH           = 27
+	NeuroScriptLexerKW_ENDTRY              = 28
+	NeuroScriptLexerKW_ENDWHILE            = 29
+	NeuroScriptLexerKW_ERROR               = 30
+	NeuroScriptLexerKW_EVAL                = 31
+	NeuroScriptLexerKW_EVENT               = 32
+	NeuroScriptLexerKW_FAIL                = 33
+	NeuroScriptLexerKW_FALSE               = 34
+	NeuroScriptLexerKW_FINALLY             = 35
+	NeuroScriptLexerKW_FOR                 = 36
+	NeuroScriptLexerKW_FUNC                = 37
+	NeuroScriptLexerKW_FUZZY               = 38
+	NeuroScriptLexerKW_IF                  = 39
+	NeuroScriptLexerKW_IN                  = 40
+	NeuroScriptLexerKW_INTO                = 41
+	NeuroScriptLexerKW_LAST                = 42
+	NeuroScriptLexerKW_LEN                 = 43
+	NeuroScriptLexerKW_LN                  = 44
+	NeuroScriptLexerKW_LOG                 = 45
+	NeuroScriptLexerKW_MEANS               = 46
+	NeuroScriptLexerKW_MUST                = 47
+	NeuroScriptLexerKW_MUSTBE              = 48
+	NeuroScriptLexerKW_NAMED               = 49
+	NeuroScriptLexerKW_NEEDS               = 50
+	NeuroScriptLexerKW_NIL                 = 51
+	NeuroScriptLexerKW_NO                  = 52
+	NeuroScriptLexerKW_NOT                 = 53
+	NeuroScriptLexerKW_ON                  = 54
+	NeuroScriptLexerKW_OPTIONAL            = 55
+	NeuroScriptLexerKW_OR                  = 56
+	NeuroScriptLexerKW_PROMPTUSER          = 57
+	NeuroScriptLexerKW_RAISE               = 58
+	NeuroScriptLexerKW_RETURN              = 59
+	NeuroScriptLexerKW_RETURNS             = 60
+	NeuroScriptLexerKW_SET                 = 61
+	NeuroScriptLexerKW_SIN                 = 62
+	NeuroScriptLexerKW_SOME                = 63
+	NeuroScriptLexerKW_SWITCH              = 64
+	NeuroScriptLexerKW_TAN                 = 65
+	NeuroScriptLexerKW_TIMEDATE            = 66
+	NeuroScriptLexerKW_TOOL                = 67
+	NeuroScriptLexerKW_TRUE                = 68
+	NeuroScriptLexerKW_TRY                 = 69
+	NeuroScriptLexerKW_TYPEOF              = 70
+	NeuroScriptLexerKW_WHERE               = 71
+	NeuroScriptLexerKW_WHILE               = 72
+	NeuroScriptLexerKW_WHISPER             = 73
+	NeuroScriptLexerKW_WITH                = 74
+	NeuroScriptLexerAT                     = 75
+	NeuroScriptLexerSTRING_LIT             = 76
+	NeuroScriptLexerTRIPLE_BACKTICK_STRING = 77
+	NeuroScriptLexerTRIPLE_SQ_STRING       = 78
+	NeuroScriptLexerDOUBLE_BRACKET_STRING  = 79
+	NeuroScriptLexerMETADATA_LINE          = 80
+	NeuroScriptLexerNUMBER_LIT             = 81
+	NeuroScriptLexerIDENTIFIER             = 82
+	NeuroScriptLexerASSIGN                 = 83
+	NeuroScriptLexerPLUS                   = 84
+	NeuroScriptLexerMINUS                  = 85
+	NeuroScriptLexerSTAR                   = 86
+	NeuroScriptLexerSLASH                  = 87
+	NeuroScriptLexerPERCENT                = 88
+	NeuroScriptLexerSTAR_STAR              = 89
+	NeuroScriptLexerAMPERSAND              = 90
+	NeuroScriptLexerPIPE                   = 91
+	NeuroScriptLexerCARET                  = 92
+	NeuroScriptLexerTILDE                  = 93
+	NeuroScriptLexerLPAREN                 = 94
+	NeuroScriptLexerRPAREN                 = 95
+	NeuroScriptLexerCOMMA                  = 96
+	NeuroScriptLexerLBRACK                 = 97
+	NeuroScriptLexerRBRACK                 = 98
+	NeuroScriptLexerLBRACE                 = 99
+	NeuroScriptLexerRBRACE                 = 100
+	NeuroScriptLexerCOLON                  = 101
+	NeuroScriptLexerDOT                    = 102
+	NeuroScriptLexerPLACEHOLDER_START      = 103
+	NeuroScriptLexerEQ                     = 104
+	NeuroScriptLexerNEQ                    = 105
+	NeuroScriptLexerGT                     = 106
+	NeuroScriptLexerLT                     = 107
+	NeuroScriptLexerGTE                    = 108
+	NeuroScriptLexerLTE                    = 109
+	NeuroScriptLexerLINE_COMMENT           = 110
+	NeuroScriptLexerNEWLINE                = 111
+	NeuroScriptLexerWS                     = 112
 )
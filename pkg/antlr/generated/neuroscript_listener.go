@@ -226,6 +226,42 @@ type NeuroScriptListener interface {
 	// EnterMap_entry is called when entering the map_entry production.
 	EnterMap_entry(c *Map_entryContext)
 
+	// EnterArgument_list_opt is called when entering the argument_list_opt production.
+	EnterArgument_list_opt(c *Argument_list_optContext)
+
+	// EnterArgument_list is called when entering the argument_list production.
+	EnterArgument_list(c *Argument_listContext)
+
+	// EnterArgument is called when entering the argument production.
+	EnterArgument(c *ArgumentContext)
+
+	// EnterOptional_param_list is called when entering the optional_param_list production.
+	EnterOptional_param_list(c *Optional_param_listContext)
+
+	// EnterOptional_param is called when entering the optional_param production.
+	EnterOptional_param(c *Optional_paramContext)
+
+	// EnterLambda_expr is called when entering the lambda_expr production.
+	EnterLambda_expr(c *Lambda_exprContext)
+
+	// EnterElse_clause is called when entering the else_clause production.
+	EnterElse_clause(c *Else_clauseContext)
+
+	// EnterSwitch_statement is called when entering the switch_statement production.
+	EnterSwitch_statement(c *Switch_statementContext)
+
+	// EnterSwitch_case is called when entering the switch_case production.
+	EnterSwitch_case(c *Switch_caseContext)
+
+	// EnterTry_statement is called when entering the try_statement production.
+	EnterTry_statement(c *Try_statementContext)
+
+	// EnterSubscript is called when entering the subscript production.
+	EnterSubscript(c *SubscriptContext)
+
+	// EnterRaise_statement is called when entering the raise_statement production.
+	EnterRaise_statement(c *Raise_statementContext)
+
 	// ExitProgram is called when exiting the program production.
 	ExitProgram(c *ProgramContext)
 
@@ -444,4 +480,40 @@ type NeuroScriptListener interface {
 
 	// ExitMap_entry is called when exiting the map_entry production.
 	ExitMap_entry(c *Map_entryContext)
+
+	// ExitArgument_list_opt is called when exiting the argument_list_opt production.
+	ExitArgument_list_opt(c *Argument_list_optContext)
+
+	// ExitArgument_list is called when exiting the argument_list production.
+	ExitArgument_list(c *Argument_listContext)
+
+	// ExitArgument is called when exiting the argument production.
+	ExitArgument(c *ArgumentContext)
+
+	// ExitOptional_param_list is called when exiting the optional_param_list production.
+	ExitOptional_param_list(c *Optional_param_listContext)
+
+	// ExitOptional_param is called when exiting the optional_param production.
+	ExitOptional_param(c *Optional_paramContext)
+
+	// ExitLambda_expr is called when exiting the lambda_expr production.
+	ExitLambda_expr(c *Lambda_exprContext)
+
+	// ExitElse_clause is called when exiting the else_clause production.
+	ExitElse_clause(c *Else_clauseContext)
+
+	// ExitSwitch_statement is called when exiting the switch_statement production.
+	ExitSwitch_statement(c *Switch_statementContext)
+
+	// ExitSwitch_case is called when exiting the switch_case production.
+	ExitSwitch_case(c *Switch_caseContext)
+
+	// ExitTry_statement is called when exiting the try_statement production.
+	ExitTry_statement(c *Try_statementContext)
+
+	// ExitSubscript is called when exiting the subscript production.
+	ExitSubscript(c *SubscriptContext)
+
+	// ExitRaise_statement is called when exiting the raise_statement production.
+	ExitRaise_statement(c *Raise_statementContext)
 }
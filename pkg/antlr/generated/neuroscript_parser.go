@@ -33,36 +33,40 @@ func neuroscriptParserInit() {
 	staticData := &NeuroScriptParserStaticData
 	staticData.LiteralNames = []string{
 		"", "", "'acos'", "'and'", "'as'", "'asin'", "'ask'", "'atan'", "'break'",
-		"'call'", "'clear'", "'clear_error'", "'command'", "'continue'", "'cos'",
-		"'do'", "'each'", "'else'", "'emit'", "'endcommand'", "'endfor'", "'endfunc'",
-		"'endif'", "'endon'", "'endwhile'", "'error'", "'eval'", "'event'",
-		"'fail'", "'false'", "'for'", "'func'", "'fuzzy'", "'if'", "'in'", "'into'",
-		"'last'", "'len'", "'ln'", "'log'", "'means'", "'must'", "'mustbe'",
+		"'call'", "'case'", "'catch'", "'clear'", "'clear_error'", "'command'",
+		"'continue'", "'cos'", "'default'", "'do'", "'each'", "'else'", "'emit'",
+		"'endcommand'", "'endfor'", "'endfunc'", "'endif'", "'endon'", "'endswitch'",
+		"'endtry'", "'endwhile'", "'error'", "'eval'", "'event'", "'fail'",
+		"'false'", "'finally'", "'for'", "'func'", "'fuzzy'", "'if'", "'in'",
+		"'into'", "'last'", "'len'", "'ln'", "'log'", "'means'", "'must'", "'mustbe'",
 		"'named'", "'needs'", "'nil'", "'no'", "'not'", "'on'", "'optional'",
-		"'or'", "'promptuser'", "'return'", "'returns'", "'set'", "'sin'", "'some'",
-		"'tan'", "'timedate'", "'tool'", "'true'", "'typeof'", "'while'", "'whisper'",
-		"'with'", "'@'", "", "", "", "", "", "", "", "'='", "'+'", "'-'", "'*'",
-		"'/'", "'%'", "'**'", "'&'", "'|'", "'^'", "'~'", "'('", "')'", "','",
-		"'['", "']'", "'{'", "'}'", "':'", "'.'", "'{{'", "'=='", "'!='", "'>'",
-		"'<'", "'>='", "'<='",
+		"'or'", "'promptuser'", "'raise'", "'return'", "'returns'", "'set'",
+		"'sin'", "'some'", "'switch'", "'tan'", "'timedate'", "'tool'", "'true'",
+		"'try'", "'typeof'", "'where'", "'while'", "'whisper'", "'with'", "'@'",
+		"", "", "", "", "", "", "", "'='", "'+'", "'-'", "'*'", "'/'", "'%'",
+		"'**'", "'&'", "'|'", "'^'", "'~'", "'('", "')'", "','", "'['", "']'",
+		"'{'", "'}'", "':'", "'.'", "'{{'", "'=='", "'!='", "'>'", "'<'", "'>='",
+		"'<='",
 	}
 	staticData.SymbolicNames = []string{
 		"", "LINE_ESCAPE_GLOBAL", "KW_ACOS", "KW_AND", "KW_AS", "KW_ASIN", "KW_ASK",
-		"KW_ATAN", "KW_BREAK", "KW_CALL", "KW_CLEAR", "KW_CLEAR_ERROR", "KW_COMMAND",
-		"KW_CONTINUE", "KW_COS", "KW_DO", "KW_EACH", "KW_ELSE", "KW_EMIT", "KW_ENDCOMMAND",
-		"KW_ENDFOR", "KW_ENDFUNC", "KW_ENDIF", "KW_ENDON", "KW_ENDWHILE", "KW_ERROR",
-		"KW_EVAL", "KW_EVENT", "KW_FAIL", "KW_FALSE", "KW_FOR", "KW_FUNC", "KW_FUZZY",
-		"KW_IF", "KW_IN", "KW_INTO", "KW_LAST", "KW_LEN", "KW_LN", "KW_LOG",
-		"KW_MEANS", "KW_MUST", "KW_MUSTBE", "KW_NAMED", "KW_NEEDS", "KW_NIL",
-		"KW_NO", "KW_NOT", "KW_ON", "KW_OPTIONAL", "KW_OR", "KW_PROMPTUSER",
-		"KW_RETURN", "KW_RETURNS", "KW_SET", "KW_SIN", "KW_SOME", "KW_TAN",
-		"KW_TIMEDATE", "KW_TOOL", "KW_TRUE", "KW_TYPEOF", "KW_WHILE", "KW_WHISPER",
-		"KW_WITH", "AT", "STRING_LIT", "TRIPLE_BACKTICK_STRING", "TRIPLE_SQ_STRING",
-		"DOUBLE_BRACKET_STRING", "METADATA_LINE", "NUMBER_LIT", "IDENTIFIER",
-		"ASSIGN", "PLUS", "MINUS", "STAR", "SLASH", "PERCENT", "STAR_STAR",
-		"AMPERSAND", "PIPE", "CARET", "TILDE", "LPAREN", "RPAREN", "COMMA",
-		"LBRACK", "RBRACK", "LBRACE", "RBRACE", "COLON", "DOT", "PLACEHOLDER_START",
-		"EQ", "NEQ", "GT", "LT", "GTE", "LTE", "LINE_COMMENT", "NEWLINE", "WS",
+		"KW_ATAN", "KW_BREAK", "KW_CALL", "KW_CASE", "KW_CATCH", "KW_CLEAR",
+		"KW_CLEAR_ERROR", "KW_COMMAND", "KW_CONTINUE", "KW_COS", "KW_DEFAULT",
+		"KW_DO", "KW_EACH", "KW_ELSE", "KW_EMIT", "KW_ENDCOMMAND", "KW_ENDFOR",
+		"KW_ENDFUNC", "KW_ENDIF", "KW_ENDON", "KW_ENDSWITCH", "KW_ENDTRY", "KW_ENDWHILE",
+		"KW_ERROR", "KW_EVAL", "KW_EVENT", "KW_FAIL", "KW_FALSE", "KW_FINALLY",
+		"KW_FOR", "KW_FUNC", "KW_FUZZY", "KW_IF", "KW_IN", "KW_INTO", "KW_LAST",
+		"KW_LEN", "KW_LN", "KW_LOG", "KW_MEANS", "KW_MUST", "KW_MUSTBE", "KW_NAMED",
+		"KW_NEEDS", "KW_NIL", "KW_NO", "KW_NOT", "KW_ON", "KW_OPTIONAL", "KW_OR",
+		"KW_PROMPTUSER", "KW_RAISE", "KW_RETURN", "KW_RETURNS", "KW_SET", "KW_SIN",
+		"KW_SOME", "KW_SWITCH", "KW_TAN", "KW_TIMEDATE", "KW_TOOL", "KW_TRUE",
+		"KW_TRY", "KW_TYPEOF", "KW_WHERE", "KW_WHILE", "KW_WHISPER", "KW_WITH",
+		"AT", "STRING_LIT", "TRIPLE_BACKTICK_STRING", "TRIPLE_SQ_STRING", "DOUBLE_BRACKET_STRING",
+		"METADATA_LINE", "NUMBER_LIT", "IDENTIFIER", "ASSIGN", "PLUS", "MINUS",
+		"STAR", "SLASH", "PERCENT", "STAR_STAR", "AMPERSAND", "PIPE", "CARET",
+		"TILDE", "LPAREN", "RPAREN", "COMMA", "LBRACK", "RBRACK", "LBRACE",
+		"RBRACE", "COLON", "DOT", "PLACEHOLDER_START", "EQ", "NEQ", "GT", "LT",
+		"GTE", "LTE", "LINE_COMMENT", "NEWLINE", "WS",
 	}
 	staticData.RuleNames = []string{
 		"program", "file_header", "library_script", "command_script", "library_block",
@@ -82,11 +86,13 @@ func neuroscriptParserInit() {
 		"power_expr", "accessor_expr", "primary", "callable_expr", "placeholder",
 		"literal", "nil_literal", "boolean_literal", "list_literal", "map_literal",
 		"expression_list_opt", "expression_list", "map_entry_list_opt", "map_entry_list",
-		"map_entry",
+		"map_entry", "argument_list_opt", "argument_list", "argument", "optional_param_list",
+		"optional_param", "lambda_expr", "else_clause", "switch_statement",
+		"switch_case", "try_statement", "subscript", "raise_statement",
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 1, 102, 668, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
+		4, 1, 112, 826, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
 		4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7,
 		10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15,
 		2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2,
@@ -99,293 +105,368 @@ func neuroscriptParserInit() {
 		52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57, 7, 57,
 		2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7, 62, 2,
 		63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7, 67, 2, 68,
-		7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72, 1, 0, 1,
-		0, 1, 0, 3, 0, 150, 8, 0, 1, 0, 1, 0, 1, 1, 5, 1, 155, 8, 1, 10, 1, 12,
-		1, 158, 9, 1, 1, 2, 4, 2, 161, 8, 2, 11, 2, 12, 2, 162, 1, 3, 4, 3, 166,
-		8, 3, 11, 3, 12, 3, 167, 1, 4, 1, 4, 1, 4, 3, 4, 173, 8, 4, 1, 4, 5, 4,
-		176, 8, 4, 10, 4, 12, 4, 179, 9, 4, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5,
-		5, 5, 187, 8, 5, 10, 5, 12, 5, 190, 9, 5, 1, 6, 5, 6, 193, 8, 6, 10, 6,
-		12, 6, 196, 9, 6, 1, 6, 1, 6, 1, 6, 5, 6, 201, 8, 6, 10, 6, 12, 6, 204,
-		9, 6, 1, 7, 1, 7, 1, 7, 1, 7, 3, 7, 210, 8, 7, 1, 8, 1, 8, 1, 8, 3, 8,
-		215, 8, 8, 1, 9, 1, 9, 1, 9, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10,
-		1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 3, 10, 231, 8, 10, 1, 11, 1, 11, 1,
-		11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 12, 1, 12, 1, 12, 1, 12,
-		5, 12, 246, 8, 12, 10, 12, 12, 12, 249, 9, 12, 1, 12, 1, 12, 1, 12, 1,
-		12, 4, 12, 255, 8, 12, 11, 12, 12, 12, 256, 1, 12, 3, 12, 260, 8, 12, 1,
-		13, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 15, 1, 15, 1, 15, 1, 16, 1, 16,
-		1, 16, 5, 16, 274, 8, 16, 10, 16, 12, 16, 277, 9, 16, 1, 17, 1, 17, 5,
-		17, 281, 8, 17, 10, 17, 12, 17, 284, 9, 17, 1, 18, 5, 18, 287, 8, 18, 10,
-		18, 12, 18, 290, 9, 18, 1, 18, 1, 18, 1, 18, 5, 18, 295, 8, 18, 10, 18,
-		12, 18, 298, 9, 18, 1, 19, 5, 19, 301, 8, 19, 10, 19, 12, 19, 304, 9, 19,
-		1, 20, 1, 20, 1, 20, 1, 20, 3, 20, 310, 8, 20, 1, 21, 1, 21, 1, 21, 3,
-		21, 315, 8, 21, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22,
-		1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 3, 22, 330, 8, 22, 1, 23, 1, 23, 1,
-		23, 3, 23, 335, 8, 23, 1, 24, 1, 24, 1, 24, 3, 24, 340, 8, 24, 1, 25, 1,
-		25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 26, 1, 26, 1, 26, 1, 26, 3, 26, 352,
-		8, 26, 1, 26, 1, 26, 3, 26, 356, 8, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1,
-		26, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 3, 27, 368, 8, 27, 1, 28, 1, 28,
-		1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 5, 28, 377, 8, 28, 10, 28, 12, 28, 380,
-		9, 28, 1, 29, 1, 29, 1, 29, 5, 29, 385, 8, 29, 10, 29, 12, 29, 388, 9,
-		29, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 31, 1, 31, 1, 31, 1, 32, 1, 32,
-		3, 32, 400, 8, 32, 1, 33, 1, 33, 1, 33, 1, 34, 1, 34, 1, 34, 1, 34, 1,
-		34, 1, 35, 1, 35, 1, 35, 1, 36, 1, 36, 3, 36, 415, 8, 36, 1, 37, 1, 37,
-		1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 3, 38, 425, 8, 38, 1, 38, 1,
-		38, 3, 38, 429, 8, 38, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 40, 1, 40,
-		1, 41, 1, 41, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 3, 42, 447,
+		7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72, 2, 73, 7,
+		73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2, 78, 7, 78,
+		2, 79, 7, 79, 2, 80, 7, 80, 2, 81, 7, 81, 2, 82, 7, 82, 2, 83, 7, 83, 2,
+		84, 7, 84, 1, 0, 1, 0, 1, 0, 3, 0, 174, 8, 0, 1, 0, 1, 0, 1, 1, 5, 1, 179,
+		8, 1, 10, 1, 12, 1, 182, 9, 1, 1, 2, 4, 2, 185, 8, 2, 11, 2, 12, 2, 186,
+		1, 3, 4, 3, 190, 8, 3, 11, 3, 12, 3, 191, 1, 4, 1, 4, 1, 4, 3, 4, 197,
+		8, 4, 1, 4, 5, 4, 200, 8, 4, 10, 4, 12, 4, 203, 9, 4, 1, 5, 1, 5, 1, 5,
+		1, 5, 1, 5, 1, 5, 5, 5, 211, 8, 5, 10, 5, 12, 5, 214, 9, 5, 1, 6, 5, 6,
+		217, 8, 6, 10, 6, 12, 6, 220, 9, 6, 1, 6, 1, 6, 1, 6, 5, 6, 225, 8, 6,
+		10, 6, 12, 6, 228, 9, 6, 1, 7, 1, 7, 1, 7, 1, 7, 3, 7, 234, 8, 7, 1, 8,
+		1, 8, 1, 8, 3, 8, 239, 8, 8, 1, 9, 1, 9, 1, 9, 1, 10, 1, 10, 1, 10, 1,
+		10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 3, 10, 256,
+		8, 10, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1,
+		12, 1, 12, 1, 12, 1, 12, 5, 12, 271, 8, 12, 10, 12, 12, 12, 274, 9, 12,
+		1, 12, 1, 12, 1, 12, 1, 12, 4, 12, 280, 8, 12, 11, 12, 12, 12, 281, 1,
+		12, 3, 12, 285, 8, 12, 1, 13, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 15,
+		1, 15, 1, 15, 1, 16, 1, 16, 1, 16, 5, 16, 299, 8, 16, 10, 16, 12, 16, 302,
+		9, 16, 1, 17, 1, 17, 5, 17, 306, 8, 17, 10, 17, 12, 17, 309, 9, 17, 1,
+		18, 5, 18, 312, 8, 18, 10, 18, 12, 18, 315, 9, 18, 1, 18, 1, 18, 1, 18,
+		5, 18, 320, 8, 18, 10, 18, 12, 18, 323, 9, 18, 1, 19, 5, 19, 326, 8, 19,
+		10, 19, 12, 19, 329, 9, 19, 1, 20, 1, 20, 1, 20, 1, 20, 3, 20, 335, 8,
+		20, 1, 21, 1, 21, 1, 21, 3, 21, 340, 8, 21, 1, 22, 1, 22, 1, 22, 1, 22,
+		1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 3,
+		22, 356, 8, 22, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 3, 23, 363, 8, 23, 1,
+		24, 1, 24, 1, 24, 3, 24, 368, 8, 24, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25,
+		1, 25, 1, 26, 1, 26, 1, 26, 1, 26, 3, 26, 380, 8, 26, 1, 26, 1, 26, 3,
+		26, 384, 8, 26, 1, 26, 1, 26, 3, 26, 388, 8, 26, 1, 26, 1, 26, 1, 26, 1,
+		26, 1, 26, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 3, 27, 400, 8, 27, 1, 28,
+		1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 5, 28, 409, 8, 28, 10, 28, 12,
+		28, 412, 9, 28, 1, 29, 1, 29, 1, 29, 5, 29, 417, 8, 29, 10, 29, 12, 29,
+		420, 9, 29, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 31, 1, 31, 1, 31, 1,
+		32, 1, 32, 3, 32, 432, 8, 32, 1, 33, 1, 33, 1, 33, 1, 34, 1, 34, 1, 34,
+		1, 34, 1, 34, 1, 35, 1, 35, 1, 35, 1, 36, 1, 36, 3, 36, 447, 8, 36, 1,
+		37, 1, 37, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 3, 38, 457, 8, 38,
+		1, 38, 1, 38, 3, 38, 461, 8, 38, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1,
+		40, 1, 40, 1, 41, 1, 41, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 3, 42, 477,
 		8, 42, 1, 42, 1, 42, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 44, 1,
 		44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 45, 1, 45, 1, 45,
-		5, 45, 469, 8, 45, 10, 45, 12, 45, 472, 9, 45, 1, 46, 1, 46, 1, 46, 1,
-		46, 3, 46, 478, 8, 46, 1, 47, 1, 47, 1, 48, 1, 48, 1, 48, 5, 48, 485, 8,
-		48, 10, 48, 12, 48, 488, 9, 48, 1, 49, 1, 49, 1, 49, 5, 49, 493, 8, 49,
-		10, 49, 12, 49, 496, 9, 49, 1, 50, 1, 50, 1, 50, 5, 50, 501, 8, 50, 10,
-		50, 12, 50, 504, 9, 50, 1, 51, 1, 51, 1, 51, 5, 51, 509, 8, 51, 10, 51,
-		12, 51, 512, 9, 51, 1, 52, 1, 52, 1, 52, 5, 52, 517, 8, 52, 10, 52, 12,
-		52, 520, 9, 52, 1, 53, 1, 53, 1, 53, 5, 53, 525, 8, 53, 10, 53, 12, 53,
-		528, 9, 53, 1, 54, 1, 54, 1, 54, 5, 54, 533, 8, 54, 10, 54, 12, 54, 536,
-		9, 54, 1, 55, 1, 55, 1, 55, 5, 55, 541, 8, 55, 10, 55, 12, 55, 544, 9,
-		55, 1, 56, 1, 56, 1, 56, 5, 56, 549, 8, 56, 10, 56, 12, 56, 552, 9, 56,
-		1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 3, 57, 559, 8, 57, 1, 58, 1, 58, 1,
-		58, 3, 58, 564, 8, 58, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 5, 59, 571, 8,
-		59, 10, 59, 12, 59, 574, 9, 59, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60,
-		1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 3, 60, 590, 8,
-		60, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61,
-		3, 61, 602, 8, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 62, 1, 62, 3, 62, 610,
-		8, 62, 1, 62, 1, 62, 3, 62, 614, 8, 62, 1, 62, 1, 62, 1, 62, 1, 63, 1,
-		63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 3, 63, 628, 8, 63,
-		1, 64, 1, 64, 1, 65, 1, 65, 1, 66, 1, 66, 1, 66, 1, 66, 1, 67, 1, 67, 1,
-		67, 1, 67, 1, 68, 3, 68, 643, 8, 68, 1, 69, 1, 69, 1, 69, 5, 69, 648, 8,
-		69, 10, 69, 12, 69, 651, 9, 69, 1, 70, 3, 70, 654, 8, 70, 1, 71, 1, 71,
-		1, 71, 5, 71, 659, 8, 71, 10, 71, 12, 71, 662, 9, 71, 1, 72, 1, 72, 1,
-		72, 1, 72, 1, 72, 0, 0, 73, 0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22,
-		24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58,
-		60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 94,
-		96, 98, 100, 102, 104, 106, 108, 110, 112, 114, 116, 118, 120, 122, 124,
-		126, 128, 130, 132, 134, 136, 138, 140, 142, 144, 0, 7, 2, 0, 70, 70, 101,
-		101, 1, 0, 94, 95, 1, 0, 96, 99, 1, 0, 74, 75, 1, 0, 76, 78, 4, 0, 46,
-		47, 56, 56, 75, 75, 83, 83, 2, 0, 29, 29, 60, 60, 703, 0, 146, 1, 0, 0,
-		0, 2, 156, 1, 0, 0, 0, 4, 160, 1, 0, 0, 0, 6, 165, 1, 0, 0, 0, 8, 172,
-		1, 0, 0, 0, 10, 180, 1, 0, 0, 0, 12, 194, 1, 0, 0, 0, 14, 209, 1, 0, 0,
-		0, 16, 214, 1, 0, 0, 0, 18, 216, 1, 0, 0, 0, 20, 230, 1, 0, 0, 0, 22, 232,
-		1, 0, 0, 0, 24, 259, 1, 0, 0, 0, 26, 261, 1, 0, 0, 0, 28, 264, 1, 0, 0,
-		0, 30, 267, 1, 0, 0, 0, 32, 270, 1, 0, 0, 0, 34, 282, 1, 0, 0, 0, 36, 288,
-		1, 0, 0, 0, 38, 302, 1, 0, 0, 0, 40, 309, 1, 0, 0, 0, 42, 314, 1, 0, 0,
-		0, 44, 329, 1, 0, 0, 0, 46, 334, 1, 0, 0, 0, 48, 336, 1, 0, 0, 0, 50, 341,
-		1, 0, 0, 0, 52, 347, 1, 0, 0, 0, 54, 362, 1, 0, 0, 0, 56, 369, 1, 0, 0,
-		0, 58, 381, 1, 0, 0, 0, 60, 389, 1, 0, 0, 0, 62, 394, 1, 0, 0, 0, 64, 397,
-		1, 0, 0, 0, 66, 401, 1, 0, 0, 0, 68, 404, 1, 0, 0, 0, 70, 409, 1, 0, 0,
-		0, 72, 412, 1, 0, 0, 0, 74, 416, 1, 0, 0, 0, 76, 418, 1, 0, 0, 0, 78, 430,
-		1, 0, 0, 0, 80, 435, 1, 0, 0, 0, 82, 437, 1, 0, 0, 0, 84, 439, 1, 0, 0,
-		0, 86, 450, 1, 0, 0, 0, 88, 456, 1, 0, 0, 0, 90, 465, 1, 0, 0, 0, 92, 477,
-		1, 0, 0, 0, 94, 479, 1, 0, 0, 0, 96, 481, 1, 0, 0, 0, 98, 489, 1, 0, 0,
-		0, 100, 497, 1, 0, 0, 0, 102, 505, 1, 0, 0, 0, 104, 513, 1, 0, 0, 0, 106,
-		521, 1, 0, 0, 0, 108, 529, 1, 0, 0, 0, 110, 537, 1, 0, 0, 0, 112, 545,
-		1, 0, 0, 0, 114, 558, 1, 0, 0, 0, 116, 560, 1, 0, 0, 0, 118, 565, 1, 0,
-		0, 0, 120, 589, 1, 0, 0, 0, 122, 601, 1, 0, 0, 0, 124, 607, 1, 0, 0, 0,
-		126, 627, 1, 0, 0, 0, 128, 629, 1, 0, 0, 0, 130, 631, 1, 0, 0, 0, 132,
-		633, 1, 0, 0, 0, 134, 637, 1, 0, 0, 0, 136, 642, 1, 0, 0, 0, 138, 644,
-		1, 0, 0, 0, 140, 653, 1, 0, 0, 0, 142, 655, 1, 0, 0, 0, 144, 663, 1, 0,
-		0, 0, 146, 149, 3, 2, 1, 0, 147, 150, 3, 4, 2, 0, 148, 150, 3, 6, 3, 0,
-		149, 147, 1, 0, 0, 0, 149, 148, 1, 0, 0, 0, 149, 150, 1, 0, 0, 0, 150,
-		151, 1, 0, 0, 0, 151, 152, 5, 0, 0, 1, 152, 1, 1, 0, 0, 0, 153, 155, 7,
-		0, 0, 0, 154, 153, 1, 0, 0, 0, 155, 158, 1, 0, 0, 0, 156, 154, 1, 0, 0,
-		0, 156, 157, 1, 0, 0, 0, 157, 3, 1, 0, 0, 0, 158, 156, 1, 0, 0, 0, 159,
-		161, 3, 8, 4, 0, 160, 159, 1, 0, 0, 0, 161, 162, 1, 0, 0, 0, 162, 160,
-		1, 0, 0, 0, 162, 163, 1, 0, 0, 0, 163, 5, 1, 0, 0, 0, 164, 166, 3, 10,
-		5, 0, 165, 164, 1, 0, 0, 0, 166, 167, 1, 0, 0, 0, 167, 165, 1, 0, 0, 0,
-		167, 168, 1, 0, 0, 0, 168, 7, 1, 0, 0, 0, 169, 173, 3, 22, 11, 0, 170,
-		171, 5, 48, 0, 0, 171, 173, 3, 52, 26, 0, 172, 169, 1, 0, 0, 0, 172, 170,
-		1, 0, 0, 0, 173, 177, 1, 0, 0, 0, 174, 176, 5, 101, 0, 0, 175, 174, 1,
-		0, 0, 0, 176, 179, 1, 0, 0, 0, 177, 175, 1, 0, 0, 0, 177, 178, 1, 0, 0,
-		0, 178, 9, 1, 0, 0, 0, 179, 177, 1, 0, 0, 0, 180, 181, 5, 12, 0, 0, 181,
-		182, 5, 101, 0, 0, 182, 183, 3, 34, 17, 0, 183, 184, 3, 12, 6, 0, 184,
-		188, 5, 19, 0, 0, 185, 187, 5, 101, 0, 0, 186, 185, 1, 0, 0, 0, 187, 190,
-		1, 0, 0, 0, 188, 186, 1, 0, 0, 0, 188, 189, 1, 0, 0, 0, 189, 11, 1, 0,
-		0, 0, 190, 188, 1, 0, 0, 0, 191, 193, 5, 101, 0, 0, 192, 191, 1, 0, 0,
-		0, 193, 196, 1, 0, 0, 0, 194, 192, 1, 0, 0, 0, 194, 195, 1, 0, 0, 0, 195,
-		197, 1, 0, 0, 0, 196, 194, 1, 0, 0, 0, 197, 198, 3, 16, 8, 0, 198, 202,
-		5, 101, 0, 0, 199, 201, 3, 14, 7, 0, 200, 199, 1, 0, 0, 0, 201, 204, 1,
-		0, 0, 0, 202, 200, 1, 0, 0, 0, 202, 203, 1, 0, 0, 0, 203, 13, 1, 0, 0,
-		0, 204, 202, 1, 0, 0, 0, 205, 206, 3, 16, 8, 0, 206, 207, 5, 101, 0, 0,
-		207, 210, 1, 0, 0, 0, 208, 210, 5, 101, 0, 0, 209, 205, 1, 0, 0, 0, 209,
-		208, 1, 0, 0, 0, 210, 15, 1, 0, 0, 0, 211, 215, 3, 20, 10, 0, 212, 215,
-		3, 46, 23, 0, 213, 215, 3, 18, 9, 0, 214, 211, 1, 0, 0, 0, 214, 212, 1,
-		0, 0, 0, 214, 213, 1, 0, 0, 0, 215, 17, 1, 0, 0, 0, 216, 217, 5, 48, 0,
-		0, 217, 218, 3, 50, 25, 0, 218, 19, 1, 0, 0, 0, 219, 231, 3, 60, 30, 0,
-		220, 231, 3, 62, 31, 0, 221, 231, 3, 66, 33, 0, 222, 231, 3, 68, 34, 0,
-		223, 231, 3, 70, 35, 0, 224, 231, 3, 72, 36, 0, 225, 231, 3, 54, 27, 0,
-		226, 231, 3, 76, 38, 0, 227, 231, 3, 78, 39, 0, 228, 231, 3, 80, 40, 0,
-		229, 231, 3, 82, 41, 0, 230, 219, 1, 0, 0, 0, 230, 220, 1, 0, 0, 0, 230,
-		221, 1, 0, 0, 0, 230, 222, 1, 0, 0, 0, 230, 223, 1, 0, 0, 0, 230, 224,
-		1, 0, 0, 0, 230, 225, 1, 0, 0, 0, 230, 226, 1, 0, 0, 0, 230, 227, 1, 0,
-		0, 0, 230, 228, 1, 0, 0, 0, 230, 229, 1, 0, 0, 0, 231, 21, 1, 0, 0, 0,
-		232, 233, 5, 31, 0, 0, 233, 234, 5, 72, 0, 0, 234, 235, 3, 24, 12, 0, 235,
-		236, 5, 40, 0, 0, 236, 237, 5, 101, 0, 0, 237, 238, 3, 34, 17, 0, 238,
-		239, 3, 36, 18, 0, 239, 240, 5, 21, 0, 0, 240, 23, 1, 0, 0, 0, 241, 247,
-		5, 84, 0, 0, 242, 246, 3, 26, 13, 0, 243, 246, 3, 28, 14, 0, 244, 246,
-		3, 30, 15, 0, 245, 242, 1, 0, 0, 0, 245, 243, 1, 0, 0, 0, 245, 244, 1,
-		0, 0, 0, 246, 249, 1, 0, 0, 0, 247, 245, 1, 0, 0, 0, 247, 248, 1, 0, 0,
-		0, 248, 250, 1, 0, 0, 0, 249, 247, 1, 0, 0, 0, 250, 260, 5, 85, 0, 0, 251,
-		255, 3, 26, 13, 0, 252, 255, 3, 28, 14, 0, 253, 255, 3, 30, 15, 0, 254,
-		251, 1, 0, 0, 0, 254, 252, 1, 0, 0, 0, 254, 253, 1, 0, 0, 0, 255, 256,
-		1, 0, 0, 0, 256, 254, 1, 0, 0, 0, 256, 257, 1, 0, 0, 0, 257, 260, 1, 0,
-		0, 0, 258, 260, 1, 0, 0, 0, 259, 241, 1, 0, 0, 0, 259, 254, 1, 0, 0, 0,
-		259, 258, 1, 0, 0, 0, 260, 25, 1, 0, 0, 0, 261, 262, 5, 44, 0, 0, 262,
-		263, 3, 32, 16, 0, 263, 27, 1, 0, 0, 0, 264, 265, 5, 49, 0, 0, 265, 266,
-		3, 32, 16, 0, 266, 29, 1, 0, 0, 0, 267, 268, 5, 53, 0, 0, 268, 269, 3,
-		32, 16, 0, 269, 31, 1, 0, 0, 0, 270, 275, 5, 72, 0, 0, 271, 272, 5, 86,
-		0, 0, 272, 274, 5, 72, 0, 0, 273, 271, 1, 0, 0, 0, 274, 277, 1, 0, 0, 0,
-		275, 273, 1, 0, 0, 0, 275, 276, 1, 0, 0, 0, 276, 33, 1, 0, 0, 0, 277, 275,
-		1, 0, 0, 0, 278, 279, 5, 70, 0, 0, 279, 281, 5, 101, 0, 0, 280, 278, 1,
-		0, 0, 0, 281, 284, 1, 0, 0, 0, 282, 280, 1, 0, 0, 0, 282, 283, 1, 0, 0,
-		0, 283, 35, 1, 0, 0, 0, 284, 282, 1, 0, 0, 0, 285, 287, 5, 101, 0, 0, 286,
-		285, 1, 0, 0, 0, 287, 290, 1, 0, 0, 0, 288, 286, 1, 0, 0, 0, 288, 289,
-		1, 0, 0, 0, 289, 291, 1, 0, 0, 0, 290, 288, 1, 0, 0, 0, 291, 292, 3, 42,
-		21, 0, 292, 296, 5, 101, 0, 0, 293, 295, 3, 40, 20, 0, 294, 293, 1, 0,
-		0, 0, 295, 298, 1, 0, 0, 0, 296, 294, 1, 0, 0, 0, 296, 297, 1, 0, 0, 0,
-		297, 37, 1, 0, 0, 0, 298, 296, 1, 0, 0, 0, 299, 301, 3, 40, 20, 0, 300,
-		299, 1, 0, 0, 0, 301, 304, 1, 0, 0, 0, 302, 300, 1, 0, 0, 0, 302, 303,
-		1, 0, 0, 0, 303, 39, 1, 0, 0, 0, 304, 302, 1, 0, 0, 0, 305, 306, 3, 42,
-		21, 0, 306, 307, 5, 101, 0, 0, 307, 310, 1, 0, 0, 0, 308, 310, 5, 101,
-		0, 0, 309, 305, 1, 0, 0, 0, 309, 308, 1, 0, 0, 0, 310, 41, 1, 0, 0, 0,
-		311, 315, 3, 44, 22, 0, 312, 315, 3, 46, 23, 0, 313, 315, 3, 48, 24, 0,
-		314, 311, 1, 0, 0, 0, 314, 312, 1, 0, 0, 0, 314, 313, 1, 0, 0, 0, 315,
-		43, 1, 0, 0, 0, 316, 330, 3, 60, 30, 0, 317, 330, 3, 62, 31, 0, 318, 330,
-		3, 64, 32, 0, 319, 330, 3, 66, 33, 0, 320, 330, 3, 68, 34, 0, 321, 330,
-		3, 70, 35, 0, 322, 330, 3, 72, 36, 0, 323, 330, 3, 74, 37, 0, 324, 330,
-		3, 54, 27, 0, 325, 330, 3, 76, 38, 0, 326, 330, 3, 78, 39, 0, 327, 330,
-		3, 80, 40, 0, 328, 330, 3, 82, 41, 0, 329, 316, 1, 0, 0, 0, 329, 317, 1,
-		0, 0, 0, 329, 318, 1, 0, 0, 0, 329, 319, 1, 0, 0, 0, 329, 320, 1, 0, 0,
-		0, 329, 321, 1, 0, 0, 0, 329, 322, 1, 0, 0, 0, 329, 323, 1, 0, 0, 0, 329,
-		324, 1, 0, 0, 0, 329, 325, 1, 0, 0, 0, 329, 326, 1, 0, 0, 0, 329, 327,
-		1, 0, 0, 0, 329, 328, 1, 0, 0, 0, 330, 45, 1, 0, 0, 0, 331, 335, 3, 84,
-		42, 0, 332, 335, 3, 86, 43, 0, 333, 335, 3, 88, 44, 0, 334, 331, 1, 0,
-		0, 0, 334, 332, 1, 0, 0, 0, 334, 333, 1, 0, 0, 0, 335, 47, 1, 0, 0, 0,
-		336, 339, 5, 48, 0, 0, 337, 340, 3, 50, 25, 0, 338, 340, 3, 52, 26, 0,
-		339, 337, 1, 0, 0, 0, 339, 338, 1, 0, 0, 0, 340, 49, 1, 0, 0, 0, 341, 342,
-		5, 25, 0, 0, 342, 343, 5, 15, 0, 0, 343, 344, 5, 101, 0, 0, 344, 345, 3,
-		36, 18, 0, 345, 346, 5, 23, 0, 0, 346, 51, 1, 0, 0, 0, 347, 348, 5, 27,
-		0, 0, 348, 351, 3, 94, 47, 0, 349, 350, 5, 43, 0, 0, 350, 352, 5, 66, 0,
-		0, 351, 349, 1, 0, 0, 0, 351, 352, 1, 0, 0, 0, 352, 355, 1, 0, 0, 0, 353,
-		354, 5, 4, 0, 0, 354, 356, 5, 72, 0, 0, 355, 353, 1, 0, 0, 0, 355, 356,
-		1, 0, 0, 0, 356, 357, 1, 0, 0, 0, 357, 358, 5, 15, 0, 0, 358, 359, 5, 101,
-		0, 0, 359, 360, 3, 36, 18, 0, 360, 361, 5, 23, 0, 0, 361, 53, 1, 0, 0,
-		0, 362, 363, 5, 10, 0, 0, 363, 367, 5, 27, 0, 0, 364, 368, 3, 94, 47, 0,
-		365, 366, 5, 43, 0, 0, 366, 368, 5, 66, 0, 0, 367, 364, 1, 0, 0, 0, 367,
-		365, 1, 0, 0, 0, 368, 55, 1, 0, 0, 0, 369, 378, 5, 72, 0, 0, 370, 371,
-		5, 87, 0, 0, 371, 372, 3, 94, 47, 0, 372, 373, 5, 88, 0, 0, 373, 377, 1,
-		0, 0, 0, 374, 375, 5, 92, 0, 0, 375, 377, 5, 72, 0, 0, 376, 370, 1, 0,
-		0, 0, 376, 374, 1, 0, 0, 0, 377, 380, 1, 0, 0, 0, 378, 376, 1, 0, 0, 0,
-		378, 379, 1, 0, 0, 0, 379, 57, 1, 0, 0, 0, 380, 378, 1, 0, 0, 0, 381, 386,
-		3, 56, 28, 0, 382, 383, 5, 86, 0, 0, 383, 385, 3, 56, 28, 0, 384, 382,
-		1, 0, 0, 0, 385, 388, 1, 0, 0, 0, 386, 384, 1, 0, 0, 0, 386, 387, 1, 0,
-		0, 0, 387, 59, 1, 0, 0, 0, 388, 386, 1, 0, 0, 0, 389, 390, 5, 54, 0, 0,
-		390, 391, 3, 58, 29, 0, 391, 392, 5, 73, 0, 0, 392, 393, 3, 94, 47, 0,
-		393, 61, 1, 0, 0, 0, 394, 395, 5, 9, 0, 0, 395, 396, 3, 122, 61, 0, 396,
-		63, 1, 0, 0, 0, 397, 399, 5, 52, 0, 0, 398, 400, 3, 138, 69, 0, 399, 398,
-		1, 0, 0, 0, 399, 400, 1, 0, 0, 0, 400, 65, 1, 0, 0, 0, 401, 402, 5, 18,
-		0, 0, 402, 403, 3, 94, 47, 0, 403, 67, 1, 0, 0, 0, 404, 405, 5, 63, 0,
-		0, 405, 406, 3, 94, 47, 0, 406, 407, 5, 86, 0, 0, 407, 408, 3, 94, 47,
-		0, 408, 69, 1, 0, 0, 0, 409, 410, 5, 41, 0, 0, 410, 411, 3, 94, 47, 0,
-		411, 71, 1, 0, 0, 0, 412, 414, 5, 28, 0, 0, 413, 415, 3, 94, 47, 0, 414,
-		413, 1, 0, 0, 0, 414, 415, 1, 0, 0, 0, 415, 73, 1, 0, 0, 0, 416, 417, 5,
-		11, 0, 0, 417, 75, 1, 0, 0, 0, 418, 419, 5, 6, 0, 0, 419, 420, 3, 94, 47,
-		0, 420, 421, 5, 86, 0, 0, 421, 424, 3, 94, 47, 0, 422, 423, 5, 64, 0, 0,
-		423, 425, 3, 94, 47, 0, 424, 422, 1, 0, 0, 0, 424, 425, 1, 0, 0, 0, 425,
-		428, 1, 0, 0, 0, 426, 427, 5, 35, 0, 0, 427, 429, 3, 56, 28, 0, 428, 426,
-		1, 0, 0, 0, 428, 429, 1, 0, 0, 0, 429, 77, 1, 0, 0, 0, 430, 431, 5, 51,
-		0, 0, 431, 432, 3, 94, 47, 0, 432, 433, 5, 35, 0, 0, 433, 434, 3, 56, 28,
-		0, 434, 79, 1, 0, 0, 0, 435, 436, 5, 8, 0, 0, 436, 81, 1, 0, 0, 0, 437,
-		438, 5, 13, 0, 0, 438, 83, 1, 0, 0, 0, 439, 440, 5, 33, 0, 0, 440, 441,
-		3, 94, 47, 0, 441, 442, 5, 101, 0, 0, 442, 446, 3, 36, 18, 0, 443, 444,
-		5, 17, 0, 0, 444, 445, 5, 101, 0, 0, 445, 447, 3, 36, 18, 0, 446, 443,
-		1, 0, 0, 0, 446, 447, 1, 0, 0, 0, 447, 448, 1, 0, 0, 0, 448, 449, 5, 22,
-		0, 0, 449, 85, 1, 0, 0, 0, 450, 451, 5, 62, 0, 0, 451, 452, 3, 94, 47,
-		0, 452, 453, 5, 101, 0, 0, 453, 454, 3, 36, 18, 0, 454, 455, 5, 24, 0,
-		0, 455, 87, 1, 0, 0, 0, 456, 457, 5, 30, 0, 0, 457, 458, 5, 16, 0, 0, 458,
-		459, 5, 72, 0, 0, 459, 460, 5, 34, 0, 0, 460, 461, 3, 94, 47, 0, 461, 462,
-		5, 101, 0, 0, 462, 463, 3, 36, 18, 0, 463, 464, 5, 20, 0, 0, 464, 89, 1,
-		0, 0, 0, 465, 470, 5, 72, 0, 0, 466, 467, 5, 92, 0, 0, 467, 469, 5, 72,
-		0, 0, 468, 466, 1, 0, 0, 0, 469, 472, 1, 0, 0, 0, 470, 468, 1, 0, 0, 0,
-		470, 471, 1, 0, 0, 0, 471, 91, 1, 0, 0, 0, 472, 470, 1, 0, 0, 0, 473, 478,
-		5, 72, 0, 0, 474, 475, 5, 59, 0, 0, 475, 476, 5, 92, 0, 0, 476, 478, 3,
-		90, 45, 0, 477, 473, 1, 0, 0, 0, 477, 474, 1, 0, 0, 0, 478, 93, 1, 0, 0,
-		0, 479, 480, 3, 96, 48, 0, 480, 95, 1, 0, 0, 0, 481, 486, 3, 98, 49, 0,
-		482, 483, 5, 50, 0, 0, 483, 485, 3, 98, 49, 0, 484, 482, 1, 0, 0, 0, 485,
-		488, 1, 0, 0, 0, 486, 484, 1, 0, 0, 0, 486, 487, 1, 0, 0, 0, 487, 97, 1,
-		0, 0, 0, 488, 486, 1, 0, 0, 0, 489, 494, 3, 100, 50, 0, 490, 491, 5, 3,
-		0, 0, 491, 493, 3, 100, 50, 0, 492, 490, 1, 0, 0, 0, 493, 496, 1, 0, 0,
-		0, 494, 492, 1, 0, 0, 0, 494, 495, 1, 0, 0, 0, 495, 99, 1, 0, 0, 0, 496,
-		494, 1, 0, 0, 0, 497, 502, 3, 102, 51, 0, 498, 499, 5, 81, 0, 0, 499, 501,
-		3, 102, 51, 0, 500, 498, 1, 0, 0, 0, 501, 504, 1, 0, 0, 0, 502, 500, 1,
-		0, 0, 0, 502, 503, 1, 0, 0, 0, 503, 101, 1, 0, 0, 0, 504, 502, 1, 0, 0,
-		0, 505, 510, 3, 104, 52, 0, 506, 507, 5, 82, 0, 0, 507, 509, 3, 104, 52,
-		0, 508, 506, 1, 0, 0, 0, 509, 512, 1, 0, 0, 0, 510, 508, 1, 0, 0, 0, 510,
-		511, 1, 0, 0, 0, 511, 103, 1, 0, 0, 0, 512, 510, 1, 0, 0, 0, 513, 518,
-		3, 106, 53, 0, 514, 515, 5, 80, 0, 0, 515, 517, 3, 106, 53, 0, 516, 514,
-		1, 0, 0, 0, 517, 520, 1, 0, 0, 0, 518, 516, 1, 0, 0, 0, 518, 519, 1, 0,
-		0, 0, 519, 105, 1, 0, 0, 0, 520, 518, 1, 0, 0, 0, 521, 526, 3, 108, 54,
-		0, 522, 523, 7, 1, 0, 0, 523, 525, 3, 108, 54, 0, 524, 522, 1, 0, 0, 0,
-		525, 528, 1, 0, 0, 0, 526, 524, 1, 0, 0, 0, 526, 527, 1, 0, 0, 0, 527,
-		107, 1, 0, 0, 0, 528, 526, 1, 0, 0, 0, 529, 534, 3, 110, 55, 0, 530, 531,
-		7, 2, 0, 0, 531, 533, 3, 110, 55, 0, 532, 530, 1, 0, 0, 0, 533, 536, 1,
-		0, 0, 0, 534, 532, 1, 0, 0, 0, 534, 535, 1, 0, 0, 0, 535, 109, 1, 0, 0,
-		0, 536, 534, 1, 0, 0, 0, 537, 542, 3, 112, 56, 0, 538, 539, 7, 3, 0, 0,
-		539, 541, 3, 112, 56, 0, 540, 538, 1, 0, 0, 0, 541, 544, 1, 0, 0, 0, 542,
-		540, 1, 0, 0, 0, 542, 543, 1, 0, 0, 0, 543, 111, 1, 0, 0, 0, 544, 542,
-		1, 0, 0, 0, 545, 550, 3, 114, 57, 0, 546, 547, 7, 4, 0, 0, 547, 549, 3,
-		114, 57, 0, 548, 546, 1, 0, 0, 0, 549, 552, 1, 0, 0, 0, 550, 548, 1, 0,
-		0, 0, 550, 551, 1, 0, 0, 0, 551, 113, 1, 0, 0, 0, 552, 550, 1, 0, 0, 0,
-		553, 554, 7, 5, 0, 0, 554, 559, 3, 114, 57, 0, 555, 556, 5, 61, 0, 0, 556,
-		559, 3, 114, 57, 0, 557, 559, 3, 116, 58, 0, 558, 553, 1, 0, 0, 0, 558,
-		555, 1, 0, 0, 0, 558, 557, 1, 0, 0, 0, 559, 115, 1, 0, 0, 0, 560, 563,
-		3, 118, 59, 0, 561, 562, 5, 79, 0, 0, 562, 564, 3, 116, 58, 0, 563, 561,
-		1, 0, 0, 0, 563, 564, 1, 0, 0, 0, 564, 117, 1, 0, 0, 0, 565, 572, 3, 120,
-		60, 0, 566, 567, 5, 87, 0, 0, 567, 568, 3, 94, 47, 0, 568, 569, 5, 88,
-		0, 0, 569, 571, 1, 0, 0, 0, 570, 566, 1, 0, 0, 0, 571, 574, 1, 0, 0, 0,
-		572, 570, 1, 0, 0, 0, 572, 573, 1, 0, 0, 0, 573, 119, 1, 0, 0, 0, 574,
-		572, 1, 0, 0, 0, 575, 590, 3, 126, 63, 0, 576, 590, 3, 124, 62, 0, 577,
-		590, 5, 72, 0, 0, 578, 590, 5, 36, 0, 0, 579, 590, 3, 122, 61, 0, 580,
-		581, 5, 26, 0, 0, 581, 582, 5, 84, 0, 0, 582, 583, 3, 94, 47, 0, 583, 584,
-		5, 85, 0, 0, 584, 590, 1, 0, 0, 0, 585, 586, 5, 84, 0, 0, 586, 587, 3,
-		94, 47, 0, 587, 588, 5, 85, 0, 0, 588, 590, 1, 0, 0, 0, 589, 575, 1, 0,
-		0, 0, 589, 576, 1, 0, 0, 0, 589, 577, 1, 0, 0, 0, 589, 578, 1, 0, 0, 0,
-		589, 579, 1, 0, 0, 0, 589, 580, 1, 0, 0, 0, 589, 585, 1, 0, 0, 0, 590,
-		121, 1, 0, 0, 0, 591, 602, 3, 92, 46, 0, 592, 602, 5, 38, 0, 0, 593, 602,
-		5, 39, 0, 0, 594, 602, 5, 55, 0, 0, 595, 602, 5, 14, 0, 0, 596, 602, 5,
-		57, 0, 0, 597, 602, 5, 5, 0, 0, 598, 602, 5, 2, 0, 0, 599, 602, 5, 7, 0,
-		0, 600, 602, 5, 37, 0, 0, 601, 591, 1, 0, 0, 0, 601, 592, 1, 0, 0, 0, 601,
-		593, 1, 0, 0, 0, 601, 594, 1, 0, 0, 0, 601, 595, 1, 0, 0, 0, 601, 596,
-		1, 0, 0, 0, 601, 597, 1, 0, 0, 0, 601, 598, 1, 0, 0, 0, 601, 599, 1, 0,
-		0, 0, 601, 600, 1, 0, 0, 0, 602, 603, 1, 0, 0, 0, 603, 604, 5, 84, 0, 0,
-		604, 605, 3, 136, 68, 0, 605, 606, 5, 85, 0, 0, 606, 123, 1, 0, 0, 0, 607,
-		613, 5, 93, 0, 0, 608, 610, 5, 65, 0, 0, 609, 608, 1, 0, 0, 0, 609, 610,
-		1, 0, 0, 0, 610, 611, 1, 0, 0, 0, 611, 614, 5, 72, 0, 0, 612, 614, 5, 36,
-		0, 0, 613, 609, 1, 0, 0, 0, 613, 612, 1, 0, 0, 0, 614, 615, 1, 0, 0, 0,
-		615, 616, 5, 90, 0, 0, 616, 617, 5, 90, 0, 0, 617, 125, 1, 0, 0, 0, 618,
-		628, 5, 66, 0, 0, 619, 628, 5, 67, 0, 0, 620, 628, 5, 68, 0, 0, 621, 628,
-		5, 69, 0, 0, 622, 628, 5, 71, 0, 0, 623, 628, 3, 132, 66, 0, 624, 628,
-		3, 134, 67, 0, 625, 628, 3, 130, 65, 0, 626, 628, 3, 128, 64, 0, 627, 618,
-		1, 0, 0, 0, 627, 619, 1, 0, 0, 0, 627, 620, 1, 0, 0, 0, 627, 621, 1, 0,
-		0, 0, 627, 622, 1, 0, 0, 0, 627, 623, 1, 0, 0, 0, 627, 624, 1, 0, 0, 0,
-		627, 625, 1, 0, 0, 0, 627, 626, 1, 0, 0, 0, 628, 127, 1, 0, 0, 0, 629,
-		630, 5, 45, 0, 0, 630, 129, 1, 0, 0, 0, 631, 632, 7, 6, 0, 0, 632, 131,
-		1, 0, 0, 0, 633, 634, 5, 87, 0, 0, 634, 635, 3, 136, 68, 0, 635, 636, 5,
-		88, 0, 0, 636, 133, 1, 0, 0, 0, 637, 638, 5, 89, 0, 0, 638, 639, 3, 140,
-		70, 0, 639, 640, 5, 90, 0, 0, 640, 135, 1, 0, 0, 0, 641, 643, 3, 138, 69,
-		0, 642, 641, 1, 0, 0, 0, 642, 643, 1, 0, 0, 0, 643, 137, 1, 0, 0, 0, 644,
-		649, 3, 94, 47, 0, 645, 646, 5, 86, 0, 0, 646, 648, 3, 94, 47, 0, 647,
-		645, 1, 0, 0, 0, 648, 651, 1, 0, 0, 0, 649, 647, 1, 0, 0, 0, 649, 650,
-		1, 0, 0, 0, 650, 139, 1, 0, 0, 0, 651, 649, 1, 0, 0, 0, 652, 654, 3, 142,
-		71, 0, 653, 652, 1, 0, 0, 0, 653, 654, 1, 0, 0, 0, 654, 141, 1, 0, 0, 0,
-		655, 660, 3, 144, 72, 0, 656, 657, 5, 86, 0, 0, 657, 659, 3, 144, 72, 0,
-		658, 656, 1, 0, 0, 0, 659, 662, 1, 0, 0, 0, 660, 658, 1, 0, 0, 0, 660,
-		661, 1, 0, 0, 0, 661, 143, 1, 0, 0, 0, 662, 660, 1, 0, 0, 0, 663, 664,
-		3, 94, 47, 0, 664, 665, 5, 91, 0, 0, 665, 666, 3, 94, 47, 0, 666, 145,
-		1, 0, 0, 0, 61, 149, 156, 162, 167, 172, 177, 188, 194, 202, 209, 214,
-		230, 245, 247, 254, 256, 259, 275, 282, 288, 296, 302, 309, 314, 329, 334,
-		339, 351, 355, 367, 376, 378, 386, 399, 414, 424, 428, 446, 470, 477, 486,
-		494, 502, 510, 518, 526, 534, 542, 550, 558, 563, 572, 589, 601, 609, 613,
-		627, 642, 649, 653, 660,
+		5, 45, 499, 8, 45, 10, 45, 12, 45, 502, 9, 45, 1, 46, 1, 46, 1, 46, 1,
+		46, 3, 46, 508, 8, 46, 1, 47, 1, 47, 1, 48, 1, 48, 1, 48, 5, 48, 515, 8,
+		48, 10, 48, 12, 48, 518, 9, 48, 1, 49, 1, 49, 1, 49, 5, 49, 523, 8, 49,
+		10, 49, 12, 49, 526, 9, 49, 1, 50, 1, 50, 1, 50, 5, 50, 531, 8, 50, 10,
+		50, 12, 50, 534, 9, 50, 1, 51, 1, 51, 1, 51, 5, 51, 539, 8, 51, 10, 51,
+		12, 51, 542, 9, 51, 1, 52, 1, 52, 1, 52, 5, 52, 547, 8, 52, 10, 52, 12,
+		52, 550, 9, 52, 1, 53, 1, 53, 1, 53, 5, 53, 555, 8, 53, 10, 53, 12, 53,
+		558, 9, 53, 1, 54, 1, 54, 1, 54, 5, 54, 563, 8, 54, 10, 54, 12, 54, 566,
+		9, 54, 1, 55, 1, 55, 1, 55, 5, 55, 571, 8, 55, 10, 55, 12, 55, 574, 9,
+		55, 1, 56, 1, 56, 1, 56, 5, 56, 579, 8, 56, 10, 56, 12, 56, 582, 9, 56,
+		1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 3, 57, 589, 8, 57, 1, 58, 1, 58, 1,
+		58, 3, 58, 594, 8, 58, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59,
+		5, 59, 603, 8, 59, 10, 59, 12, 59, 606, 9, 59, 1, 60, 1, 60, 1, 60, 1,
+		60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60,
+		3, 60, 622, 8, 60, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1,
+		61, 1, 61, 1, 61, 3, 61, 634, 8, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 62,
+		1, 62, 3, 62, 642, 8, 62, 1, 62, 1, 62, 3, 62, 646, 8, 62, 1, 62, 1, 62,
+		1, 62, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 3,
+		63, 660, 8, 63, 1, 64, 1, 64, 1, 65, 1, 65, 1, 66, 1, 66, 1, 66, 1, 66,
+		1, 67, 1, 67, 1, 67, 1, 67, 1, 68, 3, 68, 675, 8, 68, 1, 69, 1, 69, 1,
+		69, 5, 69, 680, 8, 69, 10, 69, 12, 69, 683, 9, 69, 1, 70, 3, 70, 686, 8,
+		70, 1, 71, 1, 71, 1, 71, 5, 71, 691, 8, 71, 10, 71, 12, 71, 694, 9, 71,
+		1, 72, 1, 72, 1, 72, 1, 72, 1, 73, 3, 73, 701, 8, 73, 1, 74, 1, 74, 1,
+		74, 5, 74, 706, 8, 74, 10, 74, 12, 74, 709, 9, 74, 1, 75, 1, 75, 3, 75,
+		713, 8, 75, 1, 75, 1, 75, 1, 76, 1, 76, 1, 76, 5, 76, 720, 8, 76, 10, 76,
+		12, 76, 723, 9, 76, 1, 77, 1, 77, 1, 77, 3, 77, 728, 8, 77, 1, 60, 1, 78,
+		1, 78, 1, 78, 3, 78, 734, 8, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1,
+		78, 1, 78, 3, 78, 743, 8, 78, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79,
+		3, 79, 751, 8, 79, 1, 79, 1, 79, 3, 79, 755, 8, 79, 1, 80, 1, 80, 1, 80,
+		4, 80, 760, 8, 80, 11, 80, 12, 80, 761, 1, 80, 5, 80, 765, 8, 80, 10, 80,
+		12, 80, 768, 9, 80, 1, 80, 1, 80, 1, 80, 3, 80, 773, 8, 80, 1, 80, 1, 80,
+		1, 81, 1, 81, 1, 81, 1, 81, 1, 81, 1, 81, 3, 81, 783, 8, 81, 1, 81, 1,
+		81, 1, 81, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 3, 82, 793, 8, 82, 1, 82,
+		1, 82, 3, 82, 797, 8, 82, 1, 82, 1, 82, 1, 82, 3, 82, 802, 8, 82, 1, 82,
+		1, 82, 1, 83, 1, 83, 1, 83, 3, 83, 809, 8, 83, 3, 83, 811, 8, 83, 1, 83,
+		1, 83, 3, 83, 815, 8, 83, 3, 83, 817, 8, 83, 1, 84, 1, 84, 1, 84, 1, 84,
+		1, 84, 3, 84, 824, 8, 84, 1, 84, 0, 0, 85, 0, 2, 4, 6, 8, 10, 12, 14, 16,
+		18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52,
+		54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88,
+		90, 92, 94, 96, 98, 100, 102, 104, 106, 108, 110, 112, 114, 116, 118, 120,
+		122, 124, 126, 128, 130, 132, 134, 136, 138, 140, 142, 144, 146, 148, 150,
+		152, 154, 156, 158, 160, 162, 164, 166, 168, 0, 8, 2, 0, 80, 80, 111, 111,
+		1, 0, 104, 105, 1, 0, 106, 109, 1, 0, 84, 85, 1, 0, 86, 88, 4, 0, 52, 53,
+		63, 63, 85, 85, 93, 93, 2, 0, 34, 34, 68, 68, 2, 0, 2, 74, 82, 82, 878,
+		0, 170, 1, 0, 0, 0, 2, 180, 1, 0, 0, 0, 4, 184, 1, 0, 0, 0, 6, 189, 1,
+		0, 0, 0, 8, 196, 1, 0, 0, 0, 10, 204, 1, 0, 0, 0, 12, 218, 1, 0, 0, 0,
+		14, 233, 1, 0, 0, 0, 16, 238, 1, 0, 0, 0, 18, 240, 1, 0, 0, 0, 20, 255,
+		1, 0, 0, 0, 22, 257, 1, 0, 0, 0, 24, 284, 1, 0, 0, 0, 26, 286, 1, 0, 0,
+		0, 28, 289, 1, 0, 0, 0, 30, 292, 1, 0, 0, 0, 32, 295, 1, 0, 0, 0, 34, 307,
+		1, 0, 0, 0, 36, 313, 1, 0, 0, 0, 38, 327, 1, 0, 0, 0, 40, 334, 1, 0, 0,
+		0, 42, 339, 1, 0, 0, 0, 44, 355, 1, 0, 0, 0, 46, 362, 1, 0, 0, 0, 48, 364,
+		1, 0, 0, 0, 50, 369, 1, 0, 0, 0, 52, 375, 1, 0, 0, 0, 54, 394, 1, 0, 0,
+		0, 56, 401, 1, 0, 0, 0, 58, 413, 1, 0, 0, 0, 60, 421, 1, 0, 0, 0, 62, 426,
+		1, 0, 0, 0, 64, 429, 1, 0, 0, 0, 66, 433, 1, 0, 0, 0, 68, 436, 1, 0, 0,
+		0, 70, 441, 1, 0, 0, 0, 72, 444, 1, 0, 0, 0, 74, 448, 1, 0, 0, 0, 76, 450,
+		1, 0, 0, 0, 78, 462, 1, 0, 0, 0, 80, 467, 1, 0, 0, 0, 82, 469, 1, 0, 0,
+		0, 84, 471, 1, 0, 0, 0, 86, 480, 1, 0, 0, 0, 88, 486, 1, 0, 0, 0, 90, 495,
+		1, 0, 0, 0, 92, 507, 1, 0, 0, 0, 94, 509, 1, 0, 0, 0, 96, 511, 1, 0, 0,
+		0, 98, 519, 1, 0, 0, 0, 100, 527, 1, 0, 0, 0, 102, 535, 1, 0, 0, 0, 104,
+		543, 1, 0, 0, 0, 106, 551, 1, 0, 0, 0, 108, 559, 1, 0, 0, 0, 110, 567,
+		1, 0, 0, 0, 112, 575, 1, 0, 0, 0, 114, 588, 1, 0, 0, 0, 116, 590, 1, 0,
+		0, 0, 118, 595, 1, 0, 0, 0, 120, 621, 1, 0, 0, 0, 122, 633, 1, 0, 0, 0,
+		124, 639, 1, 0, 0, 0, 126, 659, 1, 0, 0, 0, 128, 661, 1, 0, 0, 0, 130,
+		663, 1, 0, 0, 0, 132, 665, 1, 0, 0, 0, 134, 669, 1, 0, 0, 0, 136, 674,
+		1, 0, 0, 0, 138, 676, 1, 0, 0, 0, 140, 685, 1, 0, 0, 0, 142, 687, 1, 0,
+		0, 0, 144, 695, 1, 0, 0, 0, 146, 700, 1, 0, 0, 0, 148, 702, 1, 0, 0, 0,
+		150, 712, 1, 0, 0, 0, 152, 716, 1, 0, 0, 0, 154, 724, 1, 0, 0, 0, 156,
+		730, 1, 0, 0, 0, 158, 744, 1, 0, 0, 0, 160, 756, 1, 0, 0, 0, 162, 776,
+		1, 0, 0, 0, 164, 787, 1, 0, 0, 0, 166, 816, 1, 0, 0, 0, 168, 818, 1, 0,
+		0, 0, 170, 173, 3, 2, 1, 0, 171, 174, 3, 4, 2, 0, 172, 174, 3, 6, 3, 0,
+		173, 171, 1, 0, 0, 0, 173, 172, 1, 0, 0, 0, 173, 174, 1, 0, 0, 0, 174,
+		175, 1, 0, 0, 0, 175, 176, 5, 0, 0, 1, 176, 1, 1, 0, 0, 0, 177, 179, 7,
+		0, 0, 0, 178, 177, 1, 0, 0, 0, 179, 182, 1, 0, 0, 0, 180, 178, 1, 0, 0,
+		0, 180, 181, 1, 0, 0, 0, 181, 3, 1, 0, 0, 0, 182, 180, 1, 0, 0, 0, 183,
+		185, 3, 8, 4, 0, 184, 183, 1, 0, 0, 0, 185, 186, 1, 0, 0, 0, 186, 184,
+		1, 0, 0, 0, 186, 187, 1, 0, 0, 0, 187, 5, 1, 0, 0, 0, 188, 190, 3, 10,
+		5, 0, 189, 188, 1, 0, 0, 0, 190, 191, 1, 0, 0, 0, 191, 189, 1, 0, 0, 0,
+		191, 192, 1, 0, 0, 0, 192, 7, 1, 0, 0, 0, 193, 197, 3, 22, 11, 0, 194,
+		195, 5, 54, 0, 0, 195, 197, 3, 52, 26, 0, 196, 193, 1, 0, 0, 0, 196, 194,
+		1, 0, 0, 0, 197, 201, 1, 0, 0, 0, 198, 200, 5, 111, 0, 0, 199, 198, 1,
+		0, 0, 0, 200, 203, 1, 0, 0, 0, 201, 199, 1, 0, 0, 0, 201, 202, 1, 0, 0,
+		0, 202, 9, 1, 0, 0, 0, 203, 201, 1, 0, 0, 0, 204, 205, 5, 14, 0, 0, 205,
+		206, 5, 111, 0, 0, 206, 207, 3, 34, 17, 0, 207, 208, 3, 12, 6, 0, 208,
+		212, 5, 22, 0, 0, 209, 211, 5, 111, 0, 0, 210, 209, 1, 0, 0, 0, 211, 214,
+		1, 0, 0, 0, 212, 210, 1, 0, 0, 0, 212, 213, 1, 0, 0, 0, 213, 11, 1, 0,
+		0, 0, 214, 212, 1, 0, 0, 0, 215, 217, 5, 111, 0, 0, 216, 215, 1, 0, 0,
+		0, 217, 220, 1, 0, 0, 0, 218, 216, 1, 0, 0, 0, 218, 219, 1, 0, 0, 0, 219,
+		221, 1, 0, 0, 0, 220, 218, 1, 0, 0, 0, 221, 222, 3, 16, 8, 0, 222, 226,
+		5, 111, 0, 0, 223, 225, 3, 14, 7, 0, 224, 223, 1, 0, 0, 0, 225, 228, 1,
+		0, 0, 0, 226, 224, 1, 0, 0, 0, 226, 227, 1, 0, 0, 0, 227, 13, 1, 0, 0,
+		0, 228, 226, 1, 0, 0, 0, 229, 230, 3, 16, 8, 0, 230, 231, 5, 111, 0, 0,
+		231, 234, 1, 0, 0, 0, 232, 234, 5, 111, 0, 0, 233, 229, 1, 0, 0, 0, 233,
+		232, 1, 0, 0, 0, 234, 15, 1, 0, 0, 0, 235, 239, 3, 20, 10, 0, 236, 239,
+		3, 46, 23, 0, 237, 239, 3, 18, 9, 0, 238, 235, 1, 0, 0, 0, 238, 236, 1,
+		0, 0, 0, 238, 237, 1, 0, 0, 0, 239, 17, 1, 0, 0, 0, 240, 241, 5, 54, 0,
+		0, 241, 242, 3, 50, 25, 0, 242, 19, 1, 0, 0, 0, 243, 256, 3, 60, 30, 0,
+		244, 256, 3, 62, 31, 0, 245, 256, 3, 66, 33, 0, 246, 256, 3, 68, 34, 0,
+		247, 256, 3, 70, 35, 0, 248, 256, 3, 72, 36, 0, 249, 256, 3, 54, 27, 0,
+		250, 256, 3, 76, 38, 0, 251, 256, 3, 78, 39, 0, 252, 256, 3, 80, 40, 0,
+		253, 256, 3, 82, 41, 0, 254, 256, 3, 168, 84, 0, 255, 243, 1, 0, 0, 0,
+		255, 244, 1, 0, 0, 0, 255, 245, 1, 0, 0, 0, 255, 246, 1, 0, 0, 0, 255,
+		247, 1, 0, 0, 0, 255, 248, 1, 0, 0, 0, 255, 249, 1, 0, 0, 0, 255, 250,
+		1, 0, 0, 0, 255, 251, 1, 0, 0, 0, 255, 252, 1, 0, 0, 0, 255, 253, 1, 0,
+		0, 0, 255, 254, 1, 0, 0, 0, 256, 21, 1, 0, 0, 0, 257, 258, 5, 37, 0, 0,
+		258, 259, 5, 82, 0, 0, 259, 260, 3, 24, 12, 0, 260, 261, 5, 46, 0, 0, 261,
+		262, 5, 111, 0, 0, 262, 263, 3, 34, 17, 0, 263, 264, 3, 36, 18, 0, 264,
+		265, 5, 24, 0, 0, 265, 23, 1, 0, 0, 0, 266, 272, 5, 94, 0, 0, 267, 271,
+		3, 26, 13, 0, 268, 271, 3, 28, 14, 0, 269, 271, 3, 30, 15, 0, 270, 267,
+		1, 0, 0, 0, 270, 268, 1, 0, 0, 0, 270, 269, 1, 0, 0, 0, 271, 274, 1, 0,
+		0, 0, 272, 270, 1, 0, 0, 0, 272, 273, 1, 0, 0, 0, 273, 275, 1, 0, 0, 0,
+		274, 272, 1, 0, 0, 0, 275, 285, 5, 95, 0, 0, 276, 280, 3, 26, 13, 0, 277,
+		280, 3, 28, 14, 0, 278, 280, 3, 30, 15, 0, 279, 276, 1, 0, 0, 0, 279, 277,
+		1, 0, 0, 0, 279, 278, 1, 0, 0, 0, 280, 281, 1, 0, 0, 0, 281, 279, 1, 0,
+		0, 0, 281, 282, 1, 0, 0, 0, 282, 285, 1, 0, 0, 0, 283, 285, 1, 0, 0, 0,
+		284, 266, 1, 0, 0, 0, 284, 279, 1, 0, 0, 0, 284, 283, 1, 0, 0, 0, 285,
+		25, 1, 0, 0, 0, 286, 287, 5, 50, 0, 0, 287, 288, 3, 32, 16, 0, 288, 27,
+		1, 0, 0, 0, 289, 290, 5, 55, 0, 0, 290, 291, 3, 152, 76, 0, 291, 29, 1,
+		0, 0, 0, 292, 293, 5, 60, 0, 0, 293, 294, 3, 32, 16, 0, 294, 31, 1, 0,
+		0, 0, 295, 300, 5, 82, 0, 0, 296, 297, 5, 96, 0, 0, 297, 299, 5, 82, 0,
+		0, 298, 296, 1, 0, 0, 0, 299, 302, 1, 0, 0, 0, 300, 298, 1, 0, 0, 0, 300,
+		301, 1, 0, 0, 0, 301, 33, 1, 0, 0, 0, 302, 300, 1, 0, 0, 0, 303, 304, 5,
+		80, 0, 0, 304, 306, 5, 111, 0, 0, 305, 303, 1, 0, 0, 0, 306, 309, 1, 0,
+		0, 0, 307, 305, 1, 0, 0, 0, 307, 308, 1, 0, 0, 0, 308, 35, 1, 0, 0, 0,
+		309, 307, 1, 0, 0, 0, 310, 312, 5, 111, 0, 0, 311, 310, 1, 0, 0, 0, 312,
+		315, 1, 0, 0, 0, 313, 311, 1, 0, 0, 0, 313, 314, 1, 0, 0, 0, 314, 316,
+		1, 0, 0, 0, 315, 313, 1, 0, 0, 0, 316, 317, 3, 42, 21, 0, 317, 321, 5,
+		111, 0, 0, 318, 320, 3, 40, 20, 0, 319, 318, 1, 0, 0, 0, 320, 323, 1, 0,
+		0, 0, 321, 319, 1, 0, 0, 0, 321, 322, 1, 0, 0, 0, 322, 37, 1, 0, 0, 0,
+		323, 321, 1, 0, 0, 0, 324, 326, 3, 40, 20, 0, 325, 324, 1, 0, 0, 0, 326,
+		329, 1, 0, 0, 0, 327, 325, 1, 0, 0, 0, 327, 328, 1, 0, 0, 0, 328, 39, 1,
+		0, 0, 0, 329, 327, 1, 0, 0, 0, 330, 331, 3, 42, 21, 0, 331, 332, 5, 111,
+		0, 0, 332, 335, 1, 0, 0, 0, 333, 335, 5, 111, 0, 0, 334, 330, 1, 0, 0,
+		0, 334, 333, 1, 0, 0, 0, 335, 41, 1, 0, 0, 0, 336, 340, 3, 44, 22, 0, 337,
+		340, 3, 46, 23, 0, 338, 340, 3, 48, 24, 0, 339, 336, 1, 0, 0, 0, 339, 337,
+		1, 0, 0, 0, 339, 338, 1, 0, 0, 0, 340, 43, 1, 0, 0, 0, 341, 356, 3, 60,
+		30, 0, 342, 356, 3, 62, 31, 0, 343, 356, 3, 64, 32, 0, 344, 356, 3, 66,
+		33, 0, 345, 356, 3, 68, 34, 0, 346, 356, 3, 70, 35, 0, 347, 356, 3, 72,
+		36, 0, 348, 356, 3, 74, 37, 0, 349, 356, 3, 54, 27, 0, 350, 356, 3, 76,
+		38, 0, 351, 356, 3, 78, 39, 0, 352, 356, 3, 80, 40, 0, 353, 356, 3, 82,
+		41, 0, 354, 356, 3, 168, 84, 0, 355, 341, 1, 0, 0, 0, 355, 342, 1, 0, 0,
+		0, 355, 343, 1, 0, 0, 0, 355, 344, 1, 0, 0, 0, 355, 345, 1, 0, 0, 0, 355,
+		346, 1, 0, 0, 0, 355, 347, 1, 0, 0, 0, 355, 348, 1, 0, 0, 0, 355, 349,
+		1, 0, 0, 0, 355, 350, 1, 0, 0, 0, 355, 351, 1, 0, 0, 0, 355, 352, 1, 0,
+		0, 0, 355, 353, 1, 0, 0, 0, 355, 354, 1, 0, 0, 0, 356, 45, 1, 0, 0, 0,
+		357, 363, 3, 84, 42, 0, 358, 363, 3, 86, 43, 0, 359, 363, 3, 88, 44, 0,
+		360, 363, 3, 160, 80, 0, 361, 363, 3, 164, 82, 0, 362, 357, 1, 0, 0, 0,
+		362, 358, 1, 0, 0, 0, 362, 359, 1, 0, 0, 0, 362, 360, 1, 0, 0, 0, 362,
+		361, 1, 0, 0, 0, 363, 47, 1, 0, 0, 0, 364, 367, 5, 54, 0, 0, 365, 368,
+		3, 50, 25, 0, 366, 368, 3, 52, 26, 0, 367, 365, 1, 0, 0, 0, 367, 366, 1,
+		0, 0, 0, 368, 49, 1, 0, 0, 0, 369, 370, 5, 30, 0, 0, 370, 371, 5, 18, 0,
+		0, 371, 372, 5, 111, 0, 0, 372, 373, 3, 36, 18, 0, 373, 374, 5, 26, 0,
+		0, 374, 51, 1, 0, 0, 0, 375, 376, 5, 32, 0, 0, 376, 379, 3, 94, 47, 0,
+		377, 378, 5, 71, 0, 0, 378, 380, 3, 94, 47, 0, 379, 377, 1, 0, 0, 0, 379,
+		380, 1, 0, 0, 0, 380, 383, 1, 0, 0, 0, 381, 382, 5, 49, 0, 0, 382, 384,
+		5, 76, 0, 0, 383, 381, 1, 0, 0, 0, 383, 384, 1, 0, 0, 0, 384, 387, 1, 0,
+		0, 0, 385, 386, 5, 4, 0, 0, 386, 388, 5, 82, 0, 0, 387, 385, 1, 0, 0, 0,
+		387, 388, 1, 0, 0, 0, 388, 389, 1, 0, 0, 0, 389, 390, 5, 18, 0, 0, 390,
+		391, 5, 111, 0, 0, 391, 392, 3, 36, 18, 0, 392, 393, 5, 26, 0, 0, 393,
+		53, 1, 0, 0, 0, 394, 395, 5, 12, 0, 0, 395, 399, 5, 32, 0, 0, 396, 400,
+		3, 94, 47, 0, 397, 398, 5, 49, 0, 0, 398, 400, 5, 76, 0, 0, 399, 396, 1,
+		0, 0, 0, 399, 397, 1, 0, 0, 0, 400, 55, 1, 0, 0, 0, 401, 410, 5, 82, 0,
+		0, 402, 403, 5, 97, 0, 0, 403, 404, 3, 94, 47, 0, 404, 405, 5, 98, 0, 0,
+		405, 409, 1, 0, 0, 0, 406, 407, 5, 102, 0, 0, 407, 409, 5, 82, 0, 0, 408,
+		402, 1, 0, 0, 0, 408, 406, 1, 0, 0, 0, 409, 412, 1, 0, 0, 0, 410, 408,
+		1, 0, 0, 0, 410, 411, 1, 0, 0, 0, 411, 57, 1, 0, 0, 0, 412, 410, 1, 0,
+		0, 0, 413, 418, 3, 56, 28, 0, 414, 415, 5, 96, 0, 0, 415, 417, 3, 56, 28,
+		0, 416, 414, 1, 0, 0, 0, 417, 420, 1, 0, 0, 0, 418, 416, 1, 0, 0, 0, 418,
+		419, 1, 0, 0, 0, 419, 59, 1, 0, 0, 0, 420, 418, 1, 0, 0, 0, 421, 422, 5,
+		61, 0, 0, 422, 423, 3, 58, 29, 0, 423, 424, 5, 83, 0, 0, 424, 425, 3, 94,
+		47, 0, 425, 61, 1, 0, 0, 0, 426, 427, 5, 9, 0, 0, 427, 428, 3, 122, 61,
+		0, 428, 63, 1, 0, 0, 0, 429, 431, 5, 59, 0, 0, 430, 432, 3, 138, 69, 0,
+		431, 430, 1, 0, 0, 0, 431, 432, 1, 0, 0, 0, 432, 65, 1, 0, 0, 0, 433, 434,
+		5, 21, 0, 0, 434, 435, 3, 94, 47, 0, 435, 67, 1, 0, 0, 0, 436, 437, 5,
+		73, 0, 0, 437, 438, 3, 94, 47, 0, 438, 439, 5, 96, 0, 0, 439, 440, 3, 94,
+		47, 0, 440, 69, 1, 0, 0, 0, 441, 442, 5, 47, 0, 0, 442, 443, 3, 94, 47,
+		0, 443, 71, 1, 0, 0, 0, 444, 446, 5, 33, 0, 0, 445, 447, 3, 94, 47, 0,
+		446, 445, 1, 0, 0, 0, 446, 447, 1, 0, 0, 0, 447, 73, 1, 0, 0, 0, 448, 449,
+		5, 13, 0, 0, 449, 75, 1, 0, 0, 0, 450, 451, 5, 6, 0, 0, 451, 452, 3, 94,
+		47, 0, 452, 453, 5, 96, 0, 0, 453, 456, 3, 94, 47, 0, 454, 455, 5, 74,
+		0, 0, 455, 457, 3, 94, 47, 0, 456, 454, 1, 0, 0, 0, 456, 457, 1, 0, 0,
+		0, 457, 460, 1, 0, 0, 0, 458, 459, 5, 41, 0, 0, 459, 461, 3, 56, 28, 0,
+		460, 458, 1, 0, 0, 0, 460, 461, 1, 0, 0, 0, 461, 77, 1, 0, 0, 0, 462, 463,
+		5, 57, 0, 0, 463, 464, 3, 94, 47, 0, 464, 465, 5, 41, 0, 0, 465, 466, 3,
+		56, 28, 0, 466, 79, 1, 0, 0, 0, 467, 468, 5, 8, 0, 0, 468, 81, 1, 0, 0,
+		0, 469, 470, 5, 15, 0, 0, 470, 83, 1, 0, 0, 0, 471, 472, 5, 39, 0, 0, 472,
+		473, 3, 94, 47, 0, 473, 474, 5, 111, 0, 0, 474, 476, 3, 36, 18, 0, 475,
+		477, 3, 158, 79, 0, 476, 475, 1, 0, 0, 0, 476, 477, 1, 0, 0, 0, 477, 478,
+		1, 0, 0, 0, 478, 479, 5, 25, 0, 0, 479, 85, 1, 0, 0, 0, 480, 481, 5, 72,
+		0, 0, 481, 482, 3, 94, 47, 0, 482, 483, 5, 111, 0, 0, 483, 484, 3, 36,
+		18, 0, 484, 485, 5, 29, 0, 0, 485, 87, 1, 0, 0, 0, 486, 487, 5, 36, 0,
+		0, 487, 488, 5, 19, 0, 0, 488, 489, 5, 82, 0, 0, 489, 490, 5, 40, 0, 0,
+		490, 491, 3, 94, 47, 0, 491, 492, 5, 111, 0, 0, 492, 493, 3, 36, 18, 0,
+		493, 494, 5, 23, 0, 0, 494, 89, 1, 0, 0, 0, 495, 500, 5, 82, 0, 0, 496,
+		497, 5, 102, 0, 0, 497, 499, 5, 82, 0, 0, 498, 496, 1, 0, 0, 0, 499, 502,
+		1, 0, 0, 0, 500, 498, 1, 0, 0, 0, 500, 501, 1, 0, 0, 0, 501, 91, 1, 0,
+		0, 0, 502, 500, 1, 0, 0, 0, 503, 508, 5, 82, 0, 0, 504, 505, 5, 67, 0,
+		0, 505, 506, 5, 102, 0, 0, 506, 508, 3, 90, 45, 0, 507, 503, 1, 0, 0, 0,
+		507, 504, 1, 0, 0, 0, 508, 93, 1, 0, 0, 0, 509, 510, 3, 96, 48, 0, 510,
+		95, 1, 0, 0, 0, 511, 516, 3, 98, 49, 0, 512, 513, 5, 56, 0, 0, 513, 515,
+		3, 98, 49, 0, 514, 512, 1, 0, 0, 0, 515, 518, 1, 0, 0, 0, 516, 514, 1,
+		0, 0, 0, 516, 517, 1, 0, 0, 0, 517, 97, 1, 0, 0, 0, 518, 516, 1, 0, 0,
+		0, 519, 524, 3, 100, 50, 0, 520, 521, 5, 3, 0, 0, 521, 523, 3, 100, 50,
+		0, 522, 520, 1, 0, 0, 0, 523, 526, 1, 0, 0, 0, 524, 522, 1, 0, 0, 0, 524,
+		525, 1, 0, 0, 0, 525, 99, 1, 0, 0, 0, 526, 524, 1, 0, 0, 0, 527, 532, 3,
+		102, 51, 0, 528, 529, 5, 91, 0, 0, 529, 531, 3, 102, 51, 0, 530, 528, 1,
+		0, 0, 0, 531, 534, 1, 0, 0, 0, 532, 530, 1, 0, 0, 0, 532, 533, 1, 0, 0,
+		0, 533, 101, 1, 0, 0, 0, 534, 532, 1, 0, 0, 0, 535, 540, 3, 104, 52, 0,
+		536, 537, 5, 92, 0, 0, 537, 539, 3, 104, 52, 0, 538, 536, 1, 0, 0, 0, 539,
+		542, 1, 0, 0, 0, 540, 538, 1, 0, 0, 0, 540, 541, 1, 0, 0, 0, 541, 103,
+		1, 0, 0, 0, 542, 540, 1, 0, 0, 0, 543, 548, 3, 106, 53, 0, 544, 545, 5,
+		90, 0, 0, 545, 547, 3, 106, 53, 0, 546, 544, 1, 0, 0, 0, 547, 550, 1, 0,
+		0, 0, 548, 546, 1, 0, 0, 0, 548, 549, 1, 0, 0, 0, 549, 105, 1, 0, 0, 0,
+		550, 548, 1, 0, 0, 0, 551, 556, 3, 108, 54, 0, 552, 553, 7, 1, 0, 0, 553,
+		555, 3, 108, 54, 0, 554, 552, 1, 0, 0, 0, 555, 558, 1, 0, 0, 0, 556, 554,
+		1, 0, 0, 0, 556, 557, 1, 0, 0, 0, 557, 107, 1, 0, 0, 0, 558, 556, 1, 0,
+		0, 0, 559, 564, 3, 110, 55, 0, 560, 561, 7, 2, 0, 0, 561, 563, 3, 110,
+		55, 0, 562, 560, 1, 0, 0, 0, 563, 566, 1, 0, 0, 0, 564, 562, 1, 0, 0, 0,
+		564, 565, 1, 0, 0, 0, 565, 109, 1, 0, 0, 0, 566, 564, 1, 0, 0, 0, 567,
+		572, 3, 112, 56, 0, 568, 569, 7, 3, 0, 0, 569, 571, 3, 112, 56, 0, 570,
+		568, 1, 0, 0, 0, 571, 574, 1, 0, 0, 0, 572, 570, 1, 0, 0, 0, 572, 573,
+		1, 0, 0, 0, 573, 111, 1, 0, 0, 0, 574, 572, 1, 0, 0, 0, 575, 580, 3, 114,
+		57, 0, 576, 577, 7, 4, 0, 0, 577, 579, 3, 114, 57, 0, 578, 576, 1, 0, 0,
+		0, 579, 582, 1, 0, 0, 0, 580, 578, 1, 0, 0, 0, 580, 581, 1, 0, 0, 0, 581,
+		113, 1, 0, 0, 0, 582, 580, 1, 0, 0, 0, 583, 584, 7, 5, 0, 0, 584, 589,
+		3, 114, 57, 0, 585, 586, 5, 70, 0, 0, 586, 589, 3, 114, 57, 0, 587, 589,
+		3, 116, 58, 0, 588, 583, 1, 0, 0, 0, 588, 585, 1, 0, 0, 0, 588, 587, 1,
+		0, 0, 0, 589, 115, 1, 0, 0, 0, 590, 593, 3, 118, 59, 0, 591, 592, 5, 89,
+		0, 0, 592, 594, 3, 116, 58, 0, 593, 591, 1, 0, 0, 0, 593, 594, 1, 0, 0,
+		0, 594, 117, 1, 0, 0, 0, 595, 604, 3, 120, 60, 0, 596, 597, 5, 97, 0, 0,
+		597, 598, 3, 166, 83, 0, 598, 599, 5, 98, 0, 0, 599, 603, 1, 0, 0, 0, 600,
+		601, 5, 102, 0, 0, 601, 603, 5, 82, 0, 0, 602, 596, 1, 0, 0, 0, 602, 600,
+		1, 0, 0, 0, 603, 606, 1, 0, 0, 0, 604, 602, 1, 0, 0, 0, 604, 605, 1, 0,
+		0, 0, 605, 119, 1, 0, 0, 0, 606, 604, 1, 0, 0, 0, 607, 622, 3, 126, 63,
+		0, 608, 622, 3, 124, 62, 0, 609, 622, 5, 82, 0, 0, 610, 622, 5, 42, 0,
+		0, 611, 622, 3, 122, 61, 0, 612, 613, 5, 31, 0, 0, 613, 614, 5, 94, 0,
+		0, 614, 615, 3, 94, 47, 0, 615, 616, 5, 95, 0, 0, 616, 622, 1, 0, 0, 0,
+		617, 618, 5, 94, 0, 0, 618, 619, 3, 94, 47, 0, 619, 620, 5, 95, 0, 0, 620,
+		622, 1, 0, 0, 0, 621, 607, 1, 0, 0, 0, 621, 608, 1, 0, 0, 0, 621, 609,
+		1, 0, 0, 0, 621, 610, 1, 0, 0, 0, 621, 611, 1, 0, 0, 0, 621, 612, 1, 0,
+		0, 0, 621, 617, 1, 0, 0, 0, 621, 729, 1, 0, 0, 0, 622, 121, 1, 0, 0, 0,
+		623, 634, 3, 92, 46, 0, 624, 634, 5, 44, 0, 0, 625, 634, 5, 45, 0, 0, 626,
+		634, 5, 62, 0, 0, 627, 634, 5, 16, 0, 0, 628, 634, 5, 65, 0, 0, 629, 634,
+		5, 5, 0, 0, 630, 634, 5, 2, 0, 0, 631, 634, 5, 7, 0, 0, 632, 634, 5, 43,
+		0, 0, 633, 623, 1, 0, 0, 0, 633, 624, 1, 0, 0, 0, 633, 625, 1, 0, 0, 0,
+		633, 626, 1, 0, 0, 0, 633, 627, 1, 0, 0, 0, 633, 628, 1, 0, 0, 0, 633,
+		629, 1, 0, 0, 0, 633, 630, 1, 0, 0, 0, 633, 631, 1, 0, 0, 0, 633, 632,
+		1, 0, 0, 0, 634, 635, 1, 0, 0, 0, 635, 636, 5, 94, 0, 0, 636, 637, 3, 146,
+		73, 0, 637, 638, 5, 95, 0, 0, 638, 123, 1, 0, 0, 0, 639, 645, 5, 103, 0,
+		0, 640, 642, 5, 75, 0, 0, 641, 640, 1, 0, 0, 0, 641, 642, 1, 0, 0, 0, 642,
+		643, 1, 0, 0, 0, 643, 646, 5, 82, 0, 0, 644, 646, 5, 42, 0, 0, 645, 641,
+		1, 0, 0, 0, 645, 644, 1, 0, 0, 0, 646, 647, 1, 0, 0, 0, 647, 648, 5, 100,
+		0, 0, 648, 649, 5, 100, 0, 0, 649, 125, 1, 0, 0, 0, 650, 660, 5, 76, 0,
+		0, 651, 660, 5, 77, 0, 0, 652, 660, 5, 78, 0, 0, 653, 660, 5, 79, 0, 0,
+		654, 660, 5, 81, 0, 0, 655, 660, 3, 132, 66, 0, 656, 660, 3, 134, 67, 0,
+		657, 660, 3, 130, 65, 0, 658, 660, 3, 128, 64, 0, 659, 650, 1, 0, 0, 0,
+		659, 651, 1, 0, 0, 0, 659, 652, 1, 0, 0, 0, 659, 653, 1, 0, 0, 0, 659,
+		654, 1, 0, 0, 0, 659, 655, 1, 0, 0, 0, 659, 656, 1, 0, 0, 0, 659, 657,
+		1, 0, 0, 0, 659, 658, 1, 0, 0, 0, 660, 127, 1, 0, 0, 0, 661, 662, 5, 51,
+		0, 0, 662, 129, 1, 0, 0, 0, 663, 664, 7, 6, 0, 0, 664, 131, 1, 0, 0, 0,
+		665, 666, 5, 97, 0, 0, 666, 667, 3, 136, 68, 0, 667, 668, 5, 98, 0, 0,
+		668, 133, 1, 0, 0, 0, 669, 670, 5, 99, 0, 0, 670, 671, 3, 140, 70, 0, 671,
+		672, 5, 100, 0, 0, 672, 135, 1, 0, 0, 0, 673, 675, 3, 138, 69, 0, 674,
+		673, 1, 0, 0, 0, 674, 675, 1, 0, 0, 0, 675, 137, 1, 0, 0, 0, 676, 681,
+		3, 94, 47, 0, 677, 678, 5, 96, 0, 0, 678, 680, 3, 94, 47, 0, 679, 677,
+		1, 0, 0, 0, 680, 683, 1, 0, 0, 0, 681, 679, 1, 0, 0, 0, 681, 682, 1, 0,
+		0, 0, 682, 139, 1, 0, 0, 0, 683, 681, 1, 0, 0, 0, 684, 686, 3, 142, 71,
+		0, 685, 684, 1, 0, 0, 0, 685, 686, 1, 0, 0, 0, 686, 141, 1, 0, 0, 0, 687,
+		692, 3, 144, 72, 0, 688, 689, 5, 96, 0, 0, 689, 691, 3, 144, 72, 0, 690,
+		688, 1, 0, 0, 0, 691, 694, 1, 0, 0, 0, 692, 690, 1, 0, 0, 0, 692, 693,
+		1, 0, 0, 0, 693, 143, 1, 0, 0, 0, 694, 692, 1, 0, 0, 0, 695, 696, 3, 94,
+		47, 0, 696, 697, 5, 101, 0, 0, 697, 698, 3, 94, 47, 0, 698, 145, 1, 0,
+		0, 0, 699, 701, 3, 148, 74, 0, 700, 699, 1, 0, 0, 0, 700, 701, 1, 0, 0,
+		0, 701, 147, 1, 0, 0, 0, 702, 707, 3, 150, 75, 0, 703, 704, 5, 96, 0, 0,
+		704, 706, 3, 150, 75, 0, 705, 703, 1, 0, 0, 0, 706, 709, 1, 0, 0, 0, 707,
+		705, 1, 0, 0, 0, 707, 708, 1, 0, 0, 0, 708, 149, 1, 0, 0, 0, 709, 707,
+		1, 0, 0, 0, 710, 711, 7, 7, 0, 0, 711, 713, 5, 101, 0, 0, 712, 710, 1,
+		0, 0, 0, 712, 713, 1, 0, 0, 0, 713, 714, 1, 0, 0, 0, 714, 715, 3, 94, 47,
+		0, 715, 151, 1, 0, 0, 0, 716, 721, 3, 154, 77, 0, 717, 718, 5, 96, 0, 0,
+		718, 720, 3, 154, 77, 0, 719, 717, 1, 0, 0, 0, 720, 723, 1, 0, 0, 0, 721,
+		719, 1, 0, 0, 0, 721, 722, 1, 0, 0, 0, 722, 153, 1, 0, 0, 0, 723, 721,
+		1, 0, 0, 0, 724, 727, 5, 82, 0, 0, 725, 726, 5, 83, 0, 0, 726, 728, 3,
+		94, 47, 0, 727, 725, 1, 0, 0, 0, 727, 728, 1, 0, 0, 0, 728, 155, 1, 0,
+		0, 0, 729, 622, 3, 156, 78, 0, 730, 731, 5, 37, 0, 0, 731, 733, 5, 94,
+		0, 0, 732, 734, 3, 32, 16, 0, 733, 732, 1, 0, 0, 0, 733, 734, 1, 0, 0,
+		0, 734, 735, 1, 0, 0, 0, 735, 736, 5, 95, 0, 0, 736, 742, 5, 46, 0, 0,
+		737, 743, 3, 94, 47, 0, 738, 739, 5, 111, 0, 0, 739, 740, 3, 36, 18, 0,
+		740, 741, 5, 24, 0, 0, 741, 743, 1, 0, 0, 0, 742, 737, 1, 0, 0, 0, 742,
+		738, 1, 0, 0, 0, 743, 157, 1, 0, 0, 0, 744, 754, 5, 20, 0, 0, 745, 746,
+		5, 39, 0, 0, 746, 747, 3, 94, 47, 0, 747, 748, 5, 111, 0, 0, 748, 750,
+		3, 36, 18, 0, 749, 751, 3, 158, 79, 0, 750, 749, 1, 0, 0, 0, 750, 751,
+		1, 0, 0, 0, 751, 755, 1, 0, 0, 0, 752, 753, 5, 111, 0, 0, 753, 755, 3,
+		36, 18, 0, 754, 745, 1, 0, 0, 0, 754, 752, 1, 0, 0, 0, 755, 159, 1, 0,
+		0, 0, 756, 757, 5, 64, 0, 0, 757, 759, 3, 94, 47, 0, 758, 760, 5, 111,
+		0, 0, 759, 758, 1, 0, 0, 0, 760, 761, 1, 0, 0, 0, 761, 759, 1, 0, 0, 0,
+		761, 762, 1, 0, 0, 0, 762, 766, 1, 0, 0, 0, 763, 765, 3, 162, 81, 0, 764,
+		763, 1, 0, 0, 0, 765, 768, 1, 0, 0, 0, 766, 764, 1, 0, 0, 0, 766, 767,
+		1, 0, 0, 0, 767, 772, 1, 0, 0, 0, 768, 766, 1, 0, 0, 0, 769, 770, 5, 17,
+		0, 0, 770, 771, 5, 111, 0, 0, 771, 773, 3, 36, 18, 0, 772, 769, 1, 0, 0,
+		0, 772, 773, 1, 0, 0, 0, 773, 774, 1, 0, 0, 0, 774, 775, 5, 27, 0, 0, 775,
+		161, 1, 0, 0, 0, 776, 782, 5, 10, 0, 0, 777, 778, 5, 40, 0, 0, 778, 783,
+		3, 94, 47, 0, 779, 780, 5, 70, 0, 0, 780, 783, 3, 138, 69, 0, 781, 783,
+		3, 138, 69, 0, 782, 777, 1, 0, 0, 0, 782, 779, 1, 0, 0, 0, 782, 781, 1,
+		0, 0, 0, 783, 784, 1, 0, 0, 0, 784, 785, 5, 111, 0, 0, 785, 786, 3, 36,
+		18, 0, 786, 163, 1, 0, 0, 0, 787, 788, 5, 69, 0, 0, 788, 789, 5, 111, 0,
+		0, 789, 796, 3, 36, 18, 0, 790, 792, 5, 11, 0, 0, 791, 793, 5, 82, 0, 0,
+		792, 791, 1, 0, 0, 0, 792, 793, 1, 0, 0, 0, 793, 794, 1, 0, 0, 0, 794,
+		795, 5, 111, 0, 0, 795, 797, 3, 36, 18, 0, 796, 790, 1, 0, 0, 0, 796, 797,
+		1, 0, 0, 0, 797, 801, 1, 0, 0, 0, 798, 799, 5, 35, 0, 0, 799, 800, 5, 111,
+		0, 0, 800, 802, 3, 36, 18, 0, 801, 798, 1, 0, 0, 0, 801, 802, 1, 0, 0,
+		0, 802, 803, 1, 0, 0, 0, 803, 804, 5, 28, 0, 0, 804, 165, 1, 0, 0, 0, 805,
+		810, 3, 94, 47, 0, 806, 808, 5, 101, 0, 0, 807, 809, 3, 94, 47, 0, 808,
+		807, 1, 0, 0, 0, 808, 809, 1, 0, 0, 0, 809, 811, 1, 0, 0, 0, 810, 806,
+		1, 0, 0, 0, 810, 811, 1, 0, 0, 0, 811, 817, 1, 0, 0, 0, 812, 814, 5, 101,
+		0, 0, 813, 815, 3, 94, 47, 0, 814, 813, 1, 0, 0, 0, 814, 815, 1, 0, 0,
+		0, 815, 817, 1, 0, 0, 0, 816, 805, 1, 0, 0, 0, 816, 812, 1, 0, 0, 0, 817,
+		167, 1, 0, 0, 0, 818, 819, 5, 58, 0, 0, 819, 820, 5, 32, 0, 0, 820, 823,
+		3, 94, 47, 0, 821, 822, 5, 74, 0, 0, 822, 824, 3, 94, 47, 0, 823, 821,
+		1, 0, 0, 0, 823, 824, 1, 0, 0, 0, 824, 169, 1, 0, 0, 0, 84, 173, 180, 186,
+		191, 196, 201, 212, 218, 226, 233, 238, 255, 270, 272, 279, 281, 284, 300,
+		307, 313, 321, 327, 334, 339, 355, 362, 367, 383, 387, 399, 408, 410, 418,
+		431, 446, 456, 460, 476, 500, 507, 516, 524, 532, 540, 548, 556, 564, 572,
+		580, 588, 593, 602, 604, 621, 633, 641, 645, 659, 674, 681, 685, 692, 700,
+		707, 712, 721, 727, 733, 742, 750, 754, 761, 766, 772, 782, 792, 796, 801,
+		808, 810, 814, 816, 823, 379,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -433,99 +514,109 @@ const (
 	NeuroScriptParserKW_ATAN                = 7
 	NeuroScriptParserKW_BREAK               = 8
 	NeuroScriptParserKW_CALL                = 9
-	NeuroScriptParserKW_CLEAR               = 10
-	NeuroScriptParserKW_CLEAR_ERROR         = 11
-	NeuroScriptParserKW_COMMAND             = 12
-	NeuroScriptParserKW_CONTINUE            = 13
-	NeuroScriptParserKW_COS                 = 14
-	NeuroScriptParserKW_DO                  = 15
-	NeuroScriptParserKW_EACH                = 16
-	NeuroScriptParserKW_ELSE                = 17
-	NeuroScriptParserKW_EMIT                = 18
-	NeuroScriptParserKW_ENDCOMMAND          = 19
-	NeuroScriptParserKW_ENDFOR              = 20
-	NeuroScriptParserKW_ENDFUNC             = 21
-	NeuroScriptParserKW_ENDIF               = 22
-	NeuroScriptParserKW_ENDON               = 23
-	NeuroScriptParserKW_ENDWHILE            = 24
-	NeuroScriptParserKW_ERROR               = 25
-	NeuroScriptParserKW_EVAL                = 26
-	NeuroScriptParserKW_EVENT               = 27
-	NeuroScriptParserKW_FAIL                = 28
-	NeuroScriptParserKW_FALSE               = 29
-	NeuroScriptParserKW_FOR                 = 30
-	NeuroScriptParserKW_FUNC                = 31
-	NeuroScriptParserKW_FUZZY               = 32
-	NeuroScriptParserKW_IF                  = 33
-	NeuroScriptParserKW_IN                  = 34
-	NeuroScriptParserKW_INTO                = 35
-	NeuroScriptParserKW_LAST                = 36
-	NeuroScriptParserKW_LEN                 = 37
-	NeuroScriptParserKW_LN                  = 38
-	NeuroScriptParserKW_LOG                 = 39
-	NeuroScriptParserKW_MEANS               = 40
-	NeuroScriptParserKW_MUST                = 41
-	NeuroScriptParserKW_MUSTBE              = 42
-	NeuroScriptParserKW_NAMED               = 43
-	NeuroScriptParserKW_NEEDS               = 44
-	NeuroScriptParserKW_NIL                 = 45
-	NeuroScriptParserKW_NO                  = 46
-	NeuroScriptParserKW_NOT                 = 47
-	NeuroScriptParserKW_ON                  = 48
-	NeuroScriptParserKW_OPTIONAL            = 49
-	NeuroScriptParserKW_OR                  = 50
-	NeuroScriptParserKW_PROMPTUSER          = 51
-	NeuroScriptParserKW_RETURN              = 52
-	NeuroScriptParserKW_RETURNS             = 53
-	NeuroScriptParserKW_SET                 = 54
-	NeuroScriptParserKW_SIN                 = 55
-	NeuroScriptParserKW_SOME                = 56
-	NeuroScriptParserKW_TAN                 = 57
-	NeuroScriptParserKW_TIMEDATE            = 58
-	NeuroScriptParserKW_TOOL                = 59
-	NeuroScriptParserKW_TRUE                = 60
-	NeuroScriptParserKW_TYPEOF              = 61
-	NeuroScriptParserKW_WHILE               = 62
-	NeuroScriptParserKW_WHISPER             = 63
-	NeuroScriptParserKW_WITH                = 64
-	NeuroScriptParserAT                     = 65
-	NeuroScriptParserSTRING_LIT             = 66
-	NeuroScriptParserTRIPLE_BACKTICK_STRING = 67
-	NeuroScriptParserTRIPLE_SQ_STRING       = 68
-	NeuroScriptParserDOUBLE_BRACKET_STRING  = 69
-	NeuroScriptParserMETADATA_LINE          = 70
-	NeuroScriptParserNUMBER_LIT             = 71
-	NeuroScriptParserIDENTIFIER             = 72
-	NeuroScriptParserASSIGN                 = 73
-	NeuroScriptParserPLUS                   = 74
-	NeuroScriptParserMINUS                  = 75
-	NeuroScriptParserSTAR                   = 76
-	NeuroScriptParserSLASH                  = 77
-	NeuroScriptParserPERCENT                = 78
-	NeuroScriptParserSTAR_STAR              = 79
-	NeuroScriptParserAMPERSAND              = 80
-	NeuroScriptParserPIPE                   = 81
-	NeuroScriptParserCARET                  = 82
-	NeuroScriptParserTILDE                  = 83
-	NeuroScriptParserLPAREN                 = 84
-	NeuroScriptParserRPAREN                 = 85
-	NeuroScriptParserCOMMA                  = 86
-	NeuroScriptParserLBRACK                 = 87
-	NeuroScriptParserRBRACK                 = 88
-	NeuroScriptParserLBRACE                 = 89
-	NeuroScriptParserRBRACE                 = 90
-	NeuroScriptParserCOLON                  = 91
-	NeuroScriptParserDOT                    = 92
-	NeuroScriptParserPLACEHOLDER_START      = 93
-	NeuroScriptParserEQ                     = 94
-	NeuroScriptParserNEQ                    = 95
-	NeuroScriptParserGT                     = 96
-	NeuroScriptParserLT                     = 97
-	NeuroScriptParserGTE                    = 98
-	NeuroScriptParserLTE                    = 99
-	NeuroScriptParserLINE_COMMENT           = 100
-	NeuroScriptParserNEWLINE                = 101
-	NeuroScriptParserWS                     = 102
+	NeuroScriptParserKW_CASE                = 10
+	NeuroScriptParserKW_CATCH               = 11
+	NeuroScriptParserKW_CLEAR               = 12
+	NeuroScriptParserKW_CLEAR_ERROR         = 13
+	NeuroScriptParserKW_COMMAND             = 14
+	NeuroScriptParserKW_CONTINUE            = 15
+	NeuroScriptParserKW_COS                 = 16
+	NeuroScriptParserKW_DEFAULT             = 17
+	NeuroScriptParserKW_DO                  = 18
+	NeuroScriptParserKW_EACH                = 19
+	NeuroScriptParserKW_ELSE                = 20
+	NeuroScriptParserKW_EMIT                = 21
+	NeuroScriptParserKW_ENDCOMMAND          = 22
+	NeuroScriptParserKW_ENDFOR              = 23
+	NeuroScriptParserKW_ENDFUNC             = 24
+	NeuroScriptParserKW_ENDIF               = 25
+	NeuroScriptParserKW_ENDON               = 26
+	NeuroScriptParserKW_ENDSWITCH           = 27
+	NeuroScriptParserKW_ENDTRY              = 28
+	NeuroScriptParserKW_ENDWHILE            = 29
+	NeuroScriptParserKW_ERROR               = 30
+	NeuroScriptParserKW_EVAL                = 31
+	NeuroScriptParserKW_EVENT               = 32
+	NeuroScriptParserKW_FAIL                = 33
+	NeuroScriptParserKW_FALSE               = 34
+	NeuroScriptParserKW_FINALLY             = 35
+	NeuroScriptParserKW_FOR                 = 36
+	NeuroScriptParserKW_FUNC                = 37
+	NeuroScriptParserKW_FUZZY               = 38
+	NeuroScriptParserKW_IF                  = 39
+	NeuroScriptParserKW_IN                  = 40
+	NeuroScriptParserKW_INTO                = 41
+	NeuroScriptParserKW_LAST                = 42
+	NeuroScriptParserKW_LEN                 = 43
+	NeuroScriptParserKW_LN                  = 44
+	NeuroScriptParserKW_LOG                 = 45
+	NeuroScriptParserKW_MEANS               = 46
+	NeuroScriptParserKW_MUST                = 47
+	NeuroScriptParserKW_MUSTBE              = 48
+	NeuroScriptParserKW_NAMED               = 49
+	NeuroScriptParserKW_NEEDS               = 50
+	NeuroScriptParserKW_NIL                 = 51
+	NeuroScriptParserKW_NO                  = 52
+	NeuroScriptParserKW_NOT                 = 53
+	NeuroScriptParserKW_ON                  = 54
+	NeuroScriptParserKW_OPTIONAL            = 55
+	NeuroScriptParserKW_OR                  = 56
+	NeuroScriptParserKW_PROMPTUSER          = 57
+	NeuroScriptParserKW_RAISE               = 58
+	NeuroScriptParserKW_RETURN              = 59
+	NeuroScriptParserKW_RETURNS             = 60
+	NeuroScriptParserKW_SET                 = 61
+	NeuroScriptParserKW_SIN                 = 62
+	NeuroScriptParserKW_SOME                = 63
+	NeuroScriptParserKW_SWITCH              = 64
+	NeuroScriptParserKW_TAN                 = 65
+	NeuroScriptParserKW_TIMEDATE            = 66
+	NeuroScriptParserKW_TOOL                = 67
+	NeuroScriptParserKW_TRUE                = 68
+	NeuroScriptParserKW_TRY                 = 69
+	NeuroScriptParserKW_TYPEOF              = 70
+	NeuroScriptParserKW_WHERE               = 71
+	NeuroScriptParserKW_WHILE               = 72
+	NeuroScriptParserKW_WHISPER             = 73
+	NeuroScriptParserKW_WITH                = 74
+	NeuroScriptParserAT                     = 75
+	NeuroScriptParserSTRING_LIT             = 76
+	NeuroScriptParserTRIPLE_BACKTICK_STRING = 77
+	NeuroScriptParserTRIPLE_SQ_STRING       = 78
+	NeuroScriptParserDOUBLE_BRACKET_STRING  = 79
+	NeuroScriptParserMETADATA_LINE          = 80
+	NeuroScriptParserNUMBER_LIT             = 81
+	NeuroScriptParserIDENTIFIER             = 82
+	NeuroScriptParserASSIGN                 = 83
+	NeuroScriptParserPLUS                   = 84
+	NeuroScriptParserMINUS                  = 85
+	NeuroScriptParserSTAR                   = 86
+	NeuroScriptParserSLASH                  = 87
+	NeuroScriptParserPERCENT                = 88
+	NeuroScriptParserSTAR_STAR              = 89
+	NeuroScriptParserAMPERSAND              = 90
+	NeuroScriptParserPIPE                   = 91
+	NeuroScriptParserCARET                  = 92
+	NeuroScriptParserTILDE                  = 93
+	NeuroScriptParserLPAREN                 = 94
+	NeuroScriptParserRPAREN                 = 95
+	NeuroScriptParserCOMMA                  = 96
+	NeuroScriptParserLBRACK                 = 97
+	NeuroScriptParserRBRACK                 = 98
+	NeuroScriptParserLBRACE                 = 99
+	NeuroScriptParserRBRACE                 = 100
+	NeuroScriptParserCOLON                  = 101
+	NeuroScriptParserDOT                    = 102
+	NeuroScriptParserPLACEHOLDER_START      = 103
+	NeuroScriptParserEQ                     = 104
+	NeuroScriptParserNEQ                    = 105
+	NeuroScriptParserGT                     = 106
+	NeuroScriptParserLT                     = 107
+	NeuroScriptParserGTE                    = 108
+	NeuroScriptParserLTE                    = 109
+	NeuroScriptParserLINE_COMMENT           = 110
+	NeuroScriptParserNEWLINE                = 111
+	NeuroScriptParserWS                     = 112
 )
 
 // NeuroScriptParser rules.
@@ -603,6 +694,18 @@ const (
 	NeuroScriptParserRULE_map_entry_list_opt       = 70
 	NeuroScriptParserRULE_map_entry_list           = 71
 	NeuroScriptParserRULE_map_entry                = 72
+	NeuroScriptParserRULE_argument_list_opt        = 73
+	NeuroScriptParserRULE_argument_list            = 74
+	NeuroScriptParserRULE_argument                 = 75
+	NeuroScriptParserRULE_optional_param_list      = 76
+	NeuroScriptParserRULE_optional_param           = 77
+	NeuroScriptParserRULE_lambda_expr              = 78
+	NeuroScriptParserRULE_else_clause              = 79
+	NeuroScriptParserRULE_switch_statement         = 80
+	NeuroScriptParserRULE_switch_case              = 81
+	NeuroScriptParserRULE_try_statement            = 82
+	NeuroScriptParserRULE_subscript                = 83
+	NeuroScriptParserRULE_raise_statement          = 84
 )
 
 // IProgramContext is an interface to support dynamic dispatch.
@@ -741,10 +844,10 @@ func (p *NeuroScriptParser) Program() (localctx IProgramContext) {
 	p.EnterRule(localctx, 0, NeuroScriptParserRULE_program)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(146)
+		p.SetState(170)
 		p.File_header()
 	}
-	p.SetState(149)
+	p.SetState(173)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -752,13 +855,13 @@ func (p *NeuroScriptParser) Program() (localctx IProgramContext) {
 	switch p.GetTokenStream().LA(1) {
 	case NeuroScriptParserKW_FUNC, NeuroScriptParserKW_ON:
 		{
-			p.SetState(147)
+			p.SetState(171)
 			p.Library_script()
 		}
 
 	case NeuroScriptParserKW_COMMAND:
 		{
-			p.SetState(148)
+			p.SetState(172)
 			p.Command_script()
 		}
 
@@ -767,7 +870,7 @@ func (p *NeuroScriptParser) Program() (localctx IProgramContext) {
 	default:
 	}
 	{
-		p.SetState(151)
+		p.SetState(175)
 		p.Match(NeuroScriptParserEOF)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -889,7 +992,7 @@ func (p *NeuroScriptParser) File_header() (localctx IFile_headerContext) {
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(156)
+	p.SetState(180)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -898,7 +1001,7 @@ func (p *NeuroScriptParser) File_header() (localctx IFile_headerContext) {
 
 	for _la == NeuroScriptParserMETADATA_LINE || _la == NeuroScriptParserNEWLINE {
 		{
-			p.SetState(153)
+			p.SetState(177)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == NeuroScriptParserMETADATA_LINE || _la == NeuroScriptParserNEWLINE) {
@@ -909,7 +1012,7 @@ func (p *NeuroScriptParser) File_header() (localctx IFile_headerContext) {
 			}
 		}
 
-		p.SetState(158)
+		p.SetState(182)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -1054,7 +1157,7 @@ func (p *NeuroScriptParser) Library_script() (localctx ILibrary_scriptContext) {
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(160)
+	p.SetState(184)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1063,11 +1166,11 @@ func (p *NeuroScriptParser) Library_script() (localctx ILibrary_scriptContext) {
 
 	for ok := true; ok; ok = _la == NeuroScriptParserKW_FUNC || _la == NeuroScriptParserKW_ON {
 		{
-			p.SetState(159)
+			p.SetState(183)
 			p.Library_block()
 		}
 
-		p.SetState(162)
+		p.SetState(186)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -1212,7 +1315,7 @@ func (p *NeuroScriptParser) Command_script() (localctx ICommand_scriptContext) {
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(165)
+	p.SetState(189)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1221,11 +1324,11 @@ func (p *NeuroScriptParser) Command_script() (localctx ICommand_scriptContext) {
 
 	for ok := true; ok; ok = _la == NeuroScriptParserKW_COMMAND {
 		{
-			p.SetState(164)
+			p.SetState(188)
 			p.Command_block()
 		}
 
-		p.SetState(167)
+		p.SetState(191)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -1376,7 +1479,7 @@ func (p *NeuroScriptParser) Library_block() (localctx ILibrary_blockContext) {
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(172)
+	p.SetState(196)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1385,13 +1488,13 @@ func (p *NeuroScriptParser) Library_block() (localctx ILibrary_blockContext) {
 	switch p.GetTokenStream().LA(1) {
 	case NeuroScriptParserKW_FUNC:
 		{
-			p.SetState(169)
+			p.SetState(193)
 			p.Procedure_definition()
 		}
 
 	case NeuroScriptParserKW_ON:
 		{
-			p.SetState(170)
+			p.SetState(194)
 			p.Match(NeuroScriptParserKW_ON)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1399,7 +1502,7 @@ func (p *NeuroScriptParser) Library_block() (localctx ILibrary_blockContext) {
 			}
 		}
 		{
-			p.SetState(171)
+			p.SetState(195)
 			p.Event_handler()
 		}
 
@@ -1407,7 +1510,7 @@ func (p *NeuroScriptParser) Library_block() (localctx ILibrary_blockContext) {
 		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
-	p.SetState(177)
+	p.SetState(201)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1416,7 +1519,7 @@ func (p *NeuroScriptParser) Library_block() (localctx ILibrary_blockContext) {
 
 	for _la == NeuroScriptParserNEWLINE {
 		{
-			p.SetState(174)
+			p.SetState(198)
 			p.Match(NeuroScriptParserNEWLINE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1424,7 +1527,7 @@ func (p *NeuroScriptParser) Library_block() (localctx ILibrary_blockContext) {
 			}
 		}
 
-		p.SetState(179)
+		p.SetState(203)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -1581,7 +1684,7 @@ func (p *NeuroScriptParser) Command_block() (localctx ICommand_blockContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(180)
+		p.SetState(204)
 		p.Match(NeuroScriptParserKW_COMMAND)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -1589,7 +1692,7 @@ func (p *NeuroScriptParser) Command_block() (localctx ICommand_blockContext) {
 		}
 	}
 	{
-		p.SetState(181)
+		p.SetState(205)
 		p.Match(NeuroScriptParserNEWLINE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -1597,22 +1700,22 @@ func (p *NeuroScriptParser) Command_block() (localctx ICommand_blockContext) {
 		}
 	}
 	{
-		p.SetState(182)
+		p.SetState(206)
 		p.Metadata_block()
 	}
 	{
-		p.SetState(183)
+		p.SetState(207)
 		p.Command_statement_list()
 	}
 	{
-		p.SetState(184)
+		p.SetState(208)
 		p.Match(NeuroScriptParserKW_ENDCOMMAND)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(188)
+	p.SetState(212)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1621,7 +1724,7 @@ func (p *NeuroScriptParser) Command_block() (localctx ICommand_blockContext) {
 
 	for _la == NeuroScriptParserNEWLINE {
 		{
-			p.SetState(185)
+			p.SetState(209)
 			p.Match(NeuroScriptParserNEWLINE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1629,7 +1732,7 @@ func (p *NeuroScriptParser) Command_block() (localctx ICommand_blockContext) {
 			}
 		}
 
-		p.SetState(190)
+		p.SetState(214)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -1801,7 +1904,7 @@ func (p *NeuroScriptParser) Command_statement_list() (localctx ICommand_statemen
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(194)
+	p.SetState(218)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1810,7 +1913,7 @@ func (p *NeuroScriptParser) Command_statement_list() (localctx ICommand_statemen
 
 	for _la == NeuroScriptParserNEWLINE {
 		{
-			p.SetState(191)
+			p.SetState(215)
 			p.Match(NeuroScriptParserNEWLINE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1818,7 +1921,7 @@ func (p *NeuroScriptParser) Command_statement_list() (localctx ICommand_statemen
 			}
 		}
 
-		p.SetState(196)
+		p.SetState(220)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -1826,31 +1929,31 @@ func (p *NeuroScriptParser) Command_statement_list() (localctx ICommand_statemen
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(197)
+		p.SetState(221)
 		p.Command_statement()
 	}
 	{
-		p.SetState(198)
+		p.SetState(222)
 		p.Match(NeuroScriptParserNEWLINE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(202)
+	p.SetState(226)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4591136136171870400) != 0) || _la == NeuroScriptParserNEWLINE {
+	for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&2756344336506458944) != 0) || ((int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&140737488356129) != 0) {
 		{
-			p.SetState(199)
+			p.SetState(223)
 			p.Command_body_line()
 		}
 
-		p.SetState(204)
+		p.SetState(228)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -1971,21 +2074,21 @@ func (s *Command_body_lineContext) Accept(visitor antlr.ParseTreeVisitor) interf
 func (p *NeuroScriptParser) Command_body_line() (localctx ICommand_body_lineContext) {
 	localctx = NewCommand_body_lineContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 14, NeuroScriptParserRULE_command_body_line)
-	p.SetState(209)
+	p.SetState(233)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
 	switch p.GetTokenStream().LA(1) {
-	case NeuroScriptParserKW_ASK, NeuroScriptParserKW_BREAK, NeuroScriptParserKW_CALL, NeuroScriptParserKW_CLEAR, NeuroScriptParserKW_CONTINUE, NeuroScriptParserKW_EMIT, NeuroScriptParserKW_FAIL, NeuroScriptParserKW_FOR, NeuroScriptParserKW_IF, NeuroScriptParserKW_MUST, NeuroScriptParserKW_ON, NeuroScriptParserKW_PROMPTUSER, NeuroScriptParserKW_SET, NeuroScriptParserKW_WHILE, NeuroScriptParserKW_WHISPER:
+	case NeuroScriptParserKW_ASK, NeuroScriptParserKW_BREAK, NeuroScriptParserKW_CALL, NeuroScriptParserKW_CLEAR, NeuroScriptParserKW_CONTINUE, NeuroScriptParserKW_EMIT, NeuroScriptParserKW_FAIL, NeuroScriptParserKW_FOR, NeuroScriptParserKW_IF, NeuroScriptParserKW_MUST, NeuroScriptParserKW_ON, NeuroScriptParserKW_PROMPTUSER, NeuroScriptParserKW_RAISE, NeuroScriptParserKW_SET, NeuroScriptParserKW_SWITCH, NeuroScriptParserKW_TRY, NeuroScriptParserKW_WHILE, NeuroScriptParserKW_WHISPER:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(205)
+			p.SetState(229)
 			p.Command_statement()
 		}
 		{
-			p.SetState(206)
+			p.SetState(230)
 			p.Match(NeuroScriptParserNEWLINE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1996,7 +2099,7 @@ func (p *NeuroScriptParser) Command_body_line() (localctx ICommand_body_lineCont
 	case NeuroScriptParserNEWLINE:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(208)
+			p.SetState(232)
 			p.Match(NeuroScriptParserNEWLINE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2151,31 +2254,31 @@ func (s *Command_statementContext) Accept(visitor antlr.ParseTreeVisitor) interf
 func (p *NeuroScriptParser) Command_statement() (localctx ICommand_statementContext) {
 	localctx = NewCommand_statementContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 16, NeuroScriptParserRULE_command_statement)
-	p.SetState(214)
+	p.SetState(238)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
 	switch p.GetTokenStream().LA(1) {
-	case NeuroScriptParserKW_ASK, NeuroScriptParserKW_BREAK, NeuroScriptParserKW_CALL, NeuroScriptParserKW_CLEAR, NeuroScriptParserKW_CONTINUE, NeuroScriptParserKW_EMIT, NeuroScriptParserKW_FAIL, NeuroScriptParserKW_MUST, NeuroScriptParserKW_PROMPTUSER, NeuroScriptParserKW_SET, NeuroScriptParserKW_WHISPER:
+	case NeuroScriptParserKW_ASK, NeuroScriptParserKW_BREAK, NeuroScriptParserKW_CALL, NeuroScriptParserKW_CLEAR, NeuroScriptParserKW_CONTINUE, NeuroScriptParserKW_EMIT, NeuroScriptParserKW_FAIL, NeuroScriptParserKW_MUST, NeuroScriptParserKW_PROMPTUSER, NeuroScriptParserKW_RAISE, NeuroScriptParserKW_SET, NeuroScriptParserKW_WHISPER:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(211)
+			p.SetState(235)
 			p.Simple_command_statement()
 		}
 
-	case NeuroScriptParserKW_FOR, NeuroScriptParserKW_IF, NeuroScriptParserKW_WHILE:
+	case NeuroScriptParserKW_FOR, NeuroScriptParserKW_IF, NeuroScriptParserKW_SWITCH, NeuroScriptParserKW_TRY, NeuroScriptParserKW_WHILE:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(212)
+			p.SetState(236)
 			p.Block_statement()
 		}
 
 	case NeuroScriptParserKW_ON:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(213)
+			p.SetState(237)
 			p.On_error_only_stmt()
 		}
 
@@ -2299,7 +2402,7 @@ func (p *NeuroScriptParser) On_error_only_stmt() (localctx IOn_error_only_stmtCo
 	p.EnterRule(localctx, 18, NeuroScriptParserRULE_on_error_only_stmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(216)
+		p.SetState(240)
 		p.Match(NeuroScriptParserKW_ON)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2307,7 +2410,7 @@ func (p *NeuroScriptParser) On_error_only_stmt() (localctx IOn_error_only_stmtCo
 		}
 	}
 	{
-		p.SetState(217)
+		p.SetState(241)
 		p.Error_handler()
 	}
 
@@ -2344,6 +2447,7 @@ type ISimple_command_statementContext interface {
 	Break_statement() IBreak_statementContext
 	Continue_statement() IContinue_statementContext
 
+	Raise_statement() IRaise_statementContext
 	// IsSimple_command_statementContext differentiates from other interfaces.
 	IsSimple_command_statementContext()
 }
@@ -2556,6 +2660,22 @@ func (s *Simple_command_statementContext) Continue_statement() IContinue_stateme
 	return t.(IContinue_statementContext)
 }
 
+func (s *Simple_command_statementContext) Raise_statement() IRaise_statementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRaise_statementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRaise_statementContext)
+}
+
 func (s *Simple_command_statementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -2589,7 +2709,7 @@ func (s *Simple_command_statementContext) Accept(visitor antlr.ParseTreeVisitor)
 func (p *NeuroScriptParser) Simple_command_statement() (localctx ISimple_command_statementContext) {
 	localctx = NewSimple_command_statementContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 20, NeuroScriptParserRULE_simple_command_statement)
-	p.SetState(230)
+	p.SetState(255)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -2599,80 +2719,87 @@ func (p *NeuroScriptParser) Simple_command_statement() (localctx ISimple_command
 	case NeuroScriptParserKW_SET:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(219)
+			p.SetState(243)
 			p.Set_statement()
 		}
 
 	case NeuroScriptParserKW_CALL:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(220)
+			p.SetState(244)
 			p.Call_statement()
 		}
 
 	case NeuroScriptParserKW_EMIT:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(221)
+			p.SetState(245)
 			p.Emit_statement()
 		}
 
 	case NeuroScriptParserKW_WHISPER:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(222)
+			p.SetState(246)
 			p.Whisper_stmt()
 		}
 
 	case NeuroScriptParserKW_MUST:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(223)
+			p.SetState(247)
 			p.Must_statement()
 		}
 
 	case NeuroScriptParserKW_FAIL:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(224)
+			p.SetState(248)
 			p.Fail_statement()
 		}
 
 	case NeuroScriptParserKW_CLEAR:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(225)
+			p.SetState(249)
 			p.ClearEventStmt()
 		}
 
 	case NeuroScriptParserKW_ASK:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(226)
+			p.SetState(250)
 			p.Ask_stmt()
 		}
 
 	case NeuroScriptParserKW_PROMPTUSER:
 		p.EnterOuterAlt(localctx, 9)
 		{
-			p.SetState(227)
+			p.SetState(251)
 			p.Promptuser_stmt()
 		}
 
 	case NeuroScriptParserKW_BREAK:
 		p.EnterOuterAlt(localctx, 10)
 		{
-			p.SetState(228)
+			p.SetState(252)
 			p.Break_statement()
 		}
 
 	case NeuroScriptParserKW_CONTINUE:
 		p.EnterOuterAlt(localctx, 11)
 		{
-			p.SetState(229)
+			p.SetState(253)
 			p.Continue_statement()
 		}
 
+	case NeuroScriptParserKW_RAISE:
+		p.EnterOuterAlt(localctx, 12)
+		{
+			p.SetState(254)
+			p.Raise_statement()
+		}
+
 	default:
 		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
@@ -2847,7 +2974,7 @@ func (p *NeuroScriptParser) Procedure_definition() (localctx IProcedure_definiti
 	p.EnterRule(localctx, 22, NeuroScriptParserRULE_procedure_definition)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(232)
+		p.SetState(257)
 		p.Match(NeuroScriptParserKW_FUNC)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2855,7 +2982,7 @@ func (p *NeuroScriptParser) Procedure_definition() (localctx IProcedure_definiti
 		}
 	}
 	{
-		p.SetState(233)
+		p.SetState(258)
 		p.Match(NeuroScriptParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2863,11 +2990,11 @@ func (p *NeuroScriptParser) Procedure_definition() (localctx IProcedure_definiti
 		}
 	}
 	{
-		p.SetState(234)
+		p.SetState(259)
 		p.Signature_part()
 	}
 	{
-		p.SetState(235)
+		p.SetState(260)
 		p.Match(NeuroScriptParserKW_MEANS)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2875,7 +3002,7 @@ func (p *NeuroScriptParser) Procedure_definition() (localctx IProcedure_definiti
 		}
 	}
 	{
-		p.SetState(236)
+		p.SetState(261)
 		p.Match(NeuroScriptParserNEWLINE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2883,15 +3010,15 @@ func (p *NeuroScriptParser) Procedure_definition() (localctx IProcedure_definiti
 		}
 	}
 	{
-		p.SetState(237)
+		p.SetState(262)
 		p.Metadata_block()
 	}
 	{
-		p.SetState(238)
+		p.SetState(263)
 		p.Non_empty_statement_list()
 	}
 	{
-		p.SetState(239)
+		p.SetState(264)
 		p.Match(NeuroScriptParserKW_ENDFUNC)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3131,7 +3258,7 @@ func (p *NeuroScriptParser) Signature_part() (localctx ISignature_partContext) {
 	p.EnterRule(localctx, 24, NeuroScriptParserRULE_signature_part)
 	var _la int
 
-	p.SetState(259)
+	p.SetState(284)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -3141,22 +3268,22 @@ func (p *NeuroScriptParser) Signature_part() (localctx ISignature_partContext) {
 	case NeuroScriptParserLPAREN:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(241)
+			p.SetState(266)
 			p.Match(NeuroScriptParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(247)
+		p.SetState(272)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 
-		for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&9587741394206720) != 0 {
-			p.SetState(245)
+		for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&1190076201532653568) != 0 {
+			p.SetState(270)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -3165,19 +3292,19 @@ func (p *NeuroScriptParser) Signature_part() (localctx ISignature_partContext) {
 			switch p.GetTokenStream().LA(1) {
 			case NeuroScriptParserKW_NEEDS:
 				{
-					p.SetState(242)
+					p.SetState(267)
 					p.Needs_clause()
 				}
 
 			case NeuroScriptParserKW_OPTIONAL:
 				{
-					p.SetState(243)
+					p.SetState(268)
 					p.Optional_clause()
 				}
 
 			case NeuroScriptParserKW_RETURNS:
 				{
-					p.SetState(244)
+					p.SetState(269)
 					p.Returns_clause()
 				}
 
@@ -3186,7 +3313,7 @@ func (p *NeuroScriptParser) Signature_part() (localctx ISignature_partContext) {
 				goto errorExit
 			}
 
-			p.SetState(249)
+			p.SetState(274)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -3194,7 +3321,7 @@ func (p *NeuroScriptParser) Signature_part() (localctx ISignature_partContext) {
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(250)
+			p.SetState(275)
 			p.Match(NeuroScriptParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -3204,15 +3331,15 @@ func (p *NeuroScriptParser) Signature_part() (localctx ISignature_partContext) {
 
 	case NeuroScriptParserKW_NEEDS, NeuroScriptParserKW_OPTIONAL, NeuroScriptParserKW_RETURNS:
 		p.EnterOuterAlt(localctx, 2)
-		p.SetState(254)
+		p.SetState(279)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 
-		for ok := true; ok; ok = ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&9587741394206720) != 0) {
-			p.SetState(254)
+		for ok := true; ok; ok = ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&1190076201532653568) != 0) {
+			p.SetState(279)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -3221,19 +3348,19 @@ func (p *NeuroScriptParser) Signature_part() (localctx ISignature_partContext) {
 			switch p.GetTokenStream().LA(1) {
 			case NeuroScriptParserKW_NEEDS:
 				{
-					p.SetState(251)
+					p.SetState(276)
 					p.Needs_clause()
 				}
 
 			case NeuroScriptParserKW_OPTIONAL:
 				{
-					p.SetState(252)
+					p.SetState(277)
 					p.Optional_clause()
 				}
 
 			case NeuroScriptParserKW_RETURNS:
 				{
-					p.SetState(253)
+					p.SetState(278)
 					p.Returns_clause()
 				}
 
@@ -3242,7 +3369,7 @@ func (p *NeuroScriptParser) Signature_part() (localctx ISignature_partContext) {
 				goto errorExit
 			}
 
-			p.SetState(256)
+			p.SetState(281)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -3373,7 +3500,7 @@ func (p *NeuroScriptParser) Needs_clause() (localctx INeeds_clauseContext) {
 	p.EnterRule(localctx, 26, NeuroScriptParserRULE_needs_clause)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(261)
+		p.SetState(286)
 		p.Match(NeuroScriptParserKW_NEEDS)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3381,7 +3508,7 @@ func (p *NeuroScriptParser) Needs_clause() (localctx INeeds_clauseContext) {
 		}
 	}
 	{
-		p.SetState(262)
+		p.SetState(287)
 		p.Param_list()
 	}
 
@@ -3407,7 +3534,7 @@ type IOptional_clauseContext interface {
 
 	// Getter signatures
 	KW_OPTIONAL() antlr.TerminalNode
-	Param_list() IParam_listContext
+	Optional_param_list() IOptional_param_listContext
 
 	// IsOptional_clauseContext differentiates from other interfaces.
 	IsOptional_clauseContext()
@@ -3449,10 +3576,10 @@ func (s *Optional_clauseContext) KW_OPTIONAL() antlr.TerminalNode {
 	return s.GetToken(NeuroScriptParserKW_OPTIONAL, 0)
 }
 
-func (s *Optional_clauseContext) Param_list() IParam_listContext {
+func (s *Optional_clauseContext) Optional_param_list() IOptional_param_listContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IParam_listContext); ok {
+		if _, ok := ctx.(IOptional_param_listContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -3462,7 +3589,7 @@ func (s *Optional_clauseContext) Param_list() IParam_listContext {
 		return nil
 	}
 
-	return t.(IParam_listContext)
+	return t.(IOptional_param_listContext)
 }
 
 func (s *Optional_clauseContext) GetRuleContext() antlr.RuleContext {
@@ -3500,7 +3627,7 @@ func (p *NeuroScriptParser) Optional_clause() (localctx IOptional_clauseContext)
 	p.EnterRule(localctx, 28, NeuroScriptParserRULE_optional_clause)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(264)
+		p.SetState(289)
 		p.Match(NeuroScriptParserKW_OPTIONAL)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3508,8 +3635,8 @@ func (p *NeuroScriptParser) Optional_clause() (localctx IOptional_clauseContext)
 		}
 	}
 	{
-		p.SetState(265)
-		p.Param_list()
+		p.SetState(290)
+		p.Optional_param_list()
 	}
 
 errorExit:
@@ -3627,7 +3754,7 @@ func (p *NeuroScriptParser) Returns_clause() (localctx IReturns_clauseContext) {
 	p.EnterRule(localctx, 30, NeuroScriptParserRULE_returns_clause)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(267)
+		p.SetState(292)
 		p.Match(NeuroScriptParserKW_RETURNS)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3635,7 +3762,7 @@ func (p *NeuroScriptParser) Returns_clause() (localctx IReturns_clauseContext) {
 		}
 	}
 	{
-		p.SetState(268)
+		p.SetState(293)
 		p.Param_list()
 	}
 
@@ -3754,14 +3881,14 @@ func (p *NeuroScriptParser) Param_list() (localctx IParam_listContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(270)
+		p.SetState(295)
 		p.Match(NeuroScriptParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(275)
+	p.SetState(300)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -3770,7 +3897,7 @@ func (p *NeuroScriptParser) Param_list() (localctx IParam_listContext) {
 
 	for _la == NeuroScriptParserCOMMA {
 		{
-			p.SetState(271)
+			p.SetState(296)
 			p.Match(NeuroScriptParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -3778,7 +3905,7 @@ func (p *NeuroScriptParser) Param_list() (localctx IParam_listContext) {
 			}
 		}
 		{
-			p.SetState(272)
+			p.SetState(297)
 			p.Match(NeuroScriptParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -3786,7 +3913,7 @@ func (p *NeuroScriptParser) Param_list() (localctx IParam_listContext) {
 			}
 		}
 
-		p.SetState(277)
+		p.SetState(302)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -3908,7 +4035,7 @@ func (p *NeuroScriptParser) Metadata_block() (localctx IMetadata_blockContext) {
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(282)
+	p.SetState(307)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -3917,7 +4044,7 @@ func (p *NeuroScriptParser) Metadata_block() (localctx IMetadata_blockContext) {
 
 	for _la == NeuroScriptParserMETADATA_LINE {
 		{
-			p.SetState(278)
+			p.SetState(303)
 			p.Match(NeuroScriptParserMETADATA_LINE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -3925,7 +4052,7 @@ func (p *NeuroScriptParser) Metadata_block() (localctx IMetadata_blockContext) {
 			}
 		}
 		{
-			p.SetState(279)
+			p.SetState(304)
 			p.Match(NeuroScriptParserNEWLINE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -3933,7 +4060,7 @@ func (p *NeuroScriptParser) Metadata_block() (localctx IMetadata_blockContext) {
 			}
 		}
 
-		p.SetState(284)
+		p.SetState(309)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -4105,7 +4232,7 @@ func (p *NeuroScriptParser) Non_empty_statement_list() (localctx INon_empty_stat
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(288)
+	p.SetState(313)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -4114,7 +4241,7 @@ func (p *NeuroScriptParser) Non_empty_statement_list() (localctx INon_empty_stat
 
 	for _la == NeuroScriptParserNEWLINE {
 		{
-			p.SetState(285)
+			p.SetState(310)
 			p.Match(NeuroScriptParserNEWLINE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4122,7 +4249,7 @@ func (p *NeuroScriptParser) Non_empty_statement_list() (localctx INon_empty_stat
 			}
 		}
 
-		p.SetState(290)
+		p.SetState(315)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -4130,31 +4257,31 @@ func (p *NeuroScriptParser) Non_empty_statement_list() (localctx INon_empty_stat
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(291)
+		p.SetState(316)
 		p.Statement()
 	}
 	{
-		p.SetState(292)
+		p.SetState(317)
 		p.Match(NeuroScriptParserNEWLINE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(296)
+	p.SetState(321)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4586632536544497856) != 0) || _la == NeuroScriptParserNEWLINE {
+	for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&3332805088809890624) != 0) || ((int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&140737488356129) != 0) {
 		{
-			p.SetState(293)
+			p.SetState(318)
 			p.Body_line()
 		}
 
-		p.SetState(298)
+		p.SetState(323)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -4299,20 +4426,20 @@ func (p *NeuroScriptParser) Statement_list() (localctx IStatement_listContext) {
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(302)
+	p.SetState(327)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4586632536544497856) != 0) || _la == NeuroScriptParserNEWLINE {
+	for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&3332805088809890624) != 0) || ((int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&140737488356129) != 0) {
 		{
-			p.SetState(299)
+			p.SetState(324)
 			p.Body_line()
 		}
 
-		p.SetState(304)
+		p.SetState(329)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -4433,21 +4560,21 @@ func (s *Body_lineContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 func (p *NeuroScriptParser) Body_line() (localctx IBody_lineContext) {
 	localctx = NewBody_lineContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 40, NeuroScriptParserRULE_body_line)
-	p.SetState(309)
+	p.SetState(334)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
 	switch p.GetTokenStream().LA(1) {
-	case NeuroScriptParserKW_ASK, NeuroScriptParserKW_BREAK, NeuroScriptParserKW_CALL, NeuroScriptParserKW_CLEAR, NeuroScriptParserKW_CLEAR_ERROR, NeuroScriptParserKW_CONTINUE, NeuroScriptParserKW_EMIT, NeuroScriptParserKW_FAIL, NeuroScriptParserKW_FOR, NeuroScriptParserKW_IF, NeuroScriptParserKW_MUST, NeuroScriptParserKW_ON, NeuroScriptParserKW_PROMPTUSER, NeuroScriptParserKW_RETURN, NeuroScriptParserKW_SET, NeuroScriptParserKW_WHILE, NeuroScriptParserKW_WHISPER:
+	case NeuroScriptParserKW_ASK, NeuroScriptParserKW_BREAK, NeuroScriptParserKW_CALL, NeuroScriptParserKW_CLEAR, NeuroScriptParserKW_CLEAR_ERROR, NeuroScriptParserKW_CONTINUE, NeuroScriptParserKW_EMIT, NeuroScriptParserKW_FAIL, NeuroScriptParserKW_FOR, NeuroScriptParserKW_IF, NeuroScriptParserKW_MUST, NeuroScriptParserKW_ON, NeuroScriptParserKW_PROMPTUSER, NeuroScriptParserKW_RAISE, NeuroScriptParserKW_RETURN, NeuroScriptParserKW_SET, NeuroScriptParserKW_SWITCH, NeuroScriptParserKW_TRY, NeuroScriptParserKW_WHILE, NeuroScriptParserKW_WHISPER:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(305)
+			p.SetState(330)
 			p.Statement()
 		}
 		{
-			p.SetState(306)
+			p.SetState(331)
 			p.Match(NeuroScriptParserNEWLINE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4458,7 +4585,7 @@ func (p *NeuroScriptParser) Body_line() (localctx IBody_lineContext) {
 	case NeuroScriptParserNEWLINE:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(308)
+			p.SetState(333)
 			p.Match(NeuroScriptParserNEWLINE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4613,31 +4740,31 @@ func (s *StatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 func (p *NeuroScriptParser) Statement() (localctx IStatementContext) {
 	localctx = NewStatementContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 42, NeuroScriptParserRULE_statement)
-	p.SetState(314)
+	p.SetState(339)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
 	switch p.GetTokenStream().LA(1) {
-	case NeuroScriptParserKW_ASK, NeuroScriptParserKW_BREAK, NeuroScriptParserKW_CALL, NeuroScriptParserKW_CLEAR, NeuroScriptParserKW_CLEAR_ERROR, NeuroScriptParserKW_CONTINUE, NeuroScriptParserKW_EMIT, NeuroScriptParserKW_FAIL, NeuroScriptParserKW_MUST, NeuroScriptParserKW_PROMPTUSER, NeuroScriptParserKW_RETURN, NeuroScriptParserKW_SET, NeuroScriptParserKW_WHISPER:
+	case NeuroScriptParserKW_ASK, NeuroScriptParserKW_BREAK, NeuroScriptParserKW_CALL, NeuroScriptParserKW_CLEAR, NeuroScriptParserKW_CLEAR_ERROR, NeuroScriptParserKW_CONTINUE, NeuroScriptParserKW_EMIT, NeuroScriptParserKW_FAIL, NeuroScriptParserKW_MUST, NeuroScriptParserKW_PROMPTUSER, NeuroScriptParserKW_RAISE, NeuroScriptParserKW_RETURN, NeuroScriptParserKW_SET, NeuroScriptParserKW_WHISPER:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(311)
+			p.SetState(336)
 			p.Simple_statement()
 		}
 
-	case NeuroScriptParserKW_FOR, NeuroScriptParserKW_IF, NeuroScriptParserKW_WHILE:
+	case NeuroScriptParserKW_FOR, NeuroScriptParserKW_IF, NeuroScriptParserKW_SWITCH, NeuroScriptParserKW_TRY, NeuroScriptParserKW_WHILE:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(312)
+			p.SetState(337)
 			p.Block_statement()
 		}
 
 	case NeuroScriptParserKW_ON:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(313)
+			p.SetState(338)
 			p.On_stmt()
 		}
 
@@ -4681,6 +4808,7 @@ type ISimple_statementContext interface {
 	Break_statement() IBreak_statementContext
 	Continue_statement() IContinue_statementContext
 
+	Raise_statement() IRaise_statementContext
 	// IsSimple_statementContext differentiates from other interfaces.
 	IsSimple_statementContext()
 }
@@ -4925,6 +5053,22 @@ func (s *Simple_statementContext) Continue_statement() IContinue_statementContex
 	return t.(IContinue_statementContext)
 }
 
+func (s *Simple_statementContext) Raise_statement() IRaise_statementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRaise_statementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRaise_statementContext)
+}
+
 func (s *Simple_statementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -4958,7 +5102,7 @@ func (s *Simple_statementContext) Accept(visitor antlr.ParseTreeVisitor) interfa
 func (p *NeuroScriptParser) Simple_statement() (localctx ISimple_statementContext) {
 	localctx = NewSimple_statementContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 44, NeuroScriptParserRULE_simple_statement)
-	p.SetState(329)
+	p.SetState(355)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -4968,94 +5112,101 @@ func (p *NeuroScriptParser) Simple_statement() (localctx ISimple_statementContex
 	case NeuroScriptParserKW_SET:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(316)
+			p.SetState(341)
 			p.Set_statement()
 		}
 
 	case NeuroScriptParserKW_CALL:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(317)
+			p.SetState(342)
 			p.Call_statement()
 		}
 
 	case NeuroScriptParserKW_RETURN:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(318)
+			p.SetState(343)
 			p.Return_statement()
 		}
 
 	case NeuroScriptParserKW_EMIT:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(319)
+			p.SetState(344)
 			p.Emit_statement()
 		}
 
 	case NeuroScriptParserKW_WHISPER:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(320)
+			p.SetState(345)
 			p.Whisper_stmt()
 		}
 
 	case NeuroScriptParserKW_MUST:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(321)
+			p.SetState(346)
 			p.Must_statement()
 		}
 
 	case NeuroScriptParserKW_FAIL:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(322)
+			p.SetState(347)
 			p.Fail_statement()
 		}
 
 	case NeuroScriptParserKW_CLEAR_ERROR:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(323)
+			p.SetState(348)
 			p.ClearErrorStmt()
 		}
 
 	case NeuroScriptParserKW_CLEAR:
 		p.EnterOuterAlt(localctx, 9)
 		{
-			p.SetState(324)
+			p.SetState(349)
 			p.ClearEventStmt()
 		}
 
 	case NeuroScriptParserKW_ASK:
 		p.EnterOuterAlt(localctx, 10)
 		{
-			p.SetState(325)
+			p.SetState(350)
 			p.Ask_stmt()
 		}
 
 	case NeuroScriptParserKW_PROMPTUSER:
 		p.EnterOuterAlt(localctx, 11)
 		{
-			p.SetState(326)
+			p.SetState(351)
 			p.Promptuser_stmt()
 		}
 
 	case NeuroScriptParserKW_BREAK:
 		p.EnterOuterAlt(localctx, 12)
 		{
-			p.SetState(327)
+			p.SetState(352)
 			p.Break_statement()
 		}
 
 	case NeuroScriptParserKW_CONTINUE:
 		p.EnterOuterAlt(localctx, 13)
 		{
-			p.SetState(328)
+			p.SetState(353)
 			p.Continue_statement()
 		}
 
+	case NeuroScriptParserKW_RAISE:
+		p.EnterOuterAlt(localctx, 14)
+		{
+			p.SetState(354)
+			p.Raise_statement()
+		}
+
 	default:
 		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
@@ -5086,6 +5237,8 @@ type IBlock_statementContext interface {
 	While_statement() IWhile_statementContext
 	For_each_statement() IFor_each_statementContext
 
+	Switch_statement() ISwitch_statementContext
+	Try_statement() ITry_statementContext
 	// IsBlock_statementContext differentiates from other interfaces.
 	IsBlock_statementContext()
 }
@@ -5170,6 +5323,38 @@ func (s *Block_statementContext) For_each_statement() IFor_each_statementContext
 	return t.(IFor_each_statementContext)
 }
 
+func (s *Block_statementContext) Switch_statement() ISwitch_statementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISwitch_statementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISwitch_statementContext)
+}
+
+func (s *Block_statementContext) Try_statement() ITry_statementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITry_statementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITry_statementContext)
+}
+
 func (s *Block_statementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -5203,7 +5388,7 @@ func (s *Block_statementContext) Accept(visitor antlr.ParseTreeVisitor) interfac
 func (p *NeuroScriptParser) Block_statement() (localctx IBlock_statementContext) {
 	localctx = NewBlock_statementContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 46, NeuroScriptParserRULE_block_statement)
-	p.SetState(334)
+	p.SetState(362)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -5213,24 +5398,38 @@ func (p *NeuroScriptParser) Block_statement() (localctx IBlock_statementContext)
 	case NeuroScriptParserKW_IF:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(331)
+			p.SetState(357)
 			p.If_statement()
 		}
 
 	case NeuroScriptParserKW_WHILE:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(332)
+			p.SetState(358)
 			p.While_statement()
 		}
 
 	case NeuroScriptParserKW_FOR:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(333)
+			p.SetState(359)
 			p.For_each_statement()
 		}
 
+	case NeuroScriptParserKW_SWITCH:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(360)
+			p.Switch_statement()
+		}
+
+	case NeuroScriptParserKW_TRY:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(361)
+			p.Try_statement()
+		}
+
 	default:
 		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
@@ -5368,14 +5567,14 @@ func (p *NeuroScriptParser) On_stmt() (localctx IOn_stmtContext) {
 	p.EnterRule(localctx, 48, NeuroScriptParserRULE_on_stmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(336)
+		p.SetState(364)
 		p.Match(NeuroScriptParserKW_ON)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(339)
+	p.SetState(367)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -5384,13 +5583,13 @@ func (p *NeuroScriptParser) On_stmt() (localctx IOn_stmtContext) {
 	switch p.GetTokenStream().LA(1) {
 	case NeuroScriptParserKW_ERROR:
 		{
-			p.SetState(337)
+			p.SetState(365)
 			p.Error_handler()
 		}
 
 	case NeuroScriptParserKW_EVENT:
 		{
-			p.SetState(338)
+			p.SetState(366)
 			p.Event_handler()
 		}
 
@@ -5529,7 +5728,7 @@ func (p *NeuroScriptParser) Error_handler() (localctx IError_handlerContext) {
 	p.EnterRule(localctx, 50, NeuroScriptParserRULE_error_handler)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(341)
+		p.SetState(369)
 		p.Match(NeuroScriptParserKW_ERROR)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -5537,7 +5736,7 @@ func (p *NeuroScriptParser) Error_handler() (localctx IError_handlerContext) {
 		}
 	}
 	{
-		p.SetState(342)
+		p.SetState(370)
 		p.Match(NeuroScriptParserKW_DO)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -5545,7 +5744,7 @@ func (p *NeuroScriptParser) Error_handler() (localctx IError_handlerContext) {
 		}
 	}
 	{
-		p.SetState(343)
+		p.SetState(371)
 		p.Match(NeuroScriptParserNEWLINE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -5553,11 +5752,11 @@ func (p *NeuroScriptParser) Error_handler() (localctx IError_handlerContext) {
 		}
 	}
 	{
-		p.SetState(344)
+		p.SetState(372)
 		p.Non_empty_statement_list()
 	}
 	{
-		p.SetState(345)
+		p.SetState(373)
 		p.Match(NeuroScriptParserKW_ENDON)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -5587,11 +5786,13 @@ type IEvent_handlerContext interface {
 
 	// Getter signatures
 	KW_EVENT() antlr.TerminalNode
-	Expression() IExpressionContext
+	AllExpression() []IExpressionContext
+	Expression(i int) IExpressionContext
 	KW_DO() antlr.TerminalNode
 	NEWLINE() antlr.TerminalNode
 	Non_empty_statement_list() INon_empty_statement_listContext
 	KW_ENDON() antlr.TerminalNode
+	KW_WHERE() antlr.TerminalNode
 	KW_NAMED() antlr.TerminalNode
 	STRING_LIT() antlr.TerminalNode
 	KW_AS() antlr.TerminalNode
@@ -5637,12 +5838,37 @@ func (s *Event_handlerContext) KW_EVENT() antlr.TerminalNode {
 	return s.GetToken(NeuroScriptParserKW_EVENT, 0)
 }
 
-func (s *Event_handlerContext) Expression() IExpressionContext {
+func (s *Event_handlerContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *Event_handlerContext) Expression(i int) IExpressionContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IExpressionContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -5681,6 +5907,10 @@ func (s *Event_handlerContext) KW_ENDON() antlr.TerminalNode {
 	return s.GetToken(NeuroScriptParserKW_ENDON, 0)
 }
 
+func (s *Event_handlerContext) KW_WHERE() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_WHERE, 0)
+}
+
 func (s *Event_handlerContext) KW_NAMED() antlr.TerminalNode {
 	return s.GetToken(NeuroScriptParserKW_NAMED, 0)
 }
@@ -5734,7 +5964,7 @@ func (p *NeuroScriptParser) Event_handler() (localctx IEvent_handlerContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(347)
+		p.SetState(375)
 		p.Match(NeuroScriptParserKW_EVENT)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -5742,10 +5972,32 @@ func (p *NeuroScriptParser) Event_handler() (localctx IEvent_handlerContext) {
 		}
 	}
 	{
-		p.SetState(348)
+		p.SetState(376)
 		p.Expression()
 	}
-	p.SetState(351)
+	p.SetState(379)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == NeuroScriptParserKW_WHERE {
+		{
+			p.SetState(377)
+			p.Match(NeuroScriptParserKW_WHERE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(378)
+			p.Expression()
+		}
+
+	}
+	p.SetState(383)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -5754,7 +6006,7 @@ func (p *NeuroScriptParser) Event_handler() (localctx IEvent_handlerContext) {
 
 	if _la == NeuroScriptParserKW_NAMED {
 		{
-			p.SetState(349)
+			p.SetState(381)
 			p.Match(NeuroScriptParserKW_NAMED)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -5762,7 +6014,7 @@ func (p *NeuroScriptParser) Event_handler() (localctx IEvent_handlerContext) {
 			}
 		}
 		{
-			p.SetState(350)
+			p.SetState(382)
 			p.Match(NeuroScriptParserSTRING_LIT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -5771,7 +6023,7 @@ func (p *NeuroScriptParser) Event_handler() (localctx IEvent_handlerContext) {
 		}
 
 	}
-	p.SetState(355)
+	p.SetState(387)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -5780,7 +6032,7 @@ func (p *NeuroScriptParser) Event_handler() (localctx IEvent_handlerContext) {
 
 	if _la == NeuroScriptParserKW_AS {
 		{
-			p.SetState(353)
+			p.SetState(385)
 			p.Match(NeuroScriptParserKW_AS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -5788,7 +6040,7 @@ func (p *NeuroScriptParser) Event_handler() (localctx IEvent_handlerContext) {
 			}
 		}
 		{
-			p.SetState(354)
+			p.SetState(386)
 			p.Match(NeuroScriptParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -5798,7 +6050,7 @@ func (p *NeuroScriptParser) Event_handler() (localctx IEvent_handlerContext) {
 
 	}
 	{
-		p.SetState(357)
+		p.SetState(389)
 		p.Match(NeuroScriptParserKW_DO)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -5806,7 +6058,7 @@ func (p *NeuroScriptParser) Event_handler() (localctx IEvent_handlerContext) {
 		}
 	}
 	{
-		p.SetState(358)
+		p.SetState(390)
 		p.Match(NeuroScriptParserNEWLINE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -5814,11 +6066,11 @@ func (p *NeuroScriptParser) Event_handler() (localctx IEvent_handlerContext) {
 		}
 	}
 	{
-		p.SetState(359)
+		p.SetState(391)
 		p.Non_empty_statement_list()
 	}
 	{
-		p.SetState(360)
+		p.SetState(392)
 		p.Match(NeuroScriptParserKW_ENDON)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -5956,7 +6208,7 @@ func (p *NeuroScriptParser) ClearEventStmt() (localctx IClearEventStmtContext) {
 	p.EnterRule(localctx, 54, NeuroScriptParserRULE_clearEventStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(362)
+		p.SetState(394)
 		p.Match(NeuroScriptParserKW_CLEAR)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -5964,29 +6216,29 @@ func (p *NeuroScriptParser) ClearEventStmt() (localctx IClearEventStmtContext) {
 		}
 	}
 	{
-		p.SetState(363)
+		p.SetState(395)
 		p.Match(NeuroScriptParserKW_EVENT)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(367)
+	p.SetState(399)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
 	switch p.GetTokenStream().LA(1) {
-	case NeuroScriptParserKW_ACOS, NeuroScriptParserKW_ASIN, NeuroScriptParserKW_ATAN, NeuroScriptParserKW_COS, NeuroScriptParserKW_EVAL, NeuroScriptParserKW_FALSE, NeuroScriptParserKW_LAST, NeuroScriptParserKW_LEN, NeuroScriptParserKW_LN, NeuroScriptParserKW_LOG, NeuroScriptParserKW_NIL, NeuroScriptParserKW_NO, NeuroScriptParserKW_NOT, NeuroScriptParserKW_SIN, NeuroScriptParserKW_SOME, NeuroScriptParserKW_TAN, NeuroScriptParserKW_TOOL, NeuroScriptParserKW_TRUE, NeuroScriptParserKW_TYPEOF, NeuroScriptParserSTRING_LIT, NeuroScriptParserTRIPLE_BACKTICK_STRING, NeuroScriptParserTRIPLE_SQ_STRING, NeuroScriptParserDOUBLE_BRACKET_STRING, NeuroScriptParserNUMBER_LIT, NeuroScriptParserIDENTIFIER, NeuroScriptParserMINUS, NeuroScriptParserTILDE, NeuroScriptParserLPAREN, NeuroScriptParserLBRACK, NeuroScriptParserLBRACE, NeuroScriptParserPLACEHOLDER_START:
+	case NeuroScriptParserKW_ACOS, NeuroScriptParserKW_ASIN, NeuroScriptParserKW_ATAN, NeuroScriptParserKW_COS, NeuroScriptParserKW_EVAL, NeuroScriptParserKW_FALSE, NeuroScriptParserKW_FUNC, NeuroScriptParserKW_LAST, NeuroScriptParserKW_LEN, NeuroScriptParserKW_LN, NeuroScriptParserKW_LOG, NeuroScriptParserKW_NIL, NeuroScriptParserKW_NO, NeuroScriptParserKW_NOT, NeuroScriptParserKW_SIN, NeuroScriptParserKW_SOME, NeuroScriptParserKW_TAN, NeuroScriptParserKW_TOOL, NeuroScriptParserKW_TRUE, NeuroScriptParserKW_TYPEOF, NeuroScriptParserSTRING_LIT, NeuroScriptParserTRIPLE_BACKTICK_STRING, NeuroScriptParserTRIPLE_SQ_STRING, NeuroScriptParserDOUBLE_BRACKET_STRING, NeuroScriptParserNUMBER_LIT, NeuroScriptParserIDENTIFIER, NeuroScriptParserMINUS, NeuroScriptParserTILDE, NeuroScriptParserLPAREN, NeuroScriptParserLBRACK, NeuroScriptParserLBRACE, NeuroScriptParserPLACEHOLDER_START:
 		{
-			p.SetState(364)
+			p.SetState(396)
 			p.Expression()
 		}
 
 	case NeuroScriptParserKW_NAMED:
 		{
-			p.SetState(365)
+			p.SetState(397)
 			p.Match(NeuroScriptParserKW_NAMED)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -5994,7 +6246,7 @@ func (p *NeuroScriptParser) ClearEventStmt() (localctx IClearEventStmtContext) {
 			}
 		}
 		{
-			p.SetState(366)
+			p.SetState(398)
 			p.Match(NeuroScriptParserSTRING_LIT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6185,14 +6437,14 @@ func (p *NeuroScriptParser) Lvalue() (localctx ILvalueContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(369)
+		p.SetState(401)
 		p.Match(NeuroScriptParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(378)
+	p.SetState(410)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -6200,7 +6452,7 @@ func (p *NeuroScriptParser) Lvalue() (localctx ILvalueContext) {
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == NeuroScriptParserLBRACK || _la == NeuroScriptParserDOT {
-		p.SetState(376)
+		p.SetState(408)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -6209,7 +6461,7 @@ func (p *NeuroScriptParser) Lvalue() (localctx ILvalueContext) {
 		switch p.GetTokenStream().LA(1) {
 		case NeuroScriptParserLBRACK:
 			{
-				p.SetState(370)
+				p.SetState(402)
 				p.Match(NeuroScriptParserLBRACK)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -6217,11 +6469,11 @@ func (p *NeuroScriptParser) Lvalue() (localctx ILvalueContext) {
 				}
 			}
 			{
-				p.SetState(371)
+				p.SetState(403)
 				p.Expression()
 			}
 			{
-				p.SetState(372)
+				p.SetState(404)
 				p.Match(NeuroScriptParserRBRACK)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -6231,7 +6483,7 @@ func (p *NeuroScriptParser) Lvalue() (localctx ILvalueContext) {
 
 		case NeuroScriptParserDOT:
 			{
-				p.SetState(374)
+				p.SetState(406)
 				p.Match(NeuroScriptParserDOT)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -6239,7 +6491,7 @@ func (p *NeuroScriptParser) Lvalue() (localctx ILvalueContext) {
 				}
 			}
 			{
-				p.SetState(375)
+				p.SetState(407)
 				p.Match(NeuroScriptParserIDENTIFIER)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -6252,7 +6504,7 @@ func (p *NeuroScriptParser) Lvalue() (localctx ILvalueContext) {
 			goto errorExit
 		}
 
-		p.SetState(380)
+		p.SetState(412)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -6408,10 +6660,10 @@ func (p *NeuroScriptParser) Lvalue_list() (localctx ILvalue_listContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(381)
+		p.SetState(413)
 		p.Lvalue()
 	}
-	p.SetState(386)
+	p.SetState(418)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -6420,7 +6672,7 @@ func (p *NeuroScriptParser) Lvalue_list() (localctx ILvalue_listContext) {
 
 	for _la == NeuroScriptParserCOMMA {
 		{
-			p.SetState(382)
+			p.SetState(414)
 			p.Match(NeuroScriptParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6428,11 +6680,11 @@ func (p *NeuroScriptParser) Lvalue_list() (localctx ILvalue_listContext) {
 			}
 		}
 		{
-			p.SetState(383)
+			p.SetState(415)
 			p.Lvalue()
 		}
 
-		p.SetState(388)
+		p.SetState(420)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -6577,7 +6829,7 @@ func (p *NeuroScriptParser) Set_statement() (localctx ISet_statementContext) {
 	p.EnterRule(localctx, 60, NeuroScriptParserRULE_set_statement)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(389)
+		p.SetState(421)
 		p.Match(NeuroScriptParserKW_SET)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6585,11 +6837,11 @@ func (p *NeuroScriptParser) Set_statement() (localctx ISet_statementContext) {
 		}
 	}
 	{
-		p.SetState(390)
+		p.SetState(422)
 		p.Lvalue_list()
 	}
 	{
-		p.SetState(391)
+		p.SetState(423)
 		p.Match(NeuroScriptParserASSIGN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6597,7 +6849,7 @@ func (p *NeuroScriptParser) Set_statement() (localctx ISet_statementContext) {
 		}
 	}
 	{
-		p.SetState(392)
+		p.SetState(424)
 		p.Expression()
 	}
 
@@ -6716,7 +6968,7 @@ func (p *NeuroScriptParser) Call_statement() (localctx ICall_statementContext) {
 	p.EnterRule(localctx, 62, NeuroScriptParserRULE_call_statement)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(394)
+		p.SetState(426)
 		p.Match(NeuroScriptParserKW_CALL)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6724,7 +6976,7 @@ func (p *NeuroScriptParser) Call_statement() (localctx ICall_statementContext) {
 		}
 	}
 	{
-		p.SetState(395)
+		p.SetState(427)
 		p.Callable_expr()
 	}
 
@@ -6845,23 +7097,23 @@ func (p *NeuroScriptParser) Return_statement() (localctx IReturn_statementContex
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(397)
+		p.SetState(429)
 		p.Match(NeuroScriptParserKW_RETURN)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(399)
+	p.SetState(431)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&4287674167257481380) != 0) || ((int64((_la-66)) & ^0x3f) == 0 && ((int64(1)<<(_la-66))&145097327) != 0) {
+	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4595857292267552604) != 0) || ((int64((_la-65)) & ^0x3f) == 0 && ((int64(1)<<(_la-65))&297159325741) != 0) {
 		{
-			p.SetState(398)
+			p.SetState(430)
 			p.Expression_list()
 		}
 
@@ -6982,7 +7234,7 @@ func (p *NeuroScriptParser) Emit_statement() (localctx IEmit_statementContext) {
 	p.EnterRule(localctx, 66, NeuroScriptParserRULE_emit_statement)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(401)
+		p.SetState(433)
 		p.Match(NeuroScriptParserKW_EMIT)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6990,7 +7242,7 @@ func (p *NeuroScriptParser) Emit_statement() (localctx IEmit_statementContext) {
 		}
 	}
 	{
-		p.SetState(402)
+		p.SetState(434)
 		p.Expression()
 	}
 
@@ -7140,7 +7392,7 @@ func (p *NeuroScriptParser) Whisper_stmt() (localctx IWhisper_stmtContext) {
 	p.EnterRule(localctx, 68, NeuroScriptParserRULE_whisper_stmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(404)
+		p.SetState(436)
 		p.Match(NeuroScriptParserKW_WHISPER)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -7148,11 +7400,11 @@ func (p *NeuroScriptParser) Whisper_stmt() (localctx IWhisper_stmtContext) {
 		}
 	}
 	{
-		p.SetState(405)
+		p.SetState(437)
 		p.Expression()
 	}
 	{
-		p.SetState(406)
+		p.SetState(438)
 		p.Match(NeuroScriptParserCOMMA)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -7160,7 +7412,7 @@ func (p *NeuroScriptParser) Whisper_stmt() (localctx IWhisper_stmtContext) {
 		}
 	}
 	{
-		p.SetState(407)
+		p.SetState(439)
 		p.Expression()
 	}
 
@@ -7279,7 +7531,7 @@ func (p *NeuroScriptParser) Must_statement() (localctx IMust_statementContext) {
 	p.EnterRule(localctx, 70, NeuroScriptParserRULE_must_statement)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(409)
+		p.SetState(441)
 		p.Match(NeuroScriptParserKW_MUST)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -7287,7 +7539,7 @@ func (p *NeuroScriptParser) Must_statement() (localctx IMust_statementContext) {
 		}
 	}
 	{
-		p.SetState(410)
+		p.SetState(442)
 		p.Expression()
 	}
 
@@ -7408,23 +7660,23 @@ func (p *NeuroScriptParser) Fail_statement() (localctx IFail_statementContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(412)
+		p.SetState(444)
 		p.Match(NeuroScriptParserKW_FAIL)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(414)
+	p.SetState(446)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&4287674167257481380) != 0) || ((int64((_la-66)) & ^0x3f) == 0 && ((int64(1)<<(_la-66))&145097327) != 0) {
+	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4595857292267552604) != 0) || ((int64((_la-65)) & ^0x3f) == 0 && ((int64(1)<<(_la-65))&297159325741) != 0) {
 		{
-			p.SetState(413)
+			p.SetState(445)
 			p.Expression()
 		}
 
@@ -7528,7 +7780,7 @@ func (p *NeuroScriptParser) ClearErrorStmt() (localctx IClearErrorStmtContext) {
 	p.EnterRule(localctx, 74, NeuroScriptParserRULE_clearErrorStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(416)
+		p.SetState(448)
 		p.Match(NeuroScriptParserKW_CLEAR_ERROR)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -7711,7 +7963,7 @@ func (p *NeuroScriptParser) Ask_stmt() (localctx IAsk_stmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(418)
+		p.SetState(450)
 		p.Match(NeuroScriptParserKW_ASK)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -7719,11 +7971,11 @@ func (p *NeuroScriptParser) Ask_stmt() (localctx IAsk_stmtContext) {
 		}
 	}
 	{
-		p.SetState(419)
+		p.SetState(451)
 		p.Expression()
 	}
 	{
-		p.SetState(420)
+		p.SetState(452)
 		p.Match(NeuroScriptParserCOMMA)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -7731,10 +7983,10 @@ func (p *NeuroScriptParser) Ask_stmt() (localctx IAsk_stmtContext) {
 		}
 	}
 	{
-		p.SetState(421)
+		p.SetState(453)
 		p.Expression()
 	}
-	p.SetState(424)
+	p.SetState(456)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -7743,7 +7995,7 @@ func (p *NeuroScriptParser) Ask_stmt() (localctx IAsk_stmtContext) {
 
 	if _la == NeuroScriptParserKW_WITH {
 		{
-			p.SetState(422)
+			p.SetState(454)
 			p.Match(NeuroScriptParserKW_WITH)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -7751,12 +8003,12 @@ func (p *NeuroScriptParser) Ask_stmt() (localctx IAsk_stmtContext) {
 			}
 		}
 		{
-			p.SetState(423)
+			p.SetState(455)
 			p.Expression()
 		}
 
 	}
-	p.SetState(428)
+	p.SetState(460)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -7765,7 +8017,7 @@ func (p *NeuroScriptParser) Ask_stmt() (localctx IAsk_stmtContext) {
 
 	if _la == NeuroScriptParserKW_INTO {
 		{
-			p.SetState(426)
+			p.SetState(458)
 			p.Match(NeuroScriptParserKW_INTO)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -7773,7 +8025,7 @@ func (p *NeuroScriptParser) Ask_stmt() (localctx IAsk_stmtContext) {
 			}
 		}
 		{
-			p.SetState(427)
+			p.SetState(459)
 			p.Lvalue()
 		}
 
@@ -7916,7 +8168,7 @@ func (p *NeuroScriptParser) Promptuser_stmt() (localctx IPromptuser_stmtContext)
 	p.EnterRule(localctx, 78, NeuroScriptParserRULE_promptuser_stmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(430)
+		p.SetState(462)
 		p.Match(NeuroScriptParserKW_PROMPTUSER)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -7924,11 +8176,11 @@ func (p *NeuroScriptParser) Promptuser_stmt() (localctx IPromptuser_stmtContext)
 		}
 	}
 	{
-		p.SetState(431)
+		p.SetState(463)
 		p.Expression()
 	}
 	{
-		p.SetState(432)
+		p.SetState(464)
 		p.Match(NeuroScriptParserKW_INTO)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -7936,7 +8188,7 @@ func (p *NeuroScriptParser) Promptuser_stmt() (localctx IPromptuser_stmtContext)
 		}
 	}
 	{
-		p.SetState(433)
+		p.SetState(465)
 		p.Lvalue()
 	}
 
@@ -8038,7 +8290,7 @@ func (p *NeuroScriptParser) Break_statement() (localctx IBreak_statementContext)
 	p.EnterRule(localctx, 80, NeuroScriptParserRULE_break_statement)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(435)
+		p.SetState(467)
 		p.Match(NeuroScriptParserKW_BREAK)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -8144,7 +8396,7 @@ func (p *NeuroScriptParser) Continue_statement() (localctx IContinue_statementCo
 	p.EnterRule(localctx, 82, NeuroScriptParserRULE_continue_statement)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(437)
+		p.SetState(469)
 		p.Match(NeuroScriptParserKW_CONTINUE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -8175,12 +8427,10 @@ type IIf_statementContext interface {
 	// Getter signatures
 	KW_IF() antlr.TerminalNode
 	Expression() IExpressionContext
-	AllNEWLINE() []antlr.TerminalNode
-	NEWLINE(i int) antlr.TerminalNode
-	AllNon_empty_statement_list() []INon_empty_statement_listContext
-	Non_empty_statement_list(i int) INon_empty_statement_listContext
+	NEWLINE() antlr.TerminalNode
+	Non_empty_statement_list() INon_empty_statement_listContext
 	KW_ENDIF() antlr.TerminalNode
-	KW_ELSE() antlr.TerminalNode
+	Else_clause() IElse_clauseContext
 
 	// IsIf_statementContext differentiates from other interfaces.
 	IsIf_statementContext()
@@ -8238,45 +8488,36 @@ func (s *If_statementContext) Expression() IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *If_statementContext) AllNEWLINE() []antlr.TerminalNode {
-	return s.GetTokens(NeuroScriptParserNEWLINE)
-}
-
-func (s *If_statementContext) NEWLINE(i int) antlr.TerminalNode {
-	return s.GetToken(NeuroScriptParserNEWLINE, i)
+func (s *If_statementContext) NEWLINE() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserNEWLINE, 0)
 }
 
-func (s *If_statementContext) AllNon_empty_statement_list() []INon_empty_statement_listContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
+func (s *If_statementContext) Non_empty_statement_list() INon_empty_statement_listContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(INon_empty_statement_listContext); ok {
-			len++
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-	tst := make([]INon_empty_statement_listContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(INon_empty_statement_listContext); ok {
-			tst[i] = t.(INon_empty_statement_listContext)
-			i++
-		}
+	if t == nil {
+		return nil
 	}
 
-	return tst
+	return t.(INon_empty_statement_listContext)
+}
+
+func (s *If_statementContext) KW_ENDIF() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_ENDIF, 0)
 }
 
-func (s *If_statementContext) Non_empty_statement_list(i int) INon_empty_statement_listContext {
+func (s *If_statementContext) Else_clause() IElse_clauseContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(INon_empty_statement_listContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(IElse_clauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -8284,15 +8525,7 @@ func (s *If_statementContext) Non_empty_statement_list(i int) INon_empty_stateme
 		return nil
 	}
 
-	return t.(INon_empty_statement_listContext)
-}
-
-func (s *If_statementContext) KW_ENDIF() antlr.TerminalNode {
-	return s.GetToken(NeuroScriptParserKW_ENDIF, 0)
-}
-
-func (s *If_statementContext) KW_ELSE() antlr.TerminalNode {
-	return s.GetToken(NeuroScriptParserKW_ELSE, 0)
+	return t.(IElse_clauseContext)
 }
 
 func (s *If_statementContext) GetRuleContext() antlr.RuleContext {
@@ -8332,7 +8565,7 @@ func (p *NeuroScriptParser) If_statement() (localctx IIf_statementContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(439)
+		p.SetState(471)
 		p.Match(NeuroScriptParserKW_IF)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -8340,11 +8573,11 @@ func (p *NeuroScriptParser) If_statement() (localctx IIf_statementContext) {
 		}
 	}
 	{
-		p.SetState(440)
+		p.SetState(472)
 		p.Expression()
 	}
 	{
-		p.SetState(441)
+		p.SetState(473)
 		p.Match(NeuroScriptParserNEWLINE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -8352,10 +8585,10 @@ func (p *NeuroScriptParser) If_statement() (localctx IIf_statementContext) {
 		}
 	}
 	{
-		p.SetState(442)
+		p.SetState(474)
 		p.Non_empty_statement_list()
 	}
-	p.SetState(446)
+	p.SetState(476)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -8364,29 +8597,13 @@ func (p *NeuroScriptParser) If_statement() (localctx IIf_statementContext) {
 
 	if _la == NeuroScriptParserKW_ELSE {
 		{
-			p.SetState(443)
-			p.Match(NeuroScriptParserKW_ELSE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(444)
-			p.Match(NeuroScriptParserNEWLINE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(445)
-			p.Non_empty_statement_list()
+			p.SetState(475)
+			p.Else_clause()
 		}
 
 	}
 	{
-		p.SetState(448)
+		p.SetState(478)
 		p.Match(NeuroScriptParserKW_ENDIF)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -8536,7 +8753,7 @@ func (p *NeuroScriptParser) While_statement() (localctx IWhile_statementContext)
 	p.EnterRule(localctx, 86, NeuroScriptParserRULE_while_statement)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(450)
+		p.SetState(480)
 		p.Match(NeuroScriptParserKW_WHILE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -8544,11 +8761,11 @@ func (p *NeuroScriptParser) While_statement() (localctx IWhile_statementContext)
 		}
 	}
 	{
-		p.SetState(451)
+		p.SetState(481)
 		p.Expression()
 	}
 	{
-		p.SetState(452)
+		p.SetState(482)
 		p.Match(NeuroScriptParserNEWLINE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -8556,11 +8773,11 @@ func (p *NeuroScriptParser) While_statement() (localctx IWhile_statementContext)
 		}
 	}
 	{
-		p.SetState(453)
+		p.SetState(483)
 		p.Non_empty_statement_list()
 	}
 	{
-		p.SetState(454)
+		p.SetState(484)
 		p.Match(NeuroScriptParserKW_ENDWHILE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -8725,7 +8942,7 @@ func (p *NeuroScriptParser) For_each_statement() (localctx IFor_each_statementCo
 	p.EnterRule(localctx, 88, NeuroScriptParserRULE_for_each_statement)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(456)
+		p.SetState(486)
 		p.Match(NeuroScriptParserKW_FOR)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -8733,7 +8950,7 @@ func (p *NeuroScriptParser) For_each_statement() (localctx IFor_each_statementCo
 		}
 	}
 	{
-		p.SetState(457)
+		p.SetState(487)
 		p.Match(NeuroScriptParserKW_EACH)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -8741,7 +8958,7 @@ func (p *NeuroScriptParser) For_each_statement() (localctx IFor_each_statementCo
 		}
 	}
 	{
-		p.SetState(458)
+		p.SetState(488)
 		p.Match(NeuroScriptParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -8749,7 +8966,7 @@ func (p *NeuroScriptParser) For_each_statement() (localctx IFor_each_statementCo
 		}
 	}
 	{
-		p.SetState(459)
+		p.SetState(489)
 		p.Match(NeuroScriptParserKW_IN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -8757,11 +8974,11 @@ func (p *NeuroScriptParser) For_each_statement() (localctx IFor_each_statementCo
 		}
 	}
 	{
-		p.SetState(460)
+		p.SetState(490)
 		p.Expression()
 	}
 	{
-		p.SetState(461)
+		p.SetState(491)
 		p.Match(NeuroScriptParserNEWLINE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -8769,11 +8986,11 @@ func (p *NeuroScriptParser) For_each_statement() (localctx IFor_each_statementCo
 		}
 	}
 	{
-		p.SetState(462)
+		p.SetState(492)
 		p.Non_empty_statement_list()
 	}
 	{
-		p.SetState(463)
+		p.SetState(493)
 		p.Match(NeuroScriptParserKW_ENDFOR)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -8896,14 +9113,14 @@ func (p *NeuroScriptParser) Qualified_identifier() (localctx IQualified_identifi
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(465)
+		p.SetState(495)
 		p.Match(NeuroScriptParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(470)
+	p.SetState(500)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -8912,7 +9129,7 @@ func (p *NeuroScriptParser) Qualified_identifier() (localctx IQualified_identifi
 
 	for _la == NeuroScriptParserDOT {
 		{
-			p.SetState(466)
+			p.SetState(496)
 			p.Match(NeuroScriptParserDOT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -8920,7 +9137,7 @@ func (p *NeuroScriptParser) Qualified_identifier() (localctx IQualified_identifi
 			}
 		}
 		{
-			p.SetState(467)
+			p.SetState(497)
 			p.Match(NeuroScriptParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -8928,7 +9145,7 @@ func (p *NeuroScriptParser) Qualified_identifier() (localctx IQualified_identifi
 			}
 		}
 
-		p.SetState(472)
+		p.SetState(502)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -9059,7 +9276,7 @@ func (s *Call_targetContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 func (p *NeuroScriptParser) Call_target() (localctx ICall_targetContext) {
 	localctx = NewCall_targetContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 92, NeuroScriptParserRULE_call_target)
-	p.SetState(477)
+	p.SetState(507)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -9069,7 +9286,7 @@ func (p *NeuroScriptParser) Call_target() (localctx ICall_targetContext) {
 	case NeuroScriptParserIDENTIFIER:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(473)
+			p.SetState(503)
 			p.Match(NeuroScriptParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -9080,7 +9297,7 @@ func (p *NeuroScriptParser) Call_target() (localctx ICall_targetContext) {
 	case NeuroScriptParserKW_TOOL:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(474)
+			p.SetState(504)
 			p.Match(NeuroScriptParserKW_TOOL)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -9088,7 +9305,7 @@ func (p *NeuroScriptParser) Call_target() (localctx ICall_targetContext) {
 			}
 		}
 		{
-			p.SetState(475)
+			p.SetState(505)
 			p.Match(NeuroScriptParserDOT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -9096,7 +9313,7 @@ func (p *NeuroScriptParser) Call_target() (localctx ICall_targetContext) {
 			}
 		}
 		{
-			p.SetState(476)
+			p.SetState(506)
 			p.Qualified_identifier()
 		}
 
@@ -9215,7 +9432,7 @@ func (p *NeuroScriptParser) Expression() (localctx IExpressionContext) {
 	p.EnterRule(localctx, 94, NeuroScriptParserRULE_expression)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(479)
+		p.SetState(509)
 		p.Logical_or_expr()
 	}
 
@@ -9367,10 +9584,10 @@ func (p *NeuroScriptParser) Logical_or_expr() (localctx ILogical_or_exprContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(481)
+		p.SetState(511)
 		p.Logical_and_expr()
 	}
-	p.SetState(486)
+	p.SetState(516)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -9379,7 +9596,7 @@ func (p *NeuroScriptParser) Logical_or_expr() (localctx ILogical_or_exprContext)
 
 	for _la == NeuroScriptParserKW_OR {
 		{
-			p.SetState(482)
+			p.SetState(512)
 			p.Match(NeuroScriptParserKW_OR)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -9387,11 +9604,11 @@ func (p *NeuroScriptParser) Logical_or_expr() (localctx ILogical_or_exprContext)
 			}
 		}
 		{
-			p.SetState(483)
+			p.SetState(513)
 			p.Logical_and_expr()
 		}
 
-		p.SetState(488)
+		p.SetState(518)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -9547,10 +9764,10 @@ func (p *NeuroScriptParser) Logical_and_expr() (localctx ILogical_and_exprContex
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(489)
+		p.SetState(519)
 		p.Bitwise_or_expr()
 	}
-	p.SetState(494)
+	p.SetState(524)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -9559,7 +9776,7 @@ func (p *NeuroScriptParser) Logical_and_expr() (localctx ILogical_and_exprContex
 
 	for _la == NeuroScriptParserKW_AND {
 		{
-			p.SetState(490)
+			p.SetState(520)
 			p.Match(NeuroScriptParserKW_AND)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -9567,11 +9784,11 @@ func (p *NeuroScriptParser) Logical_and_expr() (localctx ILogical_and_exprContex
 			}
 		}
 		{
-			p.SetState(491)
+			p.SetState(521)
 			p.Bitwise_or_expr()
 		}
 
-		p.SetState(496)
+		p.SetState(526)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -9727,10 +9944,10 @@ func (p *NeuroScriptParser) Bitwise_or_expr() (localctx IBitwise_or_exprContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(497)
+		p.SetState(527)
 		p.Bitwise_xor_expr()
 	}
-	p.SetState(502)
+	p.SetState(532)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -9739,7 +9956,7 @@ func (p *NeuroScriptParser) Bitwise_or_expr() (localctx IBitwise_or_exprContext)
 
 	for _la == NeuroScriptParserPIPE {
 		{
-			p.SetState(498)
+			p.SetState(528)
 			p.Match(NeuroScriptParserPIPE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -9747,11 +9964,11 @@ func (p *NeuroScriptParser) Bitwise_or_expr() (localctx IBitwise_or_exprContext)
 			}
 		}
 		{
-			p.SetState(499)
+			p.SetState(529)
 			p.Bitwise_xor_expr()
 		}
 
-		p.SetState(504)
+		p.SetState(534)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -9907,10 +10124,10 @@ func (p *NeuroScriptParser) Bitwise_xor_expr() (localctx IBitwise_xor_exprContex
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(505)
+		p.SetState(535)
 		p.Bitwise_and_expr()
 	}
-	p.SetState(510)
+	p.SetState(540)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -9919,7 +10136,7 @@ func (p *NeuroScriptParser) Bitwise_xor_expr() (localctx IBitwise_xor_exprContex
 
 	for _la == NeuroScriptParserCARET {
 		{
-			p.SetState(506)
+			p.SetState(536)
 			p.Match(NeuroScriptParserCARET)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -9927,11 +10144,11 @@ func (p *NeuroScriptParser) Bitwise_xor_expr() (localctx IBitwise_xor_exprContex
 			}
 		}
 		{
-			p.SetState(507)
+			p.SetState(537)
 			p.Bitwise_and_expr()
 		}
 
-		p.SetState(512)
+		p.SetState(542)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -10087,10 +10304,10 @@ func (p *NeuroScriptParser) Bitwise_and_expr() (localctx IBitwise_and_exprContex
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(513)
+		p.SetState(543)
 		p.Equality_expr()
 	}
-	p.SetState(518)
+	p.SetState(548)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -10099,7 +10316,7 @@ func (p *NeuroScriptParser) Bitwise_and_expr() (localctx IBitwise_and_exprContex
 
 	for _la == NeuroScriptParserAMPERSAND {
 		{
-			p.SetState(514)
+			p.SetState(544)
 			p.Match(NeuroScriptParserAMPERSAND)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -10107,11 +10324,11 @@ func (p *NeuroScriptParser) Bitwise_and_expr() (localctx IBitwise_and_exprContex
 			}
 		}
 		{
-			p.SetState(515)
+			p.SetState(545)
 			p.Equality_expr()
 		}
 
-		p.SetState(520)
+		p.SetState(550)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -10277,10 +10494,10 @@ func (p *NeuroScriptParser) Equality_expr() (localctx IEquality_exprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(521)
+		p.SetState(551)
 		p.Relational_expr()
 	}
-	p.SetState(526)
+	p.SetState(556)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -10289,7 +10506,7 @@ func (p *NeuroScriptParser) Equality_expr() (localctx IEquality_exprContext) {
 
 	for _la == NeuroScriptParserEQ || _la == NeuroScriptParserNEQ {
 		{
-			p.SetState(522)
+			p.SetState(552)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == NeuroScriptParserEQ || _la == NeuroScriptParserNEQ) {
@@ -10300,11 +10517,11 @@ func (p *NeuroScriptParser) Equality_expr() (localctx IEquality_exprContext) {
 			}
 		}
 		{
-			p.SetState(523)
+			p.SetState(553)
 			p.Relational_expr()
 		}
 
-		p.SetState(528)
+		p.SetState(558)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -10490,22 +10707,22 @@ func (p *NeuroScriptParser) Relational_expr() (localctx IRelational_exprContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(529)
+		p.SetState(559)
 		p.Additive_expr()
 	}
-	p.SetState(534)
+	p.SetState(564)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for (int64((_la-96)) & ^0x3f) == 0 && ((int64(1)<<(_la-96))&15) != 0 {
+	for (int64((_la-106)) & ^0x3f) == 0 && ((int64(1)<<(_la-106))&15) != 0 {
 		{
-			p.SetState(530)
+			p.SetState(560)
 			_la = p.GetTokenStream().LA(1)
 
-			if !((int64((_la-96)) & ^0x3f) == 0 && ((int64(1)<<(_la-96))&15) != 0) {
+			if !((int64((_la-106)) & ^0x3f) == 0 && ((int64(1)<<(_la-106))&15) != 0) {
 				p.GetErrorHandler().RecoverInline(p)
 			} else {
 				p.GetErrorHandler().ReportMatch(p)
@@ -10513,11 +10730,11 @@ func (p *NeuroScriptParser) Relational_expr() (localctx IRelational_exprContext)
 			}
 		}
 		{
-			p.SetState(531)
+			p.SetState(561)
 			p.Additive_expr()
 		}
 
-		p.SetState(536)
+		p.SetState(566)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -10683,10 +10900,10 @@ func (p *NeuroScriptParser) Additive_expr() (localctx IAdditive_exprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(537)
+		p.SetState(567)
 		p.Multiplicative_expr()
 	}
-	p.SetState(542)
+	p.SetState(572)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -10695,7 +10912,7 @@ func (p *NeuroScriptParser) Additive_expr() (localctx IAdditive_exprContext) {
 
 	for _la == NeuroScriptParserPLUS || _la == NeuroScriptParserMINUS {
 		{
-			p.SetState(538)
+			p.SetState(568)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == NeuroScriptParserPLUS || _la == NeuroScriptParserMINUS) {
@@ -10706,11 +10923,11 @@ func (p *NeuroScriptParser) Additive_expr() (localctx IAdditive_exprContext) {
 			}
 		}
 		{
-			p.SetState(539)
+			p.SetState(569)
 			p.Multiplicative_expr()
 		}
 
-		p.SetState(544)
+		p.SetState(574)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -10886,22 +11103,22 @@ func (p *NeuroScriptParser) Multiplicative_expr() (localctx IMultiplicative_expr
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(545)
+		p.SetState(575)
 		p.Unary_expr()
 	}
-	p.SetState(550)
+	p.SetState(580)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for (int64((_la-76)) & ^0x3f) == 0 && ((int64(1)<<(_la-76))&7) != 0 {
+	for (int64((_la-86)) & ^0x3f) == 0 && ((int64(1)<<(_la-86))&7) != 0 {
 		{
-			p.SetState(546)
+			p.SetState(576)
 			_la = p.GetTokenStream().LA(1)
 
-			if !((int64((_la-76)) & ^0x3f) == 0 && ((int64(1)<<(_la-76))&7) != 0) {
+			if !((int64((_la-86)) & ^0x3f) == 0 && ((int64(1)<<(_la-86))&7) != 0) {
 				p.GetErrorHandler().RecoverInline(p)
 			} else {
 				p.GetErrorHandler().ReportMatch(p)
@@ -10909,11 +11126,11 @@ func (p *NeuroScriptParser) Multiplicative_expr() (localctx IMultiplicative_expr
 			}
 		}
 		{
-			p.SetState(547)
+			p.SetState(577)
 			p.Unary_expr()
 		}
 
-		p.SetState(552)
+		p.SetState(582)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -11078,7 +11295,7 @@ func (p *NeuroScriptParser) Unary_expr() (localctx IUnary_exprContext) {
 	p.EnterRule(localctx, 114, NeuroScriptParserRULE_unary_expr)
 	var _la int
 
-	p.SetState(558)
+	p.SetState(588)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -11088,10 +11305,10 @@ func (p *NeuroScriptParser) Unary_expr() (localctx IUnary_exprContext) {
 	case NeuroScriptParserKW_NO, NeuroScriptParserKW_NOT, NeuroScriptParserKW_SOME, NeuroScriptParserMINUS, NeuroScriptParserTILDE:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(553)
+			p.SetState(583)
 			_la = p.GetTokenStream().LA(1)
 
-			if !((int64((_la-46)) & ^0x3f) == 0 && ((int64(1)<<(_la-46))&137975825411) != 0) {
+			if !((int64((_la-52)) & ^0x3f) == 0 && ((int64(1)<<(_la-52))&2207613192195) != 0) {
 				p.GetErrorHandler().RecoverInline(p)
 			} else {
 				p.GetErrorHandler().ReportMatch(p)
@@ -11099,14 +11316,14 @@ func (p *NeuroScriptParser) Unary_expr() (localctx IUnary_exprContext) {
 			}
 		}
 		{
-			p.SetState(554)
+			p.SetState(584)
 			p.Unary_expr()
 		}
 
 	case NeuroScriptParserKW_TYPEOF:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(555)
+			p.SetState(585)
 			p.Match(NeuroScriptParserKW_TYPEOF)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11114,14 +11331,14 @@ func (p *NeuroScriptParser) Unary_expr() (localctx IUnary_exprContext) {
 			}
 		}
 		{
-			p.SetState(556)
+			p.SetState(586)
 			p.Unary_expr()
 		}
 
-	case NeuroScriptParserKW_ACOS, NeuroScriptParserKW_ASIN, NeuroScriptParserKW_ATAN, NeuroScriptParserKW_COS, NeuroScriptParserKW_EVAL, NeuroScriptParserKW_FALSE, NeuroScriptParserKW_LAST, NeuroScriptParserKW_LEN, NeuroScriptParserKW_LN, NeuroScriptParserKW_LOG, NeuroScriptParserKW_NIL, NeuroScriptParserKW_SIN, NeuroScriptParserKW_TAN, NeuroScriptParserKW_TOOL, NeuroScriptParserKW_TRUE, NeuroScriptParserSTRING_LIT, NeuroScriptParserTRIPLE_BACKTICK_STRING, NeuroScriptParserTRIPLE_SQ_STRING, NeuroScriptParserDOUBLE_BRACKET_STRING, NeuroScriptParserNUMBER_LIT, NeuroScriptParserIDENTIFIER, NeuroScriptParserLPAREN, NeuroScriptParserLBRACK, NeuroScriptParserLBRACE, NeuroScriptParserPLACEHOLDER_START:
+	case NeuroScriptParserKW_ACOS, NeuroScriptParserKW_ASIN, NeuroScriptParserKW_ATAN, NeuroScriptParserKW_COS, NeuroScriptParserKW_EVAL, NeuroScriptParserKW_FALSE, NeuroScriptParserKW_FUNC, NeuroScriptParserKW_LAST, NeuroScriptParserKW_LEN, NeuroScriptParserKW_LN, NeuroScriptParserKW_LOG, NeuroScriptParserKW_NIL, NeuroScriptParserKW_SIN, NeuroScriptParserKW_TAN, NeuroScriptParserKW_TOOL, NeuroScriptParserKW_TRUE, NeuroScriptParserSTRING_LIT, NeuroScriptParserTRIPLE_BACKTICK_STRING, NeuroScriptParserTRIPLE_SQ_STRING, NeuroScriptParserDOUBLE_BRACKET_STRING, NeuroScriptParserNUMBER_LIT, NeuroScriptParserIDENTIFIER, NeuroScriptParserLPAREN, NeuroScriptParserLBRACK, NeuroScriptParserLBRACE, NeuroScriptParserPLACEHOLDER_START:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(557)
+			p.SetState(587)
 			p.Power_expr()
 		}
 
@@ -11264,10 +11481,10 @@ func (p *NeuroScriptParser) Power_expr() (localctx IPower_exprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(560)
+		p.SetState(590)
 		p.Accessor_expr()
 	}
-	p.SetState(563)
+	p.SetState(593)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -11276,7 +11493,7 @@ func (p *NeuroScriptParser) Power_expr() (localctx IPower_exprContext) {
 
 	if _la == NeuroScriptParserSTAR_STAR {
 		{
-			p.SetState(561)
+			p.SetState(591)
 			p.Match(NeuroScriptParserSTAR_STAR)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11284,7 +11501,7 @@ func (p *NeuroScriptParser) Power_expr() (localctx IPower_exprContext) {
 			}
 		}
 		{
-			p.SetState(562)
+			p.SetState(592)
 			p.Power_expr()
 		}
 
@@ -11314,10 +11531,14 @@ type IAccessor_exprContext interface {
 	Primary() IPrimaryContext
 	AllLBRACK() []antlr.TerminalNode
 	LBRACK(i int) antlr.TerminalNode
-	AllExpression() []IExpressionContext
-	Expression(i int) IExpressionContext
+	AllSubscript() []ISubscriptContext
+	Subscript(i int) ISubscriptContext
 	AllRBRACK() []antlr.TerminalNode
 	RBRACK(i int) antlr.TerminalNode
+	AllDOT() []antlr.TerminalNode
+	DOT(i int) antlr.TerminalNode
+	AllIDENTIFIER() []antlr.TerminalNode
+	IDENTIFIER(i int) antlr.TerminalNode
 
 	// IsAccessor_exprContext differentiates from other interfaces.
 	IsAccessor_exprContext()
@@ -11379,20 +11600,20 @@ func (s *Accessor_exprContext) LBRACK(i int) antlr.TerminalNode {
 	return s.GetToken(NeuroScriptParserLBRACK, i)
 }
 
-func (s *Accessor_exprContext) AllExpression() []IExpressionContext {
+func (s *Accessor_exprContext) AllSubscript() []ISubscriptContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IExpressionContext); ok {
+		if _, ok := ctx.(ISubscriptContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IExpressionContext, len)
+	tst := make([]ISubscriptContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IExpressionContext); ok {
-			tst[i] = t.(IExpressionContext)
+		if t, ok := ctx.(ISubscriptContext); ok {
+			tst[i] = t.(ISubscriptContext)
 			i++
 		}
 	}
@@ -11400,11 +11621,11 @@ func (s *Accessor_exprContext) AllExpression() []IExpressionContext {
 	return tst
 }
 
-func (s *Accessor_exprContext) Expression(i int) IExpressionContext {
+func (s *Accessor_exprContext) Subscript(i int) ISubscriptContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
+		if _, ok := ctx.(ISubscriptContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -11417,7 +11638,7 @@ func (s *Accessor_exprContext) Expression(i int) IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(ISubscriptContext)
 }
 
 func (s *Accessor_exprContext) AllRBRACK() []antlr.TerminalNode {
@@ -11428,6 +11649,22 @@ func (s *Accessor_exprContext) RBRACK(i int) antlr.TerminalNode {
 	return s.GetToken(NeuroScriptParserRBRACK, i)
 }
 
+func (s *Accessor_exprContext) AllDOT() []antlr.TerminalNode {
+	return s.GetTokens(NeuroScriptParserDOT)
+}
+
+func (s *Accessor_exprContext) DOT(i int) antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserDOT, i)
+}
+
+func (s *Accessor_exprContext) AllIDENTIFIER() []antlr.TerminalNode {
+	return s.GetTokens(NeuroScriptParserIDENTIFIER)
+}
+
+func (s *Accessor_exprContext) IDENTIFIER(i int) antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserIDENTIFIER, i)
+}
+
 func (s *Accessor_exprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -11465,39 +11702,70 @@ func (p *NeuroScriptParser) Accessor_expr() (localctx IAccessor_exprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(565)
+		p.SetState(595)
 		p.Primary()
 	}
-	p.SetState(572)
+	p.SetState(604)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == NeuroScriptParserLBRACK {
-		{
-			p.SetState(566)
-			p.Match(NeuroScriptParserLBRACK)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(567)
-			p.Expression()
+	for _la == NeuroScriptParserLBRACK || _la == NeuroScriptParserDOT {
+		p.SetState(602)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
 		}
-		{
-			p.SetState(568)
-			p.Match(NeuroScriptParserRBRACK)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
+
+		switch p.GetTokenStream().LA(1) {
+		case NeuroScriptParserLBRACK:
+			{
+				p.SetState(596)
+				p.Match(NeuroScriptParserLBRACK)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(597)
+				p.Subscript()
+			}
+			{
+				p.SetState(598)
+				p.Match(NeuroScriptParserRBRACK)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		case NeuroScriptParserDOT:
+			{
+				p.SetState(600)
+				p.Match(NeuroScriptParserDOT)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(601)
+				p.Match(NeuroScriptParserIDENTIFIER)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
 			}
+
+		default:
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			goto errorExit
 		}
 
-		p.SetState(574)
+		p.SetState(606)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -11536,6 +11804,7 @@ type IPrimaryContext interface {
 	Expression() IExpressionContext
 	RPAREN() antlr.TerminalNode
 
+	Lambda_expr() ILambda_exprContext
 	// IsPrimaryContext differentiates from other interfaces.
 	IsPrimaryContext()
 }
@@ -11656,6 +11925,22 @@ func (s *PrimaryContext) RPAREN() antlr.TerminalNode {
 	return s.GetToken(NeuroScriptParserRPAREN, 0)
 }
 
+func (s *PrimaryContext) Lambda_expr() ILambda_exprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILambda_exprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILambda_exprContext)
+}
+
 func (s *PrimaryContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -11689,31 +11974,31 @@ func (s *PrimaryContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 func (p *NeuroScriptParser) Primary() (localctx IPrimaryContext) {
 	localctx = NewPrimaryContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 120, NeuroScriptParserRULE_primary)
-	p.SetState(589)
+	p.SetState(621)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 52, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 53, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(575)
+			p.SetState(607)
 			p.Literal()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(576)
+			p.SetState(608)
 			p.Placeholder()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(577)
+			p.SetState(609)
 			p.Match(NeuroScriptParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11724,7 +12009,7 @@ func (p *NeuroScriptParser) Primary() (localctx IPrimaryContext) {
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(578)
+			p.SetState(610)
 			p.Match(NeuroScriptParserKW_LAST)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11735,14 +12020,14 @@ func (p *NeuroScriptParser) Primary() (localctx IPrimaryContext) {
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(579)
+			p.SetState(611)
 			p.Callable_expr()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(580)
+			p.SetState(612)
 			p.Match(NeuroScriptParserKW_EVAL)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11750,7 +12035,7 @@ func (p *NeuroScriptParser) Primary() (localctx IPrimaryContext) {
 			}
 		}
 		{
-			p.SetState(581)
+			p.SetState(613)
 			p.Match(NeuroScriptParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11758,11 +12043,11 @@ func (p *NeuroScriptParser) Primary() (localctx IPrimaryContext) {
 			}
 		}
 		{
-			p.SetState(582)
+			p.SetState(614)
 			p.Expression()
 		}
 		{
-			p.SetState(583)
+			p.SetState(615)
 			p.Match(NeuroScriptParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11773,7 +12058,7 @@ func (p *NeuroScriptParser) Primary() (localctx IPrimaryContext) {
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(585)
+			p.SetState(617)
 			p.Match(NeuroScriptParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11781,11 +12066,11 @@ func (p *NeuroScriptParser) Primary() (localctx IPrimaryContext) {
 			}
 		}
 		{
-			p.SetState(586)
+			p.SetState(618)
 			p.Expression()
 		}
 		{
-			p.SetState(587)
+			p.SetState(619)
 			p.Match(NeuroScriptParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11793,6 +12078,13 @@ func (p *NeuroScriptParser) Primary() (localctx IPrimaryContext) {
 			}
 		}
 
+	case 8:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(729)
+			p.Lambda_expr()
+		}
+
 	case antlr.ATNInvalidAltNumber:
 		goto errorExit
 	}
@@ -11819,7 +12111,7 @@ type ICallable_exprContext interface {
 
 	// Getter signatures
 	LPAREN() antlr.TerminalNode
-	Expression_list_opt() IExpression_list_optContext
+	Argument_list_opt() IArgument_list_optContext
 	RPAREN() antlr.TerminalNode
 	Call_target() ICall_targetContext
 	KW_LN() antlr.TerminalNode
@@ -11872,10 +12164,10 @@ func (s *Callable_exprContext) LPAREN() antlr.TerminalNode {
 	return s.GetToken(NeuroScriptParserLPAREN, 0)
 }
 
-func (s *Callable_exprContext) Expression_list_opt() IExpression_list_optContext {
+func (s *Callable_exprContext) Argument_list_opt() IArgument_list_optContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpression_list_optContext); ok {
+		if _, ok := ctx.(IArgument_list_optContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -11885,7 +12177,7 @@ func (s *Callable_exprContext) Expression_list_opt() IExpression_list_optContext
 		return nil
 	}
 
-	return t.(IExpression_list_optContext)
+	return t.(IArgument_list_optContext)
 }
 
 func (s *Callable_exprContext) RPAREN() antlr.TerminalNode {
@@ -11978,7 +12270,7 @@ func (p *NeuroScriptParser) Callable_expr() (localctx ICallable_exprContext) {
 	localctx = NewCallable_exprContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 122, NeuroScriptParserRULE_callable_expr)
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(601)
+	p.SetState(633)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -11987,13 +12279,13 @@ func (p *NeuroScriptParser) Callable_expr() (localctx ICallable_exprContext) {
 	switch p.GetTokenStream().LA(1) {
 	case NeuroScriptParserKW_TOOL, NeuroScriptParserIDENTIFIER:
 		{
-			p.SetState(591)
+			p.SetState(623)
 			p.Call_target()
 		}
 
 	case NeuroScriptParserKW_LN:
 		{
-			p.SetState(592)
+			p.SetState(624)
 			p.Match(NeuroScriptParserKW_LN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12003,7 +12295,7 @@ func (p *NeuroScriptParser) Callable_expr() (localctx ICallable_exprContext) {
 
 	case NeuroScriptParserKW_LOG:
 		{
-			p.SetState(593)
+			p.SetState(625)
 			p.Match(NeuroScriptParserKW_LOG)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12013,7 +12305,7 @@ func (p *NeuroScriptParser) Callable_expr() (localctx ICallable_exprContext) {
 
 	case NeuroScriptParserKW_SIN:
 		{
-			p.SetState(594)
+			p.SetState(626)
 			p.Match(NeuroScriptParserKW_SIN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12023,7 +12315,7 @@ func (p *NeuroScriptParser) Callable_expr() (localctx ICallable_exprContext) {
 
 	case NeuroScriptParserKW_COS:
 		{
-			p.SetState(595)
+			p.SetState(627)
 			p.Match(NeuroScriptParserKW_COS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12033,7 +12325,7 @@ func (p *NeuroScriptParser) Callable_expr() (localctx ICallable_exprContext) {
 
 	case NeuroScriptParserKW_TAN:
 		{
-			p.SetState(596)
+			p.SetState(628)
 			p.Match(NeuroScriptParserKW_TAN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12043,7 +12335,7 @@ func (p *NeuroScriptParser) Callable_expr() (localctx ICallable_exprContext) {
 
 	case NeuroScriptParserKW_ASIN:
 		{
-			p.SetState(597)
+			p.SetState(629)
 			p.Match(NeuroScriptParserKW_ASIN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12053,7 +12345,7 @@ func (p *NeuroScriptParser) Callable_expr() (localctx ICallable_exprContext) {
 
 	case NeuroScriptParserKW_ACOS:
 		{
-			p.SetState(598)
+			p.SetState(630)
 			p.Match(NeuroScriptParserKW_ACOS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12063,7 +12355,7 @@ func (p *NeuroScriptParser) Callable_expr() (localctx ICallable_exprContext) {
 
 	case NeuroScriptParserKW_ATAN:
 		{
-			p.SetState(599)
+			p.SetState(631)
 			p.Match(NeuroScriptParserKW_ATAN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12073,7 +12365,7 @@ func (p *NeuroScriptParser) Callable_expr() (localctx ICallable_exprContext) {
 
 	case NeuroScriptParserKW_LEN:
 		{
-			p.SetState(600)
+			p.SetState(632)
 			p.Match(NeuroScriptParserKW_LEN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12086,7 +12378,7 @@ func (p *NeuroScriptParser) Callable_expr() (localctx ICallable_exprContext) {
 		goto errorExit
 	}
 	{
-		p.SetState(603)
+		p.SetState(635)
 		p.Match(NeuroScriptParserLPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -12094,11 +12386,11 @@ func (p *NeuroScriptParser) Callable_expr() (localctx ICallable_exprContext) {
 		}
 	}
 	{
-		p.SetState(604)
-		p.Expression_list_opt()
+		p.SetState(636)
+		p.Argument_list_opt()
 	}
 	{
-		p.SetState(605)
+		p.SetState(637)
 		p.Match(NeuroScriptParserRPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -12231,14 +12523,14 @@ func (p *NeuroScriptParser) Placeholder() (localctx IPlaceholderContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(607)
+		p.SetState(639)
 		p.Match(NeuroScriptParserPLACEHOLDER_START)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(613)
+	p.SetState(645)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -12246,7 +12538,7 @@ func (p *NeuroScriptParser) Placeholder() (localctx IPlaceholderContext) {
 
 	switch p.GetTokenStream().LA(1) {
 	case NeuroScriptParserAT, NeuroScriptParserIDENTIFIER:
-		p.SetState(609)
+		p.SetState(641)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -12255,7 +12547,7 @@ func (p *NeuroScriptParser) Placeholder() (localctx IPlaceholderContext) {
 
 		if _la == NeuroScriptParserAT {
 			{
-				p.SetState(608)
+				p.SetState(640)
 				p.Match(NeuroScriptParserAT)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -12265,7 +12557,7 @@ func (p *NeuroScriptParser) Placeholder() (localctx IPlaceholderContext) {
 
 		}
 		{
-			p.SetState(611)
+			p.SetState(643)
 			p.Match(NeuroScriptParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12275,7 +12567,7 @@ func (p *NeuroScriptParser) Placeholder() (localctx IPlaceholderContext) {
 
 	case NeuroScriptParserKW_LAST:
 		{
-			p.SetState(612)
+			p.SetState(644)
 			p.Match(NeuroScriptParserKW_LAST)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12288,7 +12580,7 @@ func (p *NeuroScriptParser) Placeholder() (localctx IPlaceholderContext) {
 		goto errorExit
 	}
 	{
-		p.SetState(615)
+		p.SetState(647)
 		p.Match(NeuroScriptParserRBRACE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -12296,7 +12588,7 @@ func (p *NeuroScriptParser) Placeholder() (localctx IPlaceholderContext) {
 		}
 	}
 	{
-		p.SetState(616)
+		p.SetState(648)
 		p.Match(NeuroScriptParserRBRACE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -12488,7 +12780,7 @@ func (s *LiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 func (p *NeuroScriptParser) Literal() (localctx ILiteralContext) {
 	localctx = NewLiteralContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 126, NeuroScriptParserRULE_literal)
-	p.SetState(627)
+	p.SetState(659)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -12498,7 +12790,7 @@ func (p *NeuroScriptParser) Literal() (localctx ILiteralContext) {
 	case NeuroScriptParserSTRING_LIT:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(618)
+			p.SetState(650)
 			p.Match(NeuroScriptParserSTRING_LIT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12509,7 +12801,7 @@ func (p *NeuroScriptParser) Literal() (localctx ILiteralContext) {
 	case NeuroScriptParserTRIPLE_BACKTICK_STRING:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(619)
+			p.SetState(651)
 			p.Match(NeuroScriptParserTRIPLE_BACKTICK_STRING)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12520,7 +12812,7 @@ func (p *NeuroScriptParser) Literal() (localctx ILiteralContext) {
 	case NeuroScriptParserTRIPLE_SQ_STRING:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(620)
+			p.SetState(652)
 			p.Match(NeuroScriptParserTRIPLE_SQ_STRING)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12531,7 +12823,7 @@ func (p *NeuroScriptParser) Literal() (localctx ILiteralContext) {
 	case NeuroScriptParserDOUBLE_BRACKET_STRING:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(621)
+			p.SetState(653)
 			p.Match(NeuroScriptParserDOUBLE_BRACKET_STRING)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12542,7 +12834,7 @@ func (p *NeuroScriptParser) Literal() (localctx ILiteralContext) {
 	case NeuroScriptParserNUMBER_LIT:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(622)
+			p.SetState(654)
 			p.Match(NeuroScriptParserNUMBER_LIT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12553,28 +12845,28 @@ func (p *NeuroScriptParser) Literal() (localctx ILiteralContext) {
 	case NeuroScriptParserLBRACK:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(623)
+			p.SetState(655)
 			p.List_literal()
 		}
 
 	case NeuroScriptParserLBRACE:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(624)
+			p.SetState(656)
 			p.Map_literal()
 		}
 
 	case NeuroScriptParserKW_FALSE, NeuroScriptParserKW_TRUE:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(625)
+			p.SetState(657)
 			p.Boolean_literal()
 		}
 
 	case NeuroScriptParserKW_NIL:
 		p.EnterOuterAlt(localctx, 9)
 		{
-			p.SetState(626)
+			p.SetState(658)
 			p.Nil_literal()
 		}
 
@@ -12681,7 +12973,7 @@ func (p *NeuroScriptParser) Nil_literal() (localctx INil_literalContext) {
 	p.EnterRule(localctx, 128, NeuroScriptParserRULE_nil_literal)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(629)
+		p.SetState(661)
 		p.Match(NeuroScriptParserKW_NIL)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -12794,7 +13086,7 @@ func (p *NeuroScriptParser) Boolean_literal() (localctx IBoolean_literalContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(631)
+		p.SetState(663)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == NeuroScriptParserKW_FALSE || _la == NeuroScriptParserKW_TRUE) {
@@ -12925,7 +13217,7 @@ func (p *NeuroScriptParser) List_literal() (localctx IList_literalContext) {
 	p.EnterRule(localctx, 132, NeuroScriptParserRULE_list_literal)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(633)
+		p.SetState(665)
 		p.Match(NeuroScriptParserLBRACK)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -12933,11 +13225,11 @@ func (p *NeuroScriptParser) List_literal() (localctx IList_literalContext) {
 		}
 	}
 	{
-		p.SetState(634)
+		p.SetState(666)
 		p.Expression_list_opt()
 	}
 	{
-		p.SetState(635)
+		p.SetState(667)
 		p.Match(NeuroScriptParserRBRACK)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -13065,7 +13357,7 @@ func (p *NeuroScriptParser) Map_literal() (localctx IMap_literalContext) {
 	p.EnterRule(localctx, 134, NeuroScriptParserRULE_map_literal)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(637)
+		p.SetState(669)
 		p.Match(NeuroScriptParserLBRACE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -13073,11 +13365,11 @@ func (p *NeuroScriptParser) Map_literal() (localctx IMap_literalContext) {
 		}
 	}
 	{
-		p.SetState(638)
+		p.SetState(670)
 		p.Map_entry_list_opt()
 	}
 	{
-		p.SetState(639)
+		p.SetState(671)
 		p.Match(NeuroScriptParserRBRACE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -13196,16 +13488,16 @@ func (p *NeuroScriptParser) Expression_list_opt() (localctx IExpression_list_opt
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(642)
+	p.SetState(674)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&4287674167257481380) != 0) || ((int64((_la-66)) & ^0x3f) == 0 && ((int64(1)<<(_la-66))&145097327) != 0) {
+	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4595857292267552604) != 0) || ((int64((_la-65)) & ^0x3f) == 0 && ((int64(1)<<(_la-65))&297159325741) != 0) {
 		{
-			p.SetState(641)
+			p.SetState(673)
 			p.Expression_list()
 		}
 
@@ -13359,10 +13651,10 @@ func (p *NeuroScriptParser) Expression_list() (localctx IExpression_listContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(644)
+		p.SetState(676)
 		p.Expression()
 	}
-	p.SetState(649)
+	p.SetState(681)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -13371,7 +13663,7 @@ func (p *NeuroScriptParser) Expression_list() (localctx IExpression_listContext)
 
 	for _la == NeuroScriptParserCOMMA {
 		{
-			p.SetState(645)
+			p.SetState(677)
 			p.Match(NeuroScriptParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -13379,11 +13671,11 @@ func (p *NeuroScriptParser) Expression_list() (localctx IExpression_listContext)
 			}
 		}
 		{
-			p.SetState(646)
+			p.SetState(678)
 			p.Expression()
 		}
 
-		p.SetState(651)
+		p.SetState(683)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -13502,16 +13794,16 @@ func (p *NeuroScriptParser) Map_entry_list_opt() (localctx IMap_entry_list_optCo
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(653)
+	p.SetState(685)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&4287674167257481380) != 0) || ((int64((_la-66)) & ^0x3f) == 0 && ((int64(1)<<(_la-66))&145097327) != 0) {
+	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4595857292267552604) != 0) || ((int64((_la-65)) & ^0x3f) == 0 && ((int64(1)<<(_la-65))&297159325741) != 0) {
 		{
-			p.SetState(652)
+			p.SetState(684)
 			p.Map_entry_list()
 		}
 
@@ -13665,10 +13957,10 @@ func (p *NeuroScriptParser) Map_entry_list() (localctx IMap_entry_listContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(655)
+		p.SetState(687)
 		p.Map_entry()
 	}
-	p.SetState(660)
+	p.SetState(692)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -13677,7 +13969,7 @@ func (p *NeuroScriptParser) Map_entry_list() (localctx IMap_entry_listContext) {
 
 	for _la == NeuroScriptParserCOMMA {
 		{
-			p.SetState(656)
+			p.SetState(688)
 			p.Match(NeuroScriptParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -13685,11 +13977,11 @@ func (p *NeuroScriptParser) Map_entry_list() (localctx IMap_entry_listContext) {
 			}
 		}
 		{
-			p.SetState(657)
+			p.SetState(689)
 			p.Map_entry()
 		}
 
-		p.SetState(662)
+		p.SetState(694)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -13838,11 +14130,11 @@ func (p *NeuroScriptParser) Map_entry() (localctx IMap_entryContext) {
 	p.EnterRule(localctx, 144, NeuroScriptParserRULE_map_entry)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(663)
+		p.SetState(695)
 		p.Expression()
 	}
 	{
-		p.SetState(664)
+		p.SetState(696)
 		p.Match(NeuroScriptParserCOLON)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -13850,7 +14142,7 @@ func (p *NeuroScriptParser) Map_entry() (localctx IMap_entryContext) {
 		}
 	}
 	{
-		p.SetState(665)
+		p.SetState(697)
 		p.Expression()
 	}
 
@@ -13866,3 +14158,2864 @@ errorExit:
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
+
+// IArgument_list_optContext is an interface to support dynamic dispatch.
+type IArgument_list_optContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Argument_list() IArgument_listContext
+
+	// IsArgument_list_optContext differentiates from other interfaces.
+	IsArgument_list_optContext()
+}
+
+type Argument_list_optContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyArgument_list_optContext() *Argument_list_optContext {
+	var p = new(Argument_list_optContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = NeuroScriptParserRULE_argument_list_opt
+	return p
+}
+
+func InitEmptyArgument_list_optContext(p *Argument_list_optContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = NeuroScriptParserRULE_argument_list_opt
+}
+
+func (*Argument_list_optContext) IsArgument_list_optContext() {}
+
+func NewArgument_list_optContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Argument_list_optContext {
+	var p = new(Argument_list_optContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = NeuroScriptParserRULE_argument_list_opt
+
+	return p
+}
+
+func (s *Argument_list_optContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Argument_list_optContext) Argument_list() IArgument_listContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IArgument_listContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IArgument_listContext)
+}
+
+func (s *Argument_list_optContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Argument_list_optContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Argument_list_optContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(NeuroScriptListener); ok {
+		listenerT.EnterArgument_list_opt(s)
+	}
+}
+
+func (s *Argument_list_optContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(NeuroScriptListener); ok {
+		listenerT.ExitArgument_list_opt(s)
+	}
+}
+
+func (s *Argument_list_optContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case NeuroScriptVisitor:
+		return t.VisitArgument_list_opt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *NeuroScriptParser) Argument_list_opt() (localctx IArgument_list_optContext) {
+	localctx = NewArgument_list_optContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 146, NeuroScriptParserRULE_argument_list_opt)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(700)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4) != 0) || ((int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&594318653439) != 0) {
+		{
+			p.SetState(699)
+			p.Argument_list()
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IArgument_listContext is an interface to support dynamic dispatch.
+type IArgument_listContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllArgument() []IArgumentContext
+	Argument(i int) IArgumentContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsArgument_listContext differentiates from other interfaces.
+	IsArgument_listContext()
+}
+
+type Argument_listContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyArgument_listContext() *Argument_listContext {
+	var p = new(Argument_listContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = NeuroScriptParserRULE_argument_list
+	return p
+}
+
+func InitEmptyArgument_listContext(p *Argument_listContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = NeuroScriptParserRULE_argument_list
+}
+
+func (*Argument_listContext) IsArgument_listContext() {}
+
+func NewArgument_listContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Argument_listContext {
+	var p = new(Argument_listContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = NeuroScriptParserRULE_argument_list
+
+	return p
+}
+
+func (s *Argument_listContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Argument_listContext) AllArgument() []IArgumentContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IArgumentContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IArgumentContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IArgumentContext); ok {
+			tst[i] = t.(IArgumentContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *Argument_listContext) Argument(i int) IArgumentContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IArgumentContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IArgumentContext)
+}
+
+func (s *Argument_listContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(NeuroScriptParserCOMMA)
+}
+
+func (s *Argument_listContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserCOMMA, i)
+}
+
+func (s *Argument_listContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Argument_listContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Argument_listContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(NeuroScriptListener); ok {
+		listenerT.EnterArgument_list(s)
+	}
+}
+
+func (s *Argument_listContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(NeuroScriptListener); ok {
+		listenerT.ExitArgument_list(s)
+	}
+}
+
+func (s *Argument_listContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case NeuroScriptVisitor:
+		return t.VisitArgument_list(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *NeuroScriptParser) Argument_list() (localctx IArgument_listContext) {
+	localctx = NewArgument_listContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 148, NeuroScriptParserRULE_argument_list)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(702)
+		p.Argument()
+	}
+	p.SetState(707)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == NeuroScriptParserCOMMA {
+		{
+			p.SetState(703)
+			p.Match(NeuroScriptParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(704)
+			p.Argument()
+		}
+
+		p.SetState(709)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IArgumentContext is an interface to support dynamic dispatch.
+type IArgumentContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Expression() IExpressionContext
+	IDENTIFIER() antlr.TerminalNode
+	KW_ACOS() antlr.TerminalNode
+	KW_AND() antlr.TerminalNode
+	KW_AS() antlr.TerminalNode
+	KW_ASIN() antlr.TerminalNode
+	KW_ASK() antlr.TerminalNode
+	KW_ATAN() antlr.TerminalNode
+	KW_BREAK() antlr.TerminalNode
+	KW_CALL() antlr.TerminalNode
+	KW_CASE() antlr.TerminalNode
+	KW_CATCH() antlr.TerminalNode
+	KW_CLEAR() antlr.TerminalNode
+	KW_CLEAR_ERROR() antlr.TerminalNode
+	KW_COMMAND() antlr.TerminalNode
+	KW_CONTINUE() antlr.TerminalNode
+	KW_COS() antlr.TerminalNode
+	KW_DEFAULT() antlr.TerminalNode
+	KW_DO() antlr.TerminalNode
+	KW_EACH() antlr.TerminalNode
+	KW_ELSE() antlr.TerminalNode
+	KW_EMIT() antlr.TerminalNode
+	KW_ENDCOMMAND() antlr.TerminalNode
+	KW_ENDFOR() antlr.TerminalNode
+	KW_ENDFUNC() antlr.TerminalNode
+	KW_ENDIF() antlr.TerminalNode
+	KW_ENDON() antlr.TerminalNode
+	KW_ENDSWITCH() antlr.TerminalNode
+	KW_ENDTRY() antlr.TerminalNode
+	KW_ENDWHILE() antlr.TerminalNode
+	KW_ERROR() antlr.TerminalNode
+	KW_EVAL() antlr.TerminalNode
+	KW_EVENT() antlr.TerminalNode
+	KW_FAIL() antlr.TerminalNode
+	KW_FALSE() antlr.TerminalNode
+	KW_FINALLY() antlr.TerminalNode
+	KW_FOR() antlr.TerminalNode
+	KW_FUNC() antlr.TerminalNode
+	KW_FUZZY() antlr.TerminalNode
+	KW_IF() antlr.TerminalNode
+	KW_IN() antlr.TerminalNode
+	KW_INTO() antlr.TerminalNode
+	KW_LAST() antlr.TerminalNode
+	KW_LEN() antlr.TerminalNode
+	KW_LN() antlr.TerminalNode
+	KW_LOG() antlr.TerminalNode
+	KW_MEANS() antlr.TerminalNode
+	KW_MUST() antlr.TerminalNode
+	KW_MUSTBE() antlr.TerminalNode
+	KW_NAMED() antlr.TerminalNode
+	KW_NEEDS() antlr.TerminalNode
+	KW_NIL() antlr.TerminalNode
+	KW_NO() antlr.TerminalNode
+	KW_NOT() antlr.TerminalNode
+	KW_ON() antlr.TerminalNode
+	KW_OPTIONAL() antlr.TerminalNode
+	KW_OR() antlr.TerminalNode
+	KW_PROMPTUSER() antlr.TerminalNode
+	KW_RAISE() antlr.TerminalNode
+	KW_RETURN() antlr.TerminalNode
+	KW_RETURNS() antlr.TerminalNode
+	KW_SET() antlr.TerminalNode
+	KW_SIN() antlr.TerminalNode
+	KW_SOME() antlr.TerminalNode
+	KW_SWITCH() antlr.TerminalNode
+	KW_TAN() antlr.TerminalNode
+	KW_TIMEDATE() antlr.TerminalNode
+	KW_TOOL() antlr.TerminalNode
+	KW_TRUE() antlr.TerminalNode
+	KW_TRY() antlr.TerminalNode
+	KW_TYPEOF() antlr.TerminalNode
+	KW_WHERE() antlr.TerminalNode
+	KW_WHILE() antlr.TerminalNode
+	KW_WHISPER() antlr.TerminalNode
+	KW_WITH() antlr.TerminalNode
+	COLON() antlr.TerminalNode
+
+	// IsArgumentContext differentiates from other interfaces.
+	IsArgumentContext()
+}
+
+type ArgumentContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyArgumentContext() *ArgumentContext {
+	var p = new(ArgumentContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = NeuroScriptParserRULE_argument
+	return p
+}
+
+func InitEmptyArgumentContext(p *ArgumentContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = NeuroScriptParserRULE_argument
+}
+
+func (*ArgumentContext) IsArgumentContext() {}
+
+func NewArgumentContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArgumentContext {
+	var p = new(ArgumentContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = NeuroScriptParserRULE_argument
+
+	return p
+}
+
+func (s *ArgumentContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ArgumentContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ArgumentContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserIDENTIFIER, 0)
+}
+
+func (s *ArgumentContext) KW_ACOS() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_ACOS, 0)
+}
+
+func (s *ArgumentContext) KW_AND() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_AND, 0)
+}
+
+func (s *ArgumentContext) KW_AS() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_AS, 0)
+}
+
+func (s *ArgumentContext) KW_ASIN() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_ASIN, 0)
+}
+
+func (s *ArgumentContext) KW_ASK() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_ASK, 0)
+}
+
+func (s *ArgumentContext) KW_ATAN() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_ATAN, 0)
+}
+
+func (s *ArgumentContext) KW_BREAK() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_BREAK, 0)
+}
+
+func (s *ArgumentContext) KW_CALL() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_CALL, 0)
+}
+
+func (s *ArgumentContext) KW_CASE() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_CASE, 0)
+}
+
+func (s *ArgumentContext) KW_CATCH() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_CATCH, 0)
+}
+
+func (s *ArgumentContext) KW_CLEAR() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_CLEAR, 0)
+}
+
+func (s *ArgumentContext) KW_CLEAR_ERROR() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_CLEAR_ERROR, 0)
+}
+
+func (s *ArgumentContext) KW_COMMAND() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_COMMAND, 0)
+}
+
+func (s *ArgumentContext) KW_CONTINUE() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_CONTINUE, 0)
+}
+
+func (s *ArgumentContext) KW_COS() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_COS, 0)
+}
+
+func (s *ArgumentContext) KW_DEFAULT() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_DEFAULT, 0)
+}
+
+func (s *ArgumentContext) KW_DO() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_DO, 0)
+}
+
+func (s *ArgumentContext) KW_EACH() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_EACH, 0)
+}
+
+func (s *ArgumentContext) KW_ELSE() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_ELSE, 0)
+}
+
+func (s *ArgumentContext) KW_EMIT() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_EMIT, 0)
+}
+
+func (s *ArgumentContext) KW_ENDCOMMAND() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_ENDCOMMAND, 0)
+}
+
+func (s *ArgumentContext) KW_ENDFOR() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_ENDFOR, 0)
+}
+
+func (s *ArgumentContext) KW_ENDFUNC() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_ENDFUNC, 0)
+}
+
+func (s *ArgumentContext) KW_ENDIF() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_ENDIF, 0)
+}
+
+func (s *ArgumentContext) KW_ENDON() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_ENDON, 0)
+}
+
+func (s *ArgumentContext) KW_ENDSWITCH() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_ENDSWITCH, 0)
+}
+
+func (s *ArgumentContext) KW_ENDTRY() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_ENDTRY, 0)
+}
+
+func (s *ArgumentContext) KW_ENDWHILE() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_ENDWHILE, 0)
+}
+
+func (s *ArgumentContext) KW_ERROR() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_ERROR, 0)
+}
+
+func (s *ArgumentContext) KW_EVAL() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_EVAL, 0)
+}
+
+func (s *ArgumentContext) KW_EVENT() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_EVENT, 0)
+}
+
+func (s *ArgumentContext) KW_FAIL() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_FAIL, 0)
+}
+
+func (s *ArgumentContext) KW_FALSE() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_FALSE, 0)
+}
+
+func (s *ArgumentContext) KW_FINALLY() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_FINALLY, 0)
+}
+
+func (s *ArgumentContext) KW_FOR() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_FOR, 0)
+}
+
+func (s *ArgumentContext) KW_FUNC() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_FUNC, 0)
+}
+
+func (s *ArgumentContext) KW_FUZZY() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_FUZZY, 0)
+}
+
+func (s *ArgumentContext) KW_IF() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_IF, 0)
+}
+
+func (s *ArgumentContext) KW_IN() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_IN, 0)
+}
+
+func (s *ArgumentContext) KW_INTO() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_INTO, 0)
+}
+
+func (s *ArgumentContext) KW_LAST() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_LAST, 0)
+}
+
+func (s *ArgumentContext) KW_LEN() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_LEN, 0)
+}
+
+func (s *ArgumentContext) KW_LN() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_LN, 0)
+}
+
+func (s *ArgumentContext) KW_LOG() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_LOG, 0)
+}
+
+func (s *ArgumentContext) KW_MEANS() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_MEANS, 0)
+}
+
+func (s *ArgumentContext) KW_MUST() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_MUST, 0)
+}
+
+func (s *ArgumentContext) KW_MUSTBE() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_MUSTBE, 0)
+}
+
+func (s *ArgumentContext) KW_NAMED() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_NAMED, 0)
+}
+
+func (s *ArgumentContext) KW_NEEDS() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_NEEDS, 0)
+}
+
+func (s *ArgumentContext) KW_NIL() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_NIL, 0)
+}
+
+func (s *ArgumentContext) KW_NO() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_NO, 0)
+}
+
+func (s *ArgumentContext) KW_NOT() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_NOT, 0)
+}
+
+func (s *ArgumentContext) KW_ON() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_ON, 0)
+}
+
+func (s *ArgumentContext) KW_OPTIONAL() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_OPTIONAL, 0)
+}
+
+func (s *ArgumentContext) KW_OR() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_OR, 0)
+}
+
+func (s *ArgumentContext) KW_PROMPTUSER() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_PROMPTUSER, 0)
+}
+
+func (s *ArgumentContext) KW_RAISE() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_RAISE, 0)
+}
+
+func (s *ArgumentContext) KW_RETURN() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_RETURN, 0)
+}
+
+func (s *ArgumentContext) KW_RETURNS() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_RETURNS, 0)
+}
+
+func (s *ArgumentContext) KW_SET() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_SET, 0)
+}
+
+func (s *ArgumentContext) KW_SIN() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_SIN, 0)
+}
+
+func (s *ArgumentContext) KW_SOME() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_SOME, 0)
+}
+
+func (s *ArgumentContext) KW_SWITCH() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_SWITCH, 0)
+}
+
+func (s *ArgumentContext) KW_TAN() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_TAN, 0)
+}
+
+func (s *ArgumentContext) KW_TIMEDATE() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_TIMEDATE, 0)
+}
+
+func (s *ArgumentContext) KW_TOOL() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_TOOL, 0)
+}
+
+func (s *ArgumentContext) KW_TRUE() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_TRUE, 0)
+}
+
+func (s *ArgumentContext) KW_TRY() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_TRY, 0)
+}
+
+func (s *ArgumentContext) KW_TYPEOF() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_TYPEOF, 0)
+}
+
+func (s *ArgumentContext) KW_WHERE() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_WHERE, 0)
+}
+
+func (s *ArgumentContext) KW_WHILE() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_WHILE, 0)
+}
+
+func (s *ArgumentContext) KW_WHISPER() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_WHISPER, 0)
+}
+
+func (s *ArgumentContext) KW_WITH() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_WITH, 0)
+}
+
+func (s *ArgumentContext) COLON() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserCOLON, 0)
+}
+
+func (s *ArgumentContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ArgumentContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ArgumentContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(NeuroScriptListener); ok {
+		listenerT.EnterArgument(s)
+	}
+}
+
+func (s *ArgumentContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(NeuroScriptListener); ok {
+		listenerT.ExitArgument(s)
+	}
+}
+
+func (s *ArgumentContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case NeuroScriptVisitor:
+		return t.VisitArgument(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *NeuroScriptParser) Argument() (localctx IArgumentContext) {
+	localctx = NewArgumentContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 150, NeuroScriptParserRULE_argument)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(712)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 64, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(710)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4) != 0) || ((int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&264191) != 0)) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+		{
+			p.SetState(711)
+			p.Match(NeuroScriptParserCOLON)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	} else if p.HasError() { // JIM
+		goto errorExit
+	}
+	{
+		p.SetState(714)
+		p.Expression()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IOptional_param_listContext is an interface to support dynamic dispatch.
+type IOptional_param_listContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllOptional_param() []IOptional_paramContext
+	Optional_param(i int) IOptional_paramContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsOptional_param_listContext differentiates from other interfaces.
+	IsOptional_param_listContext()
+}
+
+type Optional_param_listContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyOptional_param_listContext() *Optional_param_listContext {
+	var p = new(Optional_param_listContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = NeuroScriptParserRULE_optional_param_list
+	return p
+}
+
+func InitEmptyOptional_param_listContext(p *Optional_param_listContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = NeuroScriptParserRULE_optional_param_list
+}
+
+func (*Optional_param_listContext) IsOptional_param_listContext() {}
+
+func NewOptional_param_listContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Optional_param_listContext {
+	var p = new(Optional_param_listContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = NeuroScriptParserRULE_optional_param_list
+
+	return p
+}
+
+func (s *Optional_param_listContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Optional_param_listContext) AllOptional_param() []IOptional_paramContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IOptional_paramContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IOptional_paramContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IOptional_paramContext); ok {
+			tst[i] = t.(IOptional_paramContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *Optional_param_listContext) Optional_param(i int) IOptional_paramContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOptional_paramContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOptional_paramContext)
+}
+
+func (s *Optional_param_listContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(NeuroScriptParserCOMMA)
+}
+
+func (s *Optional_param_listContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserCOMMA, i)
+}
+
+func (s *Optional_param_listContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Optional_param_listContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Optional_param_listContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(NeuroScriptListener); ok {
+		listenerT.EnterOptional_param_list(s)
+	}
+}
+
+func (s *Optional_param_listContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(NeuroScriptListener); ok {
+		listenerT.ExitOptional_param_list(s)
+	}
+}
+
+func (s *Optional_param_listContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case NeuroScriptVisitor:
+		return t.VisitOptional_param_list(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *NeuroScriptParser) Optional_param_list() (localctx IOptional_param_listContext) {
+	localctx = NewOptional_param_listContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 152, NeuroScriptParserRULE_optional_param_list)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(716)
+		p.Optional_param()
+	}
+	p.SetState(721)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == NeuroScriptParserCOMMA {
+		{
+			p.SetState(717)
+			p.Match(NeuroScriptParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(718)
+			p.Optional_param()
+		}
+
+		p.SetState(723)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IOptional_paramContext is an interface to support dynamic dispatch.
+type IOptional_paramContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	IDENTIFIER() antlr.TerminalNode
+	ASSIGN() antlr.TerminalNode
+	Expression() IExpressionContext
+
+	// IsOptional_paramContext differentiates from other interfaces.
+	IsOptional_paramContext()
+}
+
+type Optional_paramContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyOptional_paramContext() *Optional_paramContext {
+	var p = new(Optional_paramContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = NeuroScriptParserRULE_optional_param
+	return p
+}
+
+func InitEmptyOptional_paramContext(p *Optional_paramContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = NeuroScriptParserRULE_optional_param
+}
+
+func (*Optional_paramContext) IsOptional_paramContext() {}
+
+func NewOptional_paramContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Optional_paramContext {
+	var p = new(Optional_paramContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = NeuroScriptParserRULE_optional_param
+
+	return p
+}
+
+func (s *Optional_paramContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Optional_paramContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserIDENTIFIER, 0)
+}
+
+func (s *Optional_paramContext) ASSIGN() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserASSIGN, 0)
+}
+
+func (s *Optional_paramContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *Optional_paramContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Optional_paramContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Optional_paramContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(NeuroScriptListener); ok {
+		listenerT.EnterOptional_param(s)
+	}
+}
+
+func (s *Optional_paramContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(NeuroScriptListener); ok {
+		listenerT.ExitOptional_param(s)
+	}
+}
+
+func (s *Optional_paramContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case NeuroScriptVisitor:
+		return t.VisitOptional_param(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *NeuroScriptParser) Optional_param() (localctx IOptional_paramContext) {
+	localctx = NewOptional_paramContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 154, NeuroScriptParserRULE_optional_param)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(724)
+		p.Match(NeuroScriptParserIDENTIFIER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(727)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == NeuroScriptParserASSIGN {
+		{
+			p.SetState(725)
+			p.Match(NeuroScriptParserASSIGN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(726)
+			p.Expression()
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ILambda_exprContext is an interface to support dynamic dispatch.
+type ILambda_exprContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	KW_FUNC() antlr.TerminalNode
+	LPAREN() antlr.TerminalNode
+	RPAREN() antlr.TerminalNode
+	KW_MEANS() antlr.TerminalNode
+	Expression() IExpressionContext
+	NEWLINE() antlr.TerminalNode
+	Non_empty_statement_list() INon_empty_statement_listContext
+	KW_ENDFUNC() antlr.TerminalNode
+	Param_list() IParam_listContext
+
+	// IsLambda_exprContext differentiates from other interfaces.
+	IsLambda_exprContext()
+}
+
+type Lambda_exprContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyLambda_exprContext() *Lambda_exprContext {
+	var p = new(Lambda_exprContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = NeuroScriptParserRULE_lambda_expr
+	return p
+}
+
+func InitEmptyLambda_exprContext(p *Lambda_exprContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = NeuroScriptParserRULE_lambda_expr
+}
+
+func (*Lambda_exprContext) IsLambda_exprContext() {}
+
+func NewLambda_exprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Lambda_exprContext {
+	var p = new(Lambda_exprContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = NeuroScriptParserRULE_lambda_expr
+
+	return p
+}
+
+func (s *Lambda_exprContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Lambda_exprContext) KW_FUNC() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_FUNC, 0)
+}
+
+func (s *Lambda_exprContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserLPAREN, 0)
+}
+
+func (s *Lambda_exprContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserRPAREN, 0)
+}
+
+func (s *Lambda_exprContext) KW_MEANS() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_MEANS, 0)
+}
+
+func (s *Lambda_exprContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *Lambda_exprContext) NEWLINE() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserNEWLINE, 0)
+}
+
+func (s *Lambda_exprContext) Non_empty_statement_list() INon_empty_statement_listContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INon_empty_statement_listContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INon_empty_statement_listContext)
+}
+
+func (s *Lambda_exprContext) KW_ENDFUNC() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_ENDFUNC, 0)
+}
+
+func (s *Lambda_exprContext) Param_list() IParam_listContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IParam_listContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IParam_listContext)
+}
+
+func (s *Lambda_exprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Lambda_exprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Lambda_exprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(NeuroScriptListener); ok {
+		listenerT.EnterLambda_expr(s)
+	}
+}
+
+func (s *Lambda_exprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(NeuroScriptListener); ok {
+		listenerT.ExitLambda_expr(s)
+	}
+}
+
+func (s *Lambda_exprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case NeuroScriptVisitor:
+		return t.VisitLambda_expr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *NeuroScriptParser) Lambda_expr() (localctx ILambda_exprContext) {
+	localctx = NewLambda_exprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 156, NeuroScriptParserRULE_lambda_expr)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(730)
+		p.Match(NeuroScriptParserKW_FUNC)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(731)
+		p.Match(NeuroScriptParserLPAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(733)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == NeuroScriptParserIDENTIFIER {
+		{
+			p.SetState(732)
+			p.Param_list()
+		}
+
+	}
+	{
+		p.SetState(735)
+		p.Match(NeuroScriptParserRPAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(736)
+		p.Match(NeuroScriptParserKW_MEANS)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(742)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case NeuroScriptParserKW_ACOS, NeuroScriptParserKW_ASIN, NeuroScriptParserKW_ATAN, NeuroScriptParserKW_COS, NeuroScriptParserKW_EVAL, NeuroScriptParserKW_FALSE, NeuroScriptParserKW_FUNC, NeuroScriptParserKW_LAST, NeuroScriptParserKW_LEN, NeuroScriptParserKW_LN, NeuroScriptParserKW_LOG, NeuroScriptParserKW_NIL, NeuroScriptParserKW_NO, NeuroScriptParserKW_NOT, NeuroScriptParserKW_SIN, NeuroScriptParserKW_SOME, NeuroScriptParserKW_TAN, NeuroScriptParserKW_TOOL, NeuroScriptParserKW_TRUE, NeuroScriptParserKW_TYPEOF, NeuroScriptParserSTRING_LIT, NeuroScriptParserTRIPLE_BACKTICK_STRING, NeuroScriptParserTRIPLE_SQ_STRING, NeuroScriptParserDOUBLE_BRACKET_STRING, NeuroScriptParserNUMBER_LIT, NeuroScriptParserIDENTIFIER, NeuroScriptParserMINUS, NeuroScriptParserTILDE, NeuroScriptParserLPAREN, NeuroScriptParserLBRACK, NeuroScriptParserLBRACE, NeuroScriptParserPLACEHOLDER_START:
+		{
+			p.SetState(737)
+			p.Expression()
+		}
+
+	case NeuroScriptParserNEWLINE:
+		{
+			p.SetState(738)
+			p.Match(NeuroScriptParserNEWLINE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(739)
+			p.Non_empty_statement_list()
+		}
+		{
+			p.SetState(740)
+			p.Match(NeuroScriptParserKW_ENDFUNC)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IElse_clauseContext is an interface to support dynamic dispatch.
+type IElse_clauseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	KW_ELSE() antlr.TerminalNode
+	KW_IF() antlr.TerminalNode
+	Expression() IExpressionContext
+	NEWLINE() antlr.TerminalNode
+	Non_empty_statement_list() INon_empty_statement_listContext
+	Else_clause() IElse_clauseContext
+
+	// IsElse_clauseContext differentiates from other interfaces.
+	IsElse_clauseContext()
+}
+
+type Else_clauseContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyElse_clauseContext() *Else_clauseContext {
+	var p = new(Else_clauseContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = NeuroScriptParserRULE_else_clause
+	return p
+}
+
+func InitEmptyElse_clauseContext(p *Else_clauseContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = NeuroScriptParserRULE_else_clause
+}
+
+func (*Else_clauseContext) IsElse_clauseContext() {}
+
+func NewElse_clauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Else_clauseContext {
+	var p = new(Else_clauseContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = NeuroScriptParserRULE_else_clause
+
+	return p
+}
+
+func (s *Else_clauseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Else_clauseContext) KW_ELSE() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_ELSE, 0)
+}
+
+func (s *Else_clauseContext) KW_IF() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_IF, 0)
+}
+
+func (s *Else_clauseContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *Else_clauseContext) NEWLINE() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserNEWLINE, 0)
+}
+
+func (s *Else_clauseContext) Non_empty_statement_list() INon_empty_statement_listContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INon_empty_statement_listContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INon_empty_statement_listContext)
+}
+
+func (s *Else_clauseContext) Else_clause() IElse_clauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IElse_clauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IElse_clauseContext)
+}
+
+func (s *Else_clauseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Else_clauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Else_clauseContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(NeuroScriptListener); ok {
+		listenerT.EnterElse_clause(s)
+	}
+}
+
+func (s *Else_clauseContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(NeuroScriptListener); ok {
+		listenerT.ExitElse_clause(s)
+	}
+}
+
+func (s *Else_clauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case NeuroScriptVisitor:
+		return t.VisitElse_clause(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *NeuroScriptParser) Else_clause() (localctx IElse_clauseContext) {
+	localctx = NewElse_clauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 158, NeuroScriptParserRULE_else_clause)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(744)
+		p.Match(NeuroScriptParserKW_ELSE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(754)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case NeuroScriptParserKW_IF:
+		{
+			p.SetState(745)
+			p.Match(NeuroScriptParserKW_IF)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(746)
+			p.Expression()
+		}
+		{
+			p.SetState(747)
+			p.Match(NeuroScriptParserNEWLINE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(748)
+			p.Non_empty_statement_list()
+		}
+		p.SetState(750)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == NeuroScriptParserKW_ELSE {
+			{
+				p.SetState(749)
+				p.Else_clause()
+			}
+
+		}
+
+	case NeuroScriptParserNEWLINE:
+		{
+			p.SetState(752)
+			p.Match(NeuroScriptParserNEWLINE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(753)
+			p.Non_empty_statement_list()
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ISwitch_statementContext is an interface to support dynamic dispatch.
+type ISwitch_statementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	KW_SWITCH() antlr.TerminalNode
+	Expression() IExpressionContext
+	KW_ENDSWITCH() antlr.TerminalNode
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
+	AllSwitch_case() []ISwitch_caseContext
+	Switch_case(i int) ISwitch_caseContext
+	KW_DEFAULT() antlr.TerminalNode
+	Non_empty_statement_list() INon_empty_statement_listContext
+
+	// IsSwitch_statementContext differentiates from other interfaces.
+	IsSwitch_statementContext()
+}
+
+type Switch_statementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySwitch_statementContext() *Switch_statementContext {
+	var p = new(Switch_statementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = NeuroScriptParserRULE_switch_statement
+	return p
+}
+
+func InitEmptySwitch_statementContext(p *Switch_statementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = NeuroScriptParserRULE_switch_statement
+}
+
+func (*Switch_statementContext) IsSwitch_statementContext() {}
+
+func NewSwitch_statementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Switch_statementContext {
+	var p = new(Switch_statementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = NeuroScriptParserRULE_switch_statement
+
+	return p
+}
+
+func (s *Switch_statementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Switch_statementContext) KW_SWITCH() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_SWITCH, 0)
+}
+
+func (s *Switch_statementContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *Switch_statementContext) KW_ENDSWITCH() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_ENDSWITCH, 0)
+}
+
+func (s *Switch_statementContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(NeuroScriptParserNEWLINE)
+}
+
+func (s *Switch_statementContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserNEWLINE, i)
+}
+
+func (s *Switch_statementContext) AllSwitch_case() []ISwitch_caseContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISwitch_caseContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ISwitch_caseContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ISwitch_caseContext); ok {
+			tst[i] = t.(ISwitch_caseContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *Switch_statementContext) Switch_case(i int) ISwitch_caseContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISwitch_caseContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISwitch_caseContext)
+}
+
+func (s *Switch_statementContext) KW_DEFAULT() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_DEFAULT, 0)
+}
+
+func (s *Switch_statementContext) Non_empty_statement_list() INon_empty_statement_listContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INon_empty_statement_listContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INon_empty_statement_listContext)
+}
+
+func (s *Switch_statementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Switch_statementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Switch_statementContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(NeuroScriptListener); ok {
+		listenerT.EnterSwitch_statement(s)
+	}
+}
+
+func (s *Switch_statementContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(NeuroScriptListener); ok {
+		listenerT.ExitSwitch_statement(s)
+	}
+}
+
+func (s *Switch_statementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case NeuroScriptVisitor:
+		return t.VisitSwitch_statement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *NeuroScriptParser) Switch_statement() (localctx ISwitch_statementContext) {
+	localctx = NewSwitch_statementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 160, NeuroScriptParserRULE_switch_statement)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(756)
+		p.Match(NeuroScriptParserKW_SWITCH)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(757)
+		p.Expression()
+	}
+	p.SetState(759)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for ok := true; ok; ok = _la == NeuroScriptParserNEWLINE {
+		{
+			p.SetState(758)
+			p.Match(NeuroScriptParserNEWLINE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+		p.SetState(761)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	p.SetState(766)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == NeuroScriptParserKW_CASE {
+		{
+			p.SetState(763)
+			p.Switch_case()
+		}
+
+		p.SetState(768)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	p.SetState(772)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == NeuroScriptParserKW_DEFAULT {
+		{
+			p.SetState(769)
+			p.Match(NeuroScriptParserKW_DEFAULT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(770)
+			p.Match(NeuroScriptParserNEWLINE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(771)
+			p.Non_empty_statement_list()
+		}
+
+	}
+	{
+		p.SetState(774)
+		p.Match(NeuroScriptParserKW_ENDSWITCH)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ISwitch_caseContext is an interface to support dynamic dispatch.
+type ISwitch_caseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	KW_CASE() antlr.TerminalNode
+	NEWLINE() antlr.TerminalNode
+	Non_empty_statement_list() INon_empty_statement_listContext
+	KW_IN() antlr.TerminalNode
+	Expression() IExpressionContext
+	KW_TYPEOF() antlr.TerminalNode
+	Expression_list() IExpression_listContext
+
+	// IsSwitch_caseContext differentiates from other interfaces.
+	IsSwitch_caseContext()
+}
+
+type Switch_caseContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySwitch_caseContext() *Switch_caseContext {
+	var p = new(Switch_caseContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = NeuroScriptParserRULE_switch_case
+	return p
+}
+
+func InitEmptySwitch_caseContext(p *Switch_caseContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = NeuroScriptParserRULE_switch_case
+}
+
+func (*Switch_caseContext) IsSwitch_caseContext() {}
+
+func NewSwitch_caseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Switch_caseContext {
+	var p = new(Switch_caseContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = NeuroScriptParserRULE_switch_case
+
+	return p
+}
+
+func (s *Switch_caseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Switch_caseContext) KW_CASE() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_CASE, 0)
+}
+
+func (s *Switch_caseContext) NEWLINE() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserNEWLINE, 0)
+}
+
+func (s *Switch_caseContext) Non_empty_statement_list() INon_empty_statement_listContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INon_empty_statement_listContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INon_empty_statement_listContext)
+}
+
+func (s *Switch_caseContext) KW_IN() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_IN, 0)
+}
+
+func (s *Switch_caseContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *Switch_caseContext) KW_TYPEOF() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_TYPEOF, 0)
+}
+
+func (s *Switch_caseContext) Expression_list() IExpression_listContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpression_listContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpression_listContext)
+}
+
+func (s *Switch_caseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Switch_caseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Switch_caseContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(NeuroScriptListener); ok {
+		listenerT.EnterSwitch_case(s)
+	}
+}
+
+func (s *Switch_caseContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(NeuroScriptListener); ok {
+		listenerT.ExitSwitch_case(s)
+	}
+}
+
+func (s *Switch_caseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case NeuroScriptVisitor:
+		return t.VisitSwitch_case(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *NeuroScriptParser) Switch_case() (localctx ISwitch_caseContext) {
+	localctx = NewSwitch_caseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 162, NeuroScriptParserRULE_switch_case)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(776)
+		p.Match(NeuroScriptParserKW_CASE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(782)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 74, p.GetParserRuleContext()) {
+	case 1:
+		{
+			p.SetState(777)
+			p.Match(NeuroScriptParserKW_IN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(778)
+			p.Expression()
+		}
+
+	case 2:
+		{
+			p.SetState(779)
+			p.Match(NeuroScriptParserKW_TYPEOF)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(780)
+			p.Expression_list()
+		}
+
+	case 3:
+		{
+			p.SetState(781)
+			p.Expression_list()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+	{
+		p.SetState(784)
+		p.Match(NeuroScriptParserNEWLINE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(785)
+		p.Non_empty_statement_list()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ITry_statementContext is an interface to support dynamic dispatch.
+type ITry_statementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	KW_TRY() antlr.TerminalNode
+	AllNEWLINE() []antlr.TerminalNode
+	NEWLINE(i int) antlr.TerminalNode
+	AllNon_empty_statement_list() []INon_empty_statement_listContext
+	Non_empty_statement_list(i int) INon_empty_statement_listContext
+	KW_ENDTRY() antlr.TerminalNode
+	KW_CATCH() antlr.TerminalNode
+	KW_FINALLY() antlr.TerminalNode
+	IDENTIFIER() antlr.TerminalNode
+
+	// IsTry_statementContext differentiates from other interfaces.
+	IsTry_statementContext()
+}
+
+type Try_statementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTry_statementContext() *Try_statementContext {
+	var p = new(Try_statementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = NeuroScriptParserRULE_try_statement
+	return p
+}
+
+func InitEmptyTry_statementContext(p *Try_statementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = NeuroScriptParserRULE_try_statement
+}
+
+func (*Try_statementContext) IsTry_statementContext() {}
+
+func NewTry_statementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Try_statementContext {
+	var p = new(Try_statementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = NeuroScriptParserRULE_try_statement
+
+	return p
+}
+
+func (s *Try_statementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Try_statementContext) KW_TRY() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_TRY, 0)
+}
+
+func (s *Try_statementContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(NeuroScriptParserNEWLINE)
+}
+
+func (s *Try_statementContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserNEWLINE, i)
+}
+
+func (s *Try_statementContext) AllNon_empty_statement_list() []INon_empty_statement_listContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(INon_empty_statement_listContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]INon_empty_statement_listContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(INon_empty_statement_listContext); ok {
+			tst[i] = t.(INon_empty_statement_listContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *Try_statementContext) Non_empty_statement_list(i int) INon_empty_statement_listContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INon_empty_statement_listContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INon_empty_statement_listContext)
+}
+
+func (s *Try_statementContext) KW_ENDTRY() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_ENDTRY, 0)
+}
+
+func (s *Try_statementContext) KW_CATCH() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_CATCH, 0)
+}
+
+func (s *Try_statementContext) KW_FINALLY() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_FINALLY, 0)
+}
+
+func (s *Try_statementContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserIDENTIFIER, 0)
+}
+
+func (s *Try_statementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Try_statementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Try_statementContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(NeuroScriptListener); ok {
+		listenerT.EnterTry_statement(s)
+	}
+}
+
+func (s *Try_statementContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(NeuroScriptListener); ok {
+		listenerT.ExitTry_statement(s)
+	}
+}
+
+func (s *Try_statementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case NeuroScriptVisitor:
+		return t.VisitTry_statement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *NeuroScriptParser) Try_statement() (localctx ITry_statementContext) {
+	localctx = NewTry_statementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 164, NeuroScriptParserRULE_try_statement)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(787)
+		p.Match(NeuroScriptParserKW_TRY)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(788)
+		p.Match(NeuroScriptParserNEWLINE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(789)
+		p.Non_empty_statement_list()
+	}
+	p.SetState(796)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == NeuroScriptParserKW_CATCH {
+		{
+			p.SetState(790)
+			p.Match(NeuroScriptParserKW_CATCH)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(792)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == NeuroScriptParserIDENTIFIER {
+			{
+				p.SetState(791)
+				p.Match(NeuroScriptParserIDENTIFIER)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
+		{
+			p.SetState(794)
+			p.Match(NeuroScriptParserNEWLINE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(795)
+			p.Non_empty_statement_list()
+		}
+
+	}
+	p.SetState(801)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == NeuroScriptParserKW_FINALLY {
+		{
+			p.SetState(798)
+			p.Match(NeuroScriptParserKW_FINALLY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(799)
+			p.Match(NeuroScriptParserNEWLINE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(800)
+			p.Non_empty_statement_list()
+		}
+
+	}
+	{
+		p.SetState(803)
+		p.Match(NeuroScriptParserKW_ENDTRY)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ISubscriptContext is an interface to support dynamic dispatch.
+type ISubscriptContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllExpression() []IExpressionContext
+	Expression(i int) IExpressionContext
+	COLON() antlr.TerminalNode
+
+	// IsSubscriptContext differentiates from other interfaces.
+	IsSubscriptContext()
+}
+
+type SubscriptContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySubscriptContext() *SubscriptContext {
+	var p = new(SubscriptContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = NeuroScriptParserRULE_subscript
+	return p
+}
+
+func InitEmptySubscriptContext(p *SubscriptContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = NeuroScriptParserRULE_subscript
+}
+
+func (*SubscriptContext) IsSubscriptContext() {}
+
+func NewSubscriptContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SubscriptContext {
+	var p = new(SubscriptContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = NeuroScriptParserRULE_subscript
+
+	return p
+}
+
+func (s *SubscriptContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SubscriptContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *SubscriptContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *SubscriptContext) COLON() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserCOLON, 0)
+}
+
+func (s *SubscriptContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SubscriptContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SubscriptContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(NeuroScriptListener); ok {
+		listenerT.EnterSubscript(s)
+	}
+}
+
+func (s *SubscriptContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(NeuroScriptListener); ok {
+		listenerT.ExitSubscript(s)
+	}
+}
+
+func (s *SubscriptContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case NeuroScriptVisitor:
+		return t.VisitSubscript(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *NeuroScriptParser) Subscript() (localctx ISubscriptContext) {
+	localctx = NewSubscriptContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 166, NeuroScriptParserRULE_subscript)
+	var _la int
+
+	p.SetState(816)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case NeuroScriptParserKW_ACOS, NeuroScriptParserKW_ASIN, NeuroScriptParserKW_ATAN, NeuroScriptParserKW_COS, NeuroScriptParserKW_EVAL, NeuroScriptParserKW_FALSE, NeuroScriptParserKW_FUNC, NeuroScriptParserKW_LAST, NeuroScriptParserKW_LEN, NeuroScriptParserKW_LN, NeuroScriptParserKW_LOG, NeuroScriptParserKW_NIL, NeuroScriptParserKW_NO, NeuroScriptParserKW_NOT, NeuroScriptParserKW_SIN, NeuroScriptParserKW_SOME, NeuroScriptParserKW_TAN, NeuroScriptParserKW_TOOL, NeuroScriptParserKW_TRUE, NeuroScriptParserKW_TYPEOF, NeuroScriptParserSTRING_LIT, NeuroScriptParserTRIPLE_BACKTICK_STRING, NeuroScriptParserTRIPLE_SQ_STRING, NeuroScriptParserDOUBLE_BRACKET_STRING, NeuroScriptParserNUMBER_LIT, NeuroScriptParserIDENTIFIER, NeuroScriptParserMINUS, NeuroScriptParserTILDE, NeuroScriptParserLPAREN, NeuroScriptParserLBRACK, NeuroScriptParserLBRACE, NeuroScriptParserPLACEHOLDER_START:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(805)
+			p.Expression()
+		}
+		p.SetState(810)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == NeuroScriptParserCOLON {
+			{
+				p.SetState(806)
+				p.Match(NeuroScriptParserCOLON)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			p.SetState(808)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+
+			if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4595857292267552604) != 0) || ((int64((_la-65)) & ^0x3f) == 0 && ((int64(1)<<(_la-65))&297159325741) != 0) {
+				{
+					p.SetState(807)
+					p.Expression()
+				}
+
+			}
+
+		}
+
+	case NeuroScriptParserCOLON:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(812)
+			p.Match(NeuroScriptParserCOLON)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(814)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4595857292267552604) != 0) || ((int64((_la-65)) & ^0x3f) == 0 && ((int64(1)<<(_la-65))&297159325741) != 0) {
+			{
+				p.SetState(813)
+				p.Expression()
+			}
+
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IRaise_statementContext is an interface to support dynamic dispatch.
+type IRaise_statementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	KW_RAISE() antlr.TerminalNode
+	KW_EVENT() antlr.TerminalNode
+	AllExpression() []IExpressionContext
+	Expression(i int) IExpressionContext
+	KW_WITH() antlr.TerminalNode
+
+	// IsRaise_statementContext differentiates from other interfaces.
+	IsRaise_statementContext()
+}
+
+type Raise_statementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyRaise_statementContext() *Raise_statementContext {
+	var p = new(Raise_statementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = NeuroScriptParserRULE_raise_statement
+	return p
+}
+
+func InitEmptyRaise_statementContext(p *Raise_statementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = NeuroScriptParserRULE_raise_statement
+}
+
+func (*Raise_statementContext) IsRaise_statementContext() {}
+
+func NewRaise_statementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Raise_statementContext {
+	var p = new(Raise_statementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = NeuroScriptParserRULE_raise_statement
+
+	return p
+}
+
+func (s *Raise_statementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Raise_statementContext) KW_RAISE() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_RAISE, 0)
+}
+
+func (s *Raise_statementContext) KW_EVENT() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_EVENT, 0)
+}
+
+func (s *Raise_statementContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *Raise_statementContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *Raise_statementContext) KW_WITH() antlr.TerminalNode {
+	return s.GetToken(NeuroScriptParserKW_WITH, 0)
+}
+
+func (s *Raise_statementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Raise_statementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Raise_statementContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(NeuroScriptListener); ok {
+		listenerT.EnterRaise_statement(s)
+	}
+}
+
+func (s *Raise_statementContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(NeuroScriptListener); ok {
+		listenerT.ExitRaise_statement(s)
+	}
+}
+
+func (s *Raise_statementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case NeuroScriptVisitor:
+		return t.VisitRaise_statement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *NeuroScriptParser) Raise_statement() (localctx IRaise_statementContext) {
+	localctx = NewRaise_statementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 168, NeuroScriptParserRULE_raise_statement)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(818)
+		p.Match(NeuroScriptParserKW_RAISE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(819)
+		p.Match(NeuroScriptParserKW_EVENT)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(820)
+		p.Expression()
+	}
+	p.SetState(823)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == NeuroScriptParserKW_WITH {
+		{
+			p.SetState(821)
+			p.Match(NeuroScriptParserKW_WITH)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(822)
+			p.Expression()
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
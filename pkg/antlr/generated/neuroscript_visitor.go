@@ -225,4 +225,40 @@ type NeuroScriptVisitor interface {
 
 	// Visit a parse tree produced by NeuroScriptParser#map_entry.
 	VisitMap_entry(ctx *Map_entryContext) interface{}
+
+	// Visit a parse tree produced by NeuroScriptParser#argument_list_opt.
+	VisitArgument_list_opt(ctx *Argument_list_optContext) interface{}
+
+	// Visit a parse tree produced by NeuroScriptParser#argument_list.
+	VisitArgument_list(ctx *Argument_listContext) interface{}
+
+	// Visit a parse tree produced by NeuroScriptParser#argument.
+	VisitArgument(ctx *ArgumentContext) interface{}
+
+	// Visit a parse tree produced by NeuroScriptParser#optional_param_list.
+	VisitOptional_param_list(ctx *Optional_param_listContext) interface{}
+
+	// Visit a parse tree produced by NeuroScriptParser#optional_param.
+	VisitOptional_param(ctx *Optional_paramContext) interface{}
+
+	// Visit a parse tree produced by NeuroScriptParser#lambda_expr.
+	VisitLambda_expr(ctx *Lambda_exprContext) interface{}
+
+	// Visit a parse tree produced by NeuroScriptParser#else_clause.
+	VisitElse_clause(ctx *Else_clauseContext) interface{}
+
+	// Visit a parse tree produced by NeuroScriptParser#switch_statement.
+	VisitSwitch_statement(ctx *Switch_statementContext) interface{}
+
+	// Visit a parse tree produced by NeuroScriptParser#switch_case.
+	VisitSwitch_case(ctx *Switch_caseContext) interface{}
+
+	// Visit a parse tree produced by NeuroScriptParser#try_statement.
+	VisitTry_statement(ctx *Try_statementContext) interface{}
+
+	// Visit a parse tree produced by NeuroScriptParser#subscript.
+	VisitSubscript(ctx *SubscriptContext) interface{}
+
+	// Visit a parse tree produced by NeuroScriptParser#raise_statement.
+	VisitRaise_statement(ctx *Raise_statementContext) interface{}
 }
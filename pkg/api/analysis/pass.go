@@ -1,6 +1,6 @@
 // NeuroScript Version: 0.8.0
-// File version: 5
-// Purpose: Updated analysis interfaces to be context-aware, allowing for cancellation.
+// File version: 6
+// Purpose: Context-aware analysis interfaces; registers the shape and undefined-variable passes.
 // filename: pkg/api/analysis/pass.go
 // nlines: 76
 // risk_rating: HIGH
@@ -104,4 +104,5 @@ func (v *shapeVisitor) visit(node interfaces.Node, inCommand bool) {
 // Automatically register the built-in passes.
 func init() {
 	RegisterPass(&ShapeValidatorPass{})
+	RegisterPass(&UndefinedVarsPass{})
 }
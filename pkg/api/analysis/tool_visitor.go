@@ -1,6 +1,6 @@
 // NeuroScript Version: 0.8.0
-// File version: 5
-// Purpose: Corrects visitor guard logic to check struct fields directly, not compare the struct to nil.
+// File version: 13
+// Purpose: Records every tool reference and literally named event raise, including those inside function literals and event guards, with its source position.
 // filename: pkg/api/analysis/tool_visitor.go
 // nlines: 105+
 // risk_rating: HIGH
@@ -9,14 +9,57 @@ package analysis
 
 import (
 	"reflect"
+	"strings"
 
 	"github.com/aprice2704/neuroscript/pkg/ast"
 	"github.com/aprice2704/neuroscript/pkg/interfaces"
+	"github.com/aprice2704/neuroscript/pkg/types"
 )
 
-// toolVisitor walks the AST and collects the names of all referenced tools.
+// ToolRef is a single reference to a tool in a script.
+type ToolRef struct {
+	Name    string          // Tool name without the "tool." prefix, e.g. "fs.read"
+	Pos     *types.Position // Position of the call; nil for trees without positions
+	InGuard bool            // The call is inside an event handler's 'where' guard
+}
+
+// EventRaise is a statically visible place where a script raises an event:
+// a 'raise event' statement or a tool.timer.Schedule call. Only raises whose
+// event name is a string literal are recorded.
+type EventRaise struct {
+	Event string          // The event name, e.g. "orders.created"
+	Via   string          // "raise event" or "tool.timer.Schedule"
+	Pos   *types.Position // Position of the statement or call
+}
+
+// toolVisitor walks the AST and collects every tool reference and event
+// raise in source order.
 type toolVisitor struct {
-	requiredTools map[string]struct{} // Using a map as a set for unique names
+	refs    []ToolRef
+	raises  []EventRaise
+	inGuard bool
+}
+
+// FindToolReferences analyzes the AST and returns every tool call it contains,
+// including repeated calls to the same tool, in traversal order.
+func FindToolReferences(tree *interfaces.Tree) []ToolRef {
+	if tree == nil || tree.Root == nil {
+		return nil
+	}
+	visitor := &toolVisitor{}
+	visitor.visit(tree.Root)
+	return visitor.refs
+}
+
+// FindEventRaises returns every raise of a literally named event in the
+// tree, in traversal order.
+func FindEventRaises(tree *interfaces.Tree) []EventRaise {
+	if tree == nil || tree.Root == nil {
+		return nil
+	}
+	visitor := &toolVisitor{}
+	visitor.visit(tree.Root)
+	return visitor.raises
 }
 
 // FindRequiredTools analyzes the AST and returns a set of unique tool names used.
@@ -24,11 +67,11 @@ func FindRequiredTools(tree *interfaces.Tree) map[string]struct{} {
 	if tree == nil || tree.Root == nil {
 		return nil
 	}
-	visitor := &toolVisitor{
-		requiredTools: make(map[string]struct{}),
+	requiredTools := make(map[string]struct{}) // Using a map as a set for unique names
+	for _, ref := range FindToolReferences(tree) {
+		requiredTools[ref.Name] = struct{}{}
 	}
-	visitor.visit(tree.Root)
-	return visitor.requiredTools
+	return requiredTools
 }
 
 // visit recursively traverses the AST nodes.
@@ -66,6 +109,9 @@ func (v *toolVisitor) visit(node interfaces.Node) {
 			v.visitStep(n.ErrorHandlers[i])
 		}
 	case *ast.OnEventDecl:
+		v.inGuard = true
+		v.visitExpression(n.GuardExpr)
+		v.inGuard = false
 		for i := range n.Body {
 			v.visitStep(&n.Body[i])
 		}
@@ -81,6 +127,11 @@ func (v *toolVisitor) visitStep(step *ast.Step) {
 	if step == nil {
 		return
 	}
+	if strings.EqualFold(step.Type, "raise") && len(step.Values) > 0 {
+		if name, ok := step.Values[0].(*ast.StringLiteralNode); ok {
+			v.raises = append(v.raises, EventRaise{Event: name.Value, Via: "raise event", Pos: step.GetPos()})
+		}
+	}
 	// Visit expressions within the step
 	v.visitExpression(step.Cond)
 	v.visitExpression(step.Collection)
@@ -111,6 +162,27 @@ func (v *toolVisitor) visitStep(step *ast.Step) {
 	for i := range step.ElseBody {
 		v.visitStep(&step.ElseBody[i])
 	}
+	if sw := step.SwitchStmt; sw != nil {
+		v.visitExpression(sw.Subject)
+		for _, c := range sw.Cases {
+			for _, val := range c.Values {
+				v.visitExpression(val)
+			}
+			for i := range c.Body {
+				v.visitStep(&c.Body[i])
+			}
+		}
+		for i := range sw.Default {
+			v.visitStep(&sw.Default[i])
+		}
+	}
+	if t := step.TryStmt; t != nil {
+		for _, block := range [][]ast.Step{t.Body, t.Catch, t.Finally} {
+			for i := range block {
+				v.visitStep(&block[i])
+			}
+		}
+	}
 }
 
 // visitExpression checks if an expression is a tool call and recurses.
@@ -124,7 +196,10 @@ func (v *toolVisitor) visitExpression(expr ast.Expression) {
 	if callExpr, ok := expr.(*ast.CallableExprNode); ok {
 		// FIX: Check the fields of the Target struct directly. Do not compare the struct to nil.
 		if callExpr.Target.IsTool && callExpr.Target.Name != "" {
-			v.requiredTools[callExpr.Target.Name] = struct{}{}
+			v.refs = append(v.refs, ToolRef{Name: callExpr.Target.Name, Pos: callExpr.GetPos(), InGuard: v.inGuard})
+			if strings.EqualFold(callExpr.Target.Name, "timer.Schedule") {
+				v.timerRaise(callExpr)
+			}
 		}
 	}
 
@@ -134,6 +209,9 @@ func (v *toolVisitor) visitExpression(expr ast.Expression) {
 		for _, arg := range e.Arguments {
 			v.visitExpression(arg)
 		}
+		for _, named := range e.NamedArgs {
+			v.visitExpression(named.Value)
+		}
 	case *ast.ListLiteralNode:
 		for _, elem := range e.Elements {
 			v.visitExpression(elem)
@@ -147,6 +225,10 @@ func (v *toolVisitor) visitExpression(expr ast.Expression) {
 	case *ast.ElementAccessNode:
 		v.visitExpression(e.Collection)
 		v.visitExpression(e.Accessor)
+	case *ast.SliceNode:
+		v.visitExpression(e.Collection)
+		v.visitExpression(e.Start)
+		v.visitExpression(e.End)
 	case *ast.UnaryOpNode:
 		v.visitExpression(e.Operand)
 	case *ast.BinaryOpNode:
@@ -156,5 +238,30 @@ func (v *toolVisitor) visitExpression(expr ast.Expression) {
 		v.visitExpression(e.Argument)
 	case *ast.TypeOfNode:
 		v.visitExpression(e.Argument)
+	case *ast.LambdaNode:
+		v.visitExpression(e.Body)
+		for i := range e.Steps {
+			v.visitStep(&e.Steps[i])
+		}
+		for _, handler := range e.ErrorHandlers {
+			v.visitStep(handler)
+		}
+	}
+}
+
+// timerRaise records the event a tool.timer.Schedule call will raise, taken
+// from its first positional argument or its 'event' named argument.
+func (v *toolVisitor) timerRaise(call *ast.CallableExprNode) {
+	var arg ast.Expression
+	if len(call.Arguments) > 0 {
+		arg = call.Arguments[0]
+	}
+	for _, named := range call.NamedArgs {
+		if named.Name == "event" {
+			arg = named.Value
+		}
+	}
+	if name, ok := arg.(*ast.StringLiteralNode); ok {
+		v.raises = append(v.raises, EventRaise{Event: name.Value, Via: "tool.timer.Schedule", Pos: call.GetPos()})
 	}
 }
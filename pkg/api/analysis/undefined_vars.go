@@ -0,0 +1,284 @@
+// NeuroScript Version: 0.8.0
+// File version: 6
+// Purpose: Static pass reporting variable reads that no earlier statement in the same block could have set.
+// filename: pkg/api/analysis/undefined_vars.go
+// nlines: 284
+// risk_rating: LOW
+
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/interfaces"
+	"github.com/aprice2704/neuroscript/pkg/types"
+)
+
+// implicitVariables are set by the interpreter rather than by the script.
+var implicitVariables = []string{"self", "stdout", "stderr", "system_error_message"}
+
+// UndefinedVarsPass reports reads of variables that are not a parameter and
+// are not set by any earlier statement in the same procedure, command block or
+// event handler. Host-provided globals are invisible to it, so its findings are
+// warnings: in strict mode each one is an error if the host did not set it.
+type UndefinedVarsPass struct{}
+
+func (p *UndefinedVarsPass) Name() string { return "undefined-vars" }
+
+func (p *UndefinedVarsPass) Analyse(ctx context.Context, tree *interfaces.Tree) []types.Diag {
+	if tree == nil || tree.Root == nil {
+		return nil
+	}
+	program, ok := tree.Root.(*ast.Program)
+	if !ok {
+		return nil
+	}
+	return FindUndefinedReads(program)
+}
+
+// FindUndefinedReads returns a warning for each possibly-undefined variable
+// read in program, in a stable order.
+func FindUndefinedReads(program *ast.Program) []types.Diag {
+	if program == nil {
+		return nil
+	}
+	v := &undefinedVarsVisitor{procs: program.Procedures}
+
+	procNames := make([]string, 0, len(program.Procedures))
+	for name := range program.Procedures {
+		procNames = append(procNames, name)
+	}
+	sort.Strings(procNames)
+	for _, name := range procNames {
+		proc := program.Procedures[name]
+		params := append([]string{proc.VariadicParamName}, proc.RequiredParams...)
+		for _, opt := range proc.OptionalParams {
+			params = append(params, opt.Name)
+		}
+		v.visitBlock(proc.Steps, proc.ErrorHandlers, params...)
+	}
+	for _, cmd := range program.Commands {
+		v.visitBlock(cmd.Body, cmd.ErrorHandlers)
+	}
+	for _, event := range program.Events {
+		// The 'where' guard runs with the event variable bound, before the body.
+		v.enterScope(event.EventVarName)
+		v.visitExpr(event.GuardExpr)
+		v.visitSteps(event.Body)
+	}
+	return v.diags
+}
+
+type undefinedVarsVisitor struct {
+	procs   map[string]*ast.Procedure
+	defined map[string]bool
+	diags   []types.Diag
+}
+
+// visitBlock checks one top-level body in a fresh scope. Like the language
+// server's check, it is order-aware but not branch-aware: a variable set on
+// either side of an 'if' counts as set from then on.
+func (v *undefinedVarsVisitor) visitBlock(body []ast.Step, handlers []*ast.Step, names ...string) {
+	v.enterScope(names...)
+	v.visitSteps(body)
+	for _, handler := range handlers {
+		v.visitStep(handler)
+	}
+}
+
+// enterScope starts a fresh scope holding the implicit variables and names.
+func (v *undefinedVarsVisitor) enterScope(names ...string) {
+	v.defined = make(map[string]bool)
+	for _, name := range implicitVariables {
+		v.defined[name] = true
+	}
+	for _, name := range names {
+		if name != "" {
+			v.defined[name] = true
+		}
+	}
+}
+
+func (v *undefinedVarsVisitor) visitSteps(steps []ast.Step) {
+	for i := range steps {
+		v.visitStep(&steps[i])
+	}
+}
+
+func (v *undefinedVarsVisitor) visitStep(step *ast.Step) {
+	if step == nil {
+		return
+	}
+	// Reads happen before the step's own writes: 'set x = x + 1' reads x first.
+	for _, val := range step.Values {
+		v.visitExpr(val)
+	}
+	v.visitExpr(step.Cond)
+	v.visitExpr(step.Collection)
+	if step.Call != nil {
+		v.visitExpr(step.Call)
+	}
+	if step.AskStmt != nil {
+		v.visitExpr(step.AskStmt.AgentModelExpr)
+		v.visitExpr(step.AskStmt.PromptExpr)
+		v.visitExpr(step.AskStmt.WithOptions)
+	}
+	if step.PromptUserStmt != nil {
+		v.visitExpr(step.PromptUserStmt.PromptExpr)
+	}
+	if step.WhisperStmt != nil {
+		v.visitExpr(step.WhisperStmt.Handle)
+		v.visitExpr(step.WhisperStmt.Value)
+	}
+	if step.ExpressionStmt != nil {
+		v.visitExpr(step.ExpressionStmt.Expression)
+	}
+
+	for _, lval := range step.LValues {
+		v.define(lval)
+	}
+	if step.AskStmt != nil {
+		v.define(step.AskStmt.IntoTarget)
+	}
+	if step.PromptUserStmt != nil {
+		v.define(step.PromptUserStmt.IntoTarget)
+	}
+	if step.LoopVarName != "" {
+		v.defined[step.LoopVarName] = true
+	}
+	if step.IndexVarName != "" {
+		v.defined[step.IndexVarName] = true
+	}
+
+	v.visitSteps(step.Body)
+	v.visitSteps(step.ElseBody)
+	if sw := step.SwitchStmt; sw != nil {
+		v.visitExpr(sw.Subject)
+		for _, c := range sw.Cases {
+			for _, val := range c.Values {
+				v.visitExpr(val)
+			}
+			v.visitSteps(c.Body)
+		}
+		v.visitSteps(sw.Default)
+	}
+	if t := step.TryStmt; t != nil {
+		v.visitSteps(t.Body)
+		if t.CatchVar != "" {
+			v.defined[t.CatchVar] = true
+		}
+		v.visitSteps(t.Catch)
+		v.visitSteps(t.Finally)
+	}
+}
+
+// define records an assignment target. Accessor keys on it are still reads.
+func (v *undefinedVarsVisitor) define(lval *ast.LValueNode) {
+	if lval == nil {
+		return
+	}
+	for _, acc := range lval.Accessors {
+		if acc != nil {
+			v.visitExpr(acc.Key)
+		}
+	}
+	v.defined[lval.Identifier] = true
+}
+
+func (v *undefinedVarsVisitor) visitExpr(expr ast.Expression) {
+	if expr == nil || (reflect.ValueOf(expr).Kind() == reflect.Ptr && reflect.ValueOf(expr).IsNil()) {
+		return
+	}
+	switch e := expr.(type) {
+	case *ast.VariableNode:
+		v.checkRead(e.Name, e.GetPos())
+	case *ast.LValueNode:
+		v.checkRead(e.Identifier, e.GetPos())
+		for _, acc := range e.Accessors {
+			if acc != nil {
+				v.visitExpr(acc.Key)
+			}
+		}
+	case *ast.CallableExprNode:
+		for _, arg := range e.Arguments {
+			v.visitExpr(arg)
+		}
+		for _, named := range e.NamedArgs {
+			v.visitExpr(named.Value)
+		}
+	case *ast.BinaryOpNode:
+		v.visitExpr(e.Left)
+		v.visitExpr(e.Right)
+	case *ast.UnaryOpNode:
+		v.visitExpr(e.Operand)
+	case *ast.TypeOfNode:
+		v.visitExpr(e.Argument)
+	case *ast.EvalNode:
+		v.visitExpr(e.Argument)
+	case *ast.ListLiteralNode:
+		for _, elem := range e.Elements {
+			v.visitExpr(elem)
+		}
+	case *ast.MapLiteralNode:
+		for _, entry := range e.Entries {
+			if entry != nil {
+				v.visitExpr(entry.Key)
+				v.visitExpr(entry.Value)
+			}
+		}
+	case *ast.ElementAccessNode:
+		v.visitExpr(e.Collection)
+		v.visitExpr(e.Accessor)
+	case *ast.SliceNode:
+		v.visitExpr(e.Collection)
+		v.visitExpr(e.Start)
+		v.visitExpr(e.End)
+	case *ast.InterpolatedStringNode:
+		for _, part := range e.Parts {
+			v.visitExpr(part)
+		}
+	case *ast.LambdaNode:
+		v.visitLambda(e)
+	}
+}
+
+// visitLambda checks a function literal's body in its own scope: the names
+// set so far, which it captures, plus its parameters. Nothing it sets is
+// visible outside it.
+func (v *undefinedVarsVisitor) visitLambda(fn *ast.LambdaNode) {
+	outer := v.defined
+	v.defined = make(map[string]bool, len(outer)+len(fn.Params))
+	for name := range outer {
+		v.defined[name] = true
+	}
+	for _, name := range fn.Params {
+		v.defined[name] = true
+	}
+	v.visitExpr(fn.Body)
+	v.visitSteps(fn.Steps)
+	for _, handler := range fn.ErrorHandlers {
+		v.visitStep(handler)
+	}
+	v.defined = outer
+}
+
+func (v *undefinedVarsVisitor) checkRead(name string, pos *types.Position) {
+	if v.defined[name] {
+		return
+	}
+	if _, isProc := v.procs[name]; isProc {
+		return
+	}
+	v.diags = append(v.diags, types.Diag{
+		Position: pos,
+		Severity: types.SeverityWarning,
+		Source:   "undefined-vars",
+		Message:  fmt.Sprintf("variable '%s' may be undefined: it is read before anything in this block sets it", name),
+	})
+	// Report each name once per block.
+	v.defined[name] = true
+}
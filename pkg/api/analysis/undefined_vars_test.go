@@ -0,0 +1,97 @@
+// NeuroScript Version: 0.8.0
+// File version: 4
+// Purpose: Tests the static pass that reports possibly-undefined variable reads.
+// filename: pkg/api/analysis/undefined_vars_test.go
+// nlines: 97
+// risk_rating: LOW
+
+package analysis_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/api"
+	"github.com/aprice2704/neuroscript/pkg/api/analysis"
+	"github.com/aprice2704/neuroscript/pkg/ast"
+)
+
+func TestFindUndefinedReads(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+		want   []string
+	}{
+		{
+			name:   "Parameters and earlier sets are defined",
+			script: "func main(needs a optional b returns r) means\n set c = a + b\n return c\nendfunc",
+		},
+		{
+			name:   "Typo is reported once",
+			script: "func main(returns r) means\n set count = 1\n set x = cuont + cuont\n return cuont\nendfunc",
+			want:   []string{"cuont"},
+		},
+		{
+			name:   "Read before set",
+			script: "command\n emit x\n set x = 1\nendcommand",
+			want:   []string{"x"},
+		},
+		{
+			name:   "Self-referencing set",
+			script: "command\n set total = total + 1\nendcommand",
+			want:   []string{"total"},
+		},
+		{
+			name:   "Loop variables and implicit names",
+			script: "command\n for each item in [1, 2]\n  emit item\n endfor\n emit stdout\nendcommand",
+		},
+		{
+			name:   "Set inside a branch counts afterwards",
+			script: "command\n if true\n  set y = 1\n endif\n emit y\nendcommand",
+		},
+		{
+			name:   "Blocks have separate scopes",
+			script: "func one() means\n set shared = 1\nendfunc\n\nfunc two(returns r) means\n return shared\nendfunc",
+			want:   []string{"shared"},
+		},
+		{
+			name:   "Function literal sees its parameters and captured names",
+			script: "command\n set n = 1\n set f = func(x) means x + n + later\n set inner = 0\n set g = func() means\n  set inner = 2\n  return inner\n endfunc\n emit x\n set later = 1\nendcommand",
+			want:   []string{"later", "x"},
+		},
+		{
+			name:   "Catch variable is defined in the catch block",
+			script: "command\n try\n  emit 1\n catch err\n  emit err\n finally\n  emit err2\n endtry\nendcommand",
+			want:   []string{"err2"},
+		},
+		{
+			name:   "Event variable is defined",
+			script: "on event \"foo\" as ev do\n emit ev\nendon",
+		},
+		{
+			name:   "Event guard sees the event variable",
+			script: "on event \"jobs\" where ev.payload[0].Payload.priority > limit as ev do\n emit ev\nendon",
+			want:   []string{"limit"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree, err := api.Parse([]byte(tt.script), api.ParseSkipComments)
+			if err != nil {
+				t.Fatalf("api.Parse failed: %v", err)
+			}
+			var got []string
+			for _, d := range analysis.FindUndefinedReads(tree.Root.(*ast.Program)) {
+				if d.Position == nil || !strings.Contains(d.Message, "may be undefined") {
+					t.Errorf("unexpected diagnostic: %+v", d)
+				}
+				got = append(got, strings.SplitN(d.Message, "'", 3)[1])
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FindUndefinedReads() reported %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,8 +1,90 @@
+// NeuroScript Version: 0.8.0
+// File version: 3
+// Purpose: Defines the content-addressed Cache interface and an in-memory LRU implementation.
+// filename: pkg/api/cache.go
+// nlines: 95
+// risk_rating: MEDIUM
+
 package api
 
-// Cache is an interface for a content-addressed cache.
-// A full implementation will be provided later.
+import (
+	"container/list"
+	"sync"
+)
+
+// Cache is an interface for a content-addressed cache. Keys are 32-byte
+// blake2b sums; values are canonical AST blobs. Implementations must be safe
+// for concurrent use and must not retain or mutate the slices passed to Put
+// or returned from Get after the call returns.
+//
+// ParseWithCache keys entries by a hash of the source text and stores the
+// blob together with the tree's source positions. Load (via
+// LoaderConfig.Cache) keys entries by the canonical hash of the blob.
 type Cache interface {
 	Get(key [32]byte) ([]byte, bool)
 	Put(key [32]byte, value []byte) error
 }
+
+// LRUCache is an in-memory Cache that evicts the least recently used entry
+// once it holds maxEntries values.
+type LRUCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	order      *list.List // front = most recently used
+	entries    map[[32]byte]*list.Element
+}
+
+type lruEntry struct {
+	key   [32]byte
+	value []byte
+}
+
+var _ Cache = (*LRUCache)(nil)
+
+// NewLRUCache creates an LRUCache holding at most maxEntries values.
+// A non-positive maxEntries means the cache is unbounded.
+func NewLRUCache(maxEntries int) *LRUCache {
+	return &LRUCache{
+		maxEntries: maxEntries,
+		order:      list.New(),
+		entries:    make(map[[32]byte]*list.Element),
+	}
+}
+
+// Get returns a copy of the value stored under key.
+func (c *LRUCache) Get(key [32]byte) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	return append([]byte(nil), el.Value.(*lruEntry).value...), true
+}
+
+// Put stores a copy of value under key, evicting the oldest entry if full.
+func (c *LRUCache) Put(key [32]byte, value []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v := append([]byte(nil), value...)
+	if el, ok := c.entries[key]; ok {
+		el.Value.(*lruEntry).value = v
+		c.order.MoveToFront(el)
+		return nil
+	}
+	c.entries[key] = c.order.PushFront(&lruEntry{key: key, value: v})
+	if c.maxEntries > 0 && c.order.Len() > c.maxEntries {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry).key)
+	}
+	return nil
+}
+
+// Len reports the number of entries currently held.
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
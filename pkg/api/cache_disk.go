@@ -0,0 +1,88 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Implements an on-disk, content-addressed Cache with one file per key.
+// filename: pkg/api/cache_disk.go
+// nlines: 75
+// risk_rating: MEDIUM
+
+package api
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// DiskCache is a Cache that stores each value in its own file under a
+// directory, named by the hex encoding of its key. Writes go through a
+// temporary file and a rename, so concurrent readers never observe a
+// partially written entry and several processes may share one directory.
+type DiskCache struct {
+	dir string
+}
+
+var _ Cache = (*DiskCache)(nil)
+
+// NewDiskCache returns a DiskCache rooted at dir, creating it if needed.
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if dir == "" {
+		return nil, errors.New("disk cache directory must not be empty")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating disk cache directory: %w", err)
+	}
+	return &DiskCache{dir: dir}, nil
+}
+
+func (c *DiskCache) path(key [32]byte) string {
+	name := hex.EncodeToString(key[:])
+	// Fan out on the first byte to keep directories small.
+	return filepath.Join(c.dir, name[:2], name)
+}
+
+// Get reads the value stored under key. Any read error is reported as a miss.
+func (c *DiskCache) Get(key [32]byte) ([]byte, bool) {
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// Put writes value under key atomically.
+func (c *DiskCache) Put(key [32]byte, value []byte) error {
+	target := c.path(key)
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return fmt.Errorf("disk cache put: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(target), ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("disk cache put: %w", err)
+	}
+	if _, err := tmp.Write(value); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("disk cache put: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("disk cache put: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), target); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("disk cache put: %w", err)
+	}
+	return nil
+}
+
+// Remove deletes the entry for key, if present.
+func (c *DiskCache) Remove(key [32]byte) error {
+	err := os.Remove(c.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,277 @@
+// NeuroScript Version: 0.8.0
+// File version: 5
+// Purpose: Tests the LRU and disk caches and their use by ParseWithCache and Load.
+// filename: pkg/api/cache_test.go
+// nlines: 277
+// risk_rating: MEDIUM
+
+package api_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/api"
+	"github.com/aprice2704/neuroscript/pkg/ast"
+)
+
+// countingCache wraps a Cache and counts hits and puts.
+type countingCache struct {
+	api.Cache
+	mu         sync.Mutex
+	hits, puts int
+}
+
+func (c *countingCache) Get(key [32]byte) ([]byte, bool) {
+	v, ok := c.Cache.Get(key)
+	if ok {
+		c.mu.Lock()
+		c.hits++
+		c.mu.Unlock()
+	}
+	return v, ok
+}
+
+func (c *countingCache) Put(key [32]byte, value []byte) error {
+	c.mu.Lock()
+	c.puts++
+	c.mu.Unlock()
+	return c.Cache.Put(key, value)
+}
+
+func TestLRUCache_Eviction(t *testing.T) {
+	c := api.NewLRUCache(2)
+	k1, k2, k3 := [32]byte{1}, [32]byte{2}, [32]byte{3}
+	_ = c.Put(k1, []byte("one"))
+	_ = c.Put(k2, []byte("two"))
+	if _, ok := c.Get(k1); !ok { // k1 is now most recently used
+		t.Fatal("k1 missing before eviction")
+	}
+	_ = c.Put(k3, []byte("three"))
+
+	if _, ok := c.Get(k2); ok {
+		t.Error("k2 should have been evicted as least recently used")
+	}
+	if v, ok := c.Get(k1); !ok || string(v) != "one" {
+		t.Errorf("k1 = %q, %v; want one, true", v, ok)
+	}
+	if c.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", c.Len())
+	}
+
+	v, _ := c.Get(k3)
+	v[0] = 'X'
+	if again, _ := c.Get(k3); string(again) != "three" {
+		t.Errorf("cached value was mutated through a returned slice: %q", again)
+	}
+}
+
+func TestDiskCache_RoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	c, err := api.NewDiskCache(dir)
+	if err != nil {
+		t.Fatalf("NewDiskCache() failed: %v", err)
+	}
+	key := [32]byte{0xab, 0xcd}
+	if _, ok := c.Get(key); ok {
+		t.Fatal("expected a miss on an empty cache")
+	}
+	if err := c.Put(key, []byte("blob")); err != nil {
+		t.Fatalf("Put() failed: %v", err)
+	}
+
+	// A second instance over the same directory sees the entry.
+	c2, _ := api.NewDiskCache(dir)
+	if v, ok := c2.Get(key); !ok || string(v) != "blob" {
+		t.Errorf("Get() = %q, %v; want blob, true", v, ok)
+	}
+	if err := c2.Remove(key); err != nil {
+		t.Fatalf("Remove() failed: %v", err)
+	}
+	if _, ok := c.Get(key); ok {
+		t.Error("entry still present after Remove()")
+	}
+}
+
+func TestParseWithCache_SkipsParsingOnHit(t *testing.T) {
+	src := []byte("command\n  emit \"from source\"\nendcommand\n")
+	cache := &countingCache{Cache: api.NewLRUCache(8)}
+
+	first, err := api.ParseWithCache(src, api.ParseSkipComments, cache)
+	if err != nil {
+		t.Fatalf("first ParseWithCache() failed: %v", err)
+	}
+	if cache.puts != 1 || cache.hits != 0 {
+		t.Fatalf("after miss: puts=%d hits=%d, want 1 and 0", cache.puts, cache.hits)
+	}
+	second, err := api.ParseWithCache(src, api.ParseSkipComments, cache)
+	if err != nil {
+		t.Fatalf("second ParseWithCache() failed: %v", err)
+	}
+	if cache.hits != 1 {
+		t.Errorf("expected a cache hit, got hits=%d", cache.hits)
+	}
+	b1, s1, _ := api.Canonicalise(first)
+	b2, s2, _ := api.Canonicalise(second)
+	if s1 != s2 || string(b1) != string(b2) {
+		t.Error("cached tree does not canonicalise identically to the parsed tree")
+	}
+
+	// Poison the entry with a different program: a hit must return it
+	// verbatim, proving the parser never ran.
+	otherSrc := []byte("command\n  emit \"from cache\"\nendcommand\n")
+	if _, err := api.ParseWithCache(otherSrc, api.ParseSkipComments, cache); err != nil {
+		t.Fatalf("ParseWithCache() of the other program failed: %v", err)
+	}
+	otherEntry, _ := cache.Get(api.SourceKey(otherSrc, api.ParseSkipComments))
+	_ = cache.Put(api.SourceKey(src, api.ParseSkipComments), otherEntry)
+	got, _ := api.ParseWithCache(src, api.ParseSkipComments, cache)
+	prog := got.Root.(*ast.Program)
+	if emitted := prog.Commands[0].Body[0].Values[0].String(); emitted != `"from cache"` {
+		t.Errorf("expected the cached program, got emit %s", emitted)
+	}
+
+	// A corrupt entry is ignored and replaced.
+	_ = cache.Put(api.SourceKey(src, api.ParseSkipComments), []byte("garbage"))
+	if _, err := api.ParseWithCache(src, api.ParseSkipComments, cache); err != nil {
+		t.Errorf("corrupt cache entry should fall back to parsing, got %v", err)
+	}
+}
+
+func TestParseWithCache_HitKeepsPositions(t *testing.T) {
+	src := []byte("func add(needs a, b returns r) means\n  set r = a + b\n  return r\nendfunc\n")
+	cache := api.NewLRUCache(8)
+	miss, err := api.ParseWithCache(src, api.ParseSkipComments, cache)
+	if err != nil {
+		t.Fatalf("ParseWithCache() miss failed: %v", err)
+	}
+	hit, err := api.ParseWithCache(src, api.ParseSkipComments, cache)
+	if err != nil {
+		t.Fatalf("ParseWithCache() hit failed: %v", err)
+	}
+	if cache.Len() != 1 {
+		t.Fatalf("expected the tree to be cached, Len() = %d", cache.Len())
+	}
+
+	want := miss.Root.(*ast.Program).Procedures["add"]
+	got, ok := hit.Root.(*ast.Program).Procedures["add"]
+	if !ok || len(got.Steps) != 2 {
+		t.Fatalf("a cache hit returned the wrong tree: %+v", hit.Root)
+	}
+	if got.GetPos() == nil || *got.GetPos() != *want.GetPos() {
+		t.Errorf("procedure position = %v, want %v", got.GetPos(), want.GetPos())
+	}
+	for i := range want.Steps {
+		if got.Steps[i].GetPos() == nil || *got.Steps[i].GetPos() != *want.Steps[i].GetPos() {
+			t.Errorf("step %d position = %v, want %v", i, got.Steps[i].GetPos(), want.Steps[i].GetPos())
+		}
+	}
+	if got.Steps[0].Values[0].GetPos() == nil || *got.Steps[0].Values[0].GetPos() != *want.Steps[0].Values[0].GetPos() {
+		t.Errorf("expression position = %v, want %v", got.Steps[0].Values[0].GetPos(), want.Steps[0].Values[0].GetPos())
+	}
+}
+
+func TestParseWithCache_CommentModes(t *testing.T) {
+	src := []byte("// leading\nfunc f() means\n  // inside\n  emit \"x\"\nendfunc\n")
+	cache := api.NewLRUCache(8)
+	for n := 0; n < 2; n++ {
+		skipped, err := api.ParseWithCache(src, api.ParseSkipComments, cache)
+		if err != nil {
+			t.Fatalf("ParseWithCache(skip) #%d failed: %v", n+1, err)
+		}
+		prog := skipped.Root.(*ast.Program)
+		if len(prog.Comments) != 0 || len(prog.Procedures["f"].Steps[0].Comments) != 0 {
+			t.Errorf("ParseSkipComments #%d kept comments", n+1)
+		}
+
+		kept, err := api.ParseWithCache(src, api.ParsePreserveComments, cache)
+		if err != nil {
+			t.Fatalf("ParseWithCache(preserve) #%d failed: %v", n+1, err)
+		}
+		keptProg := kept.Root.(*ast.Program)
+		if len(keptProg.Comments)+len(keptProg.Procedures["f"].Steps[0].Comments) == 0 {
+			t.Fatalf("ParsePreserveComments #%d dropped the comments", n+1)
+		}
+		want, _ := api.ParseWithCache(src, api.ParsePreserveComments, nil)
+		b1, s1, _ := api.Canonicalise(kept)
+		b2, s2, _ := api.Canonicalise(want)
+		if s1 != s2 || string(b1) != string(b2) {
+			t.Errorf("ParsePreserveComments #%d returned a different tree than an uncached parse", n+1)
+		}
+	}
+}
+
+func TestParseWithCache_GrammarChangeMisses(t *testing.T) {
+	src := []byte("on event \"tick\" where true named \"ticker\" do\n  emit \"tick\"\nendon\n")
+	dir := t.TempDir()
+	disk, err := api.NewDiskCache(dir)
+	if err != nil {
+		t.Fatalf("NewDiskCache() failed: %v", err)
+	}
+
+	// An entry written by a build with an older grammar...
+	current := api.GrammarVersion
+	api.GrammarVersion = "0.9.72"
+	if _, err := api.ParseWithCache(src, api.ParseSkipComments, disk); err != nil {
+		api.GrammarVersion = current
+		t.Fatalf("ParseWithCache() under the old grammar failed: %v", err)
+	}
+	api.GrammarVersion = current
+
+	// ...is never served to the current one.
+	reopened, _ := api.NewDiskCache(dir)
+	cache := &countingCache{Cache: reopened}
+	if _, err := api.ParseWithCache(src, api.ParseSkipComments, cache); err != nil {
+		t.Fatalf("ParseWithCache() failed: %v", err)
+	}
+	if cache.hits != 0 || cache.puts != 1 {
+		t.Errorf("hits=%d puts=%d, want 0 and 1: an entry from an older grammar was served", cache.hits, cache.puts)
+	}
+}
+
+func TestLoad_WithCache(t *testing.T) {
+	tree, err := api.Parse([]byte("command\n  emit \"ok\"\nendcommand"), api.ParseSkipComments)
+	if err != nil {
+		t.Fatalf("Parse() failed: %v", err)
+	}
+	blob, sum, err := api.Canonicalise(tree)
+	if err != nil {
+		t.Fatalf("Canonicalise() failed: %v", err)
+	}
+	signed := &api.SignedAST{Blob: blob, Sum: sum}
+	cache := &countingCache{Cache: api.NewLRUCache(8)}
+	cfg := api.LoaderConfig{SkipVerification: true, Cache: cache}
+
+	for n := 0; n < 2; n++ {
+		unit, err := api.Load(context.Background(), signed, cfg, nil)
+		if err != nil {
+			t.Fatalf("Load() #%d failed: %v", n+1, err)
+		}
+		if unit.Hash != sum {
+			t.Errorf("Load() #%d hash mismatch", n+1)
+		}
+	}
+	if cache.puts != 1 || cache.hits != 1 {
+		t.Errorf("puts=%d hits=%d, want 1 and 1", cache.puts, cache.hits)
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	src := []byte("func add(needs a, b returns r) means\n  set r = a + b\n  return r\nendfunc\n\nfunc greet(returns s) means\n  return \"hi\"\nendfunc\n")
+	b.Run("uncached", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			if _, err := api.ParseWithCache(src, api.ParseSkipComments, nil); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("lru", func(b *testing.B) {
+		c := api.NewLRUCache(16)
+		for n := 0; n < b.N; n++ {
+			if _, err := api.ParseWithCache(src, api.ParseSkipComments, c); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -1,6 +1,6 @@
 // NeuroScript Version: 0.8.0
-// File version: 13
-// Purpose: Corrects the test failure by using the required lowercase tool name in the policy's allow list.
+// File version: 14
+// Purpose: The failure case now expects the load-time policy preflight to refuse the script before checking run-time enforcement.
 // filename: pkg/api/capability_e2e_test.go
 // nlines: 118
 // risk_rating: HIGH
@@ -17,6 +17,7 @@ import (
 	"github.com/aprice2704/neuroscript/pkg/api"
 	"github.com/aprice2704/neuroscript/pkg/capability"
 	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/policy"
 )
 
 // secureFileWriteTool is a custom tool for testing that requires 'fs:write' capability.
@@ -118,7 +119,11 @@ func TestE2E_CapabilityCheck_Failure(t *testing.T) {
 	if err != nil {
 		t.Fatalf("api.Parse() failed: %v", err)
 	}
-	if err := api.LoadFromUnit(interp, &api.LoadedUnit{Tree: tree}); err != nil {
+	if err := api.LoadFromUnit(interp, &api.LoadedUnit{Tree: tree}); !errors.Is(err, policy.ErrCapability) {
+		t.Fatalf("Expected LoadFromUnit() to fail the policy preflight with ErrCapability, got: %v", err)
+	}
+	// Skip the preflight to confirm the run-time check refuses the call too.
+	if err := api.LoadFromUnit(interp, &api.LoadedUnit{Tree: tree}, api.SkipPolicyCheck()); err != nil {
 		t.Fatalf("api.LoadFromUnit() failed: %v", err)
 	}
 
@@ -0,0 +1,55 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: End-to-end test of function literals passed to the higher-order list tools.
+// filename: pkg/api/closures_test.go
+// nlines: 55
+// risk_rating: LOW
+
+package api_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/api"
+)
+
+func TestAPI_FunctionLiteralsWithListTools(t *testing.T) {
+	scriptContent := `
+func pipeline(returns out) means
+	set factor = 3
+	set scaled = tool.list.Map([3, 1, 2], func(x) means x * factor)
+	set big = tool.list.Filter(scaled, func(x) means x > 3)
+	set total = tool.list.Reduce(big, func(acc, x) means acc + x, 0)
+	set sorted = tool.list.SortBy(scaled, func(x) means 0 - x)
+	return [total, sorted]
+endfunc
+`
+	policy := api.NewPolicyBuilder(api.ContextNormal).
+		Allow("tool.list.*").
+		Build()
+
+	hc := newTestHostContext(nil)
+	interp := api.New(
+		api.WithHostContext(hc),
+		api.WithExecPolicy(policy),
+	)
+	tree, err := api.Parse([]byte(scriptContent), api.ParseSkipComments)
+	if err != nil {
+		t.Fatalf("api.Parse failed: %v", err)
+	}
+	if _, err := api.ExecWithInterpreter(context.Background(), interp, tree); err != nil {
+		t.Fatalf("api.ExecWithInterpreter failed to load definitions: %v", err)
+	}
+
+	result, err := api.RunProcedure(context.Background(), interp, "pipeline")
+	if err != nil {
+		t.Fatalf("api.RunProcedure failed unexpectedly: %v", err)
+	}
+	unwrapped, _ := api.Unwrap(result)
+	want := []any{int64(15), []any{int64(9), int64(6), int64(3)}}
+	if !reflect.DeepEqual(unwrapped, want) {
+		t.Errorf("got %#v, want %#v", unwrapped, want)
+	}
+}
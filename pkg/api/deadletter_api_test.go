@@ -0,0 +1,70 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Tests handler retries and the dead-letter store through the public API with a fake clock.
+// filename: pkg/api/deadletter_api_test.go
+// nlines: 70
+// risk_rating: LOW
+
+package api_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aprice2704/neuroscript/pkg/api"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/logging"
+)
+
+func TestInterpreter_DeadLetters(t *testing.T) {
+	runs := 0
+	hc, err := api.NewHostContextBuilder().
+		WithLogger(logging.NewNoOpLogger()).
+		WithStdout(io.Discard).
+		WithStdin(os.Stdin).
+		WithStderr(io.Discard).
+		WithEmitFunc(func(lang.Value) { runs++ }).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to build host context: %v", err)
+	}
+	var buried []api.DeadLetter
+	clock := api.NewFakeClock(time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC))
+	interp := api.New(
+		api.WithHostContext(hc),
+		api.WithClock(clock),
+		api.WithDeadLetters(api.DeadLetterConfig{
+			Retry:        api.EventRetryPolicy{MaxAttempts: 3, Backoff: time.Second},
+			OnDeadLetter: func(dl api.DeadLetter) { buried = append(buried, dl) },
+		}),
+	)
+
+	src := "on event \"order.paid\" as ev do\n  emit \"charging\"\n  fail \"card declined\"\nendon\n"
+	tree, err := api.Parse([]byte(src), api.ParseSkipComments)
+	if err != nil {
+		t.Fatalf("api.Parse failed: %v", err)
+	}
+	if _, err := api.ExecWithInterpreter(context.Background(), interp, tree); err != nil {
+		t.Fatalf("api.ExecWithInterpreter failed: %v", err)
+	}
+
+	interp.EmitEvent("order.paid", "billing", lang.NewMapValue(nil))
+	clock.Advance(time.Minute)
+	if runs != 3 {
+		t.Errorf("Expected the handler to run 3 times, got %d", runs)
+	}
+	if len(buried) != 1 || buried[0].Attempts != 3 || buried[0].EventName != "order.paid" {
+		t.Fatalf("Expected one dead letter after 3 attempts, got %+v", buried)
+	}
+
+	letters := interp.DeadLetters()
+	if err := letters.Replay(buried[0].ID); err == nil {
+		t.Error("Expected the replay of a failing handler to return its error")
+	}
+	if !letters.Discard(buried[0].ID) || len(letters.List()) != 0 {
+		t.Errorf("Expected Discard to empty the store, got %+v", letters.List())
+	}
+}
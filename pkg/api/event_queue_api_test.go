@@ -0,0 +1,129 @@
+// NeuroScript Version: 0.8.0
+// File version: 2
+// Purpose: Tests the public event queue option together with the drain and shutdown API, and metering from concurrent handlers.
+// filename: pkg/api/event_queue_api_test.go
+// nlines: 129
+// risk_rating: LOW
+
+package api_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aprice2704/neuroscript/pkg/api"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/logging"
+)
+
+func TestInterpreter_WithEventQueue(t *testing.T) {
+	var mu sync.Mutex
+	var emitted []string
+	hc, err := api.NewHostContextBuilder().
+		WithLogger(logging.NewNoOpLogger()).
+		WithStdout(io.Discard).
+		WithStdin(os.Stdin).
+		WithStderr(io.Discard).
+		WithEmitFunc(func(v lang.Value) {
+			s, _ := lang.ToString(v)
+			mu.Lock()
+			emitted = append(emitted, s)
+			mu.Unlock()
+		}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to build host context: %v", err)
+	}
+	interp := api.New(
+		api.WithHostContext(hc),
+		api.WithEventQueue(api.EventQueueConfig{Workers: 2, Ordering: api.EventOrderPerName}),
+	)
+
+	tree, err := api.Parse([]byte("on event \"tick\" as ev do\n  emit ev.payload[0].Payload.n\nendon\n"), api.ParseSkipComments)
+	if err != nil {
+		t.Fatalf("api.Parse failed: %v", err)
+	}
+	if _, err := api.ExecWithInterpreter(context.Background(), interp, tree); err != nil {
+		t.Fatalf("api.ExecWithInterpreter failed: %v", err)
+	}
+
+	for n := 1; n <= 3; n++ {
+		payload, _ := lang.Wrap(map[string]any{"n": n})
+		interp.EmitEvent("tick", "host", payload)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := interp.ShutdownEvents(ctx); err != nil {
+		t.Fatalf("ShutdownEvents failed: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(emitted) != 3 || emitted[0] != "1" || emitted[2] != "3" {
+		t.Errorf("Expected handlers to emit 1, 2, 3 in order, got %q", emitted)
+	}
+	if stats, ok := interp.EventQueueStats(); !ok || stats.Dispatched != 3 {
+		t.Errorf("Expected 3 dispatched events, got %+v (ok=%v)", stats, ok)
+	}
+}
+
+// TestInterpreter_EventQueueMetersConcurrently runs handlers that call a
+// metered tool on several workers at once. Run it with -race.
+func TestInterpreter_EventQueueMetersConcurrently(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "data.txt"), []byte("tick"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	hc, err := api.NewHostContextBuilder().
+		WithLogger(logging.NewNoOpLogger()).
+		WithStdout(io.Discard).
+		WithStdin(os.Stdin).
+		WithStderr(io.Discard).
+		WithEmitFunc(func(lang.Value) {}).
+		WithEventHandlerErrorCallback(func(eventName, source string, err *api.RuntimeError) {
+			t.Errorf("Handler for '%s' failed: %v", eventName, err)
+		}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to build host context: %v", err)
+	}
+	pol := api.NewPolicyBuilder(api.ContextConfig).
+		Allow("tool.fs.read").
+		Grant("fs:read:*").
+		LimitFS(1000, 1<<20).
+		Build()
+	interp := api.New(
+		api.WithHostContext(hc),
+		api.WithExecPolicy(pol),
+		api.WithSandboxDir(dir),
+		api.WithEventQueue(api.EventQueueConfig{Workers: 8, Ordering: api.EventOrderNone}),
+	)
+
+	src := "on event \"tick\" do\n  set data = tool.fs.Read(\"data.txt\")\nendon\n"
+	tree, err := api.Parse([]byte(src), api.ParseSkipComments)
+	if err != nil {
+		t.Fatalf("api.Parse failed: %v", err)
+	}
+	if _, err := api.ExecWithInterpreter(context.Background(), interp, tree); err != nil {
+		t.Fatalf("api.ExecWithInterpreter failed: %v", err)
+	}
+
+	const events = 200
+	for n := 0; n < events; n++ {
+		interp.EmitEvent("tick", "host", lang.NewMapValue(nil))
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := interp.ShutdownEvents(ctx); err != nil {
+		t.Fatalf("ShutdownEvents failed: %v", err)
+	}
+	usage := interp.Usage()
+	if usage.FSCalls != events || usage.FSBytes != events*int64(len("tick")) {
+		t.Errorf("Expected %d FS calls and %d bytes, got %d and %d", events, events*len("tick"), usage.FSCalls, usage.FSBytes)
+	}
+}
@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 34
+// :: fileVersion: 36
 // :: description: Fixes LoadOrExecute to use AppendScript for commands, preserving interpreter state.
-// :: latestChange: LoadOrExecute now uses AppendScript+Execute for commands to prevent wiping definitions.
+// :: latestChange: LoadFromUnit runs the CheckScriptPolicy preflight by default; added LoadOption/SkipPolicyCheck.
 // :: filename: pkg/api/exec.go
 // :: serialization: go
 
@@ -134,8 +134,33 @@ func ExecScript(ctx context.Context, script string, stdout io.Writer) (Value, er
 	return ExecInNewInterpreter(ctx, script, opts...)
 }
 
-// LoadFromUnit loads definitions from a verified LoadedUnit.
-func LoadFromUnit(interp *Interpreter, unit *LoadedUnit) error {
+// LoadOption adjusts how LoadFromUnit loads a unit.
+type LoadOption func(*loadOptions)
+
+type loadOptions struct {
+	skipPolicyCheck bool
+}
+
+// SkipPolicyCheck disables the CheckScriptPolicy preflight in LoadFromUnit.
+// Policy is still enforced on every tool call at run time.
+func SkipPolicyCheck() LoadOption {
+	return func(o *loadOptions) { o.skipPolicyCheck = true }
+}
+
+// LoadFromUnit loads definitions from a verified LoadedUnit, replacing the
+// interpreter's script-loaded definitions. Procedures are registered for
+// libraries and event handlers for event sinks; command blocks are never run
+// (use ExecuteUnit for that). The mode is re-derived from the tree rather than
+// trusted from unit.Mode, and units mixing commands with definitions are refused.
+//
+// Unless SkipPolicyCheck is given, the unit is first checked with
+// CheckScriptPolicy and refused with its *PolicyReport if any tool call would
+// be denied, so a handler that can never run is caught at load time.
+func LoadFromUnit(interp *Interpreter, unit *LoadedUnit, opts ...LoadOption) error {
+	var o loadOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
 	if interp == nil {
 		return fmt.Errorf("LoadFromUnit requires a non-nil interpreter")
 	}
@@ -146,9 +171,58 @@ func LoadFromUnit(interp *Interpreter, unit *LoadedUnit) error {
 	if !ok {
 		return fmt.Errorf("internal error: loaded unit root is not a runnable *ast.Program, but %T", unit.Tree.Root)
 	}
+	if _, err := CheckRunMode(unit.Tree); err != nil {
+		return fmt.Errorf("cannot load unit: %w", err)
+	}
+	if !o.skipPolicyCheck {
+		report, err := CheckScriptPolicy(&Tree{Root: program}, interp)
+		if err != nil {
+			return fmt.Errorf("cannot load unit: %w", err)
+		}
+		if err := report.Err(); err != nil {
+			return fmt.Errorf("cannot load unit: %w", err)
+		}
+	}
 	return interp.Load(&interfaces.Tree{Root: program})
 }
 
+// ExecuteUnit acts on a verified LoadedUnit according to its run mode:
+//   - Command    -> appended to the interpreter's state and executed.
+//   - EventSink  -> handlers (and helper procedures) appended; returns nil.
+//   - Library    -> refused with ErrNotExecutable; use LoadFromUnit instead.
+//   - Empty      -> nothing to do; returns nil.
+//   - Invalid    -> refused with ErrMixedScript.
+func ExecuteUnit(ctx context.Context, interp *Interpreter, unit *LoadedUnit) (Value, error) {
+	if interp == nil {
+		return nil, fmt.Errorf("ExecuteUnit requires a non-nil interpreter")
+	}
+	if unit == nil || unit.Tree == nil {
+		return nil, fmt.Errorf("cannot execute a nil unit or tree")
+	}
+	mode, err := CheckRunMode(unit.Tree)
+	if err != nil {
+		return nil, fmt.Errorf("cannot execute unit: %w", err)
+	}
+	tree := &Tree{Root: unit.Tree.Root}
+	switch mode {
+	case RunModeLibrary:
+		return nil, fmt.Errorf("cannot execute unit: %w (run mode %s)", ErrNotExecutable, mode)
+	case RunModeEventSink:
+		if err := interp.AppendScript(tree); err != nil {
+			return nil, fmt.Errorf("failed to register event handlers: %w", err)
+		}
+		return nil, nil
+	case RunModeCommand:
+		interp.SetTurnContext(ctx)
+		if err := interp.AppendScript(tree); err != nil {
+			return nil, fmt.Errorf("failed to append command script: %w", err)
+		}
+		return interp.Execute(tree)
+	default:
+		return nil, nil
+	}
+}
+
 // RunProcedure executes a named procedure with Go-native arguments.
 func RunProcedure(ctx context.Context, interp *Interpreter, name string, args ...any) (Value, error) {
 	if interp == nil {
@@ -166,10 +240,10 @@ func RunProcedure(ctx context.Context, interp *Interpreter, name string, args ..
 	return interp.Run(name, wrappedArgs...)
 }
 
-// LoadOrExecute inspects the AST and routes execution based on the content:
-//   - Mixed Definitions & Commands -> Returns error (ErrMixedScript).
-//   - Definitions only -> Calls interp.AppendScript (Persistent load).
-//   - Commands only -> Calls interp.AppendScript + Execute (Transient execution over Persistent state).
+// LoadOrExecute inspects the AST and routes execution based on its run mode:
+//   - Invalid (commands mixed with definitions) -> Returns an error wrapping ErrMixedScript.
+//   - Library or EventSink -> Calls interp.AppendScript (Persistent load; handlers are registered).
+//   - Command -> Calls interp.AppendScript + Execute (Transient execution over Persistent state).
 //   - Empty -> Returns nil, nil.
 func LoadOrExecute(ctx context.Context, interp *Interpreter, tree *Tree) (Value, error) {
 	if interp == nil {
@@ -178,29 +252,24 @@ func LoadOrExecute(ctx context.Context, interp *Interpreter, tree *Tree) (Value,
 	if tree == nil || tree.Root == nil {
 		return nil, nil // Treat as empty
 	}
-	program, ok := tree.Root.(*ast.Program)
-	if !ok {
+	if _, ok := tree.Root.(*ast.Program); !ok {
 		return nil, fmt.Errorf("internal error: tree root is not a runnable *ast.Program, but %T", tree.Root)
 	}
 
-	hasCmds := HasCommandBlock(program)
-	hasDefs := HasDefinitions(program)
-
-	// Rule: Mixed? -> Error ("Cannot mix").
-	if hasCmds && hasDefs {
-		return nil, fmt.Errorf("mixed script detected: scripts must contain either definitions OR commands, not both")
+	mode, err := CheckRunMode(tree)
+	if err != nil {
+		return nil, err
 	}
 
-	// Rule: Definitions? -> AppendScript (Persistent).
-	if hasDefs {
+	switch mode {
+	case RunModeLibrary, RunModeEventSink:
+		// Definitions -> AppendScript (Persistent).
 		if err := interp.AppendScript(tree); err != nil {
 			return nil, fmt.Errorf("failed to append definitions: %w", err)
 		}
 		return nil, nil
-	}
 
-	// Rule: Commands? -> Append then Execute (Transient action, Persistent state).
-	if hasCmds {
+	case RunModeCommand:
 		// We must NOT use ExecWithInterpreter here because it calls Load(),
 		// which resets the interpreter's state (wiping previously loaded definitions).
 		// Instead, we Append the commands (to register them in the current session)
@@ -217,7 +286,7 @@ func LoadOrExecute(ctx context.Context, interp *Interpreter, tree *Tree) (Value,
 		return interp.Execute(tree)
 	}
 
-	// Neither (e.g., empty or comments only)
+	// Empty (e.g., comments only)
 	return nil, nil
 }
 
@@ -1,14 +1,15 @@
 // NeuroScript/FDM Major Version: 1
-// File version: 6
+// File version: 7
 // Purpose: Tests 'ask' hook. Fixes mockAeiouService to fully implement interfaces.AeiouOrchestrator. Removes fallback test as panic is expected behavior for invalid types.
 // filename: pkg/api/exec_hook_test.go
-// nlines: 105
+// nlines: 202
 
 package api_test
 
 import (
 	"bytes"
 	"context"
+	"errors"
 	"os"
 	"strings"
 	"testing"
@@ -134,3 +135,68 @@ func TestAsk_ServiceHook(t *testing.T) {
 
 	t.Log("SUCCESS: The 'ask' statement correctly hooked into the mock service.")
 }
+
+// mockAeiouOptionsService also accepts 'ask ... with' options.
+type mockAeiouOptionsService struct {
+	mockAeiouService
+	receivedOptions map[string]any
+}
+
+// RunAskLoopWithOptions satisfies interfaces.AeiouOrchestratorWithOptions.
+func (m *mockAeiouOptionsService) RunAskLoopWithOptions(callingInterp any, agentModelName, initialPrompt string, options map[string]any) (any, error) {
+	m.receivedOptions = options
+	return m.RunAskLoop(callingInterp, agentModelName, initialPrompt)
+}
+
+// runAskWithOptions runs an 'ask ... with' statement against service.
+func runAskWithOptions(t *testing.T, service any) error {
+	t.Helper()
+	hc, err := api.NewHostContextBuilder().
+		WithLogger(&mockLogger{}).
+		WithStdout(&bytes.Buffer{}).
+		WithStdin(os.Stdin).
+		WithStderr(os.Stderr).
+		WithServiceRegistry(map[string]any{interfaces.AeiouServiceKey: service}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to build host context: %v", err)
+	}
+	interp := api.New(api.WithHostContext(hc))
+	tree, err := api.Parse([]byte(`
+	command
+		ask "test_agent_hook", "hello from test" with {"temperature": 0.5} into result
+	endcommand
+	`), api.ParseSkipComments)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	_, err = api.ExecWithInterpreter(context.Background(), interp, tree)
+	return err
+}
+
+// TestAsk_ServiceHookWithOptions verifies that 'with' options reach a service
+// that accepts them and are refused, with a position, by one that does not.
+func TestAsk_ServiceHookWithOptions(t *testing.T) {
+	withOpts := &mockAeiouOptionsService{mockAeiouService: mockAeiouService{
+		t: t, expectedAgent: "test_agent_hook", expectedPrompt: "hello from test", valueToReturn: lang.StringValue{Value: "ok"},
+	}}
+	if err := runAskWithOptions(t, withOpts); err != nil {
+		t.Fatalf("ask with options failed: %v", err)
+	}
+	if got, ok := withOpts.receivedOptions["temperature"].(float64); !ok || got != 0.5 {
+		t.Errorf("service received options %v, want temperature 0.5", withOpts.receivedOptions)
+	}
+
+	plain := &mockAeiouService{t: t, expectedAgent: "test_agent_hook", expectedPrompt: "hello from test"}
+	err := runAskWithOptions(t, plain)
+	var rtErr *lang.RuntimeError
+	if !errors.As(err, &rtErr) || rtErr.Code != lang.ErrorCodeNotImplemented {
+		t.Fatalf("expected a not-implemented error, got %v", err)
+	}
+	if rtErr.Position == nil || rtErr.Position.Line != 3 {
+		t.Errorf("expected the error at the 'with' options on line 3, got %v", rtErr.Position)
+	}
+	if plain.called {
+		t.Error("a service without options support was called with options dropped")
+	}
+}
@@ -1,6 +1,6 @@
 // NeuroScript Version: 0.8.0
-// File version: 17
-// Purpose: Threads the context.Context through the decoding and analysis steps to support cancellation and request-scoped data.
+// File version: 19
+// Purpose: Verifies, decodes and analyses signed ASTs with context support. RunMode detection now lives in runmode.go.
 // filename: pkg/api/loader.go
 // nlines: 85
 // risk_rating: HIGH
@@ -8,6 +8,7 @@
 package api
 
 import (
+	"bytes"
 	"context"
 	"crypto/ed25519"
 	"fmt"
@@ -19,21 +20,17 @@ import (
 	"golang.org/x/crypto/blake2b"
 )
 
-// RunMode indicates the intended execution model of a script.
-type RunMode uint8
-
-const (
-	RunModeLibrary RunMode = iota
-	RunModeCommand
-	RunModeEventSink
-)
-
 // LoaderConfig provides options to modify the behavior of the Load function.
 type LoaderConfig struct {
 	// If true, the loader will not attempt to verify the script's signature.
 	// This is intended for use in trusted environments, such as during testing.
 	// The default value (false) maintains the secure-by-default behavior.
 	SkipVerification bool
+
+	// Cache, if set, remembers canonical blobs that have already passed the
+	// analysis passes, keyed by their canonical hash. Re-loading an identical
+	// blob still verifies the signature and hash but skips the analysis.
+	Cache Cache
 }
 
 // LoadedUnit is the result of a successful Load operation.
@@ -44,12 +41,6 @@ type LoadedUnit struct {
 	RawBytes []byte
 }
 
-// DetectRunMode determines the run mode from the AST.
-func DetectRunMode(tree *interfaces.Tree) RunMode {
-	// A real implementation would inspect the tree's root and top-level nodes.
-	return RunModeCommand
-}
-
 // Load performs signature verification and analysis passes on a signed AST.
 func Load(ctx context.Context, s *SignedAST, cfg LoaderConfig, pubKey ed25519.PublicKey) (*LoadedUnit, error) {
 	if !cfg.SkipVerification {
@@ -81,9 +72,15 @@ func Load(ctx context.Context, s *SignedAST, cfg LoaderConfig, pubKey ed25519.Pu
 		return nil, fmt.Errorf("failed to decode verified blob: %w", err)
 	}
 
-	// 5. Run static analysis passes, passing the context.
-	if err := analysis.RunAll(ctx, &interfaces.Tree{Root: verifiedTree.Root}); err != nil {
-		return nil, fmt.Errorf("analysis pass failed: %w", err)
+	// 5. Run static analysis passes, passing the context, unless this exact
+	// blob has already passed them.
+	if !analysedBefore(cfg.Cache, s) {
+		if err := analysis.RunAll(ctx, &interfaces.Tree{Root: verifiedTree.Root}); err != nil {
+			return nil, fmt.Errorf("analysis pass failed: %w", err)
+		}
+		if cfg.Cache != nil {
+			_ = cfg.Cache.Put(s.Sum, s.Blob)
+		}
 	}
 
 	lu := &LoadedUnit{
@@ -95,3 +92,14 @@ func Load(ctx context.Context, s *SignedAST, cfg LoaderConfig, pubKey ed25519.Pu
 
 	return lu, nil
 }
+
+// analysedBefore reports whether c holds exactly this blob under its hash.
+// The byte comparison guards against a cache shared with ParseWithCache or
+// poisoned by another writer.
+func analysedBefore(c Cache, s *SignedAST) bool {
+	if c == nil {
+		return false
+	}
+	cached, ok := c.Get(s.Sum)
+	return ok && bytes.Equal(cached, s.Blob)
+}
@@ -1,6 +1,6 @@
 // NeuroScript Version: 0.8.0
-// File version: 22
-// Purpose: Adds WithAllowRedefinition public option.
+// File version: 23
+// Purpose: Adds WithStrictVariables public option.
 // filename: pkg/api/options.go
 // nlines: 112
 // risk_rating: LOW
@@ -72,6 +72,12 @@ func WithHostContext(hc *HostContext) Option {
 	}
 }
 
+// WithStrictVariables makes reading a variable that was never set a runtime
+// error instead of yielding nil. Scripts can opt in with ':: strict: true'.
+func WithStrictVariables() Option {
+	return interpreter.WithStrictVariables()
+}
+
 // WithAllowRedefinition sets whether the interpreter allows redefining existing symbols
 // (procedures and variables). If true, new definitions shadow or overwrite existing ones.
 func WithAllowRedefinition(allow bool) Option {
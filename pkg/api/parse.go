@@ -1,17 +1,20 @@
-// NeuroScript Version: 0.6.0
-// File version: 8
-// Purpose: Implements the public parsing entrypoint with full error handling.
+// NeuroScript Version: 0.8.0
+// File version: 12
+// Purpose: Implements the public parsing entrypoint with full error handling. Adds source-hash caching of canonical ASTs with their positions.
 // filename: pkg/api/parse.go
-// nlines: 34
+// nlines: 119
 // risk_rating: MEDIUM
 
 package api
 
 import (
 	"fmt"
+	"sync"
 
+	"github.com/aprice2704/neuroscript/pkg/canon"
 	"github.com/aprice2704/neuroscript/pkg/logging"
 	"github.com/aprice2704/neuroscript/pkg/parser"
+	"golang.org/x/crypto/blake2b"
 )
 
 // ParseMode controls parsing behavior, like comment handling.
@@ -22,9 +25,74 @@ const (
 	ParseSkipComments
 )
 
+var (
+	parseCacheMu sync.RWMutex
+	parseCache   Cache
+)
+
+// SetParseCache installs a process-wide cache used by Parse. Passing nil
+// disables caching. Hosts that re-parse the same scripts repeatedly can set
+// this once at start-up instead of switching to ParseWithCache.
+func SetParseCache(c Cache) {
+	parseCacheMu.Lock()
+	parseCache = c
+	parseCacheMu.Unlock()
+}
+
 // Parse converts a byte slice of NeuroScript source into an AST.
 // It now uses the full parsing pipeline to ensure formatting is preserved.
+// ParseSkipComments drops comments from the tree. If a cache has been
+// installed with SetParseCache it is consulted first (see ParseWithCache).
 func Parse(src []byte, mode ParseMode) (*Tree, error) {
+	parseCacheMu.RLock()
+	c := parseCache
+	parseCacheMu.RUnlock()
+	return ParseWithCache(src, mode, c)
+}
+
+// SourceKey returns the cache key ParseWithCache uses for src. It covers the
+// parse mode, the grammar version and the canonical schema version, so a
+// grammar or codec change never serves stale trees.
+func SourceKey(src []byte, mode ParseMode) [32]byte {
+	h, _ := blake2b.New256(nil)
+	h.Write([]byte("ns-src\x00" + GrammarVersion + "\x00"))
+	h.Write([]byte{canon.SchemaVersion, byte(mode)})
+	h.Write(src)
+	var key [32]byte
+	h.Sum(key[:0])
+	return key
+}
+
+// ParseWithCache behaves like Parse but consults c, keyed by SourceKey,
+// before running the parser. Entries hold the canonical blob of the AST and
+// the source position of every node, so a hit skips ANTLR parsing and AST
+// building and returns the same tree, positions included, as a miss. A tree
+// that does not survive the canonical round trip unchanged is not cached.
+// A nil c parses without caching. An entry that cannot be decoded is ignored
+// and overwritten.
+func ParseWithCache(src []byte, mode ParseMode, c Cache) (*Tree, error) {
+	if c == nil {
+		return parseSource(src, mode)
+	}
+	key := SourceKey(src, mode)
+	if entry, ok := c.Get(key); ok {
+		if decoded, err := decodeParseEntry(entry); err == nil {
+			return decoded, nil
+		}
+	}
+
+	tree, err := parseSource(src, mode)
+	if err != nil {
+		return nil, err
+	}
+	if entry, err := encodeParseEntry(tree); err == nil {
+		_ = c.Put(key, entry)
+	}
+	return tree, nil
+}
+
+// parseSource runs the full ANTLR parse and AST build.
+func parseSource(src []byte, mode ParseMode) (*Tree, error) {
 	logger := logging.NewNoOpLogger()
 	parserAPI := parser.NewParserAPI(logger)
 
@@ -43,6 +111,9 @@ func Parse(src []byte, mode ParseMode) (*Tree, error) {
 	if err != nil {
 		return nil, fmt.Errorf("AST construction failed: %w", err)
 	}
+	if mode&ParseSkipComments != 0 {
+		stripComments(program)
+	}
 
 	return &Tree{Root: program}, nil
 }
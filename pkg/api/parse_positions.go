@@ -0,0 +1,223 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Records AST node positions alongside cached canonical blobs and restores them on a parse cache hit.
+// filename: pkg/api/parse_positions.go
+// nlines: 223
+// risk_rating: MEDIUM
+
+package api
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/canon"
+	"github.com/aprice2704/neuroscript/pkg/types"
+)
+
+// parseCacheMagic prefixes ParseWithCache entries: the canonical blob
+// followed by a table of node positions. Entries without it are misses.
+var parseCacheMagic = []byte{'N', 'S', 'P', 0x01}
+
+var (
+	baseNodeType    = reflect.TypeOf(ast.BaseNode{})
+	commentListType = reflect.TypeOf([]*ast.Comment(nil))
+)
+
+// walkNodes visits the BaseNode of every AST node reachable from root in a
+// fixed order: struct fields in declaration order, slices by index and maps
+// by sorted key. Two trees with the same shape are visited node for node.
+func walkNodes(root ast.Node, fn func(*ast.BaseNode)) {
+	walkValue(reflect.ValueOf(root), make(map[uintptr]bool), fn)
+}
+
+func walkValue(v reflect.Value, seen map[uintptr]bool, fn func(*ast.BaseNode)) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() || seen[v.Pointer()] {
+			return
+		}
+		seen[v.Pointer()] = true
+		walkValue(v.Elem(), seen, fn)
+	case reflect.Interface:
+		if !v.IsNil() {
+			walkValue(v.Elem(), seen, fn)
+		}
+	case reflect.Struct:
+		if v.Type() == baseNodeType {
+			if v.CanAddr() && v.Addr().CanInterface() {
+				fn(v.Addr().Interface().(*ast.BaseNode))
+			}
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			walkValue(v.Field(i), seen, fn)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			walkValue(v.Index(i), seen, fn)
+		}
+	case reflect.Map:
+		if !v.CanInterface() {
+			return
+		}
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+		for _, k := range keys {
+			walkValue(v.MapIndex(k), seen, fn)
+		}
+	}
+}
+
+// stripComments drops every comment list from the tree, as ParseSkipComments
+// asks.
+func stripComments(root ast.Node) {
+	stripValue(reflect.ValueOf(root), make(map[uintptr]bool))
+}
+
+func stripValue(v reflect.Value, seen map[uintptr]bool) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() || seen[v.Pointer()] {
+			return
+		}
+		seen[v.Pointer()] = true
+		stripValue(v.Elem(), seen)
+	case reflect.Interface:
+		if !v.IsNil() {
+			stripValue(v.Elem(), seen)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Field(i)
+			if f.Type() == commentListType && f.CanSet() {
+				f.Set(reflect.Zero(commentListType))
+				continue
+			}
+			stripValue(f, seen)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			stripValue(v.Index(i), seen)
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			stripValue(iter.Value(), seen)
+		}
+	}
+}
+
+// encodeParseEntry builds the cache entry for tree: its canonical blob and
+// the kind and positions of each node. Kinds are stored because the codec
+// does not keep them on every sub-node, e.g. call targets. It fails if the
+// blob does not decode to a tree with the same nodes, in which case the tree
+// is not cached.
+func encodeParseEntry(tree *Tree) ([]byte, error) {
+	blob, _, err := canon.CanonicaliseWithRegistry(tree)
+	if err != nil {
+		return nil, err
+	}
+	var table []byte
+	count := 0
+	walkNodes(tree.Root, func(n *ast.BaseNode) {
+		count++
+		table = binary.AppendUvarint(table, uint64(n.NodeKind))
+		table = appendPosition(table, n.StartPos)
+		table = appendPosition(table, n.StopPos)
+	})
+
+	entry := append([]byte(nil), parseCacheMagic...)
+	entry = binary.AppendUvarint(entry, uint64(len(blob)))
+	entry = append(entry, blob...)
+	entry = binary.AppendUvarint(entry, uint64(count))
+	entry = append(entry, table...)
+
+	if _, err := decodeParseEntry(entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// decodeParseEntry rebuilds the tree held in entry, kinds and positions
+// included.
+func decodeParseEntry(entry []byte) (*Tree, error) {
+	if !bytes.HasPrefix(entry, parseCacheMagic) {
+		return nil, errors.New("not a parse cache entry")
+	}
+	r := bytes.NewReader(entry[len(parseCacheMagic):])
+	blobLen, err := binary.ReadUvarint(r)
+	if err != nil || blobLen > uint64(r.Len()) {
+		return nil, errors.New("truncated parse cache entry")
+	}
+	blob := make([]byte, blobLen)
+	_, _ = r.Read(blob)
+	tree, err := canon.DecodeWithRegistry(blob)
+	if err != nil {
+		return nil, err
+	}
+	count, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []*ast.BaseNode
+	walkNodes(tree.Root, func(n *ast.BaseNode) { nodes = append(nodes, n) })
+	if uint64(len(nodes)) != count {
+		return nil, fmt.Errorf("parse cache entry has %d positions for %d nodes", count, len(nodes))
+	}
+	for _, n := range nodes {
+		kind, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, err
+		}
+		n.NodeKind = types.Kind(kind)
+		if n.StartPos, err = readPosition(r); err != nil {
+			return nil, err
+		}
+		if n.StopPos, err = readPosition(r); err != nil {
+			return nil, err
+		}
+	}
+	return tree, nil
+}
+
+func appendPosition(b []byte, p *types.Position) []byte {
+	if p == nil {
+		return append(b, 0)
+	}
+	b = append(b, 1)
+	b = binary.AppendVarint(b, int64(p.Line))
+	b = binary.AppendVarint(b, int64(p.Column))
+	b = binary.AppendUvarint(b, uint64(len(p.File)))
+	return append(b, p.File...)
+}
+
+func readPosition(r *bytes.Reader) (*types.Position, error) {
+	present, err := r.ReadByte()
+	if err != nil || present == 0 {
+		return nil, err
+	}
+	line, err := binary.ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	col, err := binary.ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	n, err := binary.ReadUvarint(r)
+	if err != nil || n > uint64(r.Len()) {
+		return nil, errors.New("truncated position")
+	}
+	file := make([]byte, n)
+	_, _ = r.Read(file)
+	return &types.Position{Line: int(line), Column: int(col), File: string(file)}, nil
+}
@@ -0,0 +1,186 @@
+// :: product: FDM/NS
+// :: majorVersion: 1
+// :: fileVersion: 3
+// :: description: Static policy preflight: reports every tool call and event raise a script makes that the active ExecPolicy would refuse.
+// :: latestChange: Reports tool calls inside event guards.
+// :: filename: pkg/api/policy_check.go
+// :: serialization: go
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aprice2704/neuroscript/pkg/api/analysis"
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/capability"
+	"github.com/aprice2704/neuroscript/pkg/interfaces"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/policy"
+	"github.com/aprice2704/neuroscript/pkg/tool"
+	"github.com/aprice2704/neuroscript/pkg/types"
+)
+
+// ErrPolicyPreflight is matched (via errors.Is) by every non-empty PolicyReport.
+var ErrPolicyPreflight = errors.New("script fails policy preflight")
+
+// PolicyViolation is a single tool call that the active policy would refuse.
+type PolicyViolation struct {
+	Tool   string          // Fully qualified name as written, e.g. "tool.fs.write", or "raise event"
+	Pos    *types.Position // Position of the call; nil for trees without positions
+	Reason string          // Human-readable explanation
+	// Err is the error the call would fail with at run time. It wraps one of
+	// policy.ErrPolicy, policy.ErrTrust, policy.ErrCapability,
+	// lang.ErrToolNotFound or lang.ErrGuardCallNotAllowed, so callers can
+	// classify violations with errors.Is.
+	Err error
+}
+
+func (v PolicyViolation) String() string {
+	if v.Pos == nil {
+		return fmt.Sprintf("%s: %s", v.Tool, v.Reason)
+	}
+	return fmt.Sprintf("%s: %s: %s", v.Pos, v.Tool, v.Reason)
+}
+
+// PolicyReport lists every policy violation found in a script, in source
+// order. A call site that appears several times is reported each time.
+type PolicyReport struct {
+	Violations []PolicyViolation
+}
+
+// OK reports whether the script passed the preflight.
+func (r *PolicyReport) OK() bool { return r == nil || len(r.Violations) == 0 }
+
+// Err returns the report as an error, or nil if there are no violations.
+func (r *PolicyReport) Err() error {
+	if r.OK() {
+		return nil
+	}
+	return r
+}
+
+// Error implements error, listing one violation per line.
+func (r *PolicyReport) Error() string {
+	lines := make([]string, 0, len(r.Violations)+1)
+	lines = append(lines, fmt.Sprintf("%s: %d violation(s)", ErrPolicyPreflight, len(r.Violations)))
+	for _, v := range r.Violations {
+		lines = append(lines, "  "+v.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Unwrap exposes ErrPolicyPreflight and each violation's error to errors.Is/As.
+func (r *PolicyReport) Unwrap() []error {
+	errs := []error{ErrPolicyPreflight}
+	for _, v := range r.Violations {
+		if v.Err != nil {
+			errs = append(errs, v.Err)
+		}
+	}
+	return errs
+}
+
+// CheckScriptPolicy statically evaluates every tool call in the tree against
+// the interpreter's ExecPolicy, using the same trust, allow/deny, grant and
+// call-limit rules applied at run time, without consuming any limits. Tools
+// missing from the registry are reported as well, as is every 'raise event'
+// statement and tool.timer.Schedule call whose bus:write:<event> grant is
+// missing. Tool calls inside an event handler's 'where' guard are always
+// reported, since guards refuse them at run time. The returned report is never nil when err is nil; use
+// report.Err() to treat violations as an error.
+//
+// The check is conservative in one direction only: a clean report means no
+// statically visible tool call or raise will be refused, but tools invoked
+// indirectly (for example by name through another tool) and events whose
+// names are computed at run time are not seen.
+func CheckScriptPolicy(tree *Tree, interp Runtime) (*PolicyReport, error) {
+	if tree == nil || tree.Root == nil {
+		return nil, fmt.Errorf("cannot check policy on a nil tree")
+	}
+	if interp == nil {
+		return nil, fmt.Errorf("cannot check policy with a nil interpreter")
+	}
+	registry := interp.ToolRegistry()
+	if registry == nil {
+		return nil, fmt.Errorf("interpreter does not have a tool registry")
+	}
+	program, ok := tree.Root.(*ast.Program)
+	if !ok {
+		return nil, fmt.Errorf("internal error: tree root is not a checkable *ast.Program, but %T", tree.Root)
+	}
+
+	p := interp.GetExecPolicy()
+	report := &PolicyReport{}
+	checked := &interfaces.Tree{Root: program}
+	verdicts := make(map[string]error) // one evaluation per distinct tool
+	for _, ref := range analysis.FindToolReferences(checked) {
+		if ref.InGuard {
+			// Guards refuse every tool call at run time, whatever the policy.
+			msg := fmt.Sprintf("event guard cannot call tool '%s'", tool.CanonicalizeToolName(ref.Name))
+			report.Violations = append(report.Violations, PolicyViolation{
+				Tool:   "tool." + ref.Name,
+				Pos:    ref.Pos,
+				Reason: msg,
+				Err:    lang.NewRuntimeError(lang.ErrorCodeEvaluation, msg, lang.ErrGuardCallNotAllowed).WithPosition(ref.Pos),
+			})
+			continue
+		}
+		verdict, seen := verdicts[ref.Name]
+		if !seen {
+			if impl, found := registry.GetTool(types.FullName(ref.Name)); found {
+				verdict = tool.CheckCall(p, impl)
+			} else {
+				msg := fmt.Sprintf("tool '%s' is not registered", tool.CanonicalizeToolName(ref.Name))
+				verdict = lang.NewRuntimeError(lang.ErrorCodeToolNotFound, msg, lang.ErrToolNotFound)
+			}
+			verdicts[ref.Name] = verdict
+		}
+		if verdict == nil {
+			continue
+		}
+		reason := verdict.Error()
+		var rtErr *lang.RuntimeError
+		if errors.As(verdict, &rtErr) {
+			reason = rtErr.Message
+		}
+		report.Violations = append(report.Violations, PolicyViolation{
+			Tool:   "tool." + ref.Name,
+			Pos:    ref.Pos,
+			Reason: reason,
+			Err:    verdict,
+		})
+	}
+
+	for _, raise := range analysis.FindEventRaises(checked) {
+		need := capability.New(capability.ResBus, capability.VerbWrite, raise.Event)
+		if p != nil && p.Grants.Check(need) {
+			continue
+		}
+		verb := "raise"
+		if raise.Via != "raise event" {
+			verb = "schedule"
+		}
+		reason := fmt.Sprintf("permission denied to %s event '%s': requires grant %s", verb, raise.Event, need.String())
+		report.Violations = append(report.Violations, PolicyViolation{
+			Tool:   raise.Via,
+			Pos:    raise.Pos,
+			Reason: reason,
+			Err:    lang.NewRuntimeError(lang.ErrorCodePolicy, reason, policy.ErrCapability).WithPosition(raise.Pos),
+		})
+	}
+	sort.SliceStable(report.Violations, func(a, b int) bool {
+		pa, pb := report.Violations[a].Pos, report.Violations[b].Pos
+		if pa == nil || pb == nil {
+			return false
+		}
+		if pa.Line != pb.Line {
+			return pa.Line < pb.Line
+		}
+		return pa.Column < pb.Column
+	})
+	return report, nil
+}
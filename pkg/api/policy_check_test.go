@@ -0,0 +1,201 @@
+// :: product: FDM/NS
+// :: majorVersion: 1
+// :: fileVersion: 3
+// :: description: Tests the CheckScriptPolicy preflight and its use by LoadFromUnit.
+// :: latestChange: Covers tool calls inside event guards.
+// :: filename: pkg/api/policy_check_test.go
+// :: serialization: go
+
+package api_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/api"
+	"github.com/aprice2704/neuroscript/pkg/capability"
+	"github.com/aprice2704/neuroscript/pkg/interpreter"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/policy"
+)
+
+const preflightScript = `func main() means
+    call tool.test.ok()
+    call tool.test.bad()
+    call tool.test.ghost()
+endfunc
+
+on event "user.created" do
+    call tool.test.write("a")
+    call tool.test.priv()
+    call tool.test.bad()
+endon
+`
+
+func newPreflightInterpreter(t *testing.T) (*api.Interpreter, *policy.ExecPolicy) {
+	t.Helper()
+	p := &policy.ExecPolicy{
+		Context: policy.ContextNormal,
+		Allow:   []string{"tool.test.ok", "tool.test.write", "tool.test.priv"},
+		Deny:    []string{"tool.test.bad"},
+		Grants: capability.GrantSet{
+			Limits:   capability.Limits{ToolMaxCalls: map[string]int{"tool.test.ok": 1}},
+			Counters: capability.NewCounters(),
+		},
+	}
+	interp := api.New(api.WithHostContext(newTestHostContext(nil)), interpreter.WithExecPolicy(p))
+	noop := func(rt api.Runtime, args []any) (any, error) { return nil, nil }
+	tools := []api.ToolImplementation{
+		{Spec: api.ToolSpec{Name: "ok", Group: "test"}, Func: noop},
+		{Spec: api.ToolSpec{Name: "bad", Group: "test"}, Func: noop},
+		{
+			Spec:         api.ToolSpec{Name: "write", Group: "test", Args: []api.ArgSpec{{Name: "path", Type: "string"}}},
+			Func:         noop,
+			RequiredCaps: []capability.Capability{{Resource: "fs", Verbs: []string{"write"}}},
+		},
+		{Spec: api.ToolSpec{Name: "priv", Group: "test"}, Func: noop, RequiresTrust: true},
+	}
+	for _, impl := range tools {
+		if _, err := interp.ToolRegistry().RegisterTool(impl); err != nil {
+			t.Fatalf("Failed to register tool %s: %v", impl.Spec.Name, err)
+		}
+	}
+	return interp, p
+}
+
+func TestCheckScriptPolicy_ReportsEveryViolation(t *testing.T) {
+	interp, p := newPreflightInterpreter(t)
+	report, err := api.CheckScriptPolicy(mustParse(t, preflightScript), interp)
+	if err != nil {
+		t.Fatalf("CheckScriptPolicy() failed: %v", err)
+	}
+
+	want := []struct {
+		tool string
+		line int
+		is   error
+	}{
+		{"tool.test.bad", 3, policy.ErrPolicy},
+		{"tool.test.ghost", 4, lang.ErrToolNotFound},
+		{"tool.test.write", 8, policy.ErrCapability},
+		{"tool.test.priv", 9, policy.ErrTrust},
+		{"tool.test.bad", 10, policy.ErrPolicy},
+	}
+	if len(report.Violations) != len(want) {
+		t.Fatalf("got %d violations, want %d:\n%v", len(report.Violations), len(want), report)
+	}
+	for i, w := range want {
+		v := report.Violations[i]
+		if v.Tool != w.tool {
+			t.Errorf("violation %d: tool = %s, want %s", i, v.Tool, w.tool)
+		}
+		if v.Pos == nil || v.Pos.Line != w.line {
+			t.Errorf("violation %d (%s): pos = %v, want line %d", i, v.Tool, v.Pos, w.line)
+		}
+		if !errors.Is(v.Err, w.is) {
+			t.Errorf("violation %d (%s): err = %v, want %v", i, v.Tool, v.Err, w.is)
+		}
+	}
+
+	err = report.Err()
+	if !errors.Is(err, api.ErrPolicyPreflight) || !errors.Is(err, policy.ErrTrust) {
+		t.Errorf("report.Err() = %v; want it to match ErrPolicyPreflight and ErrTrust", err)
+	}
+	if n := p.Grants.Counters.ToolCalls["tool.test.ok"]; n != 0 {
+		t.Errorf("preflight consumed the call limit: ToolCalls = %d, want 0", n)
+	}
+}
+
+func TestCheckScriptPolicy_CleanScript(t *testing.T) {
+	interp, _ := newPreflightInterpreter(t)
+	report, err := api.CheckScriptPolicy(mustParse(t, "func main() means\n  call tool.test.ok()\nendfunc\n"), interp)
+	if err != nil {
+		t.Fatalf("CheckScriptPolicy() failed: %v", err)
+	}
+	if !report.OK() || report.Err() != nil {
+		t.Errorf("expected a clean report, got: %v", report)
+	}
+	if _, err := api.CheckScriptPolicy(nil, interp); err == nil {
+		t.Error("expected an error for a nil tree")
+	}
+}
+
+func TestCheckScriptPolicy_ReportsUngrantedRaises(t *testing.T) {
+	p := policy.NewBuilder(policy.ContextNormal).
+		Allow("*").
+		Grant("timer:write").
+		Grant("bus:write:orders.*").
+		Build()
+	interp := api.New(api.WithHostContext(newTestHostContext(nil)), interpreter.WithExecPolicy(p))
+	script := `func main() means
+    raise event "orders.created" with {"id": 1}
+    raise event "billing.charged"
+    call tool.timer.Schedule("billing.tick", {"every": "1m"})
+    call tool.timer.Schedule(event: "orders.tick", when: {"every": "1m"})
+    raise event "billing." + "computed"
+endfunc
+`
+	report, err := api.CheckScriptPolicy(mustParse(t, script), interp)
+	if err != nil {
+		t.Fatalf("CheckScriptPolicy() failed: %v", err)
+	}
+	want := []struct {
+		tool string
+		line int
+	}{
+		{"raise event", 3},
+		{"tool.timer.Schedule", 4},
+	}
+	if len(report.Violations) != len(want) {
+		t.Fatalf("got %d violations, want %d:\n%v", len(report.Violations), len(want), report)
+	}
+	for i, w := range want {
+		v := report.Violations[i]
+		if v.Tool != w.tool || v.Pos == nil || v.Pos.Line != w.line || !errors.Is(v.Err, policy.ErrCapability) {
+			t.Errorf("violation %d = %v (err %v), want %s at line %d", i, v, v.Err, w.tool, w.line)
+		}
+	}
+}
+
+func TestCheckScriptPolicy_ReportsGuardToolCalls(t *testing.T) {
+	interp, _ := newPreflightInterpreter(t)
+	script := `on event "user.created" where tool.test.ok() as ev do
+    call tool.test.ok()
+endon
+`
+	report, err := api.CheckScriptPolicy(mustParse(t, script), interp)
+	if err != nil {
+		t.Fatalf("CheckScriptPolicy() failed: %v", err)
+	}
+	if len(report.Violations) != 1 {
+		t.Fatalf("got %d violations, want 1:\n%v", len(report.Violations), report)
+	}
+	v := report.Violations[0]
+	if v.Tool != "tool.test.ok" || v.Pos == nil || v.Pos.Line != 1 || !errors.Is(v.Err, lang.ErrGuardCallNotAllowed) {
+		t.Errorf("got %v (err %v), want tool.test.ok in the guard on line 1", v, v.Err)
+	}
+}
+
+func TestLoadFromUnit_RunsPolicyPreflight(t *testing.T) {
+	interp, _ := newPreflightInterpreter(t)
+	unit := &api.LoadedUnit{Tree: mustParse(t, preflightScript)}
+
+	err := api.LoadFromUnit(interp, unit)
+	var report *api.PolicyReport
+	if !errors.As(err, &report) {
+		t.Fatalf("LoadFromUnit() error = %v, want a *PolicyReport", err)
+	}
+	if len(report.Violations) != 5 {
+		t.Errorf("got %d violations, want 5", len(report.Violations))
+	}
+	if n := len(interp.KnownEventHandlers()["user.created"]); n != 0 {
+		t.Errorf("refused unit registered %d handlers, want 0", n)
+	}
+
+	if err := api.LoadFromUnit(interp, unit, api.SkipPolicyCheck()); err != nil {
+		t.Fatalf("LoadFromUnit(SkipPolicyCheck) failed: %v", err)
+	}
+	if n := len(interp.KnownEventHandlers()["user.created"]); n != 1 {
+		t.Errorf("expected 1 registered handler, got %d", n)
+	}
+}
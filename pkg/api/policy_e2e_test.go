@@ -1,6 +1,6 @@
 // NeuroScript Version: 0.8.0
-// File version: 9
-// Purpose: Corrects all tests to provide a mandatory HostContext during interpreter creation, resolving a panic.
+// File version: 10
+// Purpose: Denied tools are now refused at load time by the policy preflight; run-time enforcement is still checked with SkipPolicyCheck.
 // filename: pkg/api/policy_e2e_test.go
 // nlines: 135
 // risk_rating: HIGH
@@ -126,7 +126,12 @@ endfunc
 		if _, err := interp.ToolRegistry().RegisterTool(privilegedTool); err != nil {
 			t.Fatalf("Failed to register tool: %v", err)
 		}
-		if err := api.LoadFromUnit(interp, &api.LoadedUnit{Tree: tree}); err != nil {
+		// The preflight refuses the unit outright...
+		if err := api.LoadFromUnit(interp, &api.LoadedUnit{Tree: tree}); !errors.Is(err, policy.ErrTrust) {
+			t.Fatalf("Expected LoadFromUnit() to fail the policy preflight with ErrTrust, got: %v", err)
+		}
+		// ...and the run-time check still holds when the preflight is skipped.
+		if err := api.LoadFromUnit(interp, &api.LoadedUnit{Tree: tree}, api.SkipPolicyCheck()); err != nil {
 			t.Fatalf("api.LoadFromUnit() failed: %v", err)
 		}
 
@@ -168,7 +173,10 @@ endfunc
 		t.Fatalf("Failed to register tool: %v", err)
 	}
 
-	if err := api.LoadFromUnit(interp, &api.LoadedUnit{Tree: tree}); err != nil {
+	if err := api.LoadFromUnit(interp, &api.LoadedUnit{Tree: tree}); !errors.Is(err, api.ErrPolicyPreflight) {
+		t.Fatalf("Expected LoadFromUnit() to fail the policy preflight, got: %v", err)
+	}
+	if err := api.LoadFromUnit(interp, &api.LoadedUnit{Tree: tree}, api.SkipPolicyCheck()); err != nil {
 		t.Fatalf("api.LoadFromUnit() failed: %v", err)
 	}
 
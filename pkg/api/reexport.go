@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 80
+// :: fileVersion: 86
 // :: description: Re-exports all types for the facade, correcting store interfaces AND concrete store names.
-// :: latestChange: Removed deprecated aeiou V3 types (Decision, LoopController).
+// :: latestChange: Re-exported AeiouOrchestratorWithOptions.
 // :: filename: pkg/api/reexport.go
 // :: serialization: go
 
@@ -35,6 +35,8 @@ type (
 	Value        = lang.Value
 	Kind         = types.Kind
 	Position     = types.Position
+	Diag         = types.Diag
+	Severity     = types.Severity
 	Node         = interfaces.Node
 	Tree         = interfaces.Tree
 	Logger       = interfaces.Logger
@@ -52,6 +54,8 @@ type (
 	ExecContext = policy.ExecContext
 	Capability  = capability.Capability
 	GrantSet    = capability.GrantSet
+	Limits      = capability.Limits
+	Counters    = capability.Counters
 
 	// SymbolProvider
 	SymbolProvider = interfaces.SymbolProvider
@@ -62,6 +66,9 @@ type (
 
 	// AI & State Store Types
 	AIProvider             = provider.AIProvider
+	StreamingProvider      = provider.StreamingProvider
+	StreamChunk            = provider.StreamChunk
+	ProviderStatusError    = provider.StatusError
 	ProviderRegistry       = provider.Registry
 	ProviderRegistryReader = interfaces.ProviderRegistryReader
 	ProviderRegistryAdmin  = interfaces.ProviderRegistryAdmin
@@ -85,7 +92,8 @@ type (
 	// -----------------------------------
 
 	// --- AEIOU HOOK INTERFACE ---
-	AeiouOrchestrator = interfaces.AeiouOrchestrator //
+	AeiouOrchestrator            = interfaces.AeiouOrchestrator //
+	AeiouOrchestratorWithOptions = interfaces.AeiouOrchestratorWithOptions
 	// ----------------------------
 
 	// Tooling Types
@@ -112,6 +120,10 @@ type (
 	LLMCallStartInfo   = interfaces.LLMCallStartInfo
 	LLMCallSuccessInfo = interfaces.LLMCallSuccessInfo
 	LLMCallFailureInfo = interfaces.LLMCallFailureInfo
+	TokenEmitter       = interfaces.TokenEmitter
+	LLMCallChunkInfo   = interfaces.LLMCallChunkInfo
+	RetryEmitter       = interfaces.RetryEmitter
+	LLMCallRetryInfo   = interfaces.LLMCallRetryInfo
 )
 
 // Re-exported constants
@@ -123,6 +135,10 @@ const (
 	// SymbolProvider
 	SymbolProviderKey = interfaces.SymbolProviderKey
 
+	// Diagnostic Severities
+	SeverityError   = types.SeverityError
+	SeverityWarning = types.SeverityWarning
+
 	// --- AEIOU HOOK KEY ---
 	AeiouServiceKey = interfaces.AeiouServiceKey //
 	// ----------------------
@@ -158,6 +174,7 @@ var (
 	WithoutStandardTools   = interpreter.WithoutStandardTools
 	WithAITranscriptWriter = interpreter.WithAITranscriptWriter
 	WithCapsuleStore       = interpreter.WithCapsuleStore
+	WithSecretKey          = interpreter.WithSecretKey
 
 	// Loggers
 	NewNoOpLogger = logging.NewNoOpLogger
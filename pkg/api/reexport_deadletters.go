@@ -0,0 +1,25 @@
+// NeuroScript Version: 1
+// File version: 1
+// Purpose: Re-exports dead letters, the handler retry policy and the WithDeadLetters option.
+// filename: pkg/api/reexport_deadletters.go
+// nlines: 25
+
+package api
+
+import (
+	"github.com/aprice2704/neuroscript/pkg/interfaces"
+	"github.com/aprice2704/neuroscript/pkg/interpreter"
+)
+
+// Dead-letter types. An event whose handler still fails after its retries
+// is kept as a dead letter; the host reaches them through
+// Interpreter.DeadLetters and scripts through the tool.ns_event tools.
+type (
+	DeadLetter       = interfaces.DeadLetter
+	DeadLetterAdmin  = interfaces.DeadLetterAdmin
+	DeadLetterConfig = interpreter.DeadLetterConfig
+	EventRetryPolicy = interpreter.EventRetryPolicy
+)
+
+// WithDeadLetters configures handler retries and the dead-letter store.
+var WithDeadLetters = interpreter.WithDeadLetters
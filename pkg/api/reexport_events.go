@@ -0,0 +1,39 @@
+// NeuroScript Version: 1
+// File version: 2
+// Purpose: Re-exports the asynchronous event queue configuration (WithEventQueue and its policies) and the event guard counters.
+// filename: pkg/api/reexport_events.go
+// nlines: 39
+
+package api
+
+import (
+	"github.com/aprice2704/neuroscript/pkg/interpreter"
+)
+
+// Event queue types. With WithEventQueue, EmitEvent enqueues events for a
+// worker pool; drain and stop it with Interpreter.DrainEvents and
+// Interpreter.ShutdownEvents.
+type (
+	EventQueueConfig = interpreter.EventQueueConfig
+	EventQueueStats  = interpreter.EventQueueStats
+	EventOrdering    = interpreter.EventOrdering
+	EventOverflow    = interpreter.EventOverflow
+
+	// EventGuardStats is what Interpreter.EventGuardStats reports for each
+	// handler with a 'where' guard.
+	EventGuardStats = interpreter.EventGuardStats
+)
+
+// Event queue ordering and overflow policies.
+const (
+	EventOrderPerName = interpreter.EventOrderPerName
+	EventOrderPerKey  = interpreter.EventOrderPerKey
+	EventOrderNone    = interpreter.EventOrderNone
+
+	EventOverflowBlock      = interpreter.EventOverflowBlock
+	EventOverflowDropNewest = interpreter.EventOverflowDropNewest
+	EventOverflowDropOldest = interpreter.EventOverflowDropOldest
+)
+
+// WithEventQueue enables the asynchronous event queue.
+var WithEventQueue = interpreter.WithEventQueue
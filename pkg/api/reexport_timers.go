@@ -0,0 +1,32 @@
+// NeuroScript Version: 1
+// File version: 2
+// Purpose: Re-exports the timer scheduler's clock, its fake clock for tests, and the WithClock and WithTimerMinInterval options.
+// filename: pkg/api/reexport_timers.go
+// nlines: 32
+
+package api
+
+import (
+	"github.com/aprice2704/neuroscript/pkg/interpreter"
+	"github.com/aprice2704/neuroscript/pkg/scheduler"
+)
+
+// Timer types. Scripts schedule timers with tool.timer.Schedule; the host
+// lists them with Interpreter.Timers and stops them all with
+// Interpreter.StopScheduler.
+type (
+	Clock     = scheduler.Clock
+	FakeClock = scheduler.FakeClock
+	TimerInfo = scheduler.Info
+)
+
+var (
+	// WithClock sets the clock timers run on.
+	WithClock = interpreter.WithClock
+	// WithTimerMinInterval sets the shortest 'every' interval scripts may
+	// schedule; the default is one second.
+	WithTimerMinInterval = interpreter.WithTimerMinInterval
+	// NewFakeClock returns a clock that only moves when advanced, for
+	// deterministic tests of timer-driven scripts.
+	NewFakeClock = scheduler.NewFakeClock
+)
@@ -0,0 +1,103 @@
+// :: product: FDM/NS
+// :: majorVersion: 1
+// :: fileVersion: 2
+// :: description: Classifies a program as a library, command script or event sink and reports invalid mixtures.
+// :: latestChange: ErrNotExecutable's doc now matches ExecuteUnit, which refuses only libraries.
+// :: filename: pkg/api/runmode.go
+// :: serialization: go
+
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/interfaces"
+)
+
+// RunMode indicates the intended execution model of a script.
+type RunMode uint8
+
+const (
+	// RunModeLibrary: procedure definitions only. Loaded, never executed.
+	RunModeLibrary RunMode = iota
+	// RunModeCommand: command blocks only. Executed against existing state.
+	RunModeCommand
+	// RunModeEventSink: 'on event' handlers, optionally with helper procedures.
+	// Loading registers the handlers; there is nothing to execute.
+	RunModeEventSink
+	// RunModeEmpty: no procedures, handlers or commands (e.g. comments only).
+	RunModeEmpty
+	// RunModeInvalid: command blocks mixed with definitions or handlers.
+	RunModeInvalid
+)
+
+var (
+	// ErrMixedScript is returned for a script that mixes command blocks with
+	// procedure definitions or event handlers.
+	ErrMixedScript = errors.New("mixed script detected: scripts must contain either definitions OR commands, not both")
+	// ErrNotExecutable is returned by ExecuteUnit for a library, which has
+	// nothing to run; load it with LoadFromUnit instead.
+	ErrNotExecutable = errors.New("script has no command blocks to execute")
+)
+
+// String returns the lower-case name of the mode.
+func (m RunMode) String() string {
+	switch m {
+	case RunModeLibrary:
+		return "library"
+	case RunModeCommand:
+		return "command"
+	case RunModeEventSink:
+		return "event-sink"
+	case RunModeEmpty:
+		return "empty"
+	case RunModeInvalid:
+		return "invalid"
+	default:
+		return fmt.Sprintf("RunMode(%d)", uint8(m))
+	}
+}
+
+// DetectRunMode determines the run mode from the top-level contents of the
+// program. Trees whose root is not an *ast.Program are RunModeInvalid.
+func DetectRunMode(tree *interfaces.Tree) RunMode {
+	if tree == nil || tree.Root == nil {
+		return RunModeEmpty
+	}
+	program, ok := tree.Root.(*ast.Program)
+	if !ok {
+		return RunModeInvalid
+	}
+	hasCmds := len(program.Commands) > 0
+	hasProcs := len(program.Procedures) > 0
+	hasEvents := len(program.Events) > 0
+
+	switch {
+	case hasCmds && (hasProcs || hasEvents):
+		return RunModeInvalid
+	case hasCmds:
+		return RunModeCommand
+	case hasEvents:
+		return RunModeEventSink
+	case hasProcs:
+		return RunModeLibrary
+	default:
+		return RunModeEmpty
+	}
+}
+
+// CheckRunMode is DetectRunMode with a descriptive error for invalid trees.
+func CheckRunMode(tree *interfaces.Tree) (RunMode, error) {
+	mode := DetectRunMode(tree)
+	if mode != RunModeInvalid {
+		return mode, nil
+	}
+	program, ok := tree.Root.(*ast.Program)
+	if !ok {
+		return mode, fmt.Errorf("internal error: tree root is not a runnable *ast.Program, but %T", tree.Root)
+	}
+	return mode, fmt.Errorf("%w (%d command block(s), %d procedure(s), %d event handler(s))",
+		ErrMixedScript, len(program.Commands), len(program.Procedures), len(program.Events))
+}
@@ -0,0 +1,107 @@
+// :: product: FDM/NS
+// :: majorVersion: 1
+// :: fileVersion: 1
+// :: description: Tests run-mode detection and how LoadFromUnit, ExecuteUnit and LoadOrExecute act on it.
+// :: latestChange: Initial tests.
+// :: filename: pkg/api/runmode_test.go
+// :: serialization: go
+
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/api"
+	"github.com/aprice2704/neuroscript/pkg/ast"
+)
+
+const (
+	libSrc   = "func greet(returns s) means\n  return \"hi\"\nendfunc\n"
+	sinkSrc  = "func helper() means\n  return\nendfunc\n\non event \"user.created\" do\n  emit \"seen\"\nendon\n"
+	cmdSrc   = "command\n  emit \"ran\"\nendcommand\n"
+	emptySrc = "# only a comment\n"
+)
+
+func mustParse(t *testing.T, src string) *api.Tree {
+	t.Helper()
+	tree, err := api.Parse([]byte(src), api.ParseSkipComments)
+	if err != nil {
+		t.Fatalf("api.Parse() failed: %v", err)
+	}
+	return tree
+}
+
+func TestDetectRunMode(t *testing.T) {
+	cases := []struct {
+		name string
+		src  string
+		want api.RunMode
+	}{
+		{"library", libSrc, api.RunModeLibrary},
+		{"event sink", sinkSrc, api.RunModeEventSink},
+		{"command", cmdSrc, api.RunModeCommand},
+		{"empty", emptySrc, api.RunModeEmpty},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := api.DetectRunMode(mustParse(t, tc.src)); got != tc.want {
+				t.Errorf("DetectRunMode() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+
+	// The grammar rejects mixtures, but decoded or hand-built trees can carry them.
+	mixed := mustParse(t, libSrc).Root.(*ast.Program)
+	mixed.Commands = mustParse(t, cmdSrc).Root.(*ast.Program).Commands
+	tree := &api.Tree{Root: mixed}
+	if got := api.DetectRunMode(tree); got != api.RunModeInvalid {
+		t.Errorf("DetectRunMode(mixed) = %s, want invalid", got)
+	}
+	if _, err := api.CheckRunMode(tree); !errors.Is(err, api.ErrMixedScript) {
+		t.Errorf("CheckRunMode(mixed) error = %v, want ErrMixedScript", err)
+	}
+	interp := api.New(api.WithHostContext(newTestHostContext(nil)))
+	if err := api.LoadFromUnit(interp, &api.LoadedUnit{Tree: tree}); !errors.Is(err, api.ErrMixedScript) {
+		t.Errorf("LoadFromUnit(mixed) error = %v, want ErrMixedScript", err)
+	}
+	if _, err := api.LoadOrExecute(context.Background(), interp, tree); !errors.Is(err, api.ErrMixedScript) {
+		t.Errorf("LoadOrExecute(mixed) error = %v, want ErrMixedScript", err)
+	}
+}
+
+func TestExecuteUnit_ActsOnMode(t *testing.T) {
+	ctx := context.Background()
+	var emitted []string
+	hc := newTestHostContext(nil)
+	hc.EmitFunc = func(v api.Value) { emitted = append(emitted, v.String()) }
+	interp := api.New(api.WithHostContext(hc))
+
+	// Mode is re-derived from the tree, so a zero-valued Mode is not trusted.
+	_, err := api.ExecuteUnit(ctx, interp, &api.LoadedUnit{Tree: mustParse(t, libSrc)})
+	if !errors.Is(err, api.ErrNotExecutable) {
+		t.Errorf("ExecuteUnit(library) error = %v, want ErrNotExecutable", err)
+	}
+
+	if _, err := api.ExecuteUnit(ctx, interp, &api.LoadedUnit{Tree: mustParse(t, sinkSrc), Mode: api.RunModeCommand}); err != nil {
+		t.Fatalf("ExecuteUnit(event sink) failed: %v", err)
+	}
+	if n := len(interp.KnownEventHandlers()["user.created"]); n != 1 {
+		t.Errorf("expected 1 registered handler for user.created, got %d", n)
+	}
+
+	if _, err := api.ExecuteUnit(ctx, interp, &api.LoadedUnit{Tree: mustParse(t, cmdSrc)}); err != nil {
+		t.Fatalf("ExecuteUnit(command) failed: %v", err)
+	}
+	if len(emitted) != 1 || emitted[0] != "ran" {
+		t.Errorf("ExecuteUnit(command) emitted %q, want [ran]", emitted)
+	}
+	if n := len(interp.KnownEventHandlers()["user.created"]); n != 1 {
+		t.Errorf("command execution should keep the sink's handler, got %d", n)
+	}
+
+	if val, err := api.ExecuteUnit(ctx, interp, &api.LoadedUnit{Tree: mustParse(t, emptySrc)}); err != nil || val != nil {
+		t.Errorf("ExecuteUnit(empty) = %v, %v; want nil, nil", val, err)
+	}
+}
@@ -1,18 +1,15 @@
-// NeuroScript Version: 0.5.2
-// File version: 2
-// Purpose: Decrypts secrets based on their encoding type.
+// NeuroScript Version: 0.8.0
+// File version: 3
+// Purpose: Decrypts secrets based on their encoding type by delegating to pkg/secret, which supports none, age and sealedbox.
 // filename: pkg/api/secret/secret.go
-// nlines: 36
+// nlines: 30
 // risk_rating: HIGH
 
 package secret
 
 import (
-	"bytes"
-	"errors"
-	"io"
-
-	"filippo.io/age"
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/secret"
 )
 
 // Ref represents a reference to a secret.
@@ -24,27 +21,5 @@ type Ref struct {
 
 // Decode decrypts the secret based on its encoding type.
 func Decode(ref Ref, privKey []byte) (string, error) {
-	switch ref.Enc {
-	case "none":
-		return string(ref.Raw), nil
-	case "age":
-		identity, err := age.ParseX25519Identity(string(privKey))
-		if err != nil {
-			return "", err
-		}
-
-		r, err := age.Decrypt(bytes.NewReader(ref.Raw), identity)
-		if err != nil {
-			return "", err
-		}
-		decrypted, err := io.ReadAll(r)
-		if err != nil {
-			return "", err
-		}
-		return string(decrypted), nil
-	case "sealedbox":
-		return "", errors.New("sealedbox encryption is not yet supported")
-	default:
-		return "", errors.New("unsupported secret encoding")
-	}
+	return secret.Decode(&ast.SecretRef{Path: ref.Path, Enc: ref.Enc, Raw: ref.Raw}, privKey)
 }
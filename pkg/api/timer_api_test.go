@@ -0,0 +1,81 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Tests script-scheduled timers through the public API with a fake clock, and stopping them with StopScheduler.
+// filename: pkg/api/timer_api_test.go
+// nlines: 81
+// risk_rating: LOW
+
+package api_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aprice2704/neuroscript/pkg/api"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/logging"
+)
+
+func TestInterpreter_Timers(t *testing.T) {
+	var emitted []string
+	hc, err := api.NewHostContextBuilder().
+		WithLogger(logging.NewNoOpLogger()).
+		WithStdout(io.Discard).
+		WithStdin(os.Stdin).
+		WithStderr(io.Discard).
+		WithEmitFunc(func(v lang.Value) {
+			s, _ := lang.ToString(v)
+			emitted = append(emitted, s)
+		}).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to build host context: %v", err)
+	}
+	pol := api.NewPolicyBuilder(api.ContextNormal).
+		Allow("tool.timer.*").
+		Grant("timer:write:*").
+		Grant("bus:write:poll").
+		Build()
+	clock := api.NewFakeClock(time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC))
+	interp := api.New(api.WithHostContext(hc), api.WithExecPolicy(pol), api.WithClock(clock))
+
+	script := `
+func start() means
+	call tool.timer.Schedule("poll", {"every": "1m"}, {"n": 1})
+endfunc
+
+on event "poll" as ev do
+	emit ev.payload[0].AgentID
+endon
+`
+	tree, err := api.Parse([]byte(script), api.ParseSkipComments)
+	if err != nil {
+		t.Fatalf("api.Parse failed: %v", err)
+	}
+	if _, err := api.ExecWithInterpreter(context.Background(), interp, tree); err != nil {
+		t.Fatalf("api.ExecWithInterpreter failed: %v", err)
+	}
+	if _, err := api.RunProcedure(context.Background(), interp, "start"); err != nil {
+		t.Fatalf("api.RunProcedure failed: %v", err)
+	}
+
+	clock.Advance(3 * time.Minute)
+	if len(emitted) != 3 || emitted[0] != "timer:timer-1" {
+		t.Errorf("Expected three firings from timer:timer-1, got %q", emitted)
+	}
+	timers := interp.Timers()
+	if len(timers) != 1 || timers[0].Event != "poll" || timers[0].Fired != 3 {
+		t.Errorf("Unexpected timers %+v", timers)
+	}
+
+	if err := interp.StopScheduler(context.Background()); err != nil {
+		t.Fatalf("StopScheduler failed: %v", err)
+	}
+	clock.Advance(time.Hour)
+	if len(emitted) != 3 || len(interp.Timers()) != 0 {
+		t.Errorf("Timers kept firing after StopScheduler: %q", emitted)
+	}
+}
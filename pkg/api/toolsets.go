@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.7.0
-// File version: 7
-// Purpose: Adds the 'capsule' toolset to the standard library imports.
+// File version: 10
+// Purpose: Adds the 'timer' toolset to the standard library imports.
 // filename: pkg/api/toolsets.go
-// nlines: 22
+// nlines: 41
 // risk_rating: LOW
 package api
 
@@ -27,12 +27,15 @@ import (
 	_ "github.com/aprice2704/neuroscript/pkg/tool/metadata"
 	_ "github.com/aprice2704/neuroscript/pkg/tool/ns_event"
 	_ "github.com/aprice2704/neuroscript/pkg/tool/os"
+	_ "github.com/aprice2704/neuroscript/pkg/tool/policytools"
 	_ "github.com/aprice2704/neuroscript/pkg/tool/script"
+	_ "github.com/aprice2704/neuroscript/pkg/tool/secrettools"
 	_ "github.com/aprice2704/neuroscript/pkg/tool/shape"
 	_ "github.com/aprice2704/neuroscript/pkg/tool/shell"
 	_ "github.com/aprice2704/neuroscript/pkg/tool/strtools"
 	_ "github.com/aprice2704/neuroscript/pkg/tool/syntax"
 	_ "github.com/aprice2704/neuroscript/pkg/tool/time"
+	_ "github.com/aprice2704/neuroscript/pkg/tool/timer"
 	_ "github.com/aprice2704/neuroscript/pkg/tool/tree"
 	// NOTE: Add other standard tool packages here as they are created.
 )
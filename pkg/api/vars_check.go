@@ -0,0 +1,40 @@
+// :: product: FDM/NS
+// :: majorVersion: 1
+// :: fileVersion: 1
+// :: description: Static preflight reporting variable reads that nothing earlier in their block sets.
+// :: latestChange: Initial version; runs the undefined-vars analysis pass.
+// :: filename: pkg/api/vars_check.go
+// :: serialization: go
+
+package api
+
+import (
+	"fmt"
+
+	"github.com/aprice2704/neuroscript/pkg/api/analysis"
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/interpreter"
+)
+
+// CheckUndefinedVariables runs the undefined-vars analysis pass on the tree
+// and returns a diagnostic for each variable read that no parameter or
+// earlier statement in the same block sets, in a stable order. The pass
+// cannot see globals the host sets, so its findings are warnings; they are
+// errors when strict is true or the script has a ':: strict: true' header,
+// since such a read would then fail at run time.
+func CheckUndefinedVariables(tree *Tree, strict bool) ([]Diag, error) {
+	if tree == nil || tree.Root == nil {
+		return nil, fmt.Errorf("cannot check variables on a nil tree")
+	}
+	program, ok := tree.Root.(*ast.Program)
+	if !ok {
+		return nil, fmt.Errorf("internal error: tree root is not a checkable *ast.Program, but %T", tree.Root)
+	}
+	diags := analysis.FindUndefinedReads(program)
+	if strict || interpreter.IsStrictProgram(program) {
+		for idx := range diags {
+			diags[idx].Severity = SeverityError
+		}
+	}
+	return diags, nil
+}
@@ -0,0 +1,57 @@
+// :: product: FDM/NS
+// :: majorVersion: 1
+// :: fileVersion: 1
+// :: description: Tests the CheckUndefinedVariables preflight.
+// :: latestChange: Initial version.
+// :: filename: pkg/api/vars_check_test.go
+// :: serialization: go
+
+package api_test
+
+import (
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/api"
+)
+
+const undefinedVarsScript = `func main(returns r) means
+    set count = 1
+    return cuont + count
+endfunc
+`
+
+func TestCheckUndefinedVariables(t *testing.T) {
+	cases := []struct {
+		name   string
+		script string
+		strict bool
+		want   api.Severity
+		line   int
+	}{
+		{name: "lax", script: undefinedVarsScript, want: api.SeverityWarning, line: 3},
+		{name: "strict option", script: undefinedVarsScript, strict: true, want: api.SeverityError, line: 3},
+		{name: "strict header", script: ":: strict: true\n\n" + undefinedVarsScript, want: api.SeverityError, line: 5},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			tree, err := api.Parse([]byte(c.script), api.ParseSkipComments)
+			if err != nil {
+				t.Fatalf("Parse failed: %v", err)
+			}
+			diags, err := api.CheckUndefinedVariables(tree, c.strict)
+			if err != nil {
+				t.Fatalf("CheckUndefinedVariables failed: %v", err)
+			}
+			if len(diags) != 1 {
+				t.Fatalf("expected one finding for 'cuont', got %v", diags)
+			}
+			if d := diags[0]; d.Severity != c.want || d.Position == nil || d.Position.Line != c.line {
+				t.Errorf("got %+v, want severity %d at line %d", d, c.want, c.line)
+			}
+		})
+	}
+
+	if _, err := api.CheckUndefinedVariables(nil, false); err == nil {
+		t.Error("expected an error for a nil tree")
+	}
+}
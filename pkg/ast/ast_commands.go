@@ -1,7 +1,7 @@
 // filename: pkg/ast/ast_commands.go
 // NeuroScript Version: 0.5.2
-// File version: 8
-// Purpose: Removed redundant Pos field and GetPos method to unify position handling via BaseNode.
+// File version: 9
+// Purpose: Removed redundant Pos field and GetPos method to unify position handling via BaseNode. Adds the per-file Strict flag.
 // nlines: 20+
 // risk_rating: LOW
 
@@ -16,6 +16,7 @@ type CommandNode struct {
 	Comments         []*Comment
 	Body             []Step
 	ErrorHandlers    []*Step
+	Strict           bool // set when the command's file has a ':: strict: true' header
 }
 
 func (n *CommandNode) isNode()      {}
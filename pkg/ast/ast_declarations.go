@@ -1,7 +1,7 @@
 // filename: pkg/ast/ast_declarations.go
 // NeuroScript Version: 0.6.0
-// File version: 5
-// Purpose: Added Metadata, Comments, and BlankLinesBefore fields to OnEventDecl.
+// File version: 7
+// Purpose: Added Metadata, Comments, and BlankLinesBefore fields to OnEventDecl. Added the optional GuardExpr and the per-file Strict flag.
 // nlines: 25+
 // risk_rating: MEDIUM
 
@@ -14,9 +14,11 @@ type OnEventDecl struct {
 	Metadata         map[string]string // Added for consistency
 	Comments         []*Comment        // Added for consistency
 	EventNameExpr    Expression
+	GuardExpr        Expression // optional 'where' filter; nil when absent
 	HandlerName      string
 	EventVarName     string
 	Body             []Step
+	Strict           bool // set when the handler's file has a ':: strict: true' header
 }
 
 // MetadataLine represents a single `:: key: value` line associated with a declaration.
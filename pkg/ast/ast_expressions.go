@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 8
+// :: fileVersion: 12
 // :: description: Updated MapEntryNode.Key to be generic Expression instead of *StringLiteralNode. Added InterpolatedStringNode.
-// :: latestChange: Added InterpolatedStringNode to support structured templates for [[...]] and ```...``` strings.
+// :: latestChange: ElementAccessNode records dotted member access; added SliceNode.
 // :: filename: pkg/ast/ast_expressions.go
 // :: serialization: go
 
@@ -32,26 +32,66 @@ func (ct *CallTarget) expressionNode()    {}
 func (ct *CallTarget) TestString() string { return ct.String() }
 
 // CallableExprNode represents a function or tool call expression.
+// Positional Arguments always precede NamedArgs in the source.
 type CallableExprNode struct {
 	BaseNode
 	Target    CallTarget
 	Arguments []Expression
+	NamedArgs []*NamedArgNode
 }
 
 func (n *CallableExprNode) expressionNode() {}
 func (n *CallableExprNode) String() string {
-	args := make([]string, len(n.Arguments))
-	for i, arg := range n.Arguments {
+	args := make([]string, 0, len(n.Arguments)+len(n.NamedArgs))
+	for _, arg := range n.Arguments {
 		if arg != nil {
-			args[i] = arg.String()
+			args = append(args, arg.String())
 		} else {
-			args[i] = "<nil_expr>"
+			args = append(args, "<nil_expr>")
 		}
 	}
+	for _, named := range n.NamedArgs {
+		args = append(args, named.String())
+	}
 	return fmt.Sprintf("%s(%s)", n.Target.String(), strings.Join(args, ", "))
 }
 func (n *CallableExprNode) TestString() string { return n.String() }
 
+// NamedArgNode is a `name: value` argument in a call expression.
+type NamedArgNode struct {
+	BaseNode
+	Name  string
+	Value Expression
+}
+
+func (n *NamedArgNode) String() string {
+	if n.Value == nil {
+		return n.Name + ": <nil_expr>"
+	}
+	return fmt.Sprintf("%s: %s", n.Name, n.Value.String())
+}
+
+// LambdaNode is a function literal: 'func(x) means x * 2', or a statement
+// body ending in 'endfunc'. Exactly one of Body and Steps is set; 'on error'
+// handlers in a statement body are kept apart, as for a Procedure.
+type LambdaNode struct {
+	BaseNode
+	Params        []string
+	Body          Expression
+	Steps         []Step
+	ErrorHandlers []*Step
+}
+
+func (n *LambdaNode) expressionNode() {}
+func (n *LambdaNode) String() string {
+	params := strings.Join(n.Params, ", ")
+	if n.Body == nil {
+		return fmt.Sprintf("func(%s) means ... endfunc", params)
+	}
+	return fmt.Sprintf("func(%s) means %s", params, n.Body.String())
+}
+func (n *LambdaNode) TestString() string { return n.String() }
+
 // VariableNode represents a variable reference.
 type VariableNode struct {
 	BaseNode
@@ -146,8 +186,23 @@ type NumberLiteralNode struct {
 	Value interface{} // Stores int64 or float64
 }
 
-func (n *NumberLiteralNode) expressionNode()    {}
-func (n *NumberLiteralNode) String() string     { return fmt.Sprintf("%v", n.Value) }
+func (n *NumberLiteralNode) expressionNode() {}
+func (n *NumberLiteralNode) String() string {
+	if f, ok := n.Value.(float64); ok {
+		return formatFloatLiteral(f)
+	}
+	return fmt.Sprintf("%v", n.Value)
+}
+
+// formatFloatLiteral prints a float literal so that it parses back as a
+// float: whole values keep a trailing ".0" to stay distinct from integers.
+func formatFloatLiteral(f float64) string {
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".eEIN") {
+		s += ".0"
+	}
+	return s
+}
 func (n *NumberLiteralNode) TestString() string { return n.String() }
 
 // BooleanLiteralNode represents a boolean literal (true or false).
@@ -235,20 +290,53 @@ func (n *MapLiteralNode) TestString() string {
 }
 
 // ElementAccessNode represents accessing an element of a list or map (e.g., myList[0], myMap["key"]).
+// Member access (myMap.key) is the DotAccess form, with the field name as a string literal accessor.
 type ElementAccessNode struct {
 	BaseNode
-	Collection Expression // The variable or expression yielding the collection
-	Accessor   Expression // The index or key expression
+	Collection Expression   // The variable or expression yielding the collection
+	Accessor   Expression   // The index or key expression
+	Type       AccessorType // BracketAccess or DotAccess
 }
 
 func (n *ElementAccessNode) expressionNode() {}
 func (n *ElementAccessNode) String() string {
+	if key, ok := n.Accessor.(*StringLiteralNode); ok && n.Type == DotAccess {
+		return fmt.Sprintf("%s.%s", n.Collection.String(), key.Value)
+	}
 	return fmt.Sprintf("%s[%s]", n.Collection.String(), n.Accessor.String())
 }
 func (n *ElementAccessNode) TestString() string {
+	if key, ok := n.Accessor.(*StringLiteralNode); ok && n.Type == DotAccess {
+		return fmt.Sprintf("%s.%s", n.Collection.TestString(), key.Value)
+	}
 	return fmt.Sprintf("%s[%s]", n.Collection.TestString(), n.Accessor.TestString())
 }
 
+// SliceNode represents slicing a list or string (e.g., myList[1:3], myStr[:5]).
+// Start and End are nil when omitted.
+type SliceNode struct {
+	BaseNode
+	Collection Expression
+	Start      Expression
+	End        Expression
+}
+
+func (n *SliceNode) expressionNode() {}
+func (n *SliceNode) String() string {
+	return fmt.Sprintf("%s[%s:%s]", n.Collection.String(), optionalString(n.Start, Expression.String), optionalString(n.End, Expression.String))
+}
+func (n *SliceNode) TestString() string {
+	return fmt.Sprintf("%s[%s:%s]", n.Collection.TestString(), optionalString(n.Start, Expression.TestString), optionalString(n.End, Expression.TestString))
+}
+
+// optionalString renders an optional expression, or "" when it is absent.
+func optionalString(e Expression, render func(Expression) string) string {
+	if e == nil {
+		return ""
+	}
+	return render(e)
+}
+
 // UnaryOpNode represents a unary operation (e.g., -value, not flag).
 type UnaryOpNode struct {
 	BaseNode
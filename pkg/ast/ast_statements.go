@@ -1,6 +1,6 @@
 // NeuroScript Version: 0.8.0
-// File version: 29
-// Purpose: Adds the TestString() method to LValueNode to satisfy the Expression interface.
+// File version: 32
+// Purpose: Adds TryStmt for try/catch/finally blocks. Procedures record whether their file is strict.
 // filename: pkg/ast/ast_statements.go
 // nlines: 135+
 // risk_rating: LOW
@@ -88,6 +88,8 @@ type Procedure struct {
 	ReturnVarNames    []string
 	ErrorHandlers     []*Step
 	Steps             []Step
+	// Strict is set when the procedure's file has a ':: strict: true' header.
+	Strict bool
 }
 
 func (p *Procedure) SetName(name string) {
@@ -119,6 +121,8 @@ type Step struct {
 	AskStmt          *AskStmt
 	PromptUserStmt   *PromptUserStmt
 	WhisperStmt      *WhisperStmt // Added for 'whisper' statement
+	SwitchStmt       *SwitchStmt  // Set for 'switch' steps
+	TryStmt          *TryStmt     // Set for 'try' steps
 	IsFinal          bool
 	ErrorName        string
 	tool             interfaces.Tool
@@ -159,6 +163,46 @@ type WhisperStmt struct {
 	Value  Expression // The value to be whispered
 }
 
+// SwitchCaseKind selects how a switch case is matched against the subject.
+type SwitchCaseKind int
+
+const (
+	// CaseValues matches when the subject equals any of the listed values.
+	CaseValues SwitchCaseKind = iota
+	// CaseIn matches when the subject is an element of a list or a key of a map.
+	CaseIn
+	// CaseTypeOf matches when typeof(subject) equals any of the listed type names.
+	CaseTypeOf
+)
+
+// SwitchStmt represents a 'switch' statement. Cases are tried in order and the
+// first match wins; Default is nil when the statement has no default branch.
+type SwitchStmt struct {
+	BaseNode
+	Subject Expression
+	Cases   []*SwitchCase
+	Default []Step
+}
+
+// SwitchCase is a single 'case' branch of a switch statement.
+type SwitchCase struct {
+	BaseNode
+	CaseKind SwitchCaseKind
+	Values   []Expression // For CaseIn this holds exactly one collection expression.
+	Body     []Step
+}
+
+// TryStmt represents a 'try' statement. Catch is nil when there is no catch
+// clause and Finally is nil when there is no finally clause; CatchVar is empty
+// when the caught error is not bound.
+type TryStmt struct {
+	BaseNode
+	Body     []Step
+	CatchVar string
+	Catch    []Step
+	Finally  []Step
+}
+
 // ExpressionStatementNode represents a statement that consists of a single expression,
 // like a standalone 'must' or a function call. The result of the expression is discarded.
 type ExpressionStatementNode struct {
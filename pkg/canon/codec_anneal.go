@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.6.3
-// File version: 2
+// File version: 8
 // Purpose: Provides a post-decode "annealing" pass to normalize CallTarget kinds across the entire AST.
 // filename: pkg/canon/codec_anneal.go
-// nlines: 120
+// nlines: 187
 // risk_rating: MEDIUM
 
 package canon
@@ -47,6 +47,11 @@ func restoreCallTargetKinds(p *ast.Program) {
 			for _, a := range n.Arguments {
 				visitExpr(a)
 			}
+			for _, na := range n.NamedArgs {
+				if na != nil {
+					visitExpr(na.Value)
+				}
+			}
 		case *ast.BinaryOpNode:
 			visitExpr(n.Left)
 			visitExpr(n.Right)
@@ -65,6 +70,18 @@ func restoreCallTargetKinds(p *ast.Program) {
 		case *ast.ElementAccessNode:
 			visitExpr(n.Collection)
 			visitExpr(n.Accessor)
+		case *ast.SliceNode:
+			visitExpr(n.Collection)
+			visitExpr(n.Start)
+			visitExpr(n.End)
+		case *ast.LambdaNode:
+			visitExpr(n.Body)
+			for i := range n.Steps {
+				visitStep(&n.Steps[i])
+			}
+			for _, handler := range n.ErrorHandlers {
+				visitStep(handler)
+			}
 		}
 	}
 
@@ -73,7 +90,7 @@ func restoreCallTargetKinds(p *ast.Program) {
 			return
 		}
 		switch s.Type {
-		case "set", "emit", "return", "fail":
+		case "set", "emit", "return", "fail", "raise":
 			for _, v := range s.Values {
 				visitExpr(v)
 			}
@@ -99,6 +116,11 @@ func restoreCallTargetKinds(p *ast.Program) {
 				for _, a := range s.Call.Arguments {
 					visitExpr(a)
 				}
+				for _, na := range s.Call.NamedArgs {
+					if na != nil {
+						visitExpr(na.Value)
+					}
+				}
 			}
 		case "ask":
 			if s.AskStmt != nil {
@@ -112,6 +134,29 @@ func restoreCallTargetKinds(p *ast.Program) {
 			if s.PromptUserStmt != nil {
 				visitExpr(s.PromptUserStmt.PromptExpr)
 			}
+		case "switch":
+			if s.SwitchStmt != nil {
+				visitExpr(s.SwitchStmt.Subject)
+				for _, c := range s.SwitchStmt.Cases {
+					for _, v := range c.Values {
+						visitExpr(v)
+					}
+					for i := range c.Body {
+						visitStep(&c.Body[i])
+					}
+				}
+				for i := range s.SwitchStmt.Default {
+					visitStep(&s.SwitchStmt.Default[i])
+				}
+			}
+		case "try":
+			if s.TryStmt != nil {
+				for _, block := range [][]ast.Step{s.TryStmt.Body, s.TryStmt.Catch, s.TryStmt.Finally} {
+					for i := range block {
+						visitStep(&block[i])
+					}
+				}
+			}
 		}
 	}
 
@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 2
+// :: fileVersion: 3
 // :: description: Fixed MapEntryNode key handling to support Expression type (sorting by string rep, casting correctly).
-// :: latestChange: Updated encode/decode to handle Expression keys.
+// :: latestChange: Added dotted member access and slice codecs.
 // :: filename: pkg/canon/codec_collections.go
 // :: serialization: go
 
@@ -109,7 +109,15 @@ func encodeElementAccess(v *canonVisitor, n ast.Node) error {
 }
 
 func decodeElementAccess(r *canonReader) (ast.Node, error) {
-	node := &ast.ElementAccessNode{BaseNode: ast.BaseNode{NodeKind: types.KindElementAccess}}
+	return decodeAccess(r, &ast.ElementAccessNode{BaseNode: ast.BaseNode{NodeKind: types.KindElementAccess}})
+}
+
+// decodeMemberAccess decodes 'a.b', which shares the element access payload.
+func decodeMemberAccess(r *canonReader) (ast.Node, error) {
+	return decodeAccess(r, &ast.ElementAccessNode{BaseNode: ast.BaseNode{NodeKind: types.KindMemberAccess}, Type: ast.DotAccess})
+}
+
+func decodeAccess(r *canonReader, node *ast.ElementAccessNode) (ast.Node, error) {
 	collection, err := r.visitor()
 	if err != nil {
 		return nil, err
@@ -122,3 +130,43 @@ func decodeElementAccess(r *canonReader) (ast.Node, error) {
 	node.Accessor = accessor.(ast.Expression)
 	return node, nil
 }
+
+func encodeSlice(v *canonVisitor, n ast.Node) error {
+	node := n.(*ast.SliceNode)
+	if err := v.visitor(node.Collection); err != nil {
+		return err
+	}
+	for _, bound := range []ast.Expression{node.Start, node.End} {
+		v.writeBool(bound != nil)
+		if bound != nil {
+			if err := v.visitor(bound); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func decodeSlice(r *canonReader) (ast.Node, error) {
+	node := &ast.SliceNode{BaseNode: ast.BaseNode{NodeKind: types.KindSlice}}
+	collection, err := r.visitor()
+	if err != nil {
+		return nil, err
+	}
+	node.Collection = collection.(ast.Expression)
+	for _, bound := range []*ast.Expression{&node.Start, &node.End} {
+		present, err := r.readBool()
+		if err != nil {
+			return nil, err
+		}
+		if !present {
+			continue
+		}
+		expr, err := r.visitor()
+		if err != nil {
+			return nil, err
+		}
+		*bound = expr.(ast.Expression)
+	}
+	return node, nil
+}
@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.6.3
-// File version: 2
-// Purpose: Implements encoders/decoders for complex expression AST nodes. Added BinaryOp and UnaryOp.
+// File version: 4
+// Purpose: Implements encoders/decoders for complex expression AST nodes. Adds function literals (LambdaNode).
 // filename: pkg/canon/codec_expressions.go
-// nlines: 120
+// nlines: 280
 // risk_rating: HIGH
 
 package canon
@@ -18,7 +18,13 @@ func encodeCallableExpr(v *canonVisitor, n ast.Node) error {
 	node := n.(*ast.CallableExprNode)
 	tempVisitor := &canonVisitor{w: v.w, hasher: v.hasher}
 
-	tempVisitor.write([]byte{CallMagic1, CallMagic2, CallWireVersion, CallLayoutHeader})
+	// Calls without named arguments keep the original layout so their
+	// canonical bytes, and hence hashes, are unchanged.
+	layout := byte(CallLayoutHeader)
+	if len(node.NamedArgs) > 0 {
+		layout = CallLayoutNamedArgs
+	}
+	tempVisitor.write([]byte{CallMagic1, CallMagic2, CallWireVersion, layout})
 	tempVisitor.writeBool(node.Target.IsTool)
 	tempVisitor.writeString(node.Target.Name)
 	tempVisitor.writeVarint(int64(len(node.Arguments)))
@@ -27,6 +33,14 @@ func encodeCallableExpr(v *canonVisitor, n ast.Node) error {
 			return err
 		}
 	}
+	if layout == CallLayoutNamedArgs {
+		tempVisitor.writeVarint(int64(len(node.NamedArgs)))
+		for _, named := range node.NamedArgs {
+			if err := v.visitor(named); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -57,7 +71,7 @@ func decodeCallableExpr(r *canonReader) (ast.Node, error) {
 		return nil, fmt.Errorf("callable: bad header: got [%02X %02X] ver=%02X", m1, m2, ver)
 	}
 
-	if layout != CallLayoutHeader {
+	if layout != CallLayoutHeader && layout != CallLayoutNamedArgs {
 		return nil, fmt.Errorf("callable: unsupported layout %d", layout)
 	}
 
@@ -81,10 +95,49 @@ func decodeCallableExpr(r *canonReader) (ast.Node, error) {
 		}
 		node.Arguments[i] = argNode.(ast.Expression)
 	}
+	if layout == CallLayoutNamedArgs {
+		namedc, err := r.readVarint()
+		if err != nil {
+			return nil, err
+		}
+		node.NamedArgs = make([]*ast.NamedArgNode, namedc)
+		for i := 0; i < int(namedc); i++ {
+			namedNode, err := r.visitor()
+			if err != nil {
+				return nil, err
+			}
+			named, ok := namedNode.(*ast.NamedArgNode)
+			if !ok {
+				return nil, fmt.Errorf("callable: expected *ast.NamedArgNode, got %T", namedNode)
+			}
+			node.NamedArgs[i] = named
+		}
+	}
 
 	return node, nil
 }
 
+func encodeNamedArg(v *canonVisitor, n ast.Node) error {
+	node := n.(*ast.NamedArgNode)
+	v.writeString(node.Name)
+	return v.visitor(node.Value)
+}
+
+func decodeNamedArg(r *canonReader) (ast.Node, error) {
+	node := &ast.NamedArgNode{BaseNode: ast.BaseNode{NodeKind: types.KindNamedArg}}
+	var err error
+	node.Name, err = r.readString()
+	if err != nil {
+		return nil, err
+	}
+	value, err := r.visitor()
+	if err != nil {
+		return nil, err
+	}
+	node.Value = value.(ast.Expression)
+	return node, nil
+}
+
 func encodeBinaryOp(v *canonVisitor, n ast.Node) error {
 	node := n.(*ast.BinaryOpNode)
 	v.writeString(node.Operator)
@@ -134,3 +187,94 @@ func decodeUnaryOp(r *canonReader) (ast.Node, error) {
 	node.Operand = operand.(ast.Expression)
 	return node, nil
 }
+
+// encodeLambda writes the parameters, then either the body expression or the
+// statement body and its error handlers.
+func encodeLambda(v *canonVisitor, n ast.Node) error {
+	node := n.(*ast.LambdaNode)
+	v.writeVarint(int64(len(node.Params)))
+	for _, param := range node.Params {
+		v.writeString(param)
+	}
+	v.writeBool(node.Body != nil)
+	if node.Body != nil {
+		return v.visitor(node.Body)
+	}
+	v.writeVarint(int64(len(node.Steps)))
+	for i := range node.Steps {
+		if err := v.visitor(&node.Steps[i]); err != nil {
+			return err
+		}
+	}
+	v.writeVarint(int64(len(node.ErrorHandlers)))
+	for _, handler := range node.ErrorHandlers {
+		if err := v.visitor(handler); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeLambda(r *canonReader) (ast.Node, error) {
+	node := &ast.LambdaNode{BaseNode: ast.BaseNode{NodeKind: types.KindLambda}}
+	paramc, err := r.readVarint()
+	if err != nil {
+		return nil, err
+	}
+	node.Params = make([]string, paramc)
+	for i := range node.Params {
+		if node.Params[i], err = r.readString(); err != nil {
+			return nil, err
+		}
+	}
+	hasBody, err := r.readBool()
+	if err != nil {
+		return nil, err
+	}
+	if hasBody {
+		body, err := r.visitor()
+		if err != nil {
+			return nil, err
+		}
+		expr, ok := body.(ast.Expression)
+		if !ok {
+			return nil, fmt.Errorf("lambda: expected expression body, got %T", body)
+		}
+		node.Body = expr
+		return node, nil
+	}
+
+	stepc, err := r.readVarint()
+	if err != nil {
+		return nil, err
+	}
+	node.Steps = make([]ast.Step, stepc)
+	for i := range node.Steps {
+		stepNode, err := r.visitor()
+		if err != nil {
+			return nil, err
+		}
+		step, ok := stepNode.(*ast.Step)
+		if !ok {
+			return nil, fmt.Errorf("lambda: expected *ast.Step, got %T", stepNode)
+		}
+		node.Steps[i] = *step
+	}
+	handlerc, err := r.readVarint()
+	if err != nil {
+		return nil, err
+	}
+	node.ErrorHandlers = make([]*ast.Step, handlerc)
+	for i := range node.ErrorHandlers {
+		handlerNode, err := r.visitor()
+		if err != nil {
+			return nil, err
+		}
+		handler, ok := handlerNode.(*ast.Step)
+		if !ok {
+			return nil, fmt.Errorf("lambda: expected *ast.Step handler, got %T", handlerNode)
+		}
+		node.ErrorHandlers[i] = handler
+	}
+	return node, nil
+}
@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 3
+// :: fileVersion: 4
 // :: description: Defines shared helper structs and primitive I/O methods. Includes format versioning.
-// :: latestChange: Added canonReader.version and isV2Format() for legacy blob compatibility.
+// :: latestChange: Numbers carry an int/float type marker so integer literals decode as int64.
 // :: filename: pkg/canon/codec_helpers.go
 // :: serialization: go
 
@@ -75,10 +75,21 @@ func (v *canonVisitor) writeBool(b bool) {
 	}
 }
 
+// Number type markers. Blobs written before integers existed only use
+// numberMarkerFloat.
+const (
+	numberMarkerFloat byte = 0x01
+	numberMarkerInt   byte = 0x02
+)
+
 func (v *canonVisitor) writeNumber(val interface{}) {
+	if i, ok := val.(int64); ok {
+		v.write([]byte{numberMarkerInt})
+		v.writeString(strconv.FormatInt(i, 10))
+		return
+	}
 	strVal := fmt.Sprintf("%v", val)
-	// Use a type marker for potential future use (e.g., int vs float)
-	v.write([]byte{0x01}) // 0x01 for float64/generic
+	v.write([]byte{numberMarkerFloat})
 	v.writeString(strVal)
 }
 
@@ -128,7 +139,7 @@ func (r *canonReader) readBool() (bool, error) {
 }
 
 func (r *canonReader) readNumber() (interface{}, error) {
-	_, err := r.readByte() // Read and discard the type marker
+	marker, err := r.readByte()
 	if err != nil {
 		return nil, err // Already converted
 	}
@@ -136,7 +147,13 @@ func (r *canonReader) readNumber() (interface{}, error) {
 	if err != nil {
 		return nil, err // Already converted
 	}
-	// Always parse as float64 to match parser behavior
+	if marker == numberMarkerInt {
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer format: %w", err)
+		}
+		return i, nil
+	}
 	val, err := strconv.ParseFloat(s, 64)
 	if err != nil {
 		return nil, fmt.Errorf("invalid number format: %w", err)
@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 16
+// :: fileVersion: 17
 // :: description: Updated decodeProcedure with safe type assertions to prevent panics on corrupted or legacy blobs.
-// :: latestChange: Replaced raw type assertions with safe checks.
+// :: latestChange: ValueToNode and NodeToValue round-trip IntValue through int64 number literals.
 // :: filename: pkg/canon/codec_procedure.go
 // :: serialization: go
 
@@ -263,6 +263,8 @@ func ValueToNode(val lang.Value) (ast.Node, error) {
 		return &ast.StringLiteralNode{BaseNode: ast.BaseNode{NodeKind: types.KindStringLiteral}, Value: v.Value}, nil
 	case lang.NumberValue:
 		return &ast.NumberLiteralNode{BaseNode: ast.BaseNode{NodeKind: types.KindNumberLiteral}, Value: v.Value}, nil
+	case lang.IntValue:
+		return &ast.NumberLiteralNode{BaseNode: ast.BaseNode{NodeKind: types.KindNumberLiteral}, Value: v.Value}, nil
 	case lang.BoolValue:
 		return &ast.BooleanLiteralNode{BaseNode: ast.BaseNode{NodeKind: types.KindBooleanLiteral}, Value: v.Value}, nil
 	case lang.NilValue:
@@ -312,11 +314,13 @@ func NodeToValue(node ast.Node) (lang.Value, error) {
 	case *ast.StringLiteralNode:
 		return lang.StringValue{Value: n.Value}, nil
 	case *ast.NumberLiteralNode:
-		num, ok := n.Value.(float64)
-		if !ok {
-			return nil, fmt.Errorf("NodeToValue: NumberLiteralNode value is not float64, but %T", n.Value)
+		switch num := n.Value.(type) {
+		case int64:
+			return lang.IntValue{Value: num}, nil
+		case float64:
+			return lang.NumberValue{Value: num}, nil
 		}
-		return lang.NumberValue{Value: num}, nil
+		return nil, fmt.Errorf("NodeToValue: NumberLiteralNode value is not int64 or float64, but %T", n.Value)
 	case *ast.BooleanLiteralNode:
 		return lang.BoolValue{Value: n.Value}, nil
 	case *ast.NilLiteralNode:
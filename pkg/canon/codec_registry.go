@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 9
+// :: fileVersion: 14
 // :: description: Registers codecs for Ask/Prompt/WhisperStmt, Comment, and InterpolatedString.
-// :: latestChange: Registered KindInterpolatedString codec to fix decoding panics.
+// :: latestChange: Registered the KindSlice and KindMemberAccess codecs.
 // :: filename: pkg/canon/codec_registry.go
 // :: serialization: go
 
@@ -36,9 +36,14 @@ func init() {
 		// Statements
 		types.KindStep:           {EncodeFunc: encodeStep, DecodeFunc: decodeStep},
 		types.KindExpressionStmt: {EncodeFunc: encodeExpressionStmt, DecodeFunc: decodeExpressionStmt},
+		types.KindSwitchStmt:     {EncodeFunc: encodeSwitchStmt, DecodeFunc: decodeSwitchStmt},
+		types.KindSwitchCase:     {EncodeFunc: encodeSwitchCase, DecodeFunc: decodeSwitchCase},
+		types.KindTryStmt:        {EncodeFunc: encodeTryStmt, DecodeFunc: decodeTryStmt},
 
 		// Expressions
 		types.KindCallableExpr:  {EncodeFunc: encodeCallableExpr, DecodeFunc: decodeCallableExpr},
+		types.KindNamedArg:      {EncodeFunc: encodeNamedArg, DecodeFunc: decodeNamedArg},
+		types.KindLambda:        {EncodeFunc: encodeLambda, DecodeFunc: decodeLambda},
 		types.KindVariable:      {EncodeFunc: encodeVariable, DecodeFunc: decodeVariable},
 		types.KindPlaceholder:   {EncodeFunc: encodePlaceholder, DecodeFunc: decodePlaceholder},
 		types.KindLastResult:    {EncodeFunc: encodeLast, DecodeFunc: decodeLast},
@@ -47,6 +52,8 @@ func init() {
 		types.KindBinaryOp:      {EncodeFunc: encodeBinaryOp, DecodeFunc: decodeBinaryOp},
 		types.KindUnaryOp:       {EncodeFunc: encodeUnaryOp, DecodeFunc: decodeUnaryOp},
 		types.KindElementAccess: {EncodeFunc: encodeElementAccess, DecodeFunc: decodeElementAccess},
+		types.KindMemberAccess:  {EncodeFunc: encodeElementAccess, DecodeFunc: decodeMemberAccess},
+		types.KindSlice:         {EncodeFunc: encodeSlice, DecodeFunc: decodeSlice},
 		types.KindLValue:        {EncodeFunc: encodeLValue, DecodeFunc: decodeLValue},
 
 		// Literals
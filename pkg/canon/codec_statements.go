@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 8
+// :: fileVersion: 9
 // :: description: Updated decodeOnEventDecl and wrappers with safe type assertions.
-// :: latestChange: Replaced raw type assertions with safe checks.
+// :: latestChange: Encodes the optional 'where' guard of event handlers.
 // :: filename: pkg/canon/codec_statements.go
 // :: serialization: go
 
@@ -267,6 +267,12 @@ func encodeOnEventDecl(v *canonVisitor, n ast.Node) error {
 	}
 	v.writeString(node.HandlerName)
 	v.writeString(node.EventVarName)
+	v.writeBool(node.GuardExpr != nil)
+	if node.GuardExpr != nil {
+		if err := v.visitor(node.GuardExpr); err != nil {
+			return err
+		}
+	}
 	v.writeVarint(int64(len(node.Body)))
 	for i := range node.Body {
 		if err := v.visitor(&node.Body[i]); err != nil {
@@ -314,6 +320,21 @@ func decodeOnEventDecl(r *canonReader) (ast.Node, error) {
 	if err != nil {
 		return nil, err
 	}
+	hasGuard, err := r.readBool()
+	if err != nil {
+		return nil, err
+	}
+	if hasGuard {
+		guard, err := r.visitor()
+		if err != nil {
+			return nil, err
+		}
+		guardExpr, ok := guard.(ast.Expression)
+		if !ok {
+			return nil, fmt.Errorf("expected ast.Expression for event guard, got %T", guard)
+		}
+		node.GuardExpr = guardExpr
+	}
 	bodyCount, err := r.readVarint()
 	if err != nil {
 		return nil, err
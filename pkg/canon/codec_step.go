@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 11
+// :: fileVersion: 14
 // :: description: Updated decodeStep with safe type assertions to prevent panics.
-// :: latestChange: Replaced raw type assertions with safe checks.
+// :: latestChange: Encodes 'raise' steps like the other value-list steps.
 // :: filename: pkg/canon/codec_step.go
 // :: serialization: go
 
@@ -41,7 +41,7 @@ func encodeStep(v *canonVisitor, n ast.Node) error {
 				return err
 			}
 		}
-	case "emit", "return", "fail":
+	case "emit", "return", "fail", "raise":
 		v.writeVarint(int64(len(node.Values)))
 		for _, val := range node.Values {
 			if err := v.visitor(val); err != nil {
@@ -115,6 +115,10 @@ func encodeStep(v *canonVisitor, n ast.Node) error {
 		return encodePromptUserStmt(v, stmt)
 	case "whisper":
 		return encodeWhisperStmt(v, node.WhisperStmt)
+	case "switch":
+		return v.visitor(node.SwitchStmt)
+	case "try":
+		return v.visitor(node.TryStmt)
 	}
 	return nil
 }
@@ -180,7 +184,7 @@ func decodeStep(r *canonReader) (ast.Node, error) {
 			}
 			step.Values[i] = node.(ast.Expression)
 		}
-	case "emit", "return", "fail":
+	case "emit", "return", "fail", "raise":
 		valCount, err := r.readVarint()
 		if err != nil {
 			return nil, err
@@ -303,6 +307,26 @@ func decodeStep(r *canonReader) (ast.Node, error) {
 		if err != nil {
 			return nil, err
 		}
+	case "switch":
+		node, err := r.visitor()
+		if err != nil {
+			return nil, err
+		}
+		sw, ok := node.(*ast.SwitchStmt)
+		if !ok {
+			return nil, fmt.Errorf("expected *ast.SwitchStmt in switch step, got %T", node)
+		}
+		step.SwitchStmt = sw
+	case "try":
+		node, err := r.visitor()
+		if err != nil {
+			return nil, err
+		}
+		try, ok := node.(*ast.TryStmt)
+		if !ok {
+			return nil, fmt.Errorf("expected *ast.TryStmt in try step, got %T", node)
+		}
+		step.TryStmt = try
 	}
 	return step, nil
 }
@@ -0,0 +1,146 @@
+// :: product: FDM/NS
+// :: majorVersion: 1
+// :: fileVersion: 1
+// :: description: Codecs for SwitchStmt and SwitchCase.
+// :: latestChange: Initial version.
+// :: filename: pkg/canon/codec_switch.go
+// :: serialization: go
+
+package canon
+
+import (
+	"fmt"
+
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/types"
+)
+
+func encodeSteps(v *canonVisitor, steps []ast.Step) error {
+	v.writeVarint(int64(len(steps)))
+	for i := range steps {
+		if err := v.visitor(&steps[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeSteps(r *canonReader, where string) ([]ast.Step, error) {
+	count, err := r.readVarint()
+	if err != nil {
+		return nil, err
+	}
+	steps := make([]ast.Step, count)
+	for i := range steps {
+		node, err := r.visitor()
+		if err != nil {
+			return nil, err
+		}
+		stepNode, ok := node.(*ast.Step)
+		if !ok {
+			return nil, fmt.Errorf("expected *ast.Step in %s, got %T", where, node)
+		}
+		steps[i] = *stepNode
+	}
+	return steps, nil
+}
+
+func encodeSwitchStmt(v *canonVisitor, n ast.Node) error {
+	node := n.(*ast.SwitchStmt)
+	if err := v.visitor(node.Subject); err != nil {
+		return err
+	}
+	v.writeVarint(int64(len(node.Cases)))
+	for _, c := range node.Cases {
+		if err := v.visitor(c); err != nil {
+			return err
+		}
+	}
+	v.writeBool(node.Default != nil)
+	if node.Default != nil {
+		return encodeSteps(v, node.Default)
+	}
+	return nil
+}
+
+func decodeSwitchStmt(r *canonReader) (ast.Node, error) {
+	node := &ast.SwitchStmt{BaseNode: ast.BaseNode{NodeKind: types.KindSwitchStmt}}
+	subject, err := r.visitor()
+	if err != nil {
+		return nil, err
+	}
+	expr, ok := subject.(ast.Expression)
+	if !ok {
+		return nil, fmt.Errorf("switch: expected expression subject, got %T", subject)
+	}
+	node.Subject = expr
+
+	casec, err := r.readVarint()
+	if err != nil {
+		return nil, err
+	}
+	node.Cases = make([]*ast.SwitchCase, casec)
+	for i := range node.Cases {
+		cn, err := r.visitor()
+		if err != nil {
+			return nil, err
+		}
+		sc, ok := cn.(*ast.SwitchCase)
+		if !ok {
+			return nil, fmt.Errorf("switch: expected *ast.SwitchCase, got %T", cn)
+		}
+		node.Cases[i] = sc
+	}
+
+	hasDefault, err := r.readBool()
+	if err != nil {
+		return nil, err
+	}
+	if hasDefault {
+		if node.Default, err = decodeSteps(r, "switch default"); err != nil {
+			return nil, err
+		}
+	}
+	return node, nil
+}
+
+func encodeSwitchCase(v *canonVisitor, n ast.Node) error {
+	node := n.(*ast.SwitchCase)
+	v.writeVarint(int64(node.CaseKind))
+	v.writeVarint(int64(len(node.Values)))
+	for _, val := range node.Values {
+		if err := v.visitor(val); err != nil {
+			return err
+		}
+	}
+	return encodeSteps(v, node.Body)
+}
+
+func decodeSwitchCase(r *canonReader) (ast.Node, error) {
+	node := &ast.SwitchCase{BaseNode: ast.BaseNode{NodeKind: types.KindSwitchCase}}
+	kind, err := r.readVarint()
+	if err != nil {
+		return nil, err
+	}
+	node.CaseKind = ast.SwitchCaseKind(kind)
+	valc, err := r.readVarint()
+	if err != nil {
+		return nil, err
+	}
+	node.Values = make([]ast.Expression, valc)
+	for i := range node.Values {
+		val, err := r.visitor()
+		if err != nil {
+			return nil, err
+		}
+		expr, ok := val.(ast.Expression)
+		if !ok {
+			return nil, fmt.Errorf("switch case: expected expression value, got %T", val)
+		}
+		node.Values[i] = expr
+	}
+	if node.Body, err = decodeSteps(r, "switch case body"); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.6.3
-// File version: 1
-// Purpose: Provides an end-to-end test for the new registry-based codec system.
+// File version: 6
+// Purpose: Provides end-to-end tests for the registry-based codec system, including switch, try, member access, slices, raise and event guards.
 // filename: pkg/canon/codec_test.go
-// nlines: 60
+// nlines: 326
 // risk_rating: HIGH
 
 package canon
@@ -60,3 +60,267 @@ endfunc
 		t.Errorf("FAIL: The decoded AST does not match the original using the registry codec.\n%s", diff)
 	}
 }
+
+// TestRegistryCodecRoundtrip_ElseIfAndSwitch checks that 'else if' chains and
+// switch statements, including a switch without a default, survive the codec.
+func TestRegistryCodecRoundtrip_ElseIfAndSwitch(t *testing.T) {
+	script := `
+func main(needs x) means
+    if x == 1
+        emit "one"
+    else if x == 2
+        emit "two"
+    else
+        emit "other"
+    endif
+    switch x
+        case 1, 2
+            emit "small"
+        case in [3, 4]
+            emit "medium"
+        case typeof "string"
+            emit "text"
+        default
+            emit "other"
+    endswitch
+    switch x
+        case 5
+            emit "five"
+    endswitch
+endfunc
+`
+	parserAPI := parser.NewParserAPI(logging.NewNoOpLogger())
+	antlrTree, pErr := parserAPI.Parse(script)
+	if pErr != nil {
+		t.Fatalf("parser.Parse() failed unexpectedly: %v", pErr)
+	}
+	builder := parser.NewASTBuilder(logging.NewNoOpLogger())
+	program, _, bErr := builder.Build(antlrTree)
+	if bErr != nil {
+		t.Fatalf("ast.Build() failed unexpectedly: %v", bErr)
+	}
+	originalTree := &ast.Tree{Root: program}
+
+	blob, _, err := CanonicaliseWithRegistry(originalTree)
+	if err != nil {
+		t.Fatalf("CanonicaliseWithRegistry() failed unexpectedly: %v", err)
+	}
+	decodedTree, err := DecodeWithRegistry(blob)
+	if err != nil {
+		t.Fatalf("DecodeWithRegistry() failed unexpectedly: %v", err)
+	}
+
+	cmpOpts := []cmp.Option{
+		cmpopts.IgnoreFields(ast.BaseNode{}, "StartPos", "StopPos"),
+		cmpopts.IgnoreUnexported(ast.Procedure{}, ast.Step{}, ast.LValueNode{}),
+		cmpopts.EquateEmpty(),
+	}
+	if diff := cmp.Diff(originalTree, decodedTree, cmpOpts...); diff != "" {
+		t.Errorf("FAIL: The decoded AST does not match the original.\n%s", diff)
+	}
+
+	steps := decodedTree.Root.(*ast.Program).Procedures["main"].Steps
+	if steps[2].SwitchStmt.Default != nil {
+		t.Errorf("expected switch without default to decode with a nil Default")
+	}
+}
+
+// TestRegistryCodecRoundtrip_Try checks that try statements keep their catch
+// variable and optional clauses through the codec.
+func TestRegistryCodecRoundtrip_Try(t *testing.T) {
+	script := `
+func main() means
+    try
+        fail "x"
+    catch err
+        emit err
+    finally
+        emit "done"
+    endtry
+    try
+        emit "a"
+    finally
+        emit "b"
+    endtry
+endfunc
+`
+	parserAPI := parser.NewParserAPI(logging.NewNoOpLogger())
+	antlrTree, pErr := parserAPI.Parse(script)
+	if pErr != nil {
+		t.Fatalf("parser.Parse() failed unexpectedly: %v", pErr)
+	}
+	builder := parser.NewASTBuilder(logging.NewNoOpLogger())
+	program, _, bErr := builder.Build(antlrTree)
+	if bErr != nil {
+		t.Fatalf("ast.Build() failed unexpectedly: %v", bErr)
+	}
+	originalTree := &ast.Tree{Root: program}
+
+	blob, _, err := CanonicaliseWithRegistry(originalTree)
+	if err != nil {
+		t.Fatalf("CanonicaliseWithRegistry() failed unexpectedly: %v", err)
+	}
+	decodedTree, err := DecodeWithRegistry(blob)
+	if err != nil {
+		t.Fatalf("DecodeWithRegistry() failed unexpectedly: %v", err)
+	}
+
+	cmpOpts := []cmp.Option{
+		cmpopts.IgnoreFields(ast.BaseNode{}, "StartPos", "StopPos"),
+		cmpopts.IgnoreUnexported(ast.Procedure{}, ast.Step{}, ast.LValueNode{}),
+		cmpopts.EquateEmpty(),
+	}
+	if diff := cmp.Diff(originalTree, decodedTree, cmpOpts...); diff != "" {
+		t.Errorf("FAIL: The decoded AST does not match the original.\n%s", diff)
+	}
+
+	steps := decodedTree.Root.(*ast.Program).Procedures["main"].Steps
+	if steps[0].TryStmt.CatchVar != "err" {
+		t.Errorf("expected catch variable 'err', got %q", steps[0].TryStmt.CatchVar)
+	}
+	if steps[1].TryStmt.Catch != nil {
+		t.Errorf("expected try without catch to decode with a nil Catch")
+	}
+}
+
+// TestRegistryCodecRoundtrip_MemberAccessAndSlices checks that dotted access
+// stays distinct from '["key"]' and that omitted slice bounds stay nil.
+func TestRegistryCodecRoundtrip_MemberAccessAndSlices(t *testing.T) {
+	script := `
+func main() means
+    set a = cfg.items[1:3]
+    set b = cfg["items"][:2]
+    set c = name[-3:]
+endfunc
+`
+	parserAPI := parser.NewParserAPI(logging.NewNoOpLogger())
+	antlrTree, pErr := parserAPI.Parse(script)
+	if pErr != nil {
+		t.Fatalf("parser.Parse() failed unexpectedly: %v", pErr)
+	}
+	builder := parser.NewASTBuilder(logging.NewNoOpLogger())
+	program, _, bErr := builder.Build(antlrTree)
+	if bErr != nil {
+		t.Fatalf("ast.Build() failed unexpectedly: %v", bErr)
+	}
+	originalTree := &ast.Tree{Root: program}
+
+	blob, _, err := CanonicaliseWithRegistry(originalTree)
+	if err != nil {
+		t.Fatalf("CanonicaliseWithRegistry() failed unexpectedly: %v", err)
+	}
+	decodedTree, err := DecodeWithRegistry(blob)
+	if err != nil {
+		t.Fatalf("DecodeWithRegistry() failed unexpectedly: %v", err)
+	}
+
+	cmpOpts := []cmp.Option{
+		cmpopts.IgnoreFields(ast.BaseNode{}, "StartPos", "StopPos"),
+		cmpopts.IgnoreUnexported(ast.Procedure{}, ast.Step{}, ast.LValueNode{}),
+		cmpopts.EquateEmpty(),
+	}
+	if diff := cmp.Diff(originalTree, decodedTree, cmpOpts...); diff != "" {
+		t.Errorf("FAIL: The decoded AST does not match the original.\n%s", diff)
+	}
+
+	steps := decodedTree.Root.(*ast.Program).Procedures["main"].Steps
+	want := []string{"cfg.items[1:3]", `cfg["items"][:2]`, "name[(- 3):]"}
+	for i, w := range want {
+		if got := steps[i].Values[0].TestString(); got != w {
+			t.Errorf("step %d: got %s, want %s", i, got, w)
+		}
+	}
+}
+
+// TestRegistryCodecRoundtrip_Raise checks that 'raise event' steps keep their
+// optional payload through the codec.
+func TestRegistryCodecRoundtrip_Raise(t *testing.T) {
+	script := `
+func main() means
+    raise event "orders.created" with {"id": 1}
+    raise event "orders." + kind
+endfunc
+`
+	parserAPI := parser.NewParserAPI(logging.NewNoOpLogger())
+	antlrTree, pErr := parserAPI.Parse(script)
+	if pErr != nil {
+		t.Fatalf("parser.Parse() failed unexpectedly: %v", pErr)
+	}
+	builder := parser.NewASTBuilder(logging.NewNoOpLogger())
+	program, _, bErr := builder.Build(antlrTree)
+	if bErr != nil {
+		t.Fatalf("ast.Build() failed unexpectedly: %v", bErr)
+	}
+	originalTree := &ast.Tree{Root: program}
+
+	blob, _, err := CanonicaliseWithRegistry(originalTree)
+	if err != nil {
+		t.Fatalf("CanonicaliseWithRegistry() failed unexpectedly: %v", err)
+	}
+	decodedTree, err := DecodeWithRegistry(blob)
+	if err != nil {
+		t.Fatalf("DecodeWithRegistry() failed unexpectedly: %v", err)
+	}
+
+	cmpOpts := []cmp.Option{
+		cmpopts.IgnoreFields(ast.BaseNode{}, "StartPos", "StopPos"),
+		cmpopts.IgnoreUnexported(ast.Procedure{}, ast.Step{}, ast.LValueNode{}),
+		cmpopts.EquateEmpty(),
+	}
+	if diff := cmp.Diff(originalTree, decodedTree, cmpOpts...); diff != "" {
+		t.Errorf("FAIL: The decoded AST does not match the original.\n%s", diff)
+	}
+
+	steps := decodedTree.Root.(*ast.Program).Procedures["main"].Steps
+	if steps[0].Type != "raise" || len(steps[0].Values) != 2 || len(steps[1].Values) != 1 {
+		t.Errorf("expected raise steps with 2 and 1 values, got %+v", steps)
+	}
+}
+
+// TestRegistryCodecRoundtrip_EventGuard checks that the optional 'where'
+// guard of an event handler survives the codec, and its absence too.
+func TestRegistryCodecRoundtrip_EventGuard(t *testing.T) {
+	script := `
+on event "fdm.node.*" where {"project": "p1", "queue": ["q1", "q2"]} named "nodes" as ev do
+    emit ev
+endon
+
+on event "ticks" do
+    emit "tick"
+endon
+`
+	parserAPI := parser.NewParserAPI(logging.NewNoOpLogger())
+	antlrTree, pErr := parserAPI.Parse(script)
+	if pErr != nil {
+		t.Fatalf("parser.Parse() failed unexpectedly: %v", pErr)
+	}
+	builder := parser.NewASTBuilder(logging.NewNoOpLogger())
+	program, _, bErr := builder.Build(antlrTree)
+	if bErr != nil {
+		t.Fatalf("ast.Build() failed unexpectedly: %v", bErr)
+	}
+	originalTree := &ast.Tree{Root: program}
+
+	blob, _, err := CanonicaliseWithRegistry(originalTree)
+	if err != nil {
+		t.Fatalf("CanonicaliseWithRegistry() failed unexpectedly: %v", err)
+	}
+	decodedTree, err := DecodeWithRegistry(blob)
+	if err != nil {
+		t.Fatalf("DecodeWithRegistry() failed unexpectedly: %v", err)
+	}
+
+	cmpOpts := []cmp.Option{
+		cmpopts.IgnoreFields(ast.BaseNode{}, "StartPos", "StopPos"),
+		cmpopts.IgnoreUnexported(ast.Procedure{}, ast.Step{}, ast.LValueNode{}),
+		cmpopts.EquateEmpty(),
+	}
+	if diff := cmp.Diff(originalTree, decodedTree, cmpOpts...); diff != "" {
+		t.Errorf("FAIL: The decoded AST does not match the original.\n%s", diff)
+	}
+
+	events := decodedTree.Root.(*ast.Program).Events
+	if len(events) != 2 || events[0].GuardExpr == nil || events[1].GuardExpr != nil {
+		t.Errorf("expected a guard on the first handler only, got %+v", events)
+	}
+}
@@ -0,0 +1,63 @@
+// :: product: FDM/NS
+// :: majorVersion: 1
+// :: fileVersion: 1
+// :: description: Codec for TryStmt.
+// :: latestChange: Initial version.
+// :: filename: pkg/canon/codec_try.go
+// :: serialization: go
+
+package canon
+
+import (
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/types"
+)
+
+func encodeTryStmt(v *canonVisitor, n ast.Node) error {
+	node := n.(*ast.TryStmt)
+	if err := encodeSteps(v, node.Body); err != nil {
+		return err
+	}
+	v.writeString(node.CatchVar)
+	v.writeBool(node.Catch != nil)
+	if node.Catch != nil {
+		if err := encodeSteps(v, node.Catch); err != nil {
+			return err
+		}
+	}
+	v.writeBool(node.Finally != nil)
+	if node.Finally != nil {
+		return encodeSteps(v, node.Finally)
+	}
+	return nil
+}
+
+func decodeTryStmt(r *canonReader) (ast.Node, error) {
+	node := &ast.TryStmt{BaseNode: ast.BaseNode{NodeKind: types.KindTryStmt}}
+	var err error
+	if node.Body, err = decodeSteps(r, "try body"); err != nil {
+		return nil, err
+	}
+	if node.CatchVar, err = r.readString(); err != nil {
+		return nil, err
+	}
+	hasCatch, err := r.readBool()
+	if err != nil {
+		return nil, err
+	}
+	if hasCatch {
+		if node.Catch, err = decodeSteps(r, "try catch"); err != nil {
+			return nil, err
+		}
+	}
+	hasFinally, err := r.readBool()
+	if err != nil {
+		return nil, err
+	}
+	if hasFinally {
+		if node.Finally, err = decodeSteps(r, "try finally"); err != nil {
+			return nil, err
+		}
+	}
+	return node, nil
+}
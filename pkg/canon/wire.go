@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 2
+// :: fileVersion: 4
 // :: description: Defines shared constants for the canonical binary wire format.
-// :: latestChange: Stabilized magicNumber to 0x01 instead of volatile types.KindMarker.
+// :: latestChange: Added SchemaVersion so caches of canonical blobs can be keyed on the encoding.
 // :: filename: pkg/canon/wire.go
 // :: serialization: go
 
@@ -12,11 +12,16 @@ package canon
 // It was previously dynamically tied to types.KindMarker, which broke decoding when the AST grew.
 var magicNumber = []byte{'N', 'S', 'C', 0x01}
 
+// SchemaVersion identifies the canonical encoding of the AST. Bump it whenever
+// a node's encoding or the AST it decodes into changes, so that caches keyed
+// on it stop serving blobs written by an older build.
+const SchemaVersion = 1
+
 // CallableExpr payload header immediately after KindCallableExpr:
 //
 //	byte[2]  Magic "CE" (0x43, 0x45)
 //	byte     Version (currently 0x01)
-//	byte     Layout (1 = header, 2 = node-target), payload follows as per layout.
+//	byte     Layout (1 = header, 2 = node-target, 3 = header + named args), payload follows as per layout.
 const (
 	CallMagic1 = 0x43 // 'C'
 	CallMagic2 = 0x45 // 'E'
@@ -25,4 +30,5 @@ const (
 
 	CallLayoutHeader     = 0x01 // bool isTool, string name, varint argc, args...
 	CallLayoutNodeTarget = 0x02 // node target (Variable), varint argc, args...
+	CallLayoutNamedArgs  = 0x03 // as CallLayoutHeader, then varint namedc, named args...
 )
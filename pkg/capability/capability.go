@@ -1,15 +1,18 @@
 // NeuroScript Version: 0.3.0
-// File version: 3
-// Purpose: Added a String() method to the Capability struct for human-readable output.
+// File version: 5
+// Purpose: GrantSet guards its live counters, including in-flight budget reservations, with a mutex so concurrent handlers can be metered.
 // filename: pkg/capability/capability.go
-// nlines: 85
+// nlines: 98
 // risk_rating: LOW
 
 // Package capability defines the minimal data structures for expressing
 // capabilities, limits and run-time counters, along with a GrantSet container.
 package capability
 
-import "strings"
+import (
+	"strings"
+	"sync"
+)
 
 // Capability expresses a unit of authority.
 // Resource examples: "env","secrets","net","fs","model","sandbox","proc","clock","rand","budget".
@@ -55,25 +58,33 @@ type Limits struct {
 // Counters record consumption during a run and are compared to Limits.
 type Counters struct {
 	BudgetSpentCents map[string]int
-	NetBytes         int64
-	NetCalls         int
-	FSBytes          int64
-	FSCalls          int
-	ToolCalls        map[string]int
+	// BudgetReservedCents holds the estimated cost of calls still in flight;
+	// see ReserveBudget.
+	BudgetReservedCents map[string]int
+	NetBytes            int64
+	NetCalls            int
+	FSBytes             int64
+	FSCalls             int
+	ToolCalls           map[string]int
 }
 
-// GrantSet aggregates grants, limits and live counters for a run.
+// GrantSet aggregates grants, limits and live counters for a run. Event
+// handlers and timers may count against the same GrantSet at once, so the
+// counters are only read and updated through its methods.
 type GrantSet struct {
 	Grants   []Capability
 	Limits   Limits
 	Counters *Counters
+	// mu guards Counters.
+	mu sync.Mutex
 }
 
 // NewCounters constructs zeroed counters with the necessary maps allocated.
 func NewCounters() *Counters {
 	return &Counters{
-		BudgetSpentCents: map[string]int{},
-		ToolCalls:        map[string]int{},
+		BudgetSpentCents:    map[string]int{},
+		BudgetReservedCents: map[string]int{},
+		ToolCalls:           map[string]int{},
 	}
 }
 
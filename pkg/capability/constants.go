@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.3.0
-// File version: 4 // Bumped version
-// Purpose: Defines standardized constants for capability resources and verbs, adding CapabilityAllowAll.
+// File version: 6
+// Purpose: Defines standardized constants for capability resources and verbs, adding the shell resource.
 // filename: pkg/policy/capability/constants.go
-// nlines: 28 // Adjusted line count
+// nlines: 29 // Adjusted line count
 // risk_rating: LOW
 
 package capability
@@ -21,6 +21,8 @@ const (
 	ResAccount = "account"
 	ResCapsule = "capsule"
 	ResIPC     = "ipc"
+	ResTimer   = "timer"
+	ResShell   = "shell"
 )
 
 // Standard capability verbs.
@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.3.0
-// File version: 2
-// Purpose: Limit and counter enforcement helpers. Added CheckSleep to enforce time limits.
+// File version: 6
+// Purpose: Limit and counter enforcement helpers. Every counter access holds the GrantSet's mutex.
 // filename: pkg/policy/capability/limits.go
-// nlines: 107
+// nlines: 227
 // risk_rating: MEDIUM
 
 package capability
@@ -44,66 +44,184 @@ func (g *GrantSet) CheckPerCallBudget(currency string, cents int) error {
 }
 
 // ChargeBudget increments accumulated spend and enforces per-run budget.
+// Cost reserved by calls still in flight counts against the budget.
 func (g *GrantSet) ChargeBudget(currency string, cents int) error {
-	if g.Counters == nil {
-		g.Counters = NewCounters()
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	c := g.countersLocked()
+	max := g.Limits.BudgetPerRunCents[currency]
+	cur := c.BudgetSpentCents[currency]
+	if max > 0 && cur+c.BudgetReservedCents[currency]+cents > max {
+		return ErrBudgetExceeded
+	}
+	c.BudgetSpentCents[currency] = cur + cents
+	return nil
+}
+
+// ReserveBudget sets aside the estimated cost of a call before it is made,
+// failing if spend plus all reservations would exceed the per-run budget.
+// The check and the reservation happen under one lock, so concurrent callers
+// cannot both pass against the same remaining budget. Every reservation must
+// be released with SettleBudget.
+func (g *GrantSet) ReserveBudget(currency string, cents int) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	c := g.countersLocked()
+	max := g.Limits.BudgetPerRunCents[currency]
+	if max > 0 && c.BudgetSpentCents[currency]+c.BudgetReservedCents[currency]+cents > max {
+		return ErrBudgetExceeded
+	}
+	c.BudgetReservedCents[currency] += cents
+	return nil
+}
+
+// SettleBudget replaces a reservation made by ReserveBudget with the actual
+// spend, which is zero for a call that failed. The spend is recorded even if
+// it breaks the per-run budget, since the money is already gone; the error
+// then reports the overrun.
+func (g *GrantSet) SettleBudget(currency string, reserved, cents int) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	c := g.countersLocked()
+	c.BudgetReservedCents[currency] -= reserved
+	if c.BudgetReservedCents[currency] <= 0 {
+		delete(c.BudgetReservedCents, currency)
 	}
+	c.BudgetSpentCents[currency] += cents
 	max := g.Limits.BudgetPerRunCents[currency]
-	cur := g.Counters.BudgetSpentCents[currency]
-	if max > 0 && cur+cents > max {
+	if max > 0 && c.BudgetSpentCents[currency] > max {
 		return ErrBudgetExceeded
 	}
-	g.Counters.BudgetSpentCents[currency] = cur + cents
 	return nil
 }
 
 // CountNet accounts for one network operation of given size.
 func (g *GrantSet) CountNet(bytes int64) error {
-	if g.Counters == nil {
-		g.Counters = NewCounters()
-	}
-	if g.Limits.NetMaxCalls > 0 && g.Counters.NetCalls+1 > g.Limits.NetMaxCalls {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	c := g.countersLocked()
+	if g.Limits.NetMaxCalls > 0 && c.NetCalls+1 > g.Limits.NetMaxCalls {
 		return ErrNetExceeded
 	}
-	if g.Limits.NetMaxBytes > 0 && g.Counters.NetBytes+bytes > g.Limits.NetMaxBytes {
+	if g.Limits.NetMaxBytes > 0 && c.NetBytes+bytes > g.Limits.NetMaxBytes {
 		return ErrNetExceeded
 	}
-	g.Counters.NetCalls++
-	g.Counters.NetBytes += bytes
+	c.NetCalls++
+	c.NetBytes += bytes
 	return nil
 }
 
 // CountFS accounts for one filesystem operation of given size.
 func (g *GrantSet) CountFS(bytes int64) error {
-	if g.Counters == nil {
-		g.Counters = NewCounters()
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	c := g.countersLocked()
+	if g.Limits.FSMaxCalls > 0 && c.FSCalls+1 > g.Limits.FSMaxCalls {
+		return ErrFSExceeded
 	}
-	if g.Limits.FSMaxCalls > 0 && g.Counters.FSCalls+1 > g.Limits.FSMaxCalls {
+	if g.Limits.FSMaxBytes > 0 && c.FSBytes+bytes > g.Limits.FSMaxBytes {
 		return ErrFSExceeded
 	}
-	if g.Limits.FSMaxBytes > 0 && g.Counters.FSBytes+bytes > g.Limits.FSMaxBytes {
+	c.FSCalls++
+	c.FSBytes += bytes
+	return nil
+}
+
+// AddFSBytes accounts for filesystem bytes moved by an operation whose call
+// has already been counted (see CountFS). It enforces FSMaxBytes.
+func (g *GrantSet) AddFSBytes(bytes int64) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	c := g.countersLocked()
+	if g.Limits.FSMaxBytes > 0 && c.FSBytes+bytes > g.Limits.FSMaxBytes {
 		return ErrFSExceeded
 	}
-	g.Counters.FSCalls++
-	g.Counters.FSBytes += bytes
+	c.FSBytes += bytes
+	return nil
+}
+
+// AddNetBytes accounts for network bytes moved by an operation whose call
+// has already been counted (see CountNet). It enforces NetMaxBytes.
+func (g *GrantSet) AddNetBytes(bytes int64) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	c := g.countersLocked()
+	if g.Limits.NetMaxBytes > 0 && c.NetBytes+bytes > g.Limits.NetMaxBytes {
+		return ErrNetExceeded
+	}
+	c.NetBytes += bytes
 	return nil
 }
 
+// Snapshot returns a deep copy of the live counters, safe to hand to hosts.
+func (g *GrantSet) Snapshot() Counters {
+	out := *NewCounters()
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	c := g.Counters
+	if c == nil {
+		return out
+	}
+	out.NetBytes = c.NetBytes
+	out.NetCalls = c.NetCalls
+	out.FSBytes = c.FSBytes
+	out.FSCalls = c.FSCalls
+	for k, v := range c.BudgetSpentCents {
+		out.BudgetSpentCents[k] = v
+	}
+	for k, v := range c.BudgetReservedCents {
+		out.BudgetReservedCents[k] = v
+	}
+	for k, v := range c.ToolCalls {
+		out.ToolCalls[k] = v
+	}
+	return out
+}
+
 // CountToolCall increments the per-tool call counter and enforces its limit.
 func (g *GrantSet) CountToolCall(tool string) error {
-	if g.Counters == nil {
-		g.Counters = NewCounters()
-	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	c := g.countersLocked()
 	if g.Limits.ToolMaxCalls == nil {
 		return nil
 	}
 	max, ok := g.Limits.ToolMaxCalls[tool]
 	if ok && max > 0 {
-		cur := g.Counters.ToolCalls[tool]
+		cur := c.ToolCalls[tool]
 		if cur+1 > max {
 			return ErrToolExceeded
 		}
 	}
-	g.Counters.ToolCalls[tool]++
+	c.ToolCalls[tool]++
 	return nil
 }
+
+// AddToolCall increments the per-tool call counter without enforcing any
+// limit and returns the new count.
+func (g *GrantSet) AddToolCall(tool string) int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	c := g.countersLocked()
+	c.ToolCalls[tool]++
+	return c.ToolCalls[tool]
+}
+
+// ToolCallCount returns how often a tool has been counted so far.
+func (g *GrantSet) ToolCallCount(tool string) int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.Counters == nil {
+		return 0
+	}
+	return g.Counters.ToolCalls[tool]
+}
+
+// countersLocked returns the live counters, creating them on first use.
+// The caller holds g.mu.
+func (g *GrantSet) countersLocked() *Counters {
+	if g.Counters == nil {
+		g.Counters = NewCounters()
+	}
+	return g.Counters
+}
@@ -1,6 +1,6 @@
 // NeuroScript Version: 0.3.0
-// File version: 5 // Bumped version
-// Purpose: Capability matching helpers: Reinstates specific *.domain.com logic alongside filepath.Match fallback in hostMatch.
+// File version: 7
+// Purpose: Capability matching helpers. The canonical "secret" resource now uses wildcard scope matching like "secrets", as do "bus" event names.
 // filename: pkg/policy/capability/matcher.go
 // nlines: 182 // Adjusted line count
 // risk_rating: MEDIUM
@@ -106,13 +106,13 @@ func anyScopeMatches(resource, n string, have []string) bool {
 
 // scopeMatch implements minimal wildcard semantics by resource type:
 //
-//	env/secrets/model/sandbox/proc: exact or '*' or simple prefix/suffix wildcards.
+//	env/secrets/model/sandbox/proc/bus: exact or '*' or simple prefix/suffix wildcards.
 //	fs: grant is a glob; need is a concrete path → filepath.Match(grant, need).
 //	net: host[:port]; uses filepath.Match for host part, checks port separately.
 //	clock/rand/budget: boolean or exact token equality ("true","seed:123").
 func scopeMatch(resource, need, grant string) bool {
 	switch resource {
-	case "env", "secrets", "model", "sandbox", "proc":
+	case "env", "secret", "secrets", "model", "sandbox", "proc", "bus":
 		return simpleWildcard(need, grant)
 	case "fs":
 		// Universal grant scope matches any needed path
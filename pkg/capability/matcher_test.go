@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.3.0
-// File version: 3 // Bumped version
+// File version: 4 // Bumped version
 // Purpose: Unit tests for capability matching logic, separated from test case data. Fixed panic by constructing invalid grants directly.
 // filename: pkg/policy/capability/matcher_test.go
-// nlines: 70 // Adjusted line count
+// nlines: 144 // Adjusted line count
 // risk_rating: LOW
 
 package capability
@@ -76,6 +76,34 @@ func TestLimits_BudgetRunAndPerCall(t *testing.T) {
 	}
 }
 
+func TestLimits_BudgetReservations(t *testing.T) {
+	gs := NewGrantSet(nil, Limits{BudgetPerRunCents: map[string]int{"CAD": 100}})
+	if err := gs.ReserveBudget("CAD", 60); err != nil {
+		t.Fatalf("unexpected error reserving budget: %v", err)
+	}
+	if err := gs.ReserveBudget("CAD", 50); err != ErrBudgetExceeded {
+		t.Errorf("a reservation beyond the budget left by another must fail, got %v", err)
+	}
+	if err := gs.ChargeBudget("CAD", 50); err != ErrBudgetExceeded {
+		t.Errorf("a charge must respect reservations in flight, got %v", err)
+	}
+	// The call cost less than estimated: the difference is free again.
+	if err := gs.SettleBudget("CAD", 60, 30); err != nil {
+		t.Errorf("unexpected error settling budget: %v", err)
+	}
+	if err := gs.ReserveBudget("CAD", 70); err != nil {
+		t.Errorf("unexpected error reserving the remaining budget: %v", err)
+	}
+	// Overspend is recorded and reported.
+	if err := gs.SettleBudget("CAD", 70, 80); err != ErrBudgetExceeded {
+		t.Errorf("expected run budget exceed error on settling, got %v", err)
+	}
+	usage := gs.Snapshot()
+	if usage.BudgetSpentCents["CAD"] != 110 || len(usage.BudgetReservedCents) != 0 {
+		t.Errorf("spent %v, reserved %v; want 110 spent and nothing reserved", usage.BudgetSpentCents, usage.BudgetReservedCents)
+	}
+}
+
 func TestLimits_NetAndFS(t *testing.T) {
 	gs := GrantSet{
 		Limits: Limits{
@@ -1,5 +1,5 @@
 // NeuroScript Version: 0.3.0
-// File version: 1 // New file
+// File version: 2
 // Purpose: Contains test case data for TestCapsSatisfied in matcher_test.go.
 // filename: pkg/policy/capability/matcher_test_cases.go
 // nlines: 212 // Approximate line count
@@ -268,6 +268,19 @@ var capsSatisfiedTestCases = []capsSatisfiedTestCase{
 		grants:          []string{"env:read:SECRET_*"},
 		expectSatisfied: false,
 	},
+	// Bus (Simple Wildcard)
+	{
+		name:            "Bus Scope: Prefix Wildcard Grant Match",
+		needs:           []string{"bus:write:orders.created"},
+		grants:          []string{"bus:write:orders.*"},
+		expectSatisfied: true,
+	},
+	{
+		name:            "Bus Scope: Prefix Wildcard Grant Mismatch",
+		needs:           []string{"bus:write:billing.created"},
+		grants:          []string{"bus:write:orders.*"},
+		expectSatisfied: false,
+	},
 
 	// --- Multiple Needs / Grants ---
 	{
@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.8.0
-// File version: 20
-// Purpose: Adds regression tests for accessing map-by-value and list-by-pointer.
+// File version: 21
+// Purpose: Adds tests for negative indexes, member access and slices.
 // filename: pkg/eval/access_test.go
-// nlines: 130
+// nlines: 254
 // risk_rating: HIGH
 
 package eval
@@ -140,6 +140,32 @@ func TestElementAccess(t *testing.T) {
 			InitialVars: initialVars,
 			Expected:    lang.StringValue{Value: "value1_val"},
 		},
+		{
+			Name:        "List Access Negative Index",
+			InputNode:   &ast.ElementAccessNode{Collection: &ast.VariableNode{Name: "myListPtr"}, Accessor: &ast.NumberLiteralNode{Value: int64(-2)}},
+			InitialVars: initialVars,
+			Expected:    lang.StringValue{Value: "ptr_apple"},
+		},
+		{
+			Name:            "List Access Index Out of Bounds (Negative)",
+			InputNode:       &ast.ElementAccessNode{Collection: &ast.VariableNode{Name: "myList"}, Accessor: &ast.NumberLiteralNode{Value: int64(-3)}},
+			InitialVars:     initialVars,
+			WantErr:         true,
+			ExpectedErrorIs: lang.ErrListIndexOutOfBounds,
+		},
+		{
+			Name:        "Member Access On Map",
+			InputNode:   &ast.ElementAccessNode{Collection: &ast.VariableNode{Name: "myMapPtr"}, Accessor: &ast.StringLiteralNode{Value: "key1"}, Type: ast.DotAccess},
+			InitialVars: initialVars,
+			Expected:    lang.StringValue{Value: "value1_ptr"},
+		},
+		{
+			Name:            "Member Access On List",
+			InputNode:       &ast.ElementAccessNode{Collection: &ast.VariableNode{Name: "myList"}, Accessor: &ast.StringLiteralNode{Value: "key1"}, Type: ast.DotAccess},
+			InitialVars:     initialVars,
+			WantErr:         true,
+			ExpectedErrorIs: lang.ErrInvalidOperation,
+		},
 		{
 			Name:        "Map Val Access Non-existent Key", // ADDED
 			InputNode:   &ast.ElementAccessNode{Collection: &ast.VariableNode{Name: "myMapVal"}, Accessor: &ast.StringLiteralNode{Value: "badkey"}},
@@ -152,3 +178,77 @@ func TestElementAccess(t *testing.T) {
 		runLocalExpressionTest(t, tc)
 	}
 }
+
+func TestSlice(t *testing.T) {
+	num := func(n int64) ast.Expression { return &ast.NumberLiteralNode{Value: n} }
+	initialVars := map[string]lang.Value{
+		"myList": lang.ListValue{Value: []lang.Value{
+			lang.NumberValue{Value: 1}, lang.NumberValue{Value: 2}, lang.NumberValue{Value: 3}, lang.NumberValue{Value: 4},
+		}},
+		"myStr": lang.StringValue{Value: "héllo"},
+	}
+	list := func(vals ...float64) lang.Value {
+		items := make([]lang.Value, len(vals))
+		for i, v := range vals {
+			items[i] = lang.NumberValue{Value: v}
+		}
+		return lang.ListValue{Value: items}
+	}
+
+	testCases := []localEvalTestCase{
+		{
+			Name:        "List Slice",
+			InputNode:   &ast.SliceNode{Collection: &ast.VariableNode{Name: "myList"}, Start: num(1), End: num(3)},
+			InitialVars: initialVars,
+			Expected:    list(2, 3),
+		},
+		{
+			Name:        "List Slice Negative Start",
+			InputNode:   &ast.SliceNode{Collection: &ast.VariableNode{Name: "myList"}, Start: num(-2)},
+			InitialVars: initialVars,
+			Expected:    list(3, 4),
+		},
+		{
+			Name:        "List Slice Clamped",
+			InputNode:   &ast.SliceNode{Collection: &ast.VariableNode{Name: "myList"}, Start: num(-10), End: num(10)},
+			InitialVars: initialVars,
+			Expected:    list(1, 2, 3, 4),
+		},
+		{
+			Name:        "List Slice Empty When Reversed",
+			InputNode:   &ast.SliceNode{Collection: &ast.VariableNode{Name: "myList"}, Start: num(3), End: num(1)},
+			InitialVars: initialVars,
+			Expected:    lang.ListValue{Value: []lang.Value{}},
+		},
+		{
+			Name:        "String Slice Prefix",
+			InputNode:   &ast.SliceNode{Collection: &ast.VariableNode{Name: "myStr"}, End: num(2)},
+			InitialVars: initialVars,
+			Expected:    lang.StringValue{Value: "hé"},
+		},
+		{
+			Name:        "String Slice Negative End",
+			InputNode:   &ast.SliceNode{Collection: &ast.VariableNode{Name: "myStr"}, Start: num(1), End: num(-1)},
+			InitialVars: initialVars,
+			Expected:    lang.StringValue{Value: "éll"},
+		},
+		{
+			Name:            "Slice Bound Not Integer",
+			InputNode:       &ast.SliceNode{Collection: &ast.VariableNode{Name: "myList"}, Start: &ast.StringLiteralNode{Value: "a"}},
+			InitialVars:     initialVars,
+			WantErr:         true,
+			ExpectedErrorIs: lang.ErrListInvalidIndexType,
+		},
+		{
+			Name:            "Slice Of Map",
+			InputNode:       &ast.SliceNode{Collection: &ast.MapLiteralNode{}, End: num(1)},
+			InitialVars:     initialVars,
+			WantErr:         true,
+			ExpectedErrorIs: lang.ErrInvalidOperation,
+		},
+	}
+
+	for _, tc := range testCases {
+		runLocalExpressionTest(t, tc)
+	}
+}
@@ -0,0 +1,409 @@
+// NeuroScript Version: 0.8.0
+// File version: 8
+// Purpose: Compiles AST expressions into closures that evaluate with the same semantics as Expression, with slotted variable reads and bound tool calls.
+// filename: pkg/eval/compile.go
+// nlines: 409
+// risk_rating: HIGH
+
+package eval
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/types"
+)
+
+// builtinArity is the number of arguments every built-in function takes.
+const builtinArity = 1
+
+// Compiled is an expression that has been translated into a closure tree.
+// Running it produces the same value, error and error position that
+// Expression would for the source node.
+type Compiled func(rt Runtime) (lang.Value, error)
+
+// Compile translates an AST expression into a Compiled closure. Work that
+// does not depend on the runtime is done once here: literals are converted,
+// tool names are canonicalised, built-ins are recognised and their arity is
+// checked. A compiled tool call binds its implementation on first use when
+// the runtime is a ToolBinder, and checks its argument names and count
+// against the bound spec once, so a Compiled value must only be run against
+// runtimes that share one tool registry.
+func Compile(node ast.Expression) Compiled {
+	return compileExpr(node, nil)
+}
+
+// CompileWithSlots is Compile for an expression in a procedure body. Each
+// variable it reads is given a slot in layout, and on a SlotRuntime whose
+// frame was laid out by layout the read indexes that slot instead of looking
+// the name up.
+func CompileWithSlots(node ast.Expression, layout *SlotLayout) Compiled {
+	return compileExpr(node, layout)
+}
+
+func compileExpr(node ast.Expression, slots *SlotLayout) Compiled {
+	if node == nil {
+		return func(Runtime) (lang.Value, error) { return &lang.NilValue{}, nil }
+	}
+	switch n := node.(type) {
+	case *ast.StringLiteralNode:
+		v := lang.StringValue{Value: n.Value}
+		return func(Runtime) (lang.Value, error) { return v, nil }
+	case *ast.NumberLiteralNode:
+		v := numberLiteral(n.Value)
+		return func(Runtime) (lang.Value, error) { return v, nil }
+	case *ast.BooleanLiteralNode:
+		v := lang.BoolValue{Value: n.Value}
+		return func(Runtime) (lang.Value, error) { return v, nil }
+	case *ast.NilLiteralNode:
+		return func(Runtime) (lang.Value, error) { return &lang.NilValue{}, nil }
+	case *ast.InterpolatedStringNode:
+		return compileInterpolatedString(n, slots)
+	case *ast.PlaceholderNode:
+		v, err := (&evaluation{}).evaluatePlaceholder(n)
+		return func(Runtime) (lang.Value, error) { return v, err }
+	case *ast.ListLiteralNode:
+		return compileListLiteral(n, slots)
+	case *ast.MapLiteralNode:
+		return compileMapLiteral(n, slots)
+	case *ast.VariableNode:
+		return compileVariable(n, slots)
+	case *ast.BinaryOpNode:
+		return compileBinaryOp(n, slots)
+	case *ast.UnaryOpNode:
+		operand, op := compileExpr(n.Operand, slots), n.Operator
+		return func(rt Runtime) (lang.Value, error) {
+			v, err := operand(rt)
+			if err != nil {
+				return nil, err
+			}
+			return lang.PerformUnaryOperation(op, v)
+		}
+	case *ast.TypeOfNode:
+		arg := compileExpr(n.Argument, slots)
+		return func(rt Runtime) (lang.Value, error) {
+			v, err := arg(rt)
+			if err != nil {
+				return nil, err
+			}
+			return lang.StringValue{Value: string(lang.TypeOf(v))}, nil
+		}
+	case *ast.CallableExprNode:
+		return compileCall(n, slots)
+	case *ast.LValueNode:
+		// Accessor chains are short and rarely hot; reuse the tree walker.
+		return func(rt Runtime) (lang.Value, error) {
+			return (&evaluation{rt: rt}).evaluateLValue(n)
+		}
+	case *ast.ElementAccessNode:
+		coll, acc := compileExpr(n.Collection, slots), compileExpr(n.Accessor, slots)
+		return func(rt Runtime) (lang.Value, error) {
+			collectionVal, err := coll(rt)
+			if err != nil {
+				return nil, err
+			}
+			accessorVal, err := acc(rt)
+			if err != nil {
+				return nil, err
+			}
+			return accessElement(n, collectionVal, accessorVal)
+		}
+	case *ast.SliceNode:
+		coll, start, end := compileExpr(n.Collection, slots), compileOptional(n.Start, slots), compileOptional(n.End, slots)
+		return func(rt Runtime) (lang.Value, error) {
+			collectionVal, err := coll(rt)
+			if err != nil {
+				return nil, err
+			}
+			startVal, err := start(rt)
+			if err != nil {
+				return nil, err
+			}
+			endVal, err := end(rt)
+			if err != nil {
+				return nil, err
+			}
+			return sliceElements(n, collectionVal, startVal, endVal)
+		}
+	case *ast.LambdaNode:
+		return func(rt Runtime) (lang.Value, error) { return newClosure(rt, n) }
+	default:
+		return func(Runtime) (lang.Value, error) {
+			return nil, lang.NewRuntimeError(lang.ErrorCodeInternal, fmt.Sprintf("unhandled expression node type: %T", n), lang.ErrInternal).WithPosition(n.GetPos())
+		}
+	}
+}
+
+// compileVariable compiles a variable read. A slotted read tries the frame's
+// slot first; an unset slot or a frame with another layout falls back to the
+// lookup by name, so globals, constants and strict mode behave as before.
+func compileVariable(n *ast.VariableNode, slots *SlotLayout) Compiled {
+	name := n.Name
+	byName := func(rt Runtime) (lang.Value, error) {
+		if val, exists := rt.GetVariable(name); exists {
+			return val, nil
+		}
+		return undefinedVariable(rt, name, n.GetPos())
+	}
+	if slots == nil {
+		return byName
+	}
+	slot := slots.Assign(name)
+	return func(rt Runtime) (lang.Value, error) {
+		if sr, ok := rt.(SlotRuntime); ok {
+			if val, ok := sr.SlotValue(slots, slot); ok {
+				return val, nil
+			}
+		}
+		return byName(rt)
+	}
+}
+
+func compileAll(nodes []ast.Expression, slots *SlotLayout) []Compiled {
+	out := make([]Compiled, len(nodes))
+	for i, n := range nodes {
+		out[i] = compileExpr(n, slots)
+	}
+	return out
+}
+
+// compileOptional compiles an expression that may be absent; an absent one
+// evaluates to a nil lang.Value.
+func compileOptional(n ast.Expression, slots *SlotLayout) Compiled {
+	if n == nil {
+		return func(Runtime) (lang.Value, error) { return nil, nil }
+	}
+	return compileExpr(n, slots)
+}
+
+func compileInterpolatedString(n *ast.InterpolatedStringNode, slots *SlotLayout) Compiled {
+	parts := compileAll(n.Parts, slots)
+	return func(rt Runtime) (lang.Value, error) {
+		var sb strings.Builder
+		for _, part := range parts {
+			val, err := part(rt)
+			if err != nil {
+				return nil, err
+			}
+			strVal, _ := lang.ToString(val)
+			sb.WriteString(strVal)
+		}
+		return lang.StringValue{Value: sb.String()}, nil
+	}
+}
+
+func compileListLiteral(n *ast.ListLiteralNode, slots *SlotLayout) Compiled {
+	elems := compileAll(n.Elements, slots)
+	return func(rt Runtime) (lang.Value, error) {
+		items := make([]lang.Value, len(elems))
+		for i, elem := range elems {
+			val, err := elem(rt)
+			if err != nil {
+				return nil, err
+			}
+			items[i] = val
+		}
+		return lang.ListValue{Value: items}, nil
+	}
+}
+
+func compileMapLiteral(n *ast.MapLiteralNode, slots *SlotLayout) Compiled {
+	type entry struct {
+		key, value       Compiled
+		keyNode, valNode ast.Expression
+	}
+	entries := make([]entry, len(n.Entries))
+	for i, pair := range n.Entries {
+		entries[i] = entry{key: compileExpr(pair.Key, slots), value: compileExpr(pair.Value, slots), keyNode: pair.Key, valNode: pair.Value}
+	}
+	return func(rt Runtime) (lang.Value, error) {
+		m := make(map[string]lang.Value)
+		for _, en := range entries {
+			keyVal, err := en.key(rt)
+			if err != nil {
+				return nil, lang.WrapErrorWithPosition(err, en.keyNode.GetPos(), "evaluating map key")
+			}
+			keyStr, _ := lang.ToString(keyVal)
+			val, err := en.value(rt)
+			if err != nil {
+				return nil, lang.WrapErrorWithPosition(err, en.valNode.GetPos(), "evaluating map value")
+			}
+			m[keyStr] = val
+		}
+		return lang.MapValue{Value: m}, nil
+	}
+}
+
+func compileBinaryOp(n *ast.BinaryOpNode, slots *SlotLayout) Compiled {
+	left, right, op := compileExpr(n.Left, slots), compileExpr(n.Right, slots), n.Operator
+	return func(rt Runtime) (lang.Value, error) {
+		l, err := left(rt)
+		if err != nil {
+			return nil, err
+		}
+		if result, decided := lang.ShortCircuit(op, l); decided {
+			return result, nil
+		}
+		r, err := right(rt)
+		if err != nil {
+			return nil, err
+		}
+		return lang.PerformBinaryOperation(op, l, r)
+	}
+}
+
+func compileCall(n *ast.CallableExprNode, slots *SlotLayout) Compiled {
+	args := compileAll(n.Arguments, slots)
+	argNodes := n.Arguments
+	name := n.Target.Name
+
+	evalArgs := func(rt Runtime) ([]lang.Value, error) {
+		vals := make([]lang.Value, len(args))
+		for i, arg := range args {
+			val, err := arg(rt)
+			if err != nil {
+				return nil, lang.WrapErrorWithPosition(err, argNodes[i].GetPos(), fmt.Sprintf("evaluating argument %d for call to '%s'", i+1, name))
+			}
+			vals[i] = val
+		}
+		return vals, nil
+	}
+	namedC := make([]Compiled, len(n.NamedArgs))
+	for i, na := range n.NamedArgs {
+		namedC[i] = compileExpr(na.Value, slots)
+	}
+	evalNamed := func(rt Runtime) ([]lang.Value, error) {
+		vals := make([]lang.Value, len(namedC))
+		for i, c := range namedC {
+			val, err := c(rt)
+			if err != nil {
+				na := n.NamedArgs[i]
+				return nil, lang.WrapErrorWithPosition(err, na.Value.GetPos(), fmt.Sprintf("evaluating argument '%s' for call to '%s'", na.Name, name))
+			}
+			vals[i] = val
+		}
+		return vals, nil
+	}
+
+	if isBuiltInFunction(name) {
+		// The error is built per call: callers decorate runtime errors in place.
+		arityOK := len(args) == builtinArity
+		return func(rt Runtime) (lang.Value, error) {
+			vals, err := evalArgs(rt)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := evalNamed(rt); err != nil {
+				return nil, err
+			}
+			if fn, ok := functionVariable(rt, n); ok {
+				return callFunction(rt, n, fn, vals)
+			}
+			if err := builtinNamedArgsError(n); err != nil {
+				return nil, err
+			}
+			if !arityOK {
+				return nil, lang.NewRuntimeError(lang.ErrorCodeArgMismatch, fmt.Sprintf("function '%s' expects %d argument(s), got %d", name, builtinArity, len(args)), nil).WithPosition(n.GetPos())
+			}
+			return (&evaluation{rt: rt}).evaluateBuiltInFunction(name, vals, n.GetPos())
+		}
+	}
+
+	if n.Target.IsTool {
+		toolName, nameErr := resolveToolName(n)
+		var bound atomic.Pointer[toolCall]
+		return func(rt Runtime) (lang.Value, error) {
+			vals, err := evalArgs(rt)
+			if err != nil {
+				return nil, err
+			}
+			named, err := evalNamed(rt)
+			if err != nil {
+				return nil, err
+			}
+			if nameErr != nil {
+				return nil, lang.NewRuntimeError(lang.ErrorCodeInternal, "resolving tool name failed", nameErr).WithPosition(n.GetPos())
+			}
+			call := bound.Load()
+			if call == nil {
+				// Tools cannot be unregistered or replaced, so a binding stays valid.
+				if call, err = bindToolCall(rt, toolName, n); err != nil {
+					return nil, err
+				}
+				bound.Store(call)
+			}
+			if !call.argsOK {
+				// Rebuild the error per call: callers decorate runtime errors in place.
+				_, err := argsForSpec(call.spec, toolName, vals, named, n)
+				return nil, err
+			}
+			namedArgs := call.bindArgs(vals, named, n)
+			if call.tool != nil {
+				if binder, ok := rt.(ToolBinder); ok {
+					return binder.CallBoundTool(call.tool, namedArgs)
+				}
+			}
+			return rt.ExecuteTool(toolName, namedArgs)
+		}
+	}
+
+	return func(rt Runtime) (lang.Value, error) {
+		vals, err := evalArgs(rt)
+		if err != nil {
+			return nil, err
+		}
+		named, err := evalNamed(rt)
+		if err != nil {
+			return nil, err
+		}
+		if fn, ok := functionVariable(rt, n); ok {
+			return callFunction(rt, n, fn, vals)
+		}
+		return runProcedure(rt, n, vals, named)
+	}
+}
+
+// toolCall is a compiled tool call bound to its tool. The argument count and
+// names of a call site are fixed, so whether they fit the spec is decided
+// once, at binding.
+type toolCall struct {
+	spec   ToolSpec
+	tool   *BoundTool // nil when the runtime is not a ToolBinder
+	argsOK bool
+}
+
+func bindToolCall(rt Runtime, toolName types.FullName, n *ast.CallableExprNode) (*toolCall, error) {
+	call := &toolCall{}
+	if binder, ok := rt.(ToolBinder); ok {
+		if t, found := binder.BindTool(toolName); found {
+			call.tool, call.spec = t, t.Spec
+		}
+	}
+	if call.tool == nil {
+		spec, ok := rt.GetToolSpec(toolName)
+		if !ok {
+			return nil, lang.NewRuntimeError(lang.ErrorCodeToolNotFound, fmt.Sprintf("tool '%s' not found", toolName), lang.ErrToolNotFound).WithPosition(n.GetPos())
+		}
+		call.spec = spec
+	}
+	_, err := argsForSpec(call.spec, toolName, make([]lang.Value, len(n.Arguments)), make([]lang.Value, len(n.NamedArgs)), n)
+	call.argsOK = err == nil
+	return call, nil
+}
+
+// bindArgs is argsForSpec for a call whose arguments already passed the check.
+func (c *toolCall) bindArgs(args, named []lang.Value, n *ast.CallableExprNode) map[string]lang.Value {
+	namedArgs := make(map[string]lang.Value, len(args)+len(named))
+	for i, argSpec := range c.spec.Args {
+		if i < len(args) {
+			namedArgs[argSpec.Name] = args[i]
+		}
+	}
+	for i, na := range n.NamedArgs {
+		namedArgs[na.Name] = named[i]
+	}
+	return namedArgs
+}
@@ -0,0 +1,202 @@
+// NeuroScript Version: 0.8.0
+// File version: 5
+// Purpose: Tests that compiled expressions evaluate exactly like Expression, read slots and bind tools once.
+// filename: pkg/eval/compile_test.go
+// nlines: 202
+// risk_rating: LOW
+
+package eval
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/types"
+)
+
+func TestCompile_MatchesExpression(t *testing.T) {
+	vars := map[string]lang.Value{
+		"n":    lang.NumberValue{Value: 5},
+		"s":    lang.StringValue{Value: "abc"},
+		"list": lang.ListValue{Value: []lang.Value{lang.NumberValue{Value: 1}, lang.NumberValue{Value: 2}}},
+		"m":    lang.MapValue{Value: map[string]lang.Value{"k": lang.StringValue{Value: "v"}}},
+		"f":    lang.BoolValue{Value: false},
+	}
+	nodes := map[string]ast.Expression{
+		"number literal": &ast.NumberLiteralNode{Value: int64(7)},
+		"missing var":    &ast.VariableNode{Name: "nope"},
+		"arithmetic": &ast.BinaryOpNode{
+			Left:     &ast.VariableNode{Name: "n"},
+			Operator: "*",
+			Right:    &ast.BinaryOpNode{Left: &ast.NumberLiteralNode{Value: int64(2)}, Operator: "+", Right: &ast.NumberLiteralNode{Value: 1.5}},
+		},
+		"type error":    &ast.BinaryOpNode{Left: &ast.VariableNode{Name: "n"}, Operator: "-", Right: &ast.VariableNode{Name: "s"}},
+		"short circuit": &ast.BinaryOpNode{Left: &ast.VariableNode{Name: "f"}, Operator: "and", Right: &ast.CallableExprNode{Target: ast.CallTarget{Name: "boom"}}},
+		"unary":         &ast.UnaryOpNode{Operator: "not", Operand: &ast.VariableNode{Name: "f"}},
+		"typeof":        &ast.TypeOfNode{Argument: &ast.VariableNode{Name: "list"}},
+		"list literal":  &ast.ListLiteralNode{Elements: []ast.Expression{&ast.VariableNode{Name: "n"}, &ast.StringLiteralNode{Value: "x"}}},
+		"map literal": &ast.MapLiteralNode{Entries: []*ast.MapEntryNode{
+			{Key: &ast.VariableNode{Name: "s"}, Value: &ast.VariableNode{Name: "n"}},
+		}},
+		"list index":     &ast.ElementAccessNode{Collection: &ast.VariableNode{Name: "list"}, Accessor: &ast.NumberLiteralNode{Value: int64(1)}},
+		"out of bounds":  &ast.ElementAccessNode{Collection: &ast.VariableNode{Name: "list"}, Accessor: &ast.NumberLiteralNode{Value: int64(9)}},
+		"map key":        &ast.ElementAccessNode{Collection: &ast.VariableNode{Name: "m"}, Accessor: &ast.StringLiteralNode{Value: "k"}},
+		"member":         &ast.ElementAccessNode{Collection: &ast.VariableNode{Name: "m"}, Accessor: &ast.StringLiteralNode{Value: "k"}, Type: ast.DotAccess},
+		"negative index": &ast.ElementAccessNode{Collection: &ast.VariableNode{Name: "list"}, Accessor: &ast.NumberLiteralNode{Value: int64(-1)}},
+		"slice":          &ast.SliceNode{Collection: &ast.VariableNode{Name: "s"}, Start: &ast.NumberLiteralNode{Value: int64(1)}},
+		"slice bad type": &ast.SliceNode{Collection: &ast.VariableNode{Name: "m"}, End: &ast.NumberLiteralNode{Value: int64(1)}},
+		"builtin": &ast.CallableExprNode{
+			Target:    ast.CallTarget{Name: "len"},
+			Arguments: []ast.Expression{&ast.VariableNode{Name: "s"}},
+		},
+		"builtin arity": &ast.CallableExprNode{
+			Target:    ast.CallTarget{Name: "len"},
+			Arguments: []ast.Expression{&ast.VariableNode{Name: "s"}, &ast.VariableNode{Name: "s"}},
+		},
+		"builtin named": &ast.CallableExprNode{
+			Target:    ast.CallTarget{Name: "len"},
+			Arguments: []ast.Expression{&ast.VariableNode{Name: "s"}},
+			NamedArgs: []*ast.NamedArgNode{{Name: "x", Value: &ast.VariableNode{Name: "n"}}},
+		},
+		"procedure named": &ast.CallableExprNode{
+			Target:    ast.CallTarget{Name: "proc"},
+			NamedArgs: []*ast.NamedArgNode{{Name: "x", Value: &ast.VariableNode{Name: "n"}}},
+		},
+		"missing tool":     &ast.CallableExprNode{Target: ast.CallTarget{IsTool: true, Name: "fs.read"}},
+		"procedure":        &ast.CallableExprNode{Target: ast.CallTarget{Name: "proc"}},
+		"function literal": &ast.LambdaNode{Params: []string{"x"}, Body: &ast.VariableNode{Name: "x"}},
+	}
+
+	for name, node := range nodes {
+		t.Run(name, func(t *testing.T) {
+			compiled := Compile(node)
+			// Run twice: compiled errors must not be shared between calls.
+			for run := 0; run < 2; run++ {
+				wantVal, wantErr := Expression(&mockRuntime{vars: vars}, node)
+				gotVal, gotErr := compiled(&mockRuntime{vars: vars})
+				if fmt.Sprint(gotErr) != fmt.Sprint(wantErr) {
+					t.Fatalf("run %d: error mismatch\ncompiled: %v\nwalker:   %v", run, gotErr, wantErr)
+				}
+				if fmt.Sprint(gotVal) != fmt.Sprint(wantVal) {
+					t.Fatalf("run %d: value mismatch\ncompiled: %v\nwalker:   %v", run, gotVal, wantVal)
+				}
+			}
+		})
+	}
+}
+
+func TestCompile_ToolSpecIsResolvedOnce(t *testing.T) {
+	rt := &countingSpecRuntime{}
+	compiled := Compile(&ast.CallableExprNode{Target: ast.CallTarget{IsTool: true, Name: "fs.read"}})
+	for n := 0; n < 3; n++ {
+		if _, err := compiled(rt); err != nil {
+			t.Fatalf("call %d failed: %v", n, err)
+		}
+	}
+	if rt.specLookups != 1 {
+		t.Errorf("tool spec looked up %d times, want 1", rt.specLookups)
+	}
+	if rt.calls != 3 {
+		t.Errorf("tool executed %d times, want 3", rt.calls)
+	}
+}
+
+// countingSpecRuntime counts tool spec lookups on top of countingRuntime.
+type countingSpecRuntime struct {
+	countingRuntime
+	specLookups int
+}
+
+func (c *countingSpecRuntime) GetToolSpec(toolName types.FullName) (ToolSpec, bool) {
+	c.specLookups++
+	return c.countingRuntime.GetToolSpec(toolName)
+}
+
+func TestCompile_BoundToolSkipsLookups(t *testing.T) {
+	rt := &binderRuntime{}
+	compiled := Compile(&ast.CallableExprNode{Target: ast.CallTarget{IsTool: true, Name: "fs.read"}})
+	for n := 0; n < 3; n++ {
+		if _, err := compiled(rt); err != nil {
+			t.Fatalf("call %d failed: %v", n, err)
+		}
+	}
+	if rt.binds != 1 || rt.specLookups != 0 {
+		t.Errorf("binds=%d specLookups=%d, want 1 and 0", rt.binds, rt.specLookups)
+	}
+	if rt.boundCalls != 3 || rt.calls != 0 {
+		t.Errorf("boundCalls=%d ExecuteTool calls=%d, want 3 and 0", rt.boundCalls, rt.calls)
+	}
+}
+
+func TestCompile_ToolArgumentCheckIsDecidedAtBinding(t *testing.T) {
+	rt := &binderRuntime{}
+	compiled := Compile(&ast.CallableExprNode{
+		Target:    ast.CallTarget{IsTool: true, Name: "fs.read"},
+		NamedArgs: []*ast.NamedArgNode{{Name: "nope", Value: &ast.NumberLiteralNode{Value: int64(1)}}},
+	})
+	for n := 0; n < 2; n++ {
+		_, err := compiled(rt)
+		if err == nil || !strings.Contains(err.Error(), "has no argument 'nope'") {
+			t.Fatalf("call %d: expected an unknown argument error, got %v", n, err)
+		}
+	}
+	if rt.boundCalls != 0 {
+		t.Errorf("a call with a bad argument reached the tool %d times", rt.boundCalls)
+	}
+}
+
+func TestCompileWithSlots_ReadsTheFrameSlot(t *testing.T) {
+	layout := NewSlotLayout()
+	compiled := CompileWithSlots(&ast.VariableNode{Name: "x"}, layout)
+	slot, ok := layout.Lookup("x")
+	if !ok || layout.Len() != 1 {
+		t.Fatalf("expected x to get a slot, layout has %d", layout.Len())
+	}
+
+	byName := &mockRuntime{vars: map[string]lang.Value{"x": lang.StringValue{Value: "by name"}}}
+	rt := &slotRuntime{mockRuntime: *byName, layout: layout, slots: map[int]lang.Value{slot: lang.StringValue{Value: "slot"}}}
+	if v, _ := compiled(rt); fmt.Sprint(v) != "slot" {
+		t.Errorf("slotted read = %v, want the slot value", v)
+	}
+	rt.layout = NewSlotLayout()
+	if v, _ := compiled(rt); fmt.Sprint(v) != "by name" {
+		t.Errorf("read on a frame with another layout = %v, want the named value", v)
+	}
+	if v, _ := compiled(byName); fmt.Sprint(v) != "by name" {
+		t.Errorf("read on a runtime without slots = %v, want the named value", v)
+	}
+}
+
+// binderRuntime binds tools and counts how they are reached.
+type binderRuntime struct {
+	countingSpecRuntime
+	binds, boundCalls int
+}
+
+func (b *binderRuntime) BindTool(toolName types.FullName) (*BoundTool, bool) {
+	b.binds++
+	return &BoundTool{Name: toolName, Spec: ToolSpec{FullName: toolName}}, true
+}
+
+func (b *binderRuntime) CallBoundTool(t *BoundTool, args map[string]lang.Value) (lang.Value, error) {
+	b.boundCalls++
+	return lang.BoolValue{Value: true}, nil
+}
+
+// slotRuntime serves slots of one layout on top of mockRuntime.
+type slotRuntime struct {
+	mockRuntime
+	layout *SlotLayout
+	slots  map[int]lang.Value
+}
+
+func (s *slotRuntime) SlotValue(layout *SlotLayout, slot int) (lang.Value, bool) {
+	if layout != s.layout {
+		return nil, false
+	}
+	v, ok := s.slots[slot]
+	return v, ok
+}
@@ -77,8 +77,8 @@ func TestDynamicMapKeys(t *testing.T) {
 			},
 			InitialVars: vars,
 			Expected: lang.MapValue{Value: map[string]lang.Value{
-				"static":       lang.NumberValue{Value: 1},
-				"resolved_key": lang.NumberValue{Value: 2},
+				"static":       lang.IntValue{Value: 1},
+				"resolved_key": lang.IntValue{Value: 2},
 			}},
 		},
 	}
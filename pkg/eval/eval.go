@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.8.0
-// File version: 5
-// Purpose: Adds a nil-check for the runtime to prevent panics (defense-in-depth).
+// File version: 10
+// Purpose: NamedArgRunner receives named argument nodes in source order.
 // filename: pkg/eval/eval.go
-// nlines: 49
+// nlines: 89
 // risk_rating: HIGH
 
 package eval
@@ -35,6 +35,46 @@ type Runtime interface {
 	GetToolSpec(toolName types.FullName) (ToolSpec, bool)
 }
 
+// BoundTool is a tool a ToolBinder resolved once for a compiled call. Impl
+// is opaque to the evaluator; only the runtime that bound it interprets it.
+type BoundTool struct {
+	Name types.FullName
+	Spec ToolSpec
+	Impl any
+}
+
+// ToolBinder is implemented by runtimes that let a compiled tool call bind
+// the tool's implementation on first use and invoke it directly afterwards.
+// CallBoundTool still applies policy checks, argument validation and
+// metering on every call.
+type ToolBinder interface {
+	BindTool(toolName types.FullName) (*BoundTool, bool)
+	CallBoundTool(t *BoundTool, args map[string]lang.Value) (lang.Value, error)
+}
+
+// StrictRuntime is implemented by runtimes that can turn reads of undefined
+// variables into errors. Without it, or when it reports false, such reads
+// yield nil.
+type StrictRuntime interface {
+	StrictVariables() bool
+}
+
+// NamedArgRunner is implemented by runtimes that can bind procedure arguments
+// by parameter name. namedArgs are the call's named arguments in source
+// order and named their values, in the same order. Calls with named
+// arguments fail on other runtimes.
+type NamedArgRunner interface {
+	RunProcedureNamed(procName string, args []lang.Value, namedArgs []*ast.NamedArgNode, named []lang.Value) (lang.Value, error)
+}
+
+// FunctionRuntime is implemented by runtimes that support function literals.
+// NewClosure captures the current scope for a literal and CallFunction
+// invokes a function value. Literals fail to evaluate on other runtimes.
+type FunctionRuntime interface {
+	NewClosure(node *ast.LambdaNode) (lang.Value, error)
+	CallFunction(fn lang.Callable, args []lang.Value) (lang.Value, error)
+}
+
 // Expression evaluates an AST expression node within the given runtime.
 func Expression(rt Runtime, node ast.Expression) (lang.Value, error) {
 	// DEFENSE-IN-DEPTH: Prevent nil panic if a nil runtime is ever passed.
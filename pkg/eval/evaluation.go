@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 10
+// :: fileVersion: 18
 // :: description: Updated Expression switch to handle ast.InterpolatedStringNode and ast.PlaceholderNode.
-// :: latestChange: Added runtime evaluation logic to safely assemble structured templates.
+// :: latestChange: Single-index access on strings, including negative indexes.
 // :: filename: pkg/eval/evaluation.go
 // :: serialization: go
 
@@ -42,8 +42,7 @@ func (e *evaluation) Expression(node ast.Expression) (lang.Value, error) {
 	case *ast.StringLiteralNode:
 		return lang.StringValue{Value: n.Value}, nil
 	case *ast.NumberLiteralNode:
-		val, _ := lang.ToFloat64(n.Value)
-		return lang.NumberValue{Value: val}, nil
+		return numberLiteral(n.Value), nil
 	case *ast.BooleanLiteralNode:
 		return lang.BoolValue{Value: n.Value}, nil
 	case *ast.NilLiteralNode:
@@ -74,6 +73,10 @@ func (e *evaluation) Expression(node ast.Expression) (lang.Value, error) {
 		return e.evaluateLValue(n)
 	case *ast.ElementAccessNode:
 		return e.evaluateElementAccess(n)
+	case *ast.SliceNode:
+		return e.evaluateSlice(n)
+	case *ast.LambdaNode:
+		return newClosure(e.rt, n)
 	default:
 		return nil, lang.NewRuntimeError(lang.ErrorCodeInternal, fmt.Sprintf("unhandled expression node type: %T", n), lang.ErrInternal).WithPosition(n.GetPos())
 	}
@@ -114,25 +117,45 @@ func (e *evaluation) evaluateElementAccess(n *ast.ElementAccessNode) (lang.Value
 		return nil, err
 	}
 
+	return accessElement(n, collectionVal, accessorVal)
+}
+
+// accessElement indexes an evaluated collection with an evaluated accessor.
+// Negative list and string indexes count from the end; a string is indexed
+// by character and yields a one-character string.
+func accessElement(n *ast.ElementAccessNode, collectionVal, accessorVal lang.Value) (lang.Value, error) {
+	if n.Type == ast.DotAccess {
+		switch collectionVal.(type) {
+		case lang.MapValue, *lang.MapValue, lang.ErrorValue:
+		default:
+			field, _ := lang.ToString(accessorVal)
+			return nil, lang.NewRuntimeError(lang.ErrorCodeType, fmt.Sprintf("cannot access field '%s' on type %s", field, lang.TypeOf(collectionVal)), lang.ErrInvalidOperation).WithPosition(n.GetPos())
+		}
+	}
 	switch coll := collectionVal.(type) {
 	case lang.ListValue:
-		index, ok := lang.ToInt64(accessorVal)
-		if !ok {
-			return nil, lang.NewRuntimeError(lang.ErrorCodeType, "list index must be an integer", lang.ErrListInvalidIndexType).WithPosition(n.GetPos())
-		}
-		if index < 0 || int(index) >= len(coll.Value) {
-			return nil, lang.NewRuntimeError(lang.ErrorCodeBounds, "list index out of bounds", lang.ErrListIndexOutOfBounds).WithPosition(n.GetPos())
+		index, err := resolveIndex(n, accessorVal, len(coll.Value))
+		if err != nil {
+			return nil, err
 		}
-		return coll.Value[int(index)], nil
+		return coll.Value[index], nil
 	case *lang.ListValue:
-		index, ok := lang.ToInt64(accessorVal)
-		if !ok {
-			return nil, lang.NewRuntimeError(lang.ErrorCodeType, "list index must be an integer", lang.ErrListInvalidIndexType).WithPosition(n.GetPos())
+		var items []lang.Value
+		if coll != nil {
+			items = coll.Value
 		}
-		if coll == nil || index < 0 || int(index) >= len(coll.Value) {
-			return nil, lang.NewRuntimeError(lang.ErrorCodeBounds, "list index out of bounds", lang.ErrListIndexOutOfBounds).WithPosition(n.GetPos())
+		index, err := resolveIndex(n, accessorVal, len(items))
+		if err != nil {
+			return nil, err
 		}
-		return coll.Value[int(index)], nil
+		return items[index], nil
+	case lang.StringValue:
+		runes := []rune(coll.Value)
+		index, err := resolveIndex(n, accessorVal, len(runes))
+		if err != nil {
+			return nil, err
+		}
+		return lang.StringValue{Value: string(runes[index])}, nil
 	case lang.MapValue:
 		key, _ := lang.ToString(accessorVal)
 		val, ok := coll.Value[key]
@@ -150,11 +173,112 @@ func (e *evaluation) evaluateElementAccess(n *ast.ElementAccessNode) (lang.Value
 			return &lang.NilValue{}, nil // Accessing a non-existent key returns nil
 		}
 		return val, nil
+	case lang.ErrorValue:
+		key, _ := lang.ToString(accessorVal)
+		val, ok := coll.Value[key]
+		if !ok {
+			return &lang.NilValue{}, nil
+		}
+		return val, nil
 	default:
 		return nil, lang.NewRuntimeError(lang.ErrorCodeType, fmt.Sprintf("cannot access elements on type %s", lang.TypeOf(coll)), lang.ErrInvalidOperation).WithPosition(n.GetPos())
 	}
 }
 
+// resolveIndex converts an index into a position in a list or string of the
+// given length, counting negative indexes from the end.
+func resolveIndex(n ast.Node, accessorVal lang.Value, length int) (int, error) {
+	index, ok := lang.ToInt64(accessorVal)
+	if !ok {
+		return 0, lang.NewRuntimeError(lang.ErrorCodeType, "list index must be an integer", lang.ErrListInvalidIndexType).WithPosition(n.GetPos())
+	}
+	pos := index
+	if pos < 0 {
+		pos += int64(length)
+	}
+	if pos < 0 || pos >= int64(length) {
+		return 0, lang.NewRuntimeError(lang.ErrorCodeBounds, fmt.Sprintf("index %d out of bounds for length %d", index, length), lang.ErrListIndexOutOfBounds).WithPosition(n.GetPos())
+	}
+	return int(pos), nil
+}
+
+// evaluateSlice handles expressions like `myList[1:3]` or `myStr[:5]`.
+func (e *evaluation) evaluateSlice(n *ast.SliceNode) (lang.Value, error) {
+	collectionVal, err := e.Expression(n.Collection)
+	if err != nil {
+		return nil, err
+	}
+	var startVal, endVal lang.Value
+	if n.Start != nil {
+		if startVal, err = e.Expression(n.Start); err != nil {
+			return nil, err
+		}
+	}
+	if n.End != nil {
+		if endVal, err = e.Expression(n.End); err != nil {
+			return nil, err
+		}
+	}
+	return sliceElements(n, collectionVal, startVal, endVal)
+}
+
+// sliceElements slices an evaluated list or string; a nil bound means the
+// start or end of the collection. Negative bounds count from the end and
+// bounds past either end are clamped, so a slice never fails on range.
+func sliceElements(n *ast.SliceNode, collectionVal, startVal, endVal lang.Value) (lang.Value, error) {
+	var items []lang.Value
+	var runes []rune
+	isString := false
+	switch coll := collectionVal.(type) {
+	case lang.ListValue:
+		items = coll.Value
+	case *lang.ListValue:
+		if coll != nil {
+			items = coll.Value
+		}
+	case lang.StringValue:
+		runes, isString = []rune(coll.Value), true
+	default:
+		return nil, lang.NewRuntimeError(lang.ErrorCodeType, fmt.Sprintf("cannot slice type %s", lang.TypeOf(collectionVal)), lang.ErrInvalidOperation).WithPosition(n.GetPos())
+	}
+	length := len(items)
+	if isString {
+		length = len(runes)
+	}
+
+	start, err := sliceBound(n, startVal, 0, length)
+	if err != nil {
+		return nil, err
+	}
+	end, err := sliceBound(n, endVal, length, length)
+	if err != nil {
+		return nil, err
+	}
+	if end < start {
+		end = start
+	}
+
+	if isString {
+		return lang.StringValue{Value: string(runes[start:end])}, nil
+	}
+	return lang.ListValue{Value: append([]lang.Value{}, items[start:end]...)}, nil
+}
+
+// sliceBound resolves one slice bound, clamped to [0, length].
+func sliceBound(n ast.Node, boundVal lang.Value, omitted, length int) (int, error) {
+	if boundVal == nil {
+		return omitted, nil
+	}
+	bound, ok := lang.ToInt64(boundVal)
+	if !ok {
+		return 0, lang.NewRuntimeError(lang.ErrorCodeType, "slice bounds must be integers", lang.ErrListInvalidIndexType).WithPosition(n.GetPos())
+	}
+	if bound < 0 {
+		bound += int64(length)
+	}
+	return int(max(0, min(bound, int64(length)))), nil
+}
+
 func (e *evaluation) evaluateListLiteral(node *ast.ListLiteralNode) (lang.Value, error) {
 	items := make([]lang.Value, len(node.Elements))
 	for i, itemExpr := range node.Elements {
@@ -192,8 +316,9 @@ func (e *evaluation) evaluateIdentifier(node *ast.VariableNode) (lang.Value, err
 	val, exists := e.rt.GetVariable(node.Name)
 	if !exists {
 		// As per our discussion, tool.context.get_payload() returns a MapValue.
-		// If a variable is not found, it should be nil, not an error.
-		return &lang.NilValue{}, nil
+		// If a variable is not found, it should be nil, not an error, unless
+		// the runtime is strict.
+		return undefinedVariable(e.rt, node.Name, node.GetPos())
 	}
 	return val, nil
 }
@@ -203,6 +328,11 @@ func (e *evaluation) evaluateBinaryOp(node *ast.BinaryOpNode) (lang.Value, error
 	if err != nil {
 		return nil, err
 	}
+	// 'and'/'or' must not evaluate the right operand (which may call a tool
+	// or 'ask') once the left one decides the result.
+	if result, decided := lang.ShortCircuit(node.Operator, left); decided {
+		return result, nil
+	}
 	right, err := e.Expression(node.Right)
 	if err != nil {
 		return nil, err
@@ -227,8 +357,23 @@ func (e *evaluation) evaluateCall(node *ast.CallableExprNode) (lang.Value, error
 		}
 		args[i] = val
 	}
+	named := make([]lang.Value, len(node.NamedArgs))
+	for i, na := range node.NamedArgs {
+		val, err := e.Expression(na.Value)
+		if err != nil {
+			return nil, lang.WrapErrorWithPosition(err, na.Value.GetPos(), fmt.Sprintf("evaluating argument '%s' for call to '%s'", na.Name, node.Target.Name))
+		}
+		named[i] = val
+	}
+
+	if fn, ok := functionVariable(e.rt, node); ok {
+		return callFunction(e.rt, node, fn, args)
+	}
 
 	if isBuiltInFunction(node.Target.Name) {
+		if err := builtinNamedArgsError(node); err != nil {
+			return nil, err
+		}
 		return e.evaluateBuiltInFunction(node.Target.Name, args, node.GetPos())
 	}
 
@@ -237,20 +382,20 @@ func (e *evaluation) evaluateCall(node *ast.CallableExprNode) (lang.Value, error
 		if err != nil {
 			return nil, lang.NewRuntimeError(lang.ErrorCodeInternal, "resolving tool name failed", err).WithPosition(node.GetPos())
 		}
-		namedArgs, err := e.mapArgsToSpec(toolName, args, node)
+		namedArgs, err := e.mapArgsToSpec(toolName, args, named, node)
 		if err != nil {
 			return nil, err
 		}
 		return e.rt.ExecuteTool(toolName, namedArgs)
 	}
 
-	return e.rt.RunProcedure(node.Target.Name, args...)
+	return runProcedure(e.rt, node, args, named)
 }
 
 func (e *evaluation) evaluateLValue(lval *ast.LValueNode) (lang.Value, error) {
 	baseVar, exists := e.rt.GetVariable(lval.Identifier)
 	if !exists {
-		return &lang.NilValue{}, nil
+		return undefinedVariable(e.rt, lval.Identifier, lval.GetPos())
 	}
 
 	current := baseVar
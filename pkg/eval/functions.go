@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 5
+// :: fileVersion: 7
 // :: description: Implements built-in functions including char(n) and ord(s) for Unicode handling.
-// :: latestChange: Added char(n) and ord(s) built-in functions.
+// :: latestChange: is_int/is_float judge a float by its fraction again, as before integers existed; integers are always is_int.
 // :: filename: pkg/eval/functions.go
 // :: serialization: go
 
@@ -72,17 +72,17 @@ func (e *evaluation) evaluateBuiltInFunction(funcName string, args []lang.Value,
 				length = 1
 			}
 		}
-		return lang.NumberValue{Value: float64(length)}, nil
+		return lang.IntValue{Value: int64(length)}, nil
 
 	case "char":
 		if err := checkArgCount(1); err != nil {
 			return nil, err
 		}
-		num, ok := lang.ToFloat64(args[0])
+		num, ok := lang.ToInt64(args[0])
 		if !ok {
 			return nil, lang.NewRuntimeError(lang.ErrorCodeType, "char() expects a numeric codepoint", lang.ErrArgumentMismatch).WithPosition(pos)
 		}
-		return lang.StringValue{Value: string(rune(int(num)))}, nil
+		return lang.StringValue{Value: string(rune(num))}, nil
 
 	case "ord":
 		if err := checkArgCount(1); err != nil {
@@ -93,7 +93,7 @@ func (e *evaluation) evaluateBuiltInFunction(funcName string, args []lang.Value,
 			return nil, lang.NewRuntimeError(lang.ErrorCodeType, "ord() expects a non-empty string", lang.ErrArgumentMismatch).WithPosition(pos)
 		}
 		r, _ := utf8.DecodeRuneInString(s)
-		return lang.NumberValue{Value: float64(r)}, nil
+		return lang.IntValue{Value: int64(r)}, nil
 
 	case "typeof":
 		if err := checkArgCount(1); err != nil {
@@ -112,8 +112,12 @@ func (e *evaluation) evaluateBuiltInFunction(funcName string, args []lang.Value,
 		if err := checkArgCount(1); err != nil {
 			return nil, err
 		}
-		_, ok := args[0].(lang.NumberValue)
-		return lang.BoolValue{Value: ok}, nil
+		switch args[0].(type) {
+		case lang.NumberValue, lang.IntValue:
+			return lang.BoolValue{Value: true}, nil
+		default:
+			return lang.BoolValue{Value: false}, nil
+		}
 
 	case "is_bool":
 		if err := checkArgCount(1); err != nil {
@@ -163,12 +167,14 @@ func (e *evaluation) evaluateBuiltInFunction(funcName string, args []lang.Value,
 		if err := checkArgCount(1); err != nil {
 			return nil, err
 		}
-		num, ok := args[0].(lang.NumberValue)
-		if !ok {
+		switch num := args[0].(type) {
+		case lang.IntValue:
+			return lang.BoolValue{Value: true}, nil
+		case lang.NumberValue:
+			return lang.BoolValue{Value: num.Value == math.Trunc(num.Value)}, nil
+		default:
 			return lang.BoolValue{Value: false}, nil
 		}
-		isInt := num.Value == math.Trunc(num.Value)
-		return lang.BoolValue{Value: isInt}, nil
 
 	case "is_float":
 		if err := checkArgCount(1); err != nil {
@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.8.0
-// File version: 2
-// Purpose: This file now compiles as the 'Required' field has been added to eval.ArgSpec.
+// File version: 6
+// Purpose: Binds positional and named call arguments to tool specs, procedures and function values.
 // filename: pkg/eval/helpers_eval.go
-// nlines: 80
+// nlines: 195
 // risk_rating: HIGH
 
 package eval
@@ -16,22 +16,88 @@ import (
 	"github.com/aprice2704/neuroscript/pkg/types"
 )
 
-func (e *evaluation) mapArgsToSpec(toolName types.FullName, args []lang.Value, node *ast.CallableExprNode) (map[string]lang.Value, error) {
+func (e *evaluation) mapArgsToSpec(toolName types.FullName, args, named []lang.Value, node *ast.CallableExprNode) (map[string]lang.Value, error) {
 	spec, ok := e.rt.GetToolSpec(toolName)
 	if !ok {
 		return nil, lang.NewRuntimeError(lang.ErrorCodeToolNotFound, fmt.Sprintf("tool '%s' not found", toolName), lang.ErrToolNotFound).WithPosition(node.GetPos())
 	}
+	return argsForSpec(spec, toolName, args, named, node)
+}
+
+// argsForSpec binds positional arguments, then the node's named arguments
+// (whose values are in named, in the same order), to the parameters of a tool
+// spec. A missing argument is left for the tool registry to default.
+func argsForSpec(spec ToolSpec, toolName types.FullName, args, named []lang.Value, node *ast.CallableExprNode) (map[string]lang.Value, error) {
 	namedArgs := make(map[string]lang.Value)
 	for i, argSpec := range spec.Args {
 		if i < len(args) {
 			namedArgs[argSpec.Name] = args[i]
-		} else if argSpec.Required {
+		}
+	}
+	for i, na := range node.NamedArgs {
+		if !specHasArg(spec, na.Name) {
+			return nil, lang.NewRuntimeError(lang.ErrorCodeArgMismatch, fmt.Sprintf("tool '%s' has no argument '%s'", toolName, na.Name), lang.ErrArgumentMismatch).WithPosition(na.GetPos())
+		}
+		if _, dup := namedArgs[na.Name]; dup {
+			return nil, duplicateArgError(na, string(toolName))
+		}
+		namedArgs[na.Name] = named[i]
+	}
+	for _, argSpec := range spec.Args {
+		if _, ok := namedArgs[argSpec.Name]; !ok && argSpec.Required {
 			return nil, lang.NewRuntimeError(lang.ErrorCodeArgMismatch, fmt.Sprintf("missing required argument '%s' for tool '%s'", argSpec.Name, toolName), lang.ErrAssignCountMismatch).WithPosition(node.GetPos())
 		}
 	}
 	return namedArgs, nil
 }
 
+func specHasArg(spec ToolSpec, name string) bool {
+	for _, argSpec := range spec.Args {
+		if argSpec.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// duplicateArgError reports a named argument that repeats one already bound.
+// The interpreter uses the same wording for procedures.
+func duplicateArgError(na *ast.NamedArgNode, callee string) error {
+	return lang.NewRuntimeError(lang.ErrorCodeArgMismatch, fmt.Sprintf("argument '%s' given more than once in call to '%s'", na.Name, callee), lang.ErrArgumentMismatch).WithPosition(na.GetPos())
+}
+
+// undefinedVariable returns the value of an unset variable: nil, or an error
+// at pos when the runtime is strict.
+func undefinedVariable(rt Runtime, name string, pos *types.Position) (lang.Value, error) {
+	if strict, ok := rt.(StrictRuntime); ok && strict.StrictVariables() {
+		return nil, lang.NewRuntimeError(lang.ErrorCodeUndefinedVariable, fmt.Sprintf("undefined variable '%s'", name), lang.ErrVariableNotFound).WithPosition(pos)
+	}
+	return &lang.NilValue{}, nil
+}
+
+// runProcedure calls a procedure, routing named arguments through the
+// runtime's NamedArgRunner.
+func runProcedure(rt Runtime, node *ast.CallableExprNode, args, named []lang.Value) (lang.Value, error) {
+	name := node.Target.Name
+	if len(node.NamedArgs) == 0 {
+		return rt.RunProcedure(name, args...)
+	}
+	runner, ok := rt.(NamedArgRunner)
+	if !ok {
+		return nil, lang.NewRuntimeError(lang.ErrorCodeArgMismatch, fmt.Sprintf("procedure '%s' cannot be called with named arguments in this runtime", name), lang.ErrArgumentMismatch).WithPosition(node.GetPos())
+	}
+	return runner.RunProcedureNamed(name, args, node.NamedArgs, named)
+}
+
+// builtinNamedArgsError reports named arguments passed to a built-in function,
+// or nil if there are none.
+func builtinNamedArgsError(node *ast.CallableExprNode) error {
+	if len(node.NamedArgs) == 0 {
+		return nil
+	}
+	return lang.NewRuntimeError(lang.ErrorCodeArgMismatch, fmt.Sprintf("function '%s' does not accept named arguments", node.Target.Name), lang.ErrArgumentMismatch).WithPosition(node.NamedArgs[0].GetPos())
+}
+
 func (e *evaluation) evaluateAccessorKey(accessor *ast.AccessorNode) (string, error) {
 	if accessor.Type == ast.DotAccess {
 		if strLiteral, ok := accessor.Key.(*ast.StringLiteralNode); ok {
@@ -74,3 +140,56 @@ func resolveToolName(n *ast.CallableExprNode) (types.FullName, error) {
 	}
 	return types.FullName(strings.ToLower("tool." + n.Target.Name)), nil
 }
+
+// numberLiteral converts the value of a NumberLiteralNode: the parser stores
+// int64 for integer literals and float64 for everything else.
+func numberLiteral(v interface{}) lang.Value {
+	switch n := v.(type) {
+	case int64:
+		return lang.IntValue{Value: n}
+	case int:
+		return lang.IntValue{Value: int64(n)}
+	}
+	f, _ := lang.ToFloat64(v)
+	return lang.NumberValue{Value: f}
+}
+
+// newClosure evaluates a function literal through the runtime's
+// FunctionRuntime.
+func newClosure(rt Runtime, node *ast.LambdaNode) (lang.Value, error) {
+	fr, ok := rt.(FunctionRuntime)
+	if !ok {
+		return nil, lang.NewRuntimeError(lang.ErrorCodeNotImplemented, "function literals are not supported by this runtime", lang.ErrFeatureNotImplemented).WithPosition(node.GetPos())
+	}
+	return fr.NewClosure(node)
+}
+
+// functionVariable returns the function held by the variable a plain call
+// names, if any. Such a variable shadows procedures and built-ins.
+func functionVariable(rt Runtime, node *ast.CallableExprNode) (lang.Callable, bool) {
+	if node.Target.IsTool {
+		return nil, false
+	}
+	val, exists := rt.GetVariable(node.Target.Name)
+	if !exists {
+		return nil, false
+	}
+	fn, ok := val.(lang.FunctionValue)
+	if !ok || fn.Value == nil {
+		return nil, false
+	}
+	return fn.Value, true
+}
+
+// callFunction calls a function value held in a variable. Function values
+// take positional arguments only.
+func callFunction(rt Runtime, node *ast.CallableExprNode, fn lang.Callable, args []lang.Value) (lang.Value, error) {
+	if len(node.NamedArgs) > 0 {
+		return nil, lang.NewRuntimeError(lang.ErrorCodeArgMismatch, fmt.Sprintf("function '%s' does not accept named arguments", node.Target.Name), lang.ErrArgumentMismatch).WithPosition(node.NamedArgs[0].GetPos())
+	}
+	fr, ok := rt.(FunctionRuntime)
+	if !ok {
+		return nil, lang.NewRuntimeError(lang.ErrorCodeNotImplemented, fmt.Sprintf("cannot call function '%s': function values are not supported by this runtime", node.Target.Name), lang.ErrFeatureNotImplemented).WithPosition(node.GetPos())
+	}
+	return fr.CallFunction(fn, args)
+}
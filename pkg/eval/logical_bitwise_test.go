@@ -33,7 +33,7 @@ func TestLogicalBitwiseOps(t *testing.T) {
 			Name:        "Bitwise AND",
 			InputNode:   &ast.BinaryOpNode{Left: &ast.VariableNode{Name: "num5"}, Operator: "&", Right: &ast.VariableNode{Name: "num3"}},
 			InitialVars: vars,
-			Expected:    lang.NumberValue{Value: 1}, // 101 & 011 = 001
+			Expected:    lang.IntValue{Value: 1}, // 101 & 011 = 001
 		},
 		{
 			Name:            "Bitwise AND Error Float",
@@ -0,0 +1,106 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Tests that 'and'/'or' skip the right operand once the left decides, including fuzzy operands.
+// filename: pkg/eval/shortcircuit_test.go
+// nlines: 95
+// risk_rating: LOW
+
+package eval
+
+import (
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/types"
+)
+
+// countingRuntime records every tool and procedure call made during evaluation.
+type countingRuntime struct {
+	mockRuntime
+	calls int
+}
+
+func (c *countingRuntime) ExecuteTool(toolName types.FullName, args map[string]lang.Value) (lang.Value, error) {
+	c.calls++
+	return lang.BoolValue{Value: true}, nil
+}
+
+func (c *countingRuntime) GetToolSpec(toolName types.FullName) (ToolSpec, bool) {
+	return ToolSpec{FullName: toolName}, true
+}
+
+func (c *countingRuntime) RunProcedure(procName string, args ...lang.Value) (lang.Value, error) {
+	c.calls++
+	return lang.BoolValue{Value: true}, nil
+}
+
+func TestShortCircuit_SkipsRightOperand(t *testing.T) {
+	vars := map[string]lang.Value{
+		"t":       lang.BoolValue{Value: true},
+		"f":       lang.BoolValue{Value: false},
+		"missing": &lang.NilValue{},
+		"f0":      lang.NewFuzzyValue(0),
+		"f1":      lang.NewFuzzyValue(1),
+		"fHalf":   lang.NewFuzzyValue(0.5),
+	}
+	toolCall := &ast.CallableExprNode{Target: ast.CallTarget{Name: "test.Touch", IsTool: true}}
+	procCall := &ast.CallableExprNode{Target: ast.CallTarget{Name: "touch"}}
+
+	cases := []struct {
+		name      string
+		left      string
+		op        string
+		right     ast.Expression
+		want      lang.Value
+		wantCalls int
+	}{
+		{"false and tool", "f", "and", toolCall, lang.BoolValue{Value: false}, 0},
+		{"nil and proc", "missing", "and", procCall, lang.BoolValue{Value: false}, 0},
+		{"true or tool", "t", "or", toolCall, lang.BoolValue{Value: true}, 0},
+		{"true and tool", "t", "and", toolCall, lang.BoolValue{Value: true}, 1},
+		{"false or proc", "f", "OR", procCall, lang.BoolValue{Value: true}, 1},
+		{"fuzzy 0 and tool", "f0", "and", toolCall, lang.NewFuzzyValue(0), 0},
+		{"fuzzy 1 or tool", "f1", "or", toolCall, lang.NewFuzzyValue(1), 0},
+		{"fuzzy 0.5 and tool", "fHalf", "and", toolCall, lang.NewFuzzyValue(0.5), 1},
+		{"fuzzy 0.5 or tool", "fHalf", "or", toolCall, lang.NewFuzzyValue(1), 1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			rt := &countingRuntime{mockRuntime: mockRuntime{vars: vars}}
+			node := &ast.BinaryOpNode{Left: &ast.VariableNode{Name: tc.left}, Operator: tc.op, Right: tc.right}
+			got, err := Expression(rt, node)
+			if err != nil {
+				t.Fatalf("Expression() failed: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("got %#v, want %#v", got, tc.want)
+			}
+			if rt.calls != tc.wantCalls {
+				t.Errorf("right operand called %d times, want %d", rt.calls, tc.wantCalls)
+			}
+		})
+	}
+}
+
+func TestShortCircuit_GuardsFailingAccess(t *testing.T) {
+	// 'x != nil and x["k"] == 1' must not index x when it is nil.
+	access := &ast.BinaryOpNode{
+		Left:     &ast.ElementAccessNode{Collection: &ast.VariableNode{Name: "x"}, Accessor: &ast.StringLiteralNode{Value: "k"}},
+		Operator: "==",
+		Right:    &ast.NumberLiteralNode{Value: 1},
+	}
+	guard := &ast.BinaryOpNode{
+		Left:     &ast.BinaryOpNode{Left: &ast.VariableNode{Name: "x"}, Operator: "!=", Right: &ast.NilLiteralNode{}},
+		Operator: "and",
+		Right:    access,
+	}
+	rt := &mockRuntime{vars: map[string]lang.Value{"x": &lang.NilValue{}}}
+	got, err := Expression(rt, guard)
+	if err != nil {
+		t.Fatalf("guarded access failed: %v", err)
+	}
+	if got != (lang.BoolValue{Value: false}) {
+		t.Errorf("got %#v, want false", got)
+	}
+}
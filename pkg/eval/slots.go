@@ -0,0 +1,56 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Assigns frame slots to the variables of a compiled procedure body.
+// filename: pkg/eval/slots.go
+// nlines: 56
+// risk_rating: HIGH
+
+package eval
+
+import "github.com/aprice2704/neuroscript/pkg/lang"
+
+// SlotLayout numbers the variable names of a procedure body. It is filled
+// while the body is compiled and read-only afterwards, so one layout can be
+// shared by every frame that runs the body.
+type SlotLayout struct {
+	index map[string]int
+}
+
+// NewSlotLayout creates an empty layout.
+func NewSlotLayout() *SlotLayout {
+	return &SlotLayout{index: make(map[string]int)}
+}
+
+// Assign returns the slot of name, giving it the next free one if it has none.
+func (s *SlotLayout) Assign(name string) int {
+	if idx, ok := s.index[name]; ok {
+		return idx
+	}
+	idx := len(s.index)
+	s.index[name] = idx
+	return idx
+}
+
+// Lookup returns the slot of name, if it has one.
+func (s *SlotLayout) Lookup(name string) (int, bool) {
+	idx, ok := s.index[name]
+	return idx, ok
+}
+
+// Each calls fn for every name in the layout and its slot.
+func (s *SlotLayout) Each(fn func(name string, slot int)) {
+	for name, idx := range s.index {
+		fn(name, idx)
+	}
+}
+
+// Len reports how many slots a frame laid out by s needs.
+func (s *SlotLayout) Len() int { return len(s.index) }
+
+// SlotRuntime is implemented by runtimes whose frames keep the locals of a
+// compiled procedure body in slots. SlotValue reports false when the frame
+// was not laid out by layout or the slot is unset; a compiled read then falls
+// back to GetVariable, which also resolves globals and constants.
+type SlotRuntime interface {
+	SlotValue(layout *SlotLayout, slot int) (lang.Value, bool)
+}
@@ -0,0 +1,56 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Tests that undefined variable reads are nil by default and errors on a strict runtime.
+// filename: pkg/eval/strict_test.go
+// nlines: 56
+// risk_rating: LOW
+
+package eval
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/types"
+)
+
+// strictRuntime is a mockRuntime that reports strict variable mode.
+type strictRuntime struct {
+	mockRuntime
+	strict bool
+}
+
+func (s *strictRuntime) StrictVariables() bool { return s.strict }
+
+func TestUndefinedVariable_StrictMode(t *testing.T) {
+	pos := &types.Position{Line: 3, Column: 7}
+	nodes := map[string]ast.Expression{
+		"variable": &ast.VariableNode{BaseNode: ast.BaseNode{StartPos: pos}, Name: "typo"},
+		"lvalue":   &ast.LValueNode{BaseNode: ast.BaseNode{StartPos: pos}, Identifier: "typo"},
+	}
+	for name, node := range nodes {
+		t.Run(name, func(t *testing.T) {
+			lax := &strictRuntime{mockRuntime: mockRuntime{vars: map[string]lang.Value{}}}
+			if val, err := Expression(lax, node); err != nil || lang.TypeOf(val) != lang.TypeNil {
+				t.Fatalf("lax runtime: got (%v, %v), want nil value and no error", val, err)
+			}
+
+			strict := &strictRuntime{mockRuntime: mockRuntime{vars: map[string]lang.Value{}}, strict: true}
+			for _, run := range []func() (lang.Value, error){
+				func() (lang.Value, error) { return Expression(strict, node) },
+				func() (lang.Value, error) { return Compile(node)(strict) },
+			} {
+				_, err := run()
+				var rtErr *lang.RuntimeError
+				if !errors.As(err, &rtErr) || rtErr.Code != lang.ErrorCodeUndefinedVariable {
+					t.Fatalf("strict runtime: expected ErrorCodeUndefinedVariable, got %v", err)
+				}
+				if rtErr.Position == nil || rtErr.Position.Line != 3 {
+					t.Errorf("strict runtime: expected error at line 3, got position %v", rtErr.Position)
+				}
+			}
+		})
+	}
+}
@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.8.0
-// File version: 1
+// File version: 2
 // Purpose: "Tiger Tests" to attack weak points in the eval package.
 // filename: pkg/eval/tiger_test.go
-// nlines: 125
+// nlines: 150
 // risk_rating: HIGH
 
 package eval
@@ -70,8 +70,21 @@ func TestAccessTiger_IncompatibleAccess(t *testing.T) {
 			ExpectedErrorIs: lang.ErrInvalidOperation,
 		},
 		{
-			Name:            "Access String as List",
-			InputNode:       &ast.ElementAccessNode{Collection: &ast.StringLiteralNode{Value: "hello"}, Accessor: &ast.NumberLiteralNode{Value: int64(0)}},
+			Name:        "Access String as List",
+			InputNode:   &ast.ElementAccessNode{Collection: &ast.StringLiteralNode{Value: "hello"}, Accessor: &ast.NumberLiteralNode{Value: int64(0)}},
+			InitialVars: initialVars,
+			Expected:    lang.StringValue{Value: "h"}, // Strings index by character
+		},
+		{
+			Name:            "Access String with String Key",
+			InputNode:       &ast.ElementAccessNode{Collection: &ast.StringLiteralNode{Value: "hello"}, Accessor: &ast.StringLiteralNode{Value: "key"}},
+			InitialVars:     initialVars,
+			WantErr:         true,
+			ExpectedErrorIs: lang.ErrListInvalidIndexType,
+		},
+		{
+			Name:            "Access Bool as List",
+			InputNode:       &ast.ElementAccessNode{Collection: &ast.BooleanLiteralNode{Value: true}, Accessor: &ast.NumberLiteralNode{Value: int64(0)}},
 			InitialVars:     initialVars,
 			WantErr:         true,
 			ExpectedErrorIs: lang.ErrInvalidOperation,
@@ -89,17 +102,17 @@ func TestBuiltInTiger_Len(t *testing.T) {
 		{
 			Name:      "len(123)",
 			InputNode: &ast.CallableExprNode{Target: ast.CallTarget{Name: "len"}, Arguments: []ast.Expression{&ast.NumberLiteralNode{Value: 123}}},
-			Expected:  lang.NumberValue{Value: 1}, // len of a scalar is 1
+			Expected:  lang.IntValue{Value: 1}, // len of a scalar is 1
 		},
 		{
 			Name:      "len(true)",
 			InputNode: &ast.CallableExprNode{Target: ast.CallTarget{Name: "len"}, Arguments: []ast.Expression{&ast.BooleanLiteralNode{Value: true}}},
-			Expected:  lang.NumberValue{Value: 1}, // len of a scalar is 1
+			Expected:  lang.IntValue{Value: 1}, // len of a scalar is 1
 		},
 		{
 			Name:      "len(nil)",
 			InputNode: &ast.CallableExprNode{Target: ast.CallTarget{Name: "len"}, Arguments: []ast.Expression{&ast.NilLiteralNode{}}},
-			Expected:  lang.NumberValue{Value: 0}, // len of nil is 0
+			Expected:  lang.IntValue{Value: 0}, // len of nil is 0
 		},
 	}
 	for _, tc := range testCases {
@@ -1,9 +1,9 @@
 // NeuroScript Version: 0.8.0
-// File version: 4
+// File version: 5
 // Purpose: Defines internal AeiouOrchestrator. Uses 'any' for return value to break 'lang' import cycle.
-// Latest change: Added ActiveLoopInfo and loop management methods to the interface.
+// Latest change: Added the optional AeiouOrchestratorWithOptions extension for 'ask ... with' options.
 // filename: pkg/interfaces/aeiou.go
-// nlines: 48
+// nlines: 66
 
 package interfaces
 
@@ -49,3 +49,18 @@ type AeiouOrchestrator interface {
 	CancelLoop(loopID string) error
 	// --- END NEW ---
 }
+
+// AeiouOrchestratorWithOptions is an optional extension of AeiouOrchestrator
+// for services that honour 'ask ... with' options. The options have already
+// been validated; keys are as documented for 'ask' (temperature, max_output_tokens,
+// system_capsule, ...). An 'ask' with options fails if the registered service
+// does not implement this interface.
+type AeiouOrchestratorWithOptions interface {
+	AeiouOrchestrator
+	RunAskLoopWithOptions(
+		callingInterp any,
+		agentModelName string,
+		initialPrompt string,
+		options map[string]any,
+	) (any, error)
+}
@@ -0,0 +1,45 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Defines dead letters, the records of events an 'on event' handler failed to process, and the admin interface over them.
+// filename: pkg/interfaces/deadletter.go
+// nlines: 45
+// risk_rating: LOW
+
+package interfaces
+
+import "time"
+
+// DeadLetter records an event that one handler failed to process, after
+// any automatic retries.
+type DeadLetter struct {
+	ID          string
+	EventName   string
+	Source      string
+	Handler     string // the handler's 'named' label, if any
+	Position    string // where the handler is defined
+	Error       string // the last failure
+	ErrorCode   int
+	Attempts    int
+	FirstFailed time.Time
+	LastFailed  time.Time
+	// Event is the canonical event the handler received, a lang.Value.
+	Event Value
+}
+
+// DeadLetterReader provides read-only access to the dead-letter store.
+type DeadLetterReader interface {
+	// List returns the dead letters, oldest first.
+	List() []DeadLetter
+	Get(id string) (DeadLetter, bool)
+}
+
+// DeadLetterAdmin provides replay and discard on top of read access.
+type DeadLetterAdmin interface {
+	DeadLetterReader
+	// Replay runs the failed handler again on the stored event. A letter
+	// whose replay succeeds is removed; one whose replay fails stays, with
+	// its attempt count and error updated.
+	Replay(id string) error
+	// Discard removes a dead letter and reports whether it existed.
+	Discard(id string) bool
+}
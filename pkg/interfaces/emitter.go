@@ -1,6 +1,6 @@
 // NeuroScript Version: 0.7.2
-// File version: 3
-// Purpose: Updated to import pkg/types for AIRequest/AIResponse to break import cycles.
+// File version: 6
+// Purpose: Adds the optional TokenEmitter and RetryEmitter callbacks.
 // filename: pkg/ns_interfaces/emitter.go
 
 package interfaces
@@ -38,6 +38,26 @@ type LLMCallFailureInfo struct {
 	Latency time.Duration
 }
 
+// LLMCallChunkInfo carries one partial piece of a streamed LLM response.
+type LLMCallChunkInfo struct {
+	Ctx     context.Context
+	CallID  string
+	Request types.AIRequest
+	Attempt int    // 1-based attempt the chunk belongs to; a retry starts again at Index 0
+	Index   int    // 0-based sequence number of the chunk within the attempt
+	Delta   string // Newly received text
+}
+
+// LLMCallRetryInfo describes a failed attempt that is about to be retried.
+type LLMCallRetryInfo struct {
+	Ctx     context.Context
+	CallID  string
+	Request types.AIRequest
+	Attempt int           // 1-based number of the attempt that failed
+	Err     error         // Why the attempt failed
+	Delay   time.Duration // How long the connection waits before the next attempt
+}
+
 // Emitter is an interface for a component that can receive telemetry about
 // the lifecycle of LLM calls. This decouples llmconn from any specific
 // event bus implementation (like FDM's).
@@ -45,4 +65,24 @@ type Emitter interface {
 	EmitLLMCallStarted(info LLMCallStartInfo)
 	EmitLLMCallSucceeded(info LLMCallSuccessInfo)
 	EmitLLMCallFailed(info LLMCallFailureInfo)
-}
\ No newline at end of file
+}
+
+// TokenEmitter is an optional extension of Emitter. When the emitter passed
+// to an LLM connection also implements it and the provider supports
+// streaming, partial tokens are delivered through EmitLLMCallChunk as they
+// arrive, between EmitLLMCallStarted and EmitLLMCallSucceeded/Failed.
+// The assembled response is still reported through EmitLLMCallSucceeded.
+type TokenEmitter interface {
+	Emitter
+	EmitLLMCallChunk(info LLMCallChunkInfo)
+}
+
+// RetryEmitter is an optional extension of Emitter, notified each time a
+// failed LLM call attempt is retried. A call still ends with exactly one
+// EmitLLMCallSucceeded or EmitLLMCallFailed. When streaming, chunk indexes
+// restart at zero on each attempt, so partial text shown so far should be
+// discarded on a retry.
+type RetryEmitter interface {
+	Emitter
+	EmitLLMCallRetry(info LLMCallRetryInfo)
+}
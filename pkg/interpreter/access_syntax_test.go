@@ -0,0 +1,109 @@
+// NeuroScript Version: 0.8.0
+// File version: 2
+// Purpose: Tests dotted member access, list and string indexes and slices in scripts.
+// filename: pkg/interpreter/access_syntax_test.go
+// nlines: 109
+// risk_rating: LOW
+
+package interpreter
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/lang"
+)
+
+const accessSyntaxScript = `
+func member_chain(returns out) means
+	set cfg = {"server": {"host": "localhost", "ports": [80, 443]}}
+	return cfg.server.host + ":" + cfg.server.ports[-1]
+endfunc
+
+func member_missing(returns out) means
+	set cfg = {"a": 1}
+	return typeof(cfg.b)
+endfunc
+
+func member_on_list(returns out) means
+	set xs = [1, 2]
+	return xs.first
+endfunc
+
+func negative_index(returns out) means
+	set xs = ["a", "b", "c"]
+	return xs[-1] + xs[-3]
+endfunc
+
+func index_out_of_range(returns out) means
+	set xs = ["a", "b", "c"]
+	return xs[-4]
+endfunc
+
+func list_slices(returns out) means
+	set xs = [1, 2, 3, 4, 5]
+	return [xs[1:3], xs[:2], xs[3:], xs[-2:], xs[:-4], xs[4:1], xs[2:99]]
+endfunc
+
+func string_slices(returns out) means
+	set s = "neuroscript"
+	return s[:5] + "|" + s[5:] + "|" + s[-6:-3] + "|" + s[:100]
+endfunc
+
+func string_index(returns out) means
+	set s = "héllo"
+	return "abc"[0] + "abc"[-1] + s[1] + s[-5]
+endfunc
+
+func string_index_out_of_range(returns out) means
+	return "abc"[3]
+endfunc
+
+func slice_in_loop(returns out) means
+	set total = 0
+	for each n in [10, 20, 30, 40][1:]
+		set total = total + n
+	endfor
+	return total
+endfunc
+`
+
+func TestAccessSyntax(t *testing.T) {
+	cases := []struct {
+		proc    string
+		want    string
+		wantErr error
+	}{
+		{proc: "member_chain", want: "localhost:443"},
+		{proc: "member_missing", want: "nil"},
+		{proc: "member_on_list", wantErr: lang.ErrInvalidOperation},
+		{proc: "negative_index", want: "ca"},
+		{proc: "index_out_of_range", wantErr: lang.ErrListIndexOutOfBounds},
+		{proc: "list_slices", want: "[[2, 3], [1, 2], [4, 5], [4, 5], [1], [], [3, 4, 5]]"},
+		{proc: "string_slices", want: "neuro|script|scr|neuroscript"},
+		{proc: "string_index", want: "acéh"},
+		{proc: "string_index_out_of_range", wantErr: lang.ErrListIndexOutOfBounds},
+		{proc: "slice_in_loop", want: "90"},
+	}
+	modes := map[string][]InterpreterOption{"compiled": nil, "treewalk": {WithTreeWalker()}}
+	for modeName, opts := range modes {
+		interp := newScriptInterpreter(t, accessSyntaxScript, opts...)
+		for _, c := range cases {
+			t.Run(modeName+"/"+c.proc, func(t *testing.T) {
+				got, err := interp.RunProcedure(c.proc)
+				if c.wantErr != nil {
+					if !errors.Is(err, c.wantErr) {
+						t.Fatalf("expected error %v, got %v", c.wantErr, err)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if s, _ := lang.ToString(got); s != c.want {
+					t.Errorf("got %q, want %q", s, c.want)
+				}
+			})
+		}
+	}
+}
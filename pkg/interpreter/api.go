@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.8.0
-// File version: 18
-// Purpose: Corrects GetProvider to use the provider.NewReader interface.
+// File version: 20
+// Purpose: Corrects GetProvider to use the provider.NewReader interface. Logger redacts revealed secrets. Binds tools for compiled calls.
 // filename: pkg/interpreter/api.go
-// nlines: 201
+// nlines: 190
 // risk_rating: HIGH
 
 package interpreter
@@ -18,6 +18,7 @@ import (
 	"github.com/aprice2704/neuroscript/pkg/interfaces"
 	"github.com/aprice2704/neuroscript/pkg/lang"
 	"github.com/aprice2704/neuroscript/pkg/provider"
+	"github.com/aprice2704/neuroscript/pkg/tool"
 	"github.com/aprice2704/neuroscript/pkg/types"
 )
 
@@ -106,10 +107,14 @@ func (i *Interpreter) KnownEventHandlers() map[string][]*ast.OnEventDecl {
 }
 
 // Logger returns the interpreter's configured logger from the HostContext.
+// Once a secret has been revealed the logger is wrapped to redact it.
 func (i *Interpreter) Logger() interfaces.Logger {
 	if i.hostContext == nil || i.hostContext.Logger == nil {
 		panic("FATAL: Interpreter has no logger configured in its HostContext.")
 	}
+	if r := i.secrets; !r.Empty() {
+		return redactingLogger{Logger: i.hostContext.Logger, r: r}
+	}
 	return i.hostContext.Logger
 }
 
@@ -144,20 +149,42 @@ func (i *Interpreter) SetTurnContext(ctx context.Context) {
 
 // GetToolSpec satisfies the eval.Runtime interface by fetching the full tool
 // spec and converting it to the minimal eval.ToolSpec.
-func (i_1 *Interpreter) GetToolSpec(toolName types.FullName) (eval.ToolSpec, bool) {
-	t, ok := i_1.tools.GetTool(toolName)
+func (i *Interpreter) GetToolSpec(toolName types.FullName) (eval.ToolSpec, bool) {
+	t, ok := i.tools.GetTool(toolName)
 	if !ok {
 		return eval.ToolSpec{}, false
 	}
+	return evalToolSpec(t.Spec), true
+}
 
-	// Adapt the tool.ToolSpec to the eval.ToolSpec
-	evalArgs := make([]eval.ArgSpec, len(t.Spec.Args))
-	for i, arg := range t.Spec.Args {
-		evalArgs[i] = eval.ArgSpec{Name: arg.Name, Type: string(arg.Type)}
+// BindTool satisfies eval.ToolBinder: compiled tool calls resolve the
+// implementation once and run it through CallBoundTool afterwards.
+func (i *Interpreter) BindTool(toolName types.FullName) (*eval.BoundTool, bool) {
+	t, ok := i.tools.GetTool(toolName)
+	if !ok {
+		return nil, false
 	}
+	return &eval.BoundTool{Name: toolName, Spec: evalToolSpec(t.Spec), Impl: t}, true
+}
 
+// CallBoundTool satisfies eval.ToolBinder. The registry still checks policy,
+// validates the arguments and meters the call.
+func (i *Interpreter) CallBoundTool(t *eval.BoundTool, args map[string]lang.Value) (lang.Value, error) {
+	impl, ok := t.Impl.(tool.ToolImplementation)
+	if !ok {
+		return nil, lang.NewRuntimeError(lang.ErrorCodeInternal, fmt.Sprintf("tool '%s' was bound by another runtime", t.Name), lang.ErrInternal)
+	}
+	return i.tools.ExecuteResolved(t.Name, impl, args)
+}
+
+// evalToolSpec adapts a tool.ToolSpec to the eval.ToolSpec.
+func evalToolSpec(spec tool.ToolSpec) eval.ToolSpec {
+	evalArgs := make([]eval.ArgSpec, len(spec.Args))
+	for idx, arg := range spec.Args {
+		evalArgs[idx] = eval.ArgSpec{Name: arg.Name, Type: string(arg.Type)}
+	}
 	return eval.ToolSpec{
-		FullName: t.Spec.FullName,
+		FullName: spec.FullName,
 		Args:     evalArgs,
-	}, true
+	}
 }
@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.8.0
-// File version: 3
-// Purpose: Updated AppendScript to respect AllowRedefinition flag.
+// File version: 5
+// Purpose: AppendScript respects AllowRedefinition and a script's ':: strict: true' header.
 // filename: pkg/interpreter/append.go
-// nlines: 50
+// nlines: 64
 // risk_rating: MEDIUM
 
 package interpreter
@@ -28,6 +28,8 @@ func (i *Interpreter) appendScript(tree *interfaces.Tree) error {
 		return fmt.Errorf("interpreter.AppendScript: expected root node of type *ast.Program, but got %T", tree.Root)
 	}
 
+	markStrict(program)
+
 	for name, proc := range program.Procedures {
 		if !i.AllowRedefinition {
 			if _, exists := i.state.knownProcedures[name]; exists {
@@ -1,9 +1,9 @@
 // NeuroScript Version: 0.8.0
-// File version: 41
+// File version: 44
 // Purpose: Ensures the root providerRegistry is correctly propagated to forks and copies new HandleRegistry.
-// Latest change: Removed objectCache field from clone struct literal and added handleRegistry.
+// Latest change: Clones share the dead-letter store.
 // filename: pkg/interpreter/clone.go
-// nlines: 75
+// nlines: 106
 // risk_rating: HIGH
 
 package interpreter
@@ -14,7 +14,13 @@ import (
 	"github.com/google/uuid"
 )
 
-// fork creates a new interpreter instance for sandboxing procedure calls or event handlers.
+// maxCloneRegistry bounds how many forks the root remembers for the
+// dumpClones debug tool. Older entries are dropped so that long-running
+// hosts do not retain every fork they have ever made.
+const maxCloneRegistry = 64
+
+// fork creates a new interpreter instance for sandboxing, e.g. ForkSandboxed.
+// Procedure calls, event handlers and ask turns use the cheaper newFrame instead.
 // It shares the immutable HostContext and a reference to the root interpreter.
 // It creates an isolated variable scope and a new tool registry view bound to itself.
 func (i *Interpreter) fork() *Interpreter {
@@ -27,6 +33,7 @@ func (i *Interpreter) fork() *Interpreter {
 		// Copy/share all other fields from parent 'i'
 		hostContext:   i.hostContext,
 		eventManager:  i.eventManager,
+		deadLetters:   i.deadLetters,
 		bufferManager: i.bufferManager,
 		// objectCache:         i.objectCache, // REMOVED: Replaced by HandleRegistry
 		handleRegistry:      i.handleRegistry, // ADDED: Share the HandleRegistry on fork.
@@ -37,6 +44,8 @@ func (i *Interpreter) fork() *Interpreter {
 		ExecPolicy:          i.ExecPolicy,
 		accountStore:        i.accountStore,
 		capsuleStore:        i.capsuleStore,
+		secretKeys:          i.secretKeys,
+		secrets:             i.secrets,
 		// adminCapsuleRegistry: i.adminCapsuleRegistry, // REMOVED.
 		parser:     i.parser,
 		astBuilder: i.astBuilder,
@@ -78,9 +87,20 @@ func (i *Interpreter) fork() *Interpreter {
 	root.state.variablesMu.RUnlock()
 
 	// Register with root for debugging.
-	root.cloneRegistryMu.Lock()
-	root.cloneRegistry = append(root.cloneRegistry, clone)
-	root.cloneRegistryMu.Unlock()
+	root.registerClone(clone)
 
 	return clone
 }
+
+// registerClone records a fork in the root's bounded clone registry,
+// evicting the oldest entry once maxCloneRegistry is reached.
+func (i *Interpreter) registerClone(clone *Interpreter) {
+	i.cloneRegistryMu.Lock()
+	defer i.cloneRegistryMu.Unlock()
+	if len(i.cloneRegistry) >= maxCloneRegistry {
+		n := copy(i.cloneRegistry, i.cloneRegistry[1:])
+		i.cloneRegistry[n] = clone
+		return
+	}
+	i.cloneRegistry = append(i.cloneRegistry, clone)
+}
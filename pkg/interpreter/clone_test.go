@@ -1,5 +1,5 @@
 // NeuroScript Version: 0.8.0
-// File version: 17
+// File version: 18
 // Purpose: Corrects the test to treat the 'tools' field as isolated. This is now the correct behavior, as a forked interpreter gets a new "view" of the tool registry bound to its own runtime.
 // filename: pkg/interpreter/clone_test.go
 
@@ -84,6 +84,8 @@ func TestInterpreter_Clone_Integrity(t *testing.T) {
 		"tools":           true,
 		"cloneRegistry":   true,
 		"cloneRegistryMu": true,
+		// Only the root's timersMu is ever locked; each copy carries its own.
+		"timersMu": true,
 	}
 
 	parentVal := reflect.ValueOf(parent).Elem()
@@ -0,0 +1,101 @@
+// NeuroScript Version: 0.8.0
+// File version: 2
+// Purpose: Function literals: closures over their defining scope and calls to function values.
+// filename: pkg/interpreter/closure.go
+// nlines: 101
+// risk_rating: HIGH
+
+package interpreter
+
+import (
+	"fmt"
+
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/eval"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+)
+
+// closureName is how a function literal appears in call stacks and errors.
+const closureName = "func"
+
+// closure is the value of a function literal. It captures the local
+// variables of the frame that evaluated the literal by value, so later
+// assignments on either side are not seen by the other; globals are still
+// resolved through the root as in any frame.
+type closure struct {
+	node     *ast.LambdaNode
+	captured map[string]lang.Value
+	env      *Interpreter
+}
+
+func (c *closure) IsCallable()  {}
+func (c *closure) Name() string { return closureName }
+
+// NewClosure captures the current scope for a function literal. It
+// satisfies eval.FunctionRuntime.
+func (i *Interpreter) NewClosure(node *ast.LambdaNode) (lang.Value, error) {
+	captured := i.state.copyLocals()
+	return lang.FunctionValue{Value: &closure{node: node, captured: captured, env: i}}, nil
+}
+
+// CallFunction calls a function value with positional arguments. It
+// satisfies eval.FunctionRuntime and is how tools call back into scripts.
+func (i *Interpreter) CallFunction(fn lang.Callable, args []lang.Value) (lang.Value, error) {
+	switch f := fn.(type) {
+	case *closure:
+		return i.callClosure(f, args)
+	case *ast.Procedure:
+		return i.runProcedure(f.Name(), args...)
+	case nil:
+		return nil, lang.NewRuntimeError(lang.ErrorCodeType, "cannot call a nil function", lang.ErrInvalidArgument)
+	default:
+		return nil, lang.NewRuntimeError(lang.ErrorCodeType, fmt.Sprintf("cannot call function value of type %T", fn), lang.ErrInvalidArgument)
+	}
+}
+
+func (i *Interpreter) callClosure(c *closure, args []lang.Value) (lang.Value, error) {
+	node := c.node
+	if len(args) != len(node.Params) {
+		return nil, lang.NewRuntimeError(lang.ErrorCodeArgMismatch,
+			fmt.Sprintf("function expects %d argument(s), got %d", len(node.Params), len(args)),
+			lang.ErrArgumentMismatch).WithPosition(node.GetPos())
+	}
+	// Tools call back through the root, so the defining frame's stack may be
+	// the deeper one; counting it keeps recursion through tools bounded.
+	stack := i.state.stackFrames
+	if len(c.env.state.stackFrames) > len(stack) {
+		stack = c.env.state.stackFrames
+	}
+	if len(stack) >= maxCallDepth {
+		return nil, lang.NewRuntimeError(
+			lang.ErrorCodeResourceExhaustion,
+			fmt.Sprintf("maximum call depth of %d exceeded", maxCallDepth),
+			lang.ErrMaxCallDepthExceeded,
+		)
+	}
+
+	frame := c.env.newFrame()
+	frame.state.currentProcName = c.env.state.currentProcName
+	frame.state.stackFrames = append(stack[:len(stack):len(stack)], closureName)
+	for k, v := range c.captured {
+		frame.state.variables[k] = v
+	}
+	for idx, param := range node.Params {
+		frame.SetVariable(param, args[idx])
+	}
+
+	if node.Body != nil {
+		return eval.Expression(frame, node.Body)
+	}
+	if len(node.ErrorHandlers) > 0 {
+		frame.state.errorHandlerStack = append(frame.state.errorHandlerStack, node.ErrorHandlers)
+	}
+	result, _, _, err := frame.executeSteps(node.Steps, false, nil)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return &lang.NilValue{}, nil
+	}
+	return result, nil
+}
@@ -0,0 +1,118 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Tests function literals: capture, calls through variables, block bodies and errors.
+// filename: pkg/interpreter/closure_test.go
+// nlines: 118
+// risk_rating: LOW
+
+package interpreter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/lang"
+)
+
+const closureScript = `
+func expression_body(returns out) means
+	set double = func(x) means x * 2
+	return double(21)
+endfunc
+
+func captures_by_value(returns out) means
+	set n = 10
+	set add_n = func(x) means x + n
+	set n = 100
+	return add_n(1)
+endfunc
+
+func make_adder(needs n returns out) means
+	return func(x) means x + n
+endfunc
+
+func returned_closure(returns out) means
+	set add5 = make_adder(5)
+	return add5(2)
+endfunc
+
+func block_body(returns out) means
+	set classify = func(x) means
+		if x > 0
+			return "positive"
+		endif
+		return "not positive"
+	endfunc
+	return classify(3) + "," + classify(-1)
+endfunc
+
+func handled(returns out) means
+	set risky = func() means
+		on error do
+			clear_error
+		endon
+		fail "boom"
+	endfunc
+	set r = risky()
+	return typeof(r)
+endfunc
+
+func shadows_procedure(returns out) means
+	set make_adder = func(a) means "shadowed " + a
+	return make_adder(1)
+endfunc
+
+func wrong_arity(returns out) means
+	set f = func(a, b) means a + b
+	return f(1)
+endfunc
+
+func named_args(returns out) means
+	set f = func(a) means a
+	return f(a: 1)
+endfunc
+
+func value_type(returns out) means
+	set f = func() means 1
+	return typeof(f)
+endfunc
+`
+
+func TestClosures(t *testing.T) {
+	cases := []struct {
+		proc    string
+		want    string
+		wantErr string
+	}{
+		{proc: "expression_body", want: "42"},
+		{proc: "captures_by_value", want: "11"},
+		{proc: "returned_closure", want: "7"},
+		{proc: "block_body", want: "positive,not positive"},
+		{proc: "handled", want: "nil"},
+		{proc: "shadows_procedure", want: "shadowed 1"},
+		{proc: "wrong_arity", wantErr: "function expects 2 argument(s), got 1"},
+		{proc: "named_args", wantErr: "function 'f' does not accept named arguments"},
+		{proc: "value_type", want: "function"},
+	}
+	modes := map[string][]InterpreterOption{"compiled": nil, "treewalk": {WithTreeWalker()}}
+	for modeName, opts := range modes {
+		interp := newScriptInterpreter(t, closureScript, opts...)
+		for _, c := range cases {
+			t.Run(modeName+"/"+c.proc, func(t *testing.T) {
+				got, err := interp.RunProcedure(c.proc)
+				if c.wantErr != "" {
+					if err == nil || !strings.Contains(err.Error(), c.wantErr) {
+						t.Fatalf("expected error containing %q, got %v", c.wantErr, err)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if s, _ := lang.ToString(got); s != c.want {
+					t.Errorf("got %q, want %q", s, c.want)
+				}
+			})
+		}
+	}
+}
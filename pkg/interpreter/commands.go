@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 9
+// :: fileVersion: 10
 // :: description: Updated command block execution to push context to stackFrames, ensuring correct stacking in loops.
-// :: latestChange: Pushed contextName to stackFrames using baseStack.
+// :: latestChange: Each command block runs strict only if its own file is.
 // :: filename: pkg/interpreter/commands.go
 // :: serialization: go
 
@@ -22,6 +22,8 @@ func (i *Interpreter) executeCommands() (lang.Value, error) {
 	// Capture the base stack before iterating commands.
 	// This ensures that if we have multiple command blocks, the stack doesn't grow infinitely with siblings.
 	baseStack := i.state.stackFrames
+	baseStrict := i.state.strict
+	defer func() { i.state.strict = baseStrict }()
 
 	for _, cmdNode := range i.state.commands {
 		if cmdNode == nil || len(cmdNode.Body) == 0 {
@@ -37,6 +39,7 @@ func (i *Interpreter) executeCommands() (lang.Value, error) {
 		// Push context to stackFrames so called procedures inherit it in their trace.
 		// We append to baseStack to treat each command block as a sibling in the call stack.
 		i.state.stackFrames = append(baseStack, contextName)
+		i.state.strict = cmdNode.Strict
 
 		var wasReturn, wasCleared bool
 		finalResult, wasReturn, wasCleared, err = i.executeSteps(cmdNode.Body, false, nil)
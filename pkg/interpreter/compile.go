@@ -0,0 +1,238 @@
+// NeuroScript Version: 0.8.0
+// File version: 5
+// Purpose: Compiles step lists into a pre-resolved executable form with slotted variables and caches it on the root interpreter.
+// filename: pkg/interpreter/compile.go
+// nlines: 238
+// risk_rating: HIGH
+
+package interpreter
+
+import (
+	"strings"
+
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/eval"
+)
+
+// maxCompiledBlocks bounds the root's compile cache. Hosts that execute a
+// stream of fresh ASTs (ask turns, ad-hoc commands) would otherwise grow it
+// forever; when full it is simply dropped and rebuilt on demand.
+const maxCompiledBlocks = 4096
+
+// stepKind is the pre-resolved form of ast.Step.Type.
+type stepKind uint8
+
+const (
+	stepUnknown stepKind = iota
+	stepSet
+	stepCall
+	stepReturn
+	stepEmit
+	stepWhisper
+	stepIf
+	stepWhile
+	stepFor
+	stepMust
+	stepFail
+	stepOnError
+	stepClearError
+	stepAsk
+	stepPromptUser
+	stepBreak
+	stepContinue
+	stepExpression
+	stepSwitch
+	stepTry
+	stepRaise
+)
+
+var stepKinds = map[string]stepKind{
+	"set":                  stepSet,
+	"assign":               stepSet,
+	"call":                 stepCall,
+	"return":               stepReturn,
+	"emit":                 stepEmit,
+	"whisper":              stepWhisper,
+	"if":                   stepIf,
+	"while":                stepWhile,
+	"for":                  stepFor,
+	"for_each":             stepFor,
+	"must":                 stepMust,
+	"mustbe":               stepMust,
+	"fail":                 stepFail,
+	"on_error":             stepOnError,
+	"clear_error":          stepClearError,
+	"ask":                  stepAsk,
+	"promptuser":           stepPromptUser,
+	"break":                stepBreak,
+	"continue":             stepContinue,
+	"expression_statement": stepExpression,
+	"switch":               stepSwitch,
+	"try":                  stepTry,
+	"raise":                stepRaise,
+}
+
+// compiledStep is one step with its dispatch decided and its hot expressions
+// compiled. Steps whose executors are not on a hot path keep only the
+// original ast.Step and run through the tree-walking executor.
+type compiledStep struct {
+	step              *ast.Step
+	kind              stepKind
+	typeLower         string // context for ensureRuntimeError, as in the tree walker
+	updatesLastResult bool
+
+	expr     eval.Compiled   // set RHS, call, emit, must, expression statement
+	exprNode ast.Expression  // source of expr, for error positions
+	values   []eval.Compiled // return values
+	cond     eval.Compiled   // if / while condition, switch subject
+	coll     eval.Compiled   // for each
+	body     *compiledBlock
+	elseBody *compiledBlock // nil when the step has no else branch, switch default or catch
+
+	finallyBody *compiledBlock // try's finally block, nil when absent
+
+	caseVals   [][]eval.Compiled // switch case values, by case
+	caseBodies []*compiledBlock  // switch case bodies, by case
+}
+
+// compiledBlock is the executable form of a []ast.Step. A top-level block
+// carries the slot layout its variable reads were compiled against; a
+// procedure frame that adopts it reads those variables by slot.
+type compiledBlock struct {
+	steps  []compiledStep
+	layout *eval.SlotLayout
+}
+
+// compileKey identifies a step list by its backing array and length.
+type compileKey struct {
+	first *ast.Step
+	n     int
+}
+
+// compileSteps translates a step list into its executable form, giving every
+// variable its expressions read a slot in layout.
+func compileSteps(steps []ast.Step, layout *eval.SlotLayout) *compiledBlock {
+	b := &compiledBlock{steps: make([]compiledStep, len(steps))}
+	for idx := range steps {
+		step := &steps[idx]
+		typeLower := strings.ToLower(step.Type)
+		cs := compiledStep{
+			step:              step,
+			kind:              stepKinds[typeLower],
+			typeLower:         typeLower,
+			updatesLastResult: shouldUpdateLastResult(typeLower),
+		}
+		switch cs.kind {
+		case stepSet:
+			if len(step.Values) > 0 {
+				cs.exprNode = step.Values[0]
+			} else if step.Call != nil {
+				cs.exprNode = step.Call
+			}
+		case stepCall:
+			if step.Call != nil {
+				cs.exprNode = step.Call
+			}
+		case stepEmit, stepExpression:
+			if len(step.Values) > 0 {
+				cs.exprNode = step.Values[0]
+			}
+		case stepMust:
+			if step.Cond != nil {
+				cs.exprNode = step.Cond
+			} else if step.Call != nil {
+				cs.exprNode = step.Call
+			}
+		case stepReturn:
+			cs.values = make([]eval.Compiled, len(step.Values))
+			for vi, v := range step.Values {
+				cs.values[vi] = eval.CompileWithSlots(v, layout)
+			}
+		case stepIf:
+			cs.cond = eval.CompileWithSlots(step.Cond, layout)
+			cs.body = compileSteps(step.Body, layout)
+			if step.ElseBody != nil {
+				cs.elseBody = compileSteps(step.ElseBody, layout)
+			}
+		case stepWhile:
+			if step.Cond != nil {
+				cs.cond = eval.CompileWithSlots(step.Cond, layout)
+			}
+			cs.body = compileSteps(step.Body, layout)
+		case stepFor:
+			if step.Collection != nil {
+				cs.coll = eval.CompileWithSlots(step.Collection, layout)
+			}
+			cs.body = compileSteps(step.Body, layout)
+		case stepSwitch:
+			if sw := step.SwitchStmt; sw != nil && sw.Subject != nil {
+				cs.cond = eval.CompileWithSlots(sw.Subject, layout)
+				cs.caseVals = make([][]eval.Compiled, len(sw.Cases))
+				cs.caseBodies = make([]*compiledBlock, len(sw.Cases))
+				for ci, c := range sw.Cases {
+					cs.caseVals[ci] = make([]eval.Compiled, len(c.Values))
+					for vi, v := range c.Values {
+						cs.caseVals[ci][vi] = eval.CompileWithSlots(v, layout)
+					}
+					cs.caseBodies[ci] = compileSteps(c.Body, layout)
+				}
+				if sw.Default != nil {
+					cs.elseBody = compileSteps(sw.Default, layout)
+				}
+			}
+		case stepTry:
+			if t := step.TryStmt; t != nil {
+				cs.body = compileSteps(t.Body, layout)
+				if t.Catch != nil {
+					cs.elseBody = compileSteps(t.Catch, layout)
+				}
+				if t.Finally != nil {
+					cs.finallyBody = compileSteps(t.Finally, layout)
+				}
+			}
+		}
+		if cs.exprNode != nil {
+			cs.expr = eval.CompileWithSlots(cs.exprNode, layout)
+		}
+		b.steps[idx] = cs
+	}
+	return b
+}
+
+// compiled returns the executable form of a step list, compiling it on first
+// use. The cache lives on the root so procedures and handlers are compiled
+// once no matter how many frames run them.
+func (i *Interpreter) compiled(steps []ast.Step) *compiledBlock {
+	if len(steps) == 0 {
+		return &compiledBlock{}
+	}
+	root := i.rootInterpreter()
+	key := compileKey{first: &steps[0], n: len(steps)}
+
+	root.state.compiledMu.RLock()
+	b, ok := root.state.compiled[key]
+	root.state.compiledMu.RUnlock()
+	if ok {
+		return b
+	}
+
+	layout := eval.NewSlotLayout()
+	b = compileSteps(steps, layout)
+	b.layout = layout
+	root.state.compiledMu.Lock()
+	if root.state.compiled == nil || len(root.state.compiled) >= maxCompiledBlocks {
+		root.state.compiled = make(map[compileKey]*compiledBlock)
+	}
+	root.state.compiled[key] = b
+	root.state.compiledMu.Unlock()
+	return b
+}
+
+// resetCompiled drops every cached compiled block, e.g. when the tool
+// registry a compiled tool call may have bound to is replaced.
+func (i *Interpreter) resetCompiled() {
+	root := i.rootInterpreter()
+	root.state.compiledMu.Lock()
+	root.state.compiled = nil
+	root.state.compiledMu.Unlock()
+}
@@ -0,0 +1,288 @@
+// NeuroScript Version: 0.8.0
+// File version: 3
+// Purpose: Checks that compiled execution matches the tree walker and benchmarks the two.
+// filename: pkg/interpreter/compile_test.go
+// nlines: 288
+// risk_rating: LOW
+
+package interpreter
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/policy"
+	"github.com/aprice2704/neuroscript/pkg/tool"
+)
+
+const compileParityScript = `
+func loops(returns total, skipped) means
+	set total = 0
+	set skipped = 0
+	for each n in [1, 2, 3, 4, 5, 6]
+		if n == 2
+			set skipped = skipped + 1
+			continue
+		endif
+		if n == 5
+			break
+		endif
+		set total = total + n
+	endfor
+	set i = 0
+	while i < 3
+		set i = i + 1
+		set total = total + i
+	endwhile
+	return total, skipped
+endfunc
+
+func chars(returns out) means
+	set out = ""
+	for each c in "abc"
+		set out = out + c + "-"
+	endfor
+	return out
+endfunc
+
+func nested(returns m) means
+	set m = {"a": [1, 2, {"b": "c"}]}
+	set m["a"][2]["b"] = "d"
+	return m
+endfunc
+
+func caught(returns result) means
+	set result = "unhandled"
+	on error do
+		set result = "caught " + system_error_message
+		clear_error
+	endon
+	must 1 > 2
+	return result
+endfunc
+
+func uncaught() means
+	set x = 1
+	fail "boom " + x
+endfunc
+
+func bad_builtin() means
+	set n = len("a", "b")
+endfunc
+
+func missing_proc() means
+	call nowhere(1)
+endfunc
+
+func use_tool(returns r) means
+	set r = tool.bench.add(2, 3)
+	set r = r + tool.bench.add(r, 1)
+	return r
+endfunc
+
+func slotted(needs n returns out) means
+	set base = n * 10
+	set add = func(x) means x + base
+	set base = 0
+	set out = add(1) + base + n
+	for each k in [1, 2]
+		set out = out + k
+	endfor
+	return out + k
+endfunc
+
+func recurse(needs n returns out) means
+	if n <= 0
+		return 0
+	endif
+	set below = recurse(n - 1)
+	return n + below
+endfunc
+
+func sum_list(needs items returns total) means
+	set total = 0
+	for each item in items
+		set total = total + item * 2
+	endfor
+	return total
+endfunc
+`
+
+// registerBenchAdd registers tool.bench.add, which sums two numbers.
+func registerBenchAdd(tb testing.TB, interp *Interpreter) {
+	tb.Helper()
+	_, err := interp.ToolRegistry().RegisterTool(tool.ToolImplementation{
+		Spec: tool.ToolSpec{
+			Name:       "add",
+			Group:      "bench",
+			Args:       []tool.ArgSpec{{Name: "a", Type: tool.ArgTypeFloat}, {Name: "b", Type: tool.ArgTypeFloat}},
+			ReturnType: tool.ArgTypeFloat,
+		},
+		Func: func(_ tool.Runtime, args []interface{}) (interface{}, error) {
+			a, _ := args[0].(float64)
+			b, _ := args[1].(float64)
+			return a + b, nil
+		},
+	})
+	if err != nil {
+		tb.Fatalf("register tool.bench.add: %v", err)
+	}
+}
+
+// executionModes lists the two execution paths. Each mode sets the path
+// explicitly, so neither depends on the interpreter's default.
+var executionModes = []struct {
+	name string
+	opt  InterpreterOption
+}{
+	{"compiled", func(i *Interpreter) { i.state.treeWalker = false }},
+	{"treewalk", WithTreeWalker()},
+}
+
+func TestCompiledExecution_MatchesTreeWalker(t *testing.T) {
+	compiled := newScriptInterpreter(t, compileParityScript, executionModes[0].opt)
+	walker := newScriptInterpreter(t, compileParityScript, executionModes[1].opt)
+	registerBenchAdd(t, compiled)
+	registerBenchAdd(t, walker)
+
+	list := lang.ListValue{Value: []lang.Value{lang.NumberValue{Value: 1}, lang.NumberValue{Value: 2}}}
+	calls := []struct {
+		proc string
+		args []lang.Value
+	}{
+		{"loops", nil},
+		{"chars", nil},
+		{"nested", nil},
+		{"caught", nil},
+		{"uncaught", nil},
+		{"bad_builtin", nil},
+		{"missing_proc", nil},
+		{"use_tool", nil},
+		{"sum_list", []lang.Value{list}},
+		{"slotted", []lang.Value{lang.NumberValue{Value: 4}}},
+		{"recurse", []lang.Value{lang.NumberValue{Value: 5}}},
+	}
+	for _, c := range calls {
+		t.Run(c.proc, func(t *testing.T) {
+			// Run twice so the second call exercises the compile cache.
+			for run := 0; run < 2; run++ {
+				gotVal, gotErr := compiled.RunProcedure(c.proc, c.args...)
+				wantVal, wantErr := walker.RunProcedure(c.proc, c.args...)
+				if fmt.Sprint(gotErr) != fmt.Sprint(wantErr) {
+					t.Fatalf("run %d: error mismatch\ncompiled: %v\nwalker:   %v", run, gotErr, wantErr)
+				}
+				if fmt.Sprint(gotVal) != fmt.Sprint(wantVal) {
+					t.Fatalf("run %d: value mismatch\ncompiled: %v\nwalker:   %v", run, gotVal, wantVal)
+				}
+			}
+		})
+	}
+}
+
+func TestCompiledExecution_CacheIsBoundedAndResetOnLoad(t *testing.T) {
+	interp := newScriptInterpreter(t, compileParityScript, executionModes[0].opt)
+	if _, err := interp.RunProcedure("loops"); err != nil {
+		t.Fatalf("loops failed: %v", err)
+	}
+	if len(interp.state.compiled) == 0 {
+		t.Fatal("expected the procedure body to be cached after a run")
+	}
+	if err := interp.Load(nil); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if n := len(interp.state.compiled); n != 0 {
+		t.Errorf("Load left %d compiled blocks in the cache, want 0", n)
+	}
+
+	for n := 0; n <= maxCompiledBlocks; n++ {
+		interp.compiled(make([]ast.Step, 1))
+	}
+	if n := len(interp.state.compiled); n > maxCompiledBlocks {
+		t.Errorf("compile cache holds %d blocks, want at most %d", n, maxCompiledBlocks)
+	}
+}
+
+func TestCompiledExecution_ProcedureLocalsUseSlots(t *testing.T) {
+	interp := newScriptInterpreter(t, compileParityScript, executionModes[0].opt)
+	if _, err := interp.RunProcedure("slotted", lang.NumberValue{Value: 4}); err != nil {
+		t.Fatalf("slotted failed: %v", err)
+	}
+	layout := interp.compiled(interp.state.knownProcedures["slotted"].Steps).layout
+	for _, name := range []string{"n", "base", "out", "k"} {
+		if _, ok := layout.Lookup(name); !ok {
+			t.Errorf("variable %q has no slot", name)
+		}
+	}
+
+	frame := interp.newFrame()
+	frame.state.useLayout(layout)
+	if err := frame.SetVariable("base", lang.NumberValue{Value: 7}); err != nil {
+		t.Fatalf("SetVariable failed: %v", err)
+	}
+	slot, _ := layout.Lookup("base")
+	if v, ok := frame.SlotValue(layout, slot); !ok || fmt.Sprint(v) != "7" {
+		t.Errorf("SlotValue(base) = %v, %v; want 7, true", v, ok)
+	}
+	if _, inMap := frame.state.variables["base"]; inMap {
+		t.Error("a slotted variable was also written to the variable map")
+	}
+	if v, ok := frame.GetVariable("base"); !ok || fmt.Sprint(v) != "7" {
+		t.Errorf("GetVariable(base) = %v, %v; want 7, true", v, ok)
+	}
+}
+
+func TestCompiledExecution_BoundToolStillChecksPolicy(t *testing.T) {
+	interp := newScriptInterpreter(t, compileParityScript, executionModes[0].opt)
+	registerBenchAdd(t, interp)
+	if _, err := interp.RunProcedure("use_tool"); err != nil {
+		t.Fatalf("use_tool failed: %v", err)
+	}
+	// The call is bound now; a policy that no longer allows the tool must
+	// still stop it.
+	interp.ExecPolicy = policy.NewBuilder(policy.ContextNormal).Allow("tool.other.*").Build()
+	if _, err := interp.RunProcedure("use_tool"); err == nil {
+		t.Fatal("a bound tool call ran although the policy no longer allows it")
+	}
+}
+
+// listArg builds a list of n numbers for the loop benchmarks.
+func listArg(n int) lang.ListValue {
+	items := make([]lang.Value, n)
+	for idx := range items {
+		items[idx] = lang.NumberValue{Value: float64(idx)}
+	}
+	return lang.ListValue{Value: items}
+}
+
+func BenchmarkExecution(b *testing.B) {
+	for _, size := range []int{1000, 5000} {
+		arg := listArg(size)
+		for _, m := range executionModes {
+			b.Run(fmt.Sprintf("sum_list/%d/%s", size, m.name), func(b *testing.B) {
+				interp := newScriptInterpreter(b, compileParityScript, m.opt)
+				b.ReportAllocs()
+				b.ResetTimer()
+				for n := 0; n < b.N; n++ {
+					if _, err := interp.RunProcedure("sum_list", arg); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+	for _, m := range executionModes {
+		b.Run("use_tool/"+m.name, func(b *testing.B) {
+			interp := newScriptInterpreter(b, compileParityScript, m.opt)
+			registerBenchAdd(b, interp)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				if _, err := interp.RunProcedure("use_tool"); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,369 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Retries failed 'on event' handlers with backoff and keeps the events they could not process as dead letters for replay.
+// filename: pkg/interpreter/dead_letters.go
+// nlines: 369
+// risk_rating: HIGH
+
+package interpreter
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/interfaces"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/scheduler"
+)
+
+// DefaultDeadLetterCapacity bounds the dead letters of a zero DeadLetterConfig.
+const DefaultDeadLetterCapacity = 1000
+
+// Defaults applied to a zero EventRetryPolicy that allows retries.
+const (
+	DefaultEventRetryBackoff    = time.Second
+	DefaultEventRetryMultiplier = 2.0
+)
+
+// EventRetryPolicy says how often a failed handler is run again before its
+// event becomes a dead letter. The zero value never retries.
+type EventRetryPolicy struct {
+	// MaxAttempts counts every run of the handler, the first included;
+	// 0 or 1 means no retry.
+	MaxAttempts int
+	// Backoff is the wait before the first retry.
+	Backoff time.Duration
+	// Multiplier grows the wait after each further failure.
+	Multiplier float64
+	// MaxBackoff caps the wait; 0 means no cap.
+	MaxBackoff time.Duration
+}
+
+// delay returns the wait after the given number of failed attempts.
+func (p EventRetryPolicy) delay(failures int) time.Duration {
+	backoff, mult := p.Backoff, p.Multiplier
+	if backoff <= 0 {
+		backoff = DefaultEventRetryBackoff
+	}
+	if mult < 1 {
+		mult = DefaultEventRetryMultiplier
+	}
+	d := float64(backoff)
+	for n := 1; n < failures; n++ {
+		d *= mult
+		if p.MaxBackoff > 0 && d >= float64(p.MaxBackoff) {
+			break
+		}
+	}
+	if p.MaxBackoff > 0 && d > float64(p.MaxBackoff) {
+		return p.MaxBackoff
+	}
+	return time.Duration(d)
+}
+
+// DeadLetterConfig configures handler retries and the dead-letter store.
+// The zero value keeps up to DefaultDeadLetterCapacity dead letters and
+// never retries.
+type DeadLetterConfig struct {
+	// Capacity bounds the dead letters kept; the oldest is dropped first.
+	Capacity int
+	Retry    EventRetryPolicy
+	// OnDeadLetter, if set, is told about every new dead letter.
+	OnDeadLetter func(interfaces.DeadLetter)
+}
+
+// WithDeadLetters configures handler retries and the dead-letter store.
+// Retries wait on the clock set by WithClock.
+func WithDeadLetters(cfg DeadLetterConfig) InterpreterOption {
+	return func(i *Interpreter) {
+		i.deadLetters = newDeadLetterStore(i, cfg)
+	}
+}
+
+// DeadLetters returns the store of events whose handlers failed.
+func (i *Interpreter) DeadLetters() interfaces.DeadLetterAdmin {
+	return i.rootInterpreter().deadLetters
+}
+
+// handlerFailure follows one event through the retries of one handler.
+type handlerFailure struct {
+	interp    *Interpreter // the frame that dispatched the event
+	handler   *ast.OnEventDecl
+	eventName string
+	source    string
+	event     lang.Value
+	attempts  int
+	first     time.Time
+	last      time.Time
+	err       *lang.RuntimeError
+	timer     scheduler.Timer // the pending retry
+}
+
+type deadLetter struct {
+	info      interfaces.DeadLetter
+	interp    *Interpreter
+	handler   *ast.OnEventDecl
+	replaying bool
+}
+
+// deadLetterStore is owned by the root interpreter and shared by its frames
+// and clones.
+type deadLetterStore struct {
+	root *Interpreter
+	cfg  DeadLetterConfig
+
+	mu        sync.Mutex
+	letters   []*deadLetter // oldest first
+	byID      map[string]*deadLetter
+	nextID    uint64
+	retries   map[uint64]*handlerFailure
+	nextRetry uint64
+	closed    bool // no more retries are scheduled
+}
+
+func newDeadLetterStore(root *Interpreter, cfg DeadLetterConfig) *deadLetterStore {
+	if cfg.Capacity <= 0 {
+		cfg.Capacity = DefaultDeadLetterCapacity
+	}
+	return &deadLetterStore{
+		root:    root,
+		cfg:     cfg,
+		byID:    make(map[string]*deadLetter),
+		retries: make(map[uint64]*handlerFailure),
+	}
+}
+
+func (s *deadLetterStore) clock() scheduler.Clock {
+	if c := s.root.timerClock; c != nil {
+		return c
+	}
+	return scheduler.SystemClock()
+}
+
+// handlerFailed hands a failed handler run to the dead-letter store, which
+// retries it or keeps the event as a dead letter.
+func (i *Interpreter) handlerFailed(h *ast.OnEventDecl, eventName, source string, eventObj lang.Value, rtErr *lang.RuntimeError) {
+	s := i.deadLetters
+	if s == nil {
+		return
+	}
+	now := s.clock().Now()
+	s.failed(&handlerFailure{
+		interp:    i,
+		handler:   h,
+		eventName: eventName,
+		source:    source,
+		event:     eventObj,
+		attempts:  1,
+		first:     now,
+		last:      now,
+		err:       rtErr,
+	})
+}
+
+// handlerRegistered reports whether h still handles the named event.
+func (i *Interpreter) handlerRegistered(eventName string, h *ast.OnEventDecl) bool {
+	for _, registered := range i.eventManager.handlersFor(eventName) {
+		if registered == h {
+			return true
+		}
+	}
+	return false
+}
+
+// failed schedules a retry while the policy allows one, and otherwise
+// records a dead letter.
+func (s *deadLetterStore) failed(f *handlerFailure) {
+	s.mu.Lock()
+	if !s.closed && f.attempts < s.cfg.Retry.MaxAttempts {
+		s.nextRetry++
+		seq := s.nextRetry
+		s.retries[seq] = f
+		f.timer = s.clock().AfterFunc(s.cfg.Retry.delay(f.attempts), func() { s.retry(seq) })
+		s.mu.Unlock()
+		return
+	}
+	s.mu.Unlock()
+	s.bury(f)
+}
+
+// retry runs a failed handler again. Every failure is reported to the host
+// like the first one.
+func (s *deadLetterStore) retry(seq uint64) {
+	s.mu.Lock()
+	f, ok := s.retries[seq]
+	delete(s.retries, seq)
+	s.mu.Unlock()
+	if !ok {
+		return // abandoned by shutdown
+	}
+	if !f.interp.handlerRegistered(f.eventName, f.handler) {
+		s.bury(f)
+		return
+	}
+	f.attempts++
+	rtErr := f.interp.runHandler(f.handler, f.eventName, f.source, f.event)
+	if rtErr == nil {
+		return
+	}
+	f.interp.reportHandlerError(f.eventName, f.source, rtErr)
+	f.err = rtErr
+	f.last = s.clock().Now()
+	s.failed(f)
+}
+
+// bury turns a failure into a dead letter.
+func (s *deadLetterStore) bury(f *handlerFailure) {
+	dl := &deadLetter{
+		info: interfaces.DeadLetter{
+			EventName:   f.eventName,
+			Source:      f.source,
+			Handler:     f.handler.HandlerName,
+			Attempts:    f.attempts,
+			FirstFailed: f.first,
+			LastFailed:  f.last,
+			Event:       f.event,
+		},
+		interp:  f.interp,
+		handler: f.handler,
+	}
+	if pos := f.handler.GetPos(); pos != nil {
+		dl.info.Position = pos.String()
+	}
+	dl.setError(f.err)
+
+	s.mu.Lock()
+	s.nextID++
+	dl.info.ID = fmt.Sprintf("dl-%d", s.nextID)
+	s.letters = append(s.letters, dl)
+	s.byID[dl.info.ID] = dl
+	var dropped interfaces.DeadLetter
+	if len(s.letters) > s.cfg.Capacity {
+		dropped = s.letters[0].info
+		s.removeLocked(dropped.ID)
+	}
+	info := dl.info
+	s.mu.Unlock()
+
+	if dropped.ID != "" {
+		s.root.Logger().Warn("Dead-letter store is full; dropped the oldest dead letter", "id", dropped.ID, "event", dropped.EventName)
+	}
+	s.root.Logger().Warn("Event handler failed; event kept as a dead letter", "id", info.ID, "event", info.EventName, "source", info.Source, "attempts", info.Attempts, "error", info.Error)
+	if s.cfg.OnDeadLetter != nil {
+		s.cfg.OnDeadLetter(info)
+	}
+}
+
+func (dl *deadLetter) setError(rtErr *lang.RuntimeError) {
+	if rtErr == nil {
+		return
+	}
+	dl.info.Error = rtErr.Error()
+	dl.info.ErrorCode = int(rtErr.Code)
+}
+
+func (s *deadLetterStore) removeLocked(id string) bool {
+	if _, ok := s.byID[id]; !ok {
+		return false
+	}
+	delete(s.byID, id)
+	for n, dl := range s.letters {
+		if dl.info.ID == id {
+			s.letters = append(s.letters[:n], s.letters[n+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// List returns the dead letters, oldest first.
+func (s *deadLetterStore) List() []interfaces.DeadLetter {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	infos := make([]interfaces.DeadLetter, len(s.letters))
+	for n, dl := range s.letters {
+		infos[n] = dl.info
+	}
+	return infos
+}
+
+// Get returns one dead letter.
+func (s *deadLetterStore) Get(id string) (interfaces.DeadLetter, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if dl, ok := s.byID[id]; ok {
+		return dl.info, true
+	}
+	return interfaces.DeadLetter{}, false
+}
+
+// Replay runs the failed handler again on the stored event, in a frame of
+// the interpreter that first dispatched it. Its guard is not checked again.
+func (s *deadLetterStore) Replay(id string) error {
+	s.mu.Lock()
+	dl, ok := s.byID[id]
+	if !ok {
+		s.mu.Unlock()
+		return lang.NewRuntimeError(lang.ErrorCodeKeyNotFound, fmt.Sprintf("dead letter '%s' not found", id), lang.ErrDeadLetterNotFound)
+	}
+	if dl.replaying {
+		s.mu.Unlock()
+		return lang.NewRuntimeError(lang.ErrorCodePreconditionFailed, fmt.Sprintf("dead letter '%s' is already being replayed", id), lang.ErrDeadLetterBusy)
+	}
+	dl.replaying = true
+	name, source, event := dl.info.EventName, dl.info.Source, dl.info.Event
+	s.mu.Unlock()
+
+	if !dl.interp.handlerRegistered(name, dl.handler) {
+		s.mu.Lock()
+		dl.replaying = false
+		s.mu.Unlock()
+		return lang.NewRuntimeError(lang.ErrorCodePreconditionFailed, fmt.Sprintf("cannot replay dead letter '%s': its handler for '%s' is no longer registered", id, name), lang.ErrDeadLetterHandlerGone)
+	}
+	rtErr := dl.interp.runHandler(dl.handler, name, source, event)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	dl.replaying = false
+	if rtErr == nil {
+		s.removeLocked(id)
+		return nil
+	}
+	dl.info.Attempts++
+	dl.info.LastFailed = s.clock().Now()
+	dl.setError(rtErr)
+	return rtErr
+}
+
+// Discard removes a dead letter and reports whether it existed.
+func (s *deadLetterStore) Discard(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.removeLocked(id)
+}
+
+// shutdown stops scheduling retries. Events waiting for a retry become dead
+// letters at once; failures from now on are dead-lettered without retry.
+func (s *deadLetterStore) shutdown() {
+	s.mu.Lock()
+	s.closed = true
+	seqs := make([]uint64, 0, len(s.retries))
+	for seq := range s.retries {
+		seqs = append(seqs, seq)
+	}
+	sort.Slice(seqs, func(a, b int) bool { return seqs[a] < seqs[b] })
+	abandoned := make([]*handlerFailure, 0, len(seqs))
+	for _, seq := range seqs {
+		f := s.retries[seq]
+		f.timer.Stop()
+		delete(s.retries, seq)
+		abandoned = append(abandoned, f)
+	}
+	s.mu.Unlock()
+	for _, f := range abandoned {
+		s.bury(f)
+	}
+}
@@ -0,0 +1,211 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Tests handler retries with backoff, dead-lettering after the last attempt, and replaying or discarding dead letters.
+// filename: pkg/interpreter/dead_letters_test.go
+// nlines: 211
+// risk_rating: LOW
+
+package interpreter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aprice2704/neuroscript/pkg/interfaces"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/scheduler"
+)
+
+const deadLetterScript = `
+on event "job" named "worker" as ev do
+	emit "job " + ev.payload[0].Payload.n
+	if healthy == false
+		fail "backend down"
+	endif
+endon
+`
+
+type deadLetterHarness struct {
+	interp   *Interpreter
+	clock    *scheduler.FakeClock
+	emitted  []string
+	reported int
+	buried   []interfaces.DeadLetter
+}
+
+func newDeadLetterInterpreter(t *testing.T, cfg DeadLetterConfig) *deadLetterHarness {
+	t.Helper()
+	h := &deadLetterHarness{clock: scheduler.NewFakeClock(time.Date(2026, 3, 14, 10, 0, 0, 0, time.UTC))}
+	cfg.OnDeadLetter = func(dl interfaces.DeadLetter) { h.buried = append(h.buried, dl) }
+	h.interp = newScriptInterpreter(t, deadLetterScript, WithClock(h.clock), WithDeadLetters(cfg))
+	h.interp.hostContext.EmitFunc = func(v lang.Value) {
+		s, _ := lang.ToString(v)
+		h.emitted = append(h.emitted, s)
+	}
+	h.interp.hostContext.EventHandlerErrorCallback = func(string, string, *lang.RuntimeError) { h.reported++ }
+	h.setHealthy(t, false)
+	return h
+}
+
+func (h *deadLetterHarness) setHealthy(t *testing.T, healthy bool) {
+	t.Helper()
+	if err := h.interp.SetInitialVariable("healthy", healthy); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func (h *deadLetterHarness) emit(n int) {
+	payload, _ := lang.Wrap(map[string]any{"n": n})
+	h.interp.EmitEvent("job", "test", payload)
+}
+
+func TestDeadLetters_RetryWithBackoff(t *testing.T) {
+	h := newDeadLetterInterpreter(t, DeadLetterConfig{
+		Retry: EventRetryPolicy{MaxAttempts: 3, Backoff: time.Second, Multiplier: 4},
+	})
+	h.emit(1)
+	if len(h.emitted) != 1 || h.reported != 1 {
+		t.Fatalf("after the first run: %d emits, %d reports", len(h.emitted), h.reported)
+	}
+
+	h.clock.Advance(999 * time.Millisecond)
+	if len(h.emitted) != 1 {
+		t.Fatalf("retried before the backoff elapsed: %v", h.emitted)
+	}
+	h.clock.Advance(time.Millisecond)
+	if len(h.emitted) != 2 {
+		t.Fatalf("first retry did not run: %v", h.emitted)
+	}
+	h.clock.Advance(3 * time.Second)
+	if len(h.emitted) != 2 {
+		t.Fatalf("second retry ran before the grown backoff: %v", h.emitted)
+	}
+	h.clock.Advance(time.Second)
+	if len(h.emitted) != 3 || h.reported != 3 {
+		t.Fatalf("after the last attempt: %d emits, %d reports", len(h.emitted), h.reported)
+	}
+
+	if len(h.buried) != 1 {
+		t.Fatalf("got %d dead letters, want 1", len(h.buried))
+	}
+	dl := h.buried[0]
+	if dl.EventName != "job" || dl.Source != "test" || dl.Handler != "worker" || dl.Attempts != 3 {
+		t.Errorf("unexpected dead letter %+v", dl)
+	}
+	if dl.ErrorCode != int(lang.ErrorCodeFailStatement) || dl.Position == "" {
+		t.Errorf("dead letter lacks error details: %+v", dl)
+	}
+	if want := h.clock.Now().Add(-5 * time.Second); !dl.FirstFailed.Equal(want) || !dl.LastFailed.Equal(h.clock.Now()) {
+		t.Errorf("failure times %s..%s, want %s..%s", dl.FirstFailed, dl.LastFailed, want, h.clock.Now())
+	}
+	if got, ok := h.interp.DeadLetters().Get(dl.ID); !ok || got.ID != dl.ID {
+		t.Errorf("Get(%q) = %+v, %v", dl.ID, got, ok)
+	}
+}
+
+func TestDeadLetters_RetrySucceeds(t *testing.T) {
+	h := newDeadLetterInterpreter(t, DeadLetterConfig{Retry: EventRetryPolicy{MaxAttempts: 5}})
+	h.emit(1)
+	h.setHealthy(t, true)
+	h.clock.Advance(DefaultEventRetryBackoff)
+	if len(h.emitted) != 2 || len(h.buried) != 0 || h.clock.Pending() != 0 {
+		t.Errorf("got %d emits, %d dead letters, %d pending retries", len(h.emitted), len(h.buried), h.clock.Pending())
+	}
+}
+
+func TestDeadLetters_ReplayAndDiscard(t *testing.T) {
+	h := newDeadLetterInterpreter(t, DeadLetterConfig{})
+	h.emit(1)
+	h.emit(2)
+	store := h.interp.newFrame().DeadLetters()
+	letters := store.List()
+	if len(letters) != 2 || letters[0].Attempts != 1 {
+		t.Fatalf("unexpected dead letters %+v", letters)
+	}
+
+	err := store.Replay(letters[0].ID)
+	var rtErr *lang.RuntimeError
+	if !errors.As(err, &rtErr) || rtErr.Code != lang.ErrorCodeFailStatement {
+		t.Fatalf("Replay of a failing handler: got %v", err)
+	}
+	if got, _ := store.Get(letters[0].ID); got.Attempts != 2 {
+		t.Errorf("attempts after a failed replay = %d, want 2", got.Attempts)
+	}
+
+	h.setHealthy(t, true)
+	if err := store.Replay(letters[0].ID); err != nil {
+		t.Fatalf("Replay: %v", err)
+	}
+	if want := "[job 1 job 2 job 1 job 1]"; fmt.Sprint(h.emitted) != want {
+		t.Errorf("got emits %v, want %s", h.emitted, want)
+	}
+	if _, ok := store.Get(letters[0].ID); ok {
+		t.Error("a replayed dead letter was kept")
+	}
+	if !errors.Is(store.Replay(letters[0].ID), lang.ErrDeadLetterNotFound) {
+		t.Error("replaying a removed dead letter should fail with ErrDeadLetterNotFound")
+	}
+
+	if !store.Discard(letters[1].ID) || store.Discard(letters[1].ID) {
+		t.Error("Discard should report true once, then false")
+	}
+	if n := len(store.List()); n != 0 {
+		t.Errorf("%d dead letters left, want 0", n)
+	}
+}
+
+func TestDeadLetters_ReplayAfterHandlersCleared(t *testing.T) {
+	h := newDeadLetterInterpreter(t, DeadLetterConfig{})
+	h.emit(1)
+	id := h.buried[0].ID
+	h.interp.eventManager.reset()
+	if err := h.interp.DeadLetters().Replay(id); !errors.Is(err, lang.ErrDeadLetterHandlerGone) {
+		t.Fatalf("got %v, want ErrDeadLetterHandlerGone", err)
+	}
+	if _, ok := h.interp.DeadLetters().Get(id); !ok {
+		t.Error("the dead letter should be kept")
+	}
+}
+
+func TestDeadLetters_Capacity(t *testing.T) {
+	h := newDeadLetterInterpreter(t, DeadLetterConfig{Capacity: 2})
+	for n := 1; n <= 3; n++ {
+		h.emit(n)
+	}
+	letters := h.interp.DeadLetters().List()
+	if len(letters) != 2 || letters[0].ID != h.buried[1].ID || letters[1].ID != h.buried[2].ID {
+		t.Errorf("the oldest dead letter should have been dropped, got %+v", letters)
+	}
+}
+
+func TestDeadLetters_ShutdownAbandonsRetries(t *testing.T) {
+	h := newDeadLetterInterpreter(t, DeadLetterConfig{Retry: EventRetryPolicy{MaxAttempts: 3}})
+	h.emit(1)
+	if len(h.buried) != 0 {
+		t.Fatal("dead-lettered before the retries ran")
+	}
+	if err := h.interp.ShutdownEvents(context.Background()); err != nil {
+		t.Fatalf("ShutdownEvents: %v", err)
+	}
+	if len(h.buried) != 1 || h.buried[0].Attempts != 1 {
+		t.Fatalf("pending retry should become a dead letter, got %+v", h.buried)
+	}
+	h.clock.Advance(time.Minute)
+	h.emit(2)
+	if len(h.emitted) != 2 || len(h.buried) != 2 {
+		t.Errorf("after shutdown: %d emits, %d dead letters; want 2 and 2", len(h.emitted), len(h.buried))
+	}
+}
+
+func TestEventRetryPolicy_Delay(t *testing.T) {
+	p := EventRetryPolicy{Backoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	want := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second}
+	for n, w := range want {
+		if got := p.delay(n + 1); got != w {
+			t.Errorf("delay after %d failures = %s, want %s", n+1, got, w)
+		}
+	}
+}
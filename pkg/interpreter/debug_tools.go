@@ -1,5 +1,5 @@
 // NeuroScript Version: 0.8.0
-// File version: 2
+// File version: 3
 // Purpose: Implements debug tools for inspecting interpreter state.
 // filename: pkg/interpreter/debug_tools.go
 // nlines: 45
@@ -19,7 +19,7 @@ func registerDebugTools(r tool.ToolRegistry) error {
 		Spec: tool.ToolSpec{
 			Name:        "dumpClones",
 			Group:       "debug",
-			Description: "Logs the state of all registered interpreter clones to the host's stdout.",
+			Description: "Logs the state of the most recently registered interpreter clones to the host's stdout.",
 			ReturnType:  tool.ArgTypeString,
 		},
 		Func: func(rt tool.Runtime, args []interface{}) (interface{}, error) {
@@ -35,7 +35,7 @@ func registerDebugTools(r tool.ToolRegistry) error {
 			var report strings.Builder
 			report.WriteString("\n\n--- Interpreter Clone Dump ---\n")
 			report.WriteString(fmt.Sprintf("Root: %s (EmitFunc: %t)\n", root.id, root.hostContext.EmitFunc != nil))
-			report.WriteString(fmt.Sprintf("Total Clones Registered: %d\n", len(root.cloneRegistry)))
+			report.WriteString(fmt.Sprintf("Clones Registered: %d (most recent %d kept)\n", len(root.cloneRegistry), maxCloneRegistry))
 
 			for i, clone := range root.cloneRegistry {
 				report.WriteString(fmt.Sprintf(
@@ -0,0 +1,204 @@
+// NeuroScript Version: 0.8.0
+// File version: 2
+// Purpose: Evaluates the 'where' guards of event handlers in Go, before a handler frame is created, and counts their verdicts.
+// filename: pkg/interpreter/event_guards.go
+// nlines: 204
+// risk_rating: MEDIUM
+
+package interpreter
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/eval"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/types"
+)
+
+// EventGuardStats reports how one guarded handler's filter has fared.
+type EventGuardStats struct {
+	Event     string // the name or pattern the handler is registered under
+	Handler   string // the handler's 'named' label, if any
+	Position  string
+	Evaluated uint64
+	Rejected  uint64 // events the guard turned away
+	Errors    uint64 // guards that failed to evaluate; the handler was skipped
+}
+
+type eventGuardCounter struct {
+	event     string
+	decl      *ast.OnEventDecl
+	evaluated atomic.Uint64
+	rejected  atomic.Uint64
+	errors    atomic.Uint64
+}
+
+// guardRuntime evaluates guard expressions without a handler frame. It sees
+// the event under the handler's 'as' name and the root's variables, and it
+// refuses tool and procedure calls so guards stay cheap and side-effect free.
+type guardRuntime struct {
+	root     *Interpreter
+	varName  string
+	eventObj lang.Value
+}
+
+func (g *guardRuntime) GetVariable(name string) (lang.Value, bool) {
+	if g.varName != "" && name == g.varName {
+		return g.eventObj, true
+	}
+	return g.root.GetVariable(name)
+}
+
+func (g *guardRuntime) ExecuteTool(toolName types.FullName, args map[string]lang.Value) (lang.Value, error) {
+	return nil, lang.NewRuntimeError(lang.ErrorCodeEvaluation, fmt.Sprintf("event guard cannot call tool '%s'", toolName), lang.ErrGuardCallNotAllowed)
+}
+
+func (g *guardRuntime) RunProcedure(procName string, args ...lang.Value) (lang.Value, error) {
+	return nil, lang.NewRuntimeError(lang.ErrorCodeEvaluation, fmt.Sprintf("event guard cannot call procedure '%s'", procName), lang.ErrGuardCallNotAllowed)
+}
+
+func (g *guardRuntime) GetToolSpec(toolName types.FullName) (eval.ToolSpec, bool) {
+	return g.root.GetToolSpec(toolName)
+}
+
+// passesGuard decides whether handler h should run for an event. A guard
+// that evaluates to a map is matched against the event payload; any other
+// value is tested for truthiness. A guard that fails to evaluate skips the
+// handler and is reported like a handler error.
+func (i *Interpreter) passesGuard(h *ast.OnEventDecl, eventName, source string, eventObj lang.Value) bool {
+	counter := i.eventManager.guardCounter(h)
+	if counter != nil {
+		counter.evaluated.Add(1)
+	}
+
+	rt := &guardRuntime{root: i.rootInterpreter(), varName: h.EventVarName, eventObj: eventObj}
+	verdict, err := eval.Expression(rt, h.GuardExpr)
+	if err != nil {
+		if counter != nil {
+			counter.errors.Add(1)
+		}
+		rtErr := i.ensureRuntimeError(err, h.GuardExpr.GetPos(), "EVENT_GUARD")
+		if i.hostContext.EventHandlerErrorCallback != nil {
+			i.hostContext.EventHandlerErrorCallback(eventName, source, rtErr)
+		} else {
+			i.Logger().Error("Event guard failed (no callback registered)", "event", eventName, "source", source, "error", rtErr)
+		}
+		return false
+	}
+
+	var pass bool
+	if pattern, ok := unwrapForShapeValidation(verdict).(map[string]interface{}); ok {
+		pass = guardMatchesEvent(pattern, eventObj)
+	} else {
+		pass = lang.IsTruthy(verdict)
+	}
+	if !pass && counter != nil {
+		counter.rejected.Add(1)
+	}
+	return pass
+}
+
+// guardMatchesEvent matches a map guard against the payload of each envelope
+// in a canonical event; one matching envelope is enough.
+func guardMatchesEvent(pattern map[string]interface{}, eventObj lang.Value) bool {
+	event, _ := unwrapForShapeValidation(eventObj).(map[string]interface{})
+	envelopes, _ := event["payload"].([]interface{})
+	for _, env := range envelopes {
+		if m, ok := env.(map[string]interface{}); ok && matchGuardValue(pattern, m["Payload"]) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchGuardValue is a shape-lite match. Every key of a map pattern must be
+// present and match; a list pattern lists alternatives; a list value matches
+// when any of its elements does; anything else must be equal.
+func matchGuardValue(pattern, value interface{}) bool {
+	switch p := pattern.(type) {
+	case map[string]interface{}:
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, want := range p {
+			got, ok := m[key]
+			if !ok || !matchGuardValue(want, got) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		for _, alt := range p {
+			if matchGuardValue(alt, value) {
+				return true
+			}
+		}
+		return false
+	}
+	if list, ok := value.([]interface{}); ok {
+		for _, elem := range list {
+			if matchGuardValue(pattern, elem) {
+				return true
+			}
+		}
+		return false
+	}
+	if pi, pf, pIsInt, ok := guardNumber(pattern); ok {
+		vi, vf, vIsInt, ok := guardNumber(value)
+		if !ok {
+			return false
+		}
+		// Integers are compared exactly, never rounded to float64.
+		switch {
+		case pIsInt && vIsInt:
+			return pi == vi
+		case pIsInt:
+			c, ordered := lang.CompareIntFloat(pi, vf)
+			return ordered && c == 0
+		case vIsInt:
+			c, ordered := lang.CompareIntFloat(vi, pf)
+			return ordered && c == 0
+		}
+		return pf == vf
+	}
+	return pattern == value
+}
+
+// guardNumber returns a numeric guard operand as an int64 or a float64.
+func guardNumber(v interface{}) (i int64, f float64, isInt, ok bool) {
+	switch n := v.(type) {
+	case int64:
+		return n, 0, true, true
+	case int:
+		return int64(n), 0, true, true
+	case float64:
+		return 0, n, false, true
+	}
+	return 0, 0, false, false
+}
+
+// EventGuardStats reports the counters of every guarded handler, in the
+// order the handlers were registered.
+func (i *Interpreter) EventGuardStats() []EventGuardStats {
+	em := i.eventManager
+	em.eventHandlersMu.RLock()
+	defer em.eventHandlersMu.RUnlock()
+	stats := make([]EventGuardStats, 0, len(em.guardOrder))
+	for _, c := range em.guardOrder {
+		s := EventGuardStats{
+			Event:     c.event,
+			Handler:   c.decl.HandlerName,
+			Evaluated: c.evaluated.Load(),
+			Rejected:  c.rejected.Load(),
+			Errors:    c.errors.Load(),
+		}
+		if pos := c.decl.GetPos(); pos != nil {
+			s.Position = pos.String()
+		}
+		stats = append(stats, s)
+	}
+	return stats
+}
@@ -0,0 +1,166 @@
+// NeuroScript Version: 0.8.0
+// File version: 2
+// Purpose: Tests 'where' guards and '*' patterns on event handlers, and the guard counters.
+// filename: pkg/interpreter/event_guards_test.go
+// nlines: 166
+// risk_rating: LOW
+
+package interpreter
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/policy"
+)
+
+const guardScript = `
+on event "work" where {"project": "p1", "queue": ["workq1", "allcome"]} named "p1-work" as ev do
+	emit "work " + ev.payload[0].Payload.id
+endon
+
+on event "jobs" where ev.payload[0].Payload.priority > 5 as ev do
+	emit "urgent " + ev.payload[0].Payload.id
+endon
+
+on event "fdm.node.*" as ev do
+	emit "node " + ev.payload[0].Kind
+endon
+
+on event "fdm.node.created" do
+	emit "exact created"
+endon
+
+on event "audit" where check(ev) as ev do
+	emit "never"
+endon
+
+func check(needs ev returns ok) means
+	return true
+endfunc
+
+func raise_work() means
+	raise event "work" with {"id": "R1", "project": "p1", "queue": "allcome"}
+	raise event "work" with {"id": "R2", "project": "p2", "queue": "allcome"}
+endfunc
+`
+
+func newGuardInterpreter(t *testing.T) (*Interpreter, *[]string, *[]error) {
+	t.Helper()
+	var emitted []string
+	var handlerErrs []error
+	pol := policy.NewBuilder(policy.ContextNormal).Allow("*").Grant("bus:write:*").Build()
+	interp := newScriptInterpreter(t, guardScript, WithExecPolicy(pol))
+	interp.hostContext.EmitFunc = func(v lang.Value) {
+		s, _ := lang.ToString(v)
+		emitted = append(emitted, s)
+	}
+	interp.hostContext.EventHandlerErrorCallback = func(eventName, source string, err *lang.RuntimeError) {
+		handlerErrs = append(handlerErrs, err)
+	}
+	return interp, &emitted, &handlerErrs
+}
+
+func emitMap(t *testing.T, interp *Interpreter, name string, payload map[string]any) {
+	t.Helper()
+	v, err := lang.Wrap(payload)
+	if err != nil {
+		t.Fatalf("wrap payload: %v", err)
+	}
+	interp.EmitEvent(name, "test", v)
+}
+
+func TestEventGuard_MapPattern(t *testing.T) {
+	interp, emitted, _ := newGuardInterpreter(t)
+	emitMap(t, interp, "work", map[string]any{"id": "A", "project": "p1", "queue": "workq1"})
+	emitMap(t, interp, "work", map[string]any{"id": "B", "project": "p2", "queue": "workq1"})
+	emitMap(t, interp, "work", map[string]any{"id": "C", "project": "p1", "queue": "other"})
+	emitMap(t, interp, "work", map[string]any{"id": "D", "project": "p1", "queue": []any{"other", "allcome"}})
+	emitMap(t, interp, "work", map[string]any{"id": "E"})
+
+	if got := fmt.Sprint(*emitted); got != "[work A work D]" {
+		t.Errorf("got emits %s, want [work A work D]", got)
+	}
+	stats := interp.EventGuardStats()
+	if len(stats) != 3 {
+		t.Fatalf("expected counters for 3 guarded handlers, got %+v", stats)
+	}
+	if s := stats[0]; s.Event != "work" || s.Handler != "p1-work" || s.Evaluated != 5 || s.Rejected != 3 || s.Errors != 0 {
+		t.Errorf("unexpected counters for the work guard: %+v", s)
+	}
+}
+
+func TestEventGuard_Expression(t *testing.T) {
+	interp, emitted, _ := newGuardInterpreter(t)
+	emitMap(t, interp, "jobs", map[string]any{"id": "low", "priority": 2})
+	emitMap(t, interp, "jobs", map[string]any{"id": "high", "priority": 9})
+
+	if got := fmt.Sprint(*emitted); got != "[urgent high]" {
+		t.Errorf("got emits %s, want [urgent high]", got)
+	}
+	if s := interp.EventGuardStats()[1]; s.Evaluated != 2 || s.Rejected != 1 {
+		t.Errorf("unexpected counters for the jobs guard: %+v", s)
+	}
+}
+
+func TestEventGuard_CallsAreRefused(t *testing.T) {
+	interp, emitted, handlerErrs := newGuardInterpreter(t)
+	emitMap(t, interp, "audit", map[string]any{"id": "X"})
+
+	if len(*emitted) != 0 {
+		t.Errorf("expected the handler to be skipped, got emits %q", *emitted)
+	}
+	if len(*handlerErrs) != 1 || !errors.Is((*handlerErrs)[0], lang.ErrGuardCallNotAllowed) {
+		t.Fatalf("expected one ErrGuardCallNotAllowed, got %v", *handlerErrs)
+	}
+	if s := interp.EventGuardStats()[2]; s.Evaluated != 1 || s.Errors != 1 || s.Rejected != 0 {
+		t.Errorf("unexpected counters for the audit guard: %+v", s)
+	}
+}
+
+func TestEventGuard_RaisedEvents(t *testing.T) {
+	interp, emitted, _ := newGuardInterpreter(t)
+	if _, err := interp.RunProcedure("raise_work"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := fmt.Sprint(*emitted); got != "[work R1]" {
+		t.Errorf("got emits %s, want [work R1]", got)
+	}
+}
+
+func TestMatchGuardValue_NumbersCompareExactly(t *testing.T) {
+	const big = int64(1 << 53)
+	cases := []struct {
+		pattern, value interface{}
+		want           bool
+	}{
+		{big + 1, big + 1, true},
+		{big + 1, big, false},
+		{big + 1, float64(big), false},
+		{float64(big), big, true},
+		{int64(3), float64(3), true},
+		{int(3), int64(3), true},
+		{int64(3), 3.5, false},
+		{int64(3), "3", false},
+	}
+	for _, c := range cases {
+		if got := matchGuardValue(c.pattern, c.value); got != c.want {
+			t.Errorf("matchGuardValue(%#v, %#v) = %v, want %v", c.pattern, c.value, got, c.want)
+		}
+	}
+}
+
+func TestEventPattern(t *testing.T) {
+	interp, emitted, _ := newGuardInterpreter(t)
+	emitMap(t, interp, "fdm.node.created", map[string]any{})
+	emitMap(t, interp, "fdm.node.deleted", map[string]any{})
+	emitMap(t, interp, "fdm.edge.created", map[string]any{})
+	emitMap(t, interp, "fdm.node.*", map[string]any{})
+
+	want := "[exact created node fdm.node.created node fdm.node.deleted node fdm.node.*]"
+	if got := fmt.Sprint(*emitted); got != want {
+		t.Errorf("got emits %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,368 @@
+// NeuroScript Version: 0.8.0
+// File version: 2
+// Purpose: Optional asynchronous event bus: a bounded queue of emitted events dispatched by a worker pool.
+// filename: pkg/interpreter/event_queue.go
+// nlines: 368
+// risk_rating: HIGH
+
+package interpreter
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+)
+
+// EventOrdering says which queued events must be handled one at a time, in
+// the order they were emitted.
+type EventOrdering int
+
+const (
+	// EventOrderPerName handles events with the same name in FIFO order.
+	EventOrderPerName EventOrdering = iota
+	// EventOrderPerKey handles events with the same EventQueueConfig.KeyFunc
+	// key in FIFO order, whatever their names.
+	EventOrderPerKey
+	// EventOrderNone makes no ordering promise; any idle worker takes any event.
+	EventOrderNone
+)
+
+// EventOverflow says what EmitEvent does when the queue is full.
+type EventOverflow int
+
+const (
+	// EventOverflowBlock makes EmitEvent wait for room (back-pressure).
+	EventOverflowBlock EventOverflow = iota
+	// EventOverflowDropNewest discards the event being emitted.
+	EventOverflowDropNewest
+	// EventOverflowDropOldest discards the longest-waiting queued event.
+	EventOverflowDropOldest
+)
+
+// Defaults applied to a zero EventQueueConfig.
+const (
+	DefaultEventQueueCapacity = 1024
+	DefaultEventQueueWorkers  = 4
+)
+
+// EventQueueConfig configures the asynchronous event bus enabled by
+// WithEventQueue. The zero value is usable.
+type EventQueueConfig struct {
+	// Capacity bounds the number of events waiting to be handled.
+	Capacity int
+	// Workers is the number of goroutines running handlers.
+	Workers int
+	// MaxConcurrentPerEvent caps how many events of one name are handled at
+	// the same time; 0 means only Workers limits it.
+	MaxConcurrentPerEvent int
+	// EventConcurrency overrides MaxConcurrentPerEvent for individual event names.
+	EventConcurrency map[string]int
+	Ordering         EventOrdering
+	// KeyFunc returns the ordering key for EventOrderPerKey. An empty key
+	// leaves the event unordered; a nil KeyFunc orders by event name.
+	KeyFunc  func(eventName string, payload lang.Value) string
+	Overflow EventOverflow
+	// OnDrop, if set, is told about every event the queue discards.
+	OnDrop func(eventName, source string)
+}
+
+// EventQueueStats is a snapshot of the event bus counters.
+type EventQueueStats struct {
+	Pending    int
+	Running    int
+	Dispatched uint64
+	Dropped    uint64
+}
+
+type queuedEvent struct {
+	name     string
+	source   string
+	payload  lang.Value
+	handlers []*ast.OnEventDecl
+	key      string // ordering key; empty when unordered
+}
+
+// eventQueue is owned by the root interpreter. Every field below mu is
+// guarded by it.
+type eventQueue struct {
+	cfg    EventQueueConfig
+	interp *Interpreter
+	wg     sync.WaitGroup
+
+	mu         sync.Mutex
+	work       *sync.Cond // an event may have become runnable
+	space      *sync.Cond // the queue may have room
+	pending    []*queuedEvent
+	activeKeys map[string]bool
+	activeByEv map[string]int
+	running    int
+	dispatched uint64
+	dropped    uint64
+	closed     bool          // no new events are accepted
+	stopped    bool          // workers exit once nothing is pending
+	idle       chan struct{} // closed whenever nothing is pending or running
+}
+
+func newEventQueue(interp *Interpreter, cfg EventQueueConfig) *eventQueue {
+	if cfg.Capacity <= 0 {
+		cfg.Capacity = DefaultEventQueueCapacity
+	}
+	if cfg.Workers <= 0 {
+		cfg.Workers = DefaultEventQueueWorkers
+	}
+	q := &eventQueue{
+		cfg:        cfg,
+		interp:     interp,
+		activeKeys: make(map[string]bool),
+		activeByEv: make(map[string]int),
+		idle:       make(chan struct{}),
+	}
+	close(q.idle)
+	q.work = sync.NewCond(&q.mu)
+	q.space = sync.NewCond(&q.mu)
+	q.wg.Add(cfg.Workers)
+	for n := 0; n < cfg.Workers; n++ {
+		go q.worker()
+	}
+	return q
+}
+
+// orderKey returns the key that serialises ev, or "" if ev is unordered.
+func (q *eventQueue) orderKey(name string, payload lang.Value) string {
+	switch q.cfg.Ordering {
+	case EventOrderNone:
+		return ""
+	case EventOrderPerKey:
+		if q.cfg.KeyFunc != nil {
+			if key := q.cfg.KeyFunc(name, payload); key != "" {
+				return "key:" + key
+			}
+			return ""
+		}
+	}
+	return "name:" + name
+}
+
+// enqueue adds an event, applying the overflow policy when the queue is full.
+func (q *eventQueue) enqueue(name, source string, payload lang.Value, handlers []*ast.OnEventDecl) {
+	ev := &queuedEvent{name: name, source: source, payload: payload, handlers: handlers}
+	ev.key = q.orderKey(name, payload)
+
+	q.mu.Lock()
+	for !q.closed && len(q.pending) >= q.cfg.Capacity && q.cfg.Overflow == EventOverflowBlock {
+		q.space.Wait()
+	}
+	if q.closed {
+		q.mu.Unlock()
+		q.drop(ev, "event queue is shut down")
+		return
+	}
+	var evicted *queuedEvent
+	if len(q.pending) >= q.cfg.Capacity {
+		if q.cfg.Overflow == EventOverflowDropNewest {
+			q.mu.Unlock()
+			q.drop(ev, "event queue is full")
+			return
+		}
+		evicted = q.pending[0]
+		q.pending = q.pending[1:]
+	}
+	if len(q.pending) == 0 && q.running == 0 {
+		q.idle = make(chan struct{})
+	}
+	q.pending = append(q.pending, ev)
+	q.work.Signal()
+	q.mu.Unlock()
+
+	if evicted != nil {
+		q.drop(evicted, "event queue is full")
+	}
+}
+
+// drop counts and reports a discarded event. It must be called without mu held.
+func (q *eventQueue) drop(ev *queuedEvent, reason string) {
+	q.mu.Lock()
+	q.dropped++
+	q.mu.Unlock()
+	q.interp.Logger().Warn("Event dropped", "event_name", ev.name, "source", ev.source, "reason", reason)
+	if q.cfg.OnDrop != nil {
+		q.cfg.OnDrop(ev.name, ev.source)
+	}
+}
+
+// next removes and returns the oldest runnable event, or nil. An event is
+// runnable when its ordering key is free, its name is under its concurrency
+// limit, and no older event with the same key is still waiting.
+func (q *eventQueue) next() *queuedEvent {
+	var blocked map[string]bool
+	for idx, ev := range q.pending {
+		if ev.key != "" && (q.activeKeys[ev.key] || blocked[ev.key]) {
+			continue
+		}
+		if limit := q.limitFor(ev.name); limit > 0 && q.activeByEv[ev.name] >= limit {
+			if ev.key != "" {
+				if blocked == nil {
+					blocked = make(map[string]bool)
+				}
+				blocked[ev.key] = true
+			}
+			continue
+		}
+		q.pending = append(q.pending[:idx], q.pending[idx+1:]...)
+		if ev.key != "" {
+			q.activeKeys[ev.key] = true
+		}
+		q.activeByEv[ev.name]++
+		q.running++
+		q.space.Signal()
+		return ev
+	}
+	return nil
+}
+
+func (q *eventQueue) limitFor(name string) int {
+	if limit, ok := q.cfg.EventConcurrency[name]; ok {
+		return limit
+	}
+	return q.cfg.MaxConcurrentPerEvent
+}
+
+func (q *eventQueue) worker() {
+	defer q.wg.Done()
+	for {
+		q.mu.Lock()
+		ev := q.next()
+		for ev == nil {
+			if q.stopped && len(q.pending) == 0 {
+				q.mu.Unlock()
+				return
+			}
+			q.work.Wait()
+			ev = q.next()
+		}
+		q.mu.Unlock()
+
+		q.interp.dispatchEvent(ev.name, ev.source, ev.payload, ev.handlers)
+
+		q.mu.Lock()
+		if ev.key != "" {
+			delete(q.activeKeys, ev.key)
+		}
+		if q.activeByEv[ev.name]--; q.activeByEv[ev.name] == 0 {
+			delete(q.activeByEv, ev.name)
+		}
+		q.running--
+		q.dispatched++
+		q.markIdleLocked()
+		// Finishing may free a key or a name limit that another event waits on.
+		q.work.Broadcast()
+		q.mu.Unlock()
+	}
+}
+
+func (q *eventQueue) markIdleLocked() {
+	if len(q.pending) == 0 && q.running == 0 {
+		select {
+		case <-q.idle:
+		default:
+			close(q.idle)
+		}
+	}
+}
+
+// drain waits until nothing is pending or running.
+func (q *eventQueue) drain(ctx context.Context) error {
+	q.mu.Lock()
+	idle := q.idle
+	q.mu.Unlock()
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// shutdown stops accepting events, waits for the queue to drain and stops
+// the workers. If ctx ends first, events still waiting are dropped and
+// handlers already running are left to finish on their own.
+func (q *eventQueue) shutdown(ctx context.Context) error {
+	q.mu.Lock()
+	q.closed = true
+	q.space.Broadcast() // release emitters blocked on a full queue
+	q.mu.Unlock()
+
+	err := q.drain(ctx)
+
+	q.mu.Lock()
+	discarded := q.pending
+	q.pending = nil
+	q.stopped = true
+	q.markIdleLocked()
+	q.work.Broadcast()
+	q.mu.Unlock()
+	for _, ev := range discarded {
+		q.drop(ev, "event queue shut down before the event was handled")
+	}
+	if err == nil {
+		q.wg.Wait()
+	}
+	return err
+}
+
+func (q *eventQueue) stats() EventQueueStats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return EventQueueStats{
+		Pending:    len(q.pending),
+		Running:    q.running,
+		Dispatched: q.dispatched,
+		Dropped:    q.dropped,
+	}
+}
+
+// WithEventQueue makes EmitEvent enqueue events for a pool of worker
+// goroutines instead of running the handlers on the caller's goroutine.
+// 'raise event' inside scripts stays synchronous. Stop the workers with
+// ShutdownEvents.
+func WithEventQueue(cfg EventQueueConfig) InterpreterOption {
+	return func(i *Interpreter) {
+		i.eventQueue = newEventQueue(i, cfg)
+	}
+}
+
+// DrainEvents waits until every queued event has been handled. It returns
+// at once when no event queue is configured.
+func (i *Interpreter) DrainEvents(ctx context.Context) error {
+	if q := i.rootInterpreter().eventQueue; q != nil {
+		return q.drain(ctx)
+	}
+	return nil
+}
+
+// ShutdownEvents stops the event queue from accepting events, drains it and
+// stops its workers. Events emitted afterwards are dropped. It returns
+// ctx.Err() if ctx ends before the queue has drained. Handler retries stop
+// too: events waiting for one become dead letters at once, as does every
+// later handler failure.
+func (i *Interpreter) ShutdownEvents(ctx context.Context) error {
+	root := i.rootInterpreter()
+	if root.deadLetters != nil {
+		root.deadLetters.shutdown()
+	}
+	if q := root.eventQueue; q != nil {
+		return q.shutdown(ctx)
+	}
+	return nil
+}
+
+// EventQueueStats reports the event queue counters; ok is false when no
+// event queue is configured.
+func (i *Interpreter) EventQueueStats() (stats EventQueueStats, ok bool) {
+	if q := i.rootInterpreter().eventQueue; q != nil {
+		return q.stats(), true
+	}
+	return EventQueueStats{}, false
+}
@@ -0,0 +1,296 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Tests the asynchronous event queue: non-blocking emits, ordering, concurrency limits, overflow policies and shutdown.
+// filename: pkg/interpreter/event_queue_test.go
+// nlines: 296
+// risk_rating: LOW
+
+package interpreter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aprice2704/neuroscript/pkg/lang"
+)
+
+const queueScript = `
+on event "job" as ev do
+	emit "job " + ev.payload[0].Payload.n
+endon
+
+on event "audit" as ev do
+	emit "audit " + ev.payload[0].Payload.n
+endon
+`
+
+// queueRecorder collects emits from handlers running on worker goroutines.
+// While gate is non-nil every emit waits for it to close.
+type queueRecorder struct {
+	mu          sync.Mutex
+	emitted     []string
+	running     int
+	maxRunning  int
+	gate        chan struct{}
+	dropped     []string
+	interpreter *Interpreter
+}
+
+func newQueueInterpreter(t *testing.T, cfg EventQueueConfig, gated bool) *queueRecorder {
+	t.Helper()
+	rec := &queueRecorder{}
+	if gated {
+		rec.gate = make(chan struct{})
+	}
+	cfg.OnDrop = func(eventName, source string) {
+		rec.mu.Lock()
+		defer rec.mu.Unlock()
+		rec.dropped = append(rec.dropped, source)
+	}
+	interp := newScriptInterpreter(t, queueScript, WithEventQueue(cfg))
+	interp.hostContext.EmitFunc = func(v lang.Value) {
+		rec.mu.Lock()
+		rec.running++
+		if rec.running > rec.maxRunning {
+			rec.maxRunning = rec.running
+		}
+		gate := rec.gate
+		rec.mu.Unlock()
+		if gate != nil {
+			<-gate
+		}
+		s, _ := lang.ToString(v)
+		rec.mu.Lock()
+		rec.running--
+		rec.emitted = append(rec.emitted, s)
+		rec.mu.Unlock()
+	}
+	t.Cleanup(func() {
+		rec.release()
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := interp.ShutdownEvents(ctx); err != nil {
+			t.Errorf("ShutdownEvents: %v", err)
+		}
+	})
+	rec.interpreter = interp
+	return rec
+}
+
+func (r *queueRecorder) emit(name string, n int) {
+	payload, _ := lang.Wrap(map[string]any{"n": n})
+	r.interpreter.EmitEvent(name, fmt.Sprintf("src-%d", n), payload)
+}
+
+func (r *queueRecorder) release() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.gate != nil {
+		close(r.gate)
+		r.gate = nil
+	}
+}
+
+func (r *queueRecorder) drain(t *testing.T) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := r.interpreter.DrainEvents(ctx); err != nil {
+		t.Fatalf("DrainEvents: %v", err)
+	}
+}
+
+// waitFor polls until cond holds, failing the test after a few seconds.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func (r *queueRecorder) snapshot() (emitted, dropped []string, running, maxRunning int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.emitted...), append([]string(nil), r.dropped...), r.running, r.maxRunning
+}
+
+func TestEventQueue_EmitDoesNotWaitForHandlers(t *testing.T) {
+	rec := newQueueInterpreter(t, EventQueueConfig{}, true)
+	rec.emit("job", 1) // would hang here if the handler ran synchronously
+	waitFor(t, "the handler to start", func() bool { _, _, running, _ := rec.snapshot(); return running == 1 })
+	rec.release()
+	rec.drain(t)
+	if emitted, _, _, _ := rec.snapshot(); len(emitted) != 1 || emitted[0] != "job 1" {
+		t.Errorf("got emits %q, want [job 1]", emitted)
+	}
+	if stats, ok := rec.interpreter.EventQueueStats(); !ok || stats.Dispatched != 1 || stats.Pending != 0 {
+		t.Errorf("got stats %+v (ok=%v), want one dispatched and none pending", stats, ok)
+	}
+}
+
+func TestEventQueue_FIFOPerName(t *testing.T) {
+	rec := newQueueInterpreter(t, EventQueueConfig{Workers: 8}, false)
+	for n := 0; n < 50; n++ {
+		rec.emit("job", n)
+	}
+	rec.drain(t)
+	emitted, _, _, maxRunning := rec.snapshot()
+	for n, got := range emitted {
+		if want := fmt.Sprintf("job %d", n); got != want {
+			t.Fatalf("emit %d: got %q, want %q", n, got, want)
+		}
+	}
+	if len(emitted) != 50 || maxRunning != 1 {
+		t.Errorf("got %d emits with at most %d running, want 50 with 1", len(emitted), maxRunning)
+	}
+}
+
+func TestEventQueue_FIFOPerKeyAcrossNames(t *testing.T) {
+	cfg := EventQueueConfig{
+		Workers:  8,
+		Ordering: EventOrderPerKey,
+		KeyFunc:  func(string, lang.Value) string { return "order-1" },
+	}
+	rec := newQueueInterpreter(t, cfg, false)
+	var want []string
+	for n := 0; n < 20; n++ {
+		name := []string{"job", "audit"}[n%2]
+		rec.emit(name, n)
+		want = append(want, fmt.Sprintf("%s %d", name, n))
+	}
+	rec.drain(t)
+	emitted, _, _, _ := rec.snapshot()
+	if fmt.Sprint(emitted) != fmt.Sprint(want) {
+		t.Errorf("got emits %q, want %q", emitted, want)
+	}
+}
+
+func TestEventQueue_ConcurrencyLimit(t *testing.T) {
+	cfg := EventQueueConfig{
+		Workers:               6,
+		Ordering:              EventOrderNone,
+		MaxConcurrentPerEvent: 3,
+		EventConcurrency:      map[string]int{"audit": 1},
+	}
+	rec := newQueueInterpreter(t, cfg, true)
+	for n := 0; n < 6; n++ {
+		rec.emit("job", n)
+	}
+	waitFor(t, "three job handlers", func() bool { _, _, running, _ := rec.snapshot(); return running == 3 })
+	rec.emit("audit", 100)
+	rec.emit("audit", 101)
+	waitFor(t, "one audit handler", func() bool { _, _, running, _ := rec.snapshot(); return running == 4 })
+	time.Sleep(20 * time.Millisecond) // give an over-eager worker the chance to misbehave
+	if _, _, running, _ := rec.snapshot(); running != 4 {
+		t.Fatalf("got %d handlers running, want 4 (3 job + 1 audit)", running)
+	}
+	rec.release()
+	rec.drain(t)
+	if emitted, _, _, _ := rec.snapshot(); len(emitted) != 8 {
+		t.Errorf("got %d emits, want 8", len(emitted))
+	}
+}
+
+func TestEventQueue_Overflow(t *testing.T) {
+	t.Run("drop newest", func(t *testing.T) {
+		rec := newQueueInterpreter(t, EventQueueConfig{Workers: 1, Capacity: 1, Overflow: EventOverflowDropNewest}, true)
+		rec.emit("job", 1)
+		waitFor(t, "the first handler", func() bool { _, _, running, _ := rec.snapshot(); return running == 1 })
+		rec.emit("job", 2)
+		rec.emit("job", 3)
+		rec.release()
+		rec.drain(t)
+		emitted, dropped, _, _ := rec.snapshot()
+		if fmt.Sprint(emitted) != "[job 1 job 2]" || fmt.Sprint(dropped) != "[src-3]" {
+			t.Errorf("got emits %q and drops %q, want [job 1 job 2] and [src-3]", emitted, dropped)
+		}
+	})
+
+	t.Run("drop oldest", func(t *testing.T) {
+		rec := newQueueInterpreter(t, EventQueueConfig{Workers: 1, Capacity: 1, Overflow: EventOverflowDropOldest}, true)
+		rec.emit("job", 1)
+		waitFor(t, "the first handler", func() bool { _, _, running, _ := rec.snapshot(); return running == 1 })
+		rec.emit("job", 2)
+		rec.emit("job", 3)
+		rec.release()
+		rec.drain(t)
+		emitted, dropped, _, _ := rec.snapshot()
+		if fmt.Sprint(emitted) != "[job 1 job 3]" || fmt.Sprint(dropped) != "[src-2]" {
+			t.Errorf("got emits %q and drops %q, want [job 1 job 3] and [src-2]", emitted, dropped)
+		}
+	})
+
+	t.Run("block", func(t *testing.T) {
+		rec := newQueueInterpreter(t, EventQueueConfig{Workers: 1, Capacity: 1}, true)
+		rec.emit("job", 1)
+		waitFor(t, "the first handler", func() bool { _, _, running, _ := rec.snapshot(); return running == 1 })
+		rec.emit("job", 2)
+		done := make(chan struct{})
+		go func() {
+			rec.emit("job", 3)
+			close(done)
+		}()
+		select {
+		case <-done:
+			t.Fatal("EmitEvent returned while the queue was full")
+		case <-time.After(20 * time.Millisecond):
+		}
+		rec.release()
+		<-done
+		rec.drain(t)
+		emitted, dropped, _, _ := rec.snapshot()
+		if len(emitted) != 3 || len(dropped) != 0 {
+			t.Errorf("got emits %q and drops %q, want all three handled", emitted, dropped)
+		}
+	})
+}
+
+func TestEventQueue_Shutdown(t *testing.T) {
+	t.Run("drains, then drops later events", func(t *testing.T) {
+		rec := newQueueInterpreter(t, EventQueueConfig{}, false)
+		for n := 0; n < 5; n++ {
+			rec.emit("job", n)
+		}
+		if err := rec.interpreter.ShutdownEvents(context.Background()); err != nil {
+			t.Fatalf("ShutdownEvents: %v", err)
+		}
+		rec.emit("job", 99)
+		emitted, dropped, _, _ := rec.snapshot()
+		if len(emitted) != 5 || fmt.Sprint(dropped) != "[src-99]" {
+			t.Errorf("got emits %q and drops %q, want 5 emits and [src-99]", emitted, dropped)
+		}
+	})
+
+	t.Run("gives up when the context ends", func(t *testing.T) {
+		rec := newQueueInterpreter(t, EventQueueConfig{Workers: 1}, true)
+		rec.emit("job", 1)
+		waitFor(t, "the first handler", func() bool { _, _, running, _ := rec.snapshot(); return running == 1 })
+		rec.emit("job", 2)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		if err := rec.interpreter.ShutdownEvents(ctx); !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("got %v, want context.DeadlineExceeded", err)
+		}
+		if _, dropped, _, _ := rec.snapshot(); fmt.Sprint(dropped) != "[src-2]" {
+			t.Errorf("got drops %q, want the waiting event [src-2]", dropped)
+		}
+	})
+}
+
+func TestEventQueue_NotConfigured(t *testing.T) {
+	interp := newScriptInterpreter(t, queueScript)
+	if err := interp.DrainEvents(context.Background()); err != nil {
+		t.Errorf("DrainEvents: %v", err)
+	}
+	if _, ok := interp.EventQueueStats(); ok {
+		t.Error("EventQueueStats reported a queue that was never configured")
+	}
+}
@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 48
+// :: fileVersion: 55
 // :: description: Updated event handler execution to push context to stackFrames for proper trace inheritance.
-// :: latestChange: Pushed contextName to stackFrames.
+// :: latestChange: A handler's frame is strict when its file is.
 // :: filename: pkg/interpreter/events.go
 // :: serialization: go
 
@@ -37,6 +37,8 @@ func unwrapForShapeValidation(v lang.Value) interface{} { //
 		return nil
 	}
 	switch tv := v.(type) { //
+	case lang.IntValue:
+		return tv.Value
 	case lang.NumberValue: //
 		if tv.Value == math.Trunc(tv.Value) { //
 			return int64(tv.Value) //
@@ -60,9 +62,7 @@ func unwrapForShapeValidation(v lang.Value) interface{} { //
 }
 
 func (i *Interpreter) EmitEvent(eventName string, source string, payload lang.Value) { //
-	i.eventManager.eventHandlersMu.RLock()              //
-	handlers := i.eventManager.eventHandlers[eventName] //
-	i.eventManager.eventHandlersMu.RUnlock()            //
+	handlers := i.eventManager.handlersFor(eventName) //
 
 	if len(handlers) == 0 { //
 		i.Logger().Warn("Event emitted but no handlers were registered for it", "event_name", eventName, "source", source) //
@@ -78,6 +78,18 @@ func (i *Interpreter) EmitEvent(eventName string, source string, payload lang.Va
 		))
 	}
 
+	if q := i.rootInterpreter().eventQueue; q != nil {
+		q.enqueue(eventName, source, payload, handlers)
+		return
+	}
+	i.dispatchEvent(eventName, source, payload, handlers)
+}
+
+// dispatchEvent runs each handler whose guard admits the event in its own
+// frame, one event deeper than the emitting frame. Handler errors go to the
+// host's EventHandlerErrorCallback, and the failed handler is retried or its
+// event kept as a dead letter.
+func (i *Interpreter) dispatchEvent(eventName string, source string, payload lang.Value, handlers []*ast.OnEventDecl) {
 	eventObj, err := i.composeCanonicalEvent(eventName, source, payload) //
 	if err != nil {                                                      //
 		i.Logger().Error("Failed to prepare canonical event", "event", eventName, "error", err) //
@@ -85,53 +97,67 @@ func (i *Interpreter) EmitEvent(eventName string, source string, payload lang.Va
 	}
 
 	for _, handler := range handlers { //
-		// --- THE FIX: Wrap execution in defer/recover ---
-		func(h *ast.OnEventDecl) { // Create closure to capture 'h'
-			var execErr error
-			handlerInterpreter := i.fork() // Fork a clean interpreter for the handler //
-
-			// Set meaningful context for stack traces
-			contextName := fmt.Sprintf("Event: %s", eventName)
-			if pos := h.GetPos(); pos != nil {
-				contextName = fmt.Sprintf("%s (defined at %s)", contextName, pos.String())
-			}
-			handlerInterpreter.state.currentProcName = contextName
-			// Push context to stackFrames so called procedures inherit it in their trace
-			handlerInterpreter.state.stackFrames = append(handlerInterpreter.state.stackFrames, contextName)
-
-			defer func() {
-				if r := recover(); r != nil {
-					// Convert panic to a RuntimeError
-					panicMsg := fmt.Sprintf("panic executing event handler for '%s': %v", eventName, r)
-					execErr = lang.NewRuntimeError(lang.ErrorCodeInternal, panicMsg, fmt.Errorf("panic: %v", r)).WithPosition(h.GetPos())
-					i.Logger().Error("Panic recovered in event handler", "event", eventName, "source", source, "panic_value", r, "error", execErr)
-				}
-
-				// Report error (either from panic or normal execution) via callback
-				if execErr != nil {
-					// THE FIX: Use handlerInterpreter to capture the correct stack trace for this execution
-					rtErr := handlerInterpreter.ensureRuntimeError(execErr, h.GetPos(), "ON_EVENT_HANDLER") //
-					if i.hostContext.EventHandlerErrorCallback != nil {                                     //
-						i.hostContext.EventHandlerErrorCallback(eventName, source, rtErr) //
-					} else {
-						// Log if no callback is registered, as this would otherwise be silent.
-						i.Logger().Error("Unhandled error in event handler (no callback registered)", "event", eventName, "source", source, "error", rtErr)
-					}
-				}
-			}()
-
-			if h.EventVarName != "" { //
-				handlerInterpreter.SetVariable(h.EventVarName, eventObj) //
-			}
-
-			// Execute the handler steps
-			// --- FIX: Set isInHandler to false. It should only be true
-			// --- when executing the *body* of an on_error block,
-			// --- not the entire event handler.
-			_, _, _, execErr = handlerInterpreter.executeSteps(h.Body, false, nil) //
-
-		}(handler) // Pass handler into the closure
-		// --- End Fix ---
+		// A 'where' guard is checked before any frame is created.
+		if handler.GuardExpr != nil && !i.passesGuard(handler, eventName, source, eventObj) {
+			continue
+		}
+		if rtErr := i.runHandler(handler, eventName, source, eventObj); rtErr != nil {
+			i.reportHandlerError(eventName, source, rtErr)
+			i.handlerFailed(handler, eventName, source, eventObj, rtErr)
+		}
+	}
+}
+
+// runHandler runs one handler on a composed event in a fresh frame and
+// returns its error, with panics converted to RuntimeErrors.
+func (i *Interpreter) runHandler(h *ast.OnEventDecl, eventName, source string, eventObj lang.Value) (rtErr *lang.RuntimeError) {
+	var execErr error
+	handlerInterpreter := i.newFrame() // A clean scope frame for the handler //
+	handlerInterpreter.eventDepth = i.eventDepth + 1
+	handlerInterpreter.state.strict = h.Strict
+
+	// Set meaningful context for stack traces
+	contextName := fmt.Sprintf("Event: %s", eventName)
+	if pos := h.GetPos(); pos != nil {
+		contextName = fmt.Sprintf("%s (defined at %s)", contextName, pos.String())
+	}
+	handlerInterpreter.state.currentProcName = contextName
+	// Push context to stackFrames so called procedures inherit it in their trace
+	handlerInterpreter.state.stackFrames = append(handlerInterpreter.state.stackFrames, contextName)
+
+	// --- THE FIX: Wrap execution in defer/recover ---
+	defer func() {
+		if r := recover(); r != nil {
+			// Convert panic to a RuntimeError
+			panicMsg := fmt.Sprintf("panic executing event handler for '%s': %v", eventName, r)
+			execErr = lang.NewRuntimeError(lang.ErrorCodeInternal, panicMsg, fmt.Errorf("panic: %v", r)).WithPosition(h.GetPos())
+			i.Logger().Error("Panic recovered in event handler", "event", eventName, "source", source, "panic_value", r, "error", execErr)
+		}
+		if execErr != nil {
+			// THE FIX: Use handlerInterpreter to capture the correct stack trace for this execution
+			rtErr = handlerInterpreter.ensureRuntimeError(execErr, h.GetPos(), "ON_EVENT_HANDLER") //
+		}
+	}()
+
+	if h.EventVarName != "" { //
+		handlerInterpreter.SetVariable(h.EventVarName, eventObj) //
+	}
+
+	// Execute the handler steps
+	// --- FIX: Set isInHandler to false. It should only be true
+	// --- when executing the *body* of an on_error block,
+	// --- not the entire event handler.
+	_, _, _, execErr = handlerInterpreter.executeSteps(h.Body, false, nil) //
+	return nil
+}
+
+// reportHandlerError passes a handler error to the host's callback.
+func (i *Interpreter) reportHandlerError(eventName, source string, rtErr *lang.RuntimeError) {
+	if i.hostContext.EventHandlerErrorCallback != nil { //
+		i.hostContext.EventHandlerErrorCallback(eventName, source, rtErr) //
+	} else {
+		// Log if no callback is registered, as this would otherwise be silent.
+		i.Logger().Error("Unhandled error in event handler (no callback registered)", "event", eventName, "source", source, "error", rtErr)
 	}
 }
 
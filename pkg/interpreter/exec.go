@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 92
+// :: fileVersion: 98
 // :: description: Updated call sites to use the new context-aware ensureRuntimeError method.
-// :: latestChange: Updated ensureRuntimeError usage.
+// :: latestChange: Execute honours the program's ':: strict: true' header.
 // :: filename: pkg/interpreter/exec.go
 // :: serialization: go
 
@@ -24,8 +24,9 @@ func (i *Interpreter) Execute(program *ast.Program) (lang.Value, error) {
 	if program == nil {
 		return lang.NumberValue{Value: 0}, nil
 	}
-	// Fork the interpreter to create a sandboxed environment for command execution.
-	cmdInterpreter := i.fork()
+	markStrict(program)
+	// Run the commands in their own scope frame.
+	cmdInterpreter := i.newFrame()
 	cmdInterpreter.state.commands = program.Commands
 	_, err := cmdInterpreter.executeCommands()
 
@@ -42,8 +43,12 @@ func (i *Interpreter) Execute(program *ast.Program) (lang.Value, error) {
 	return lang.NumberValue{Value: 0}, nil
 }
 
+// executeSteps runs a step list, compiled unless the root was built WithTreeWalker.
 func (i *Interpreter) executeSteps(steps []ast.Step, isInHandler bool, activeError *lang.RuntimeError) (lang.Value, bool, bool, error) {
-	return i.recExecuteSteps(steps, isInHandler, activeError, 0)
+	if i.rootInterpreter().state.treeWalker {
+		return i.recExecuteSteps(steps, isInHandler, activeError, 0)
+	}
+	return i.runCompiled(i.compiled(steps), isInHandler, activeError)
 }
 
 // ... getStepSubjectForLogging implementation remains the same ...
@@ -169,6 +174,36 @@ func (i *Interpreter) recExecuteSteps(steps []ast.Step, isInHandler bool, active
 					activeError = nil
 				}
 			}
+		case "switch":
+			var switchReturned, switchCleared bool
+			var switchResult lang.Value
+			switchResult, switchReturned, switchCleared, stepErr = i.executeSwitch(step, isInHandler, activeError)
+			if stepErr == nil {
+				stepResult = switchResult
+				if switchReturned {
+					i.lastCallResult = stepResult
+					return stepResult, true, false, nil
+				}
+				if switchCleared {
+					wasCleared = true
+					activeError = nil
+				}
+			}
+		case "try":
+			var tryReturned, tryCleared bool
+			var tryResult lang.Value
+			tryResult, tryReturned, tryCleared, stepErr = i.executeTry(step, isInHandler, activeError)
+			if stepErr == nil {
+				stepResult = tryResult
+				if tryReturned {
+					i.lastCallResult = stepResult
+					return stepResult, true, false, nil
+				}
+				if tryCleared {
+					wasCleared = true
+					activeError = nil
+				}
+			}
 		case "for", "for_each":
 			var forReturned, forCleared bool
 			var forResult lang.Value
@@ -187,6 +222,8 @@ func (i *Interpreter) recExecuteSteps(steps []ast.Step, isInHandler bool, active
 			stepResult, stepErr = i.executeMust(step)
 		case "fail":
 			stepErr = i.executeFail(step)
+		case "raise":
+			stepErr = i.executeRaise(step)
 		case "on_error":
 			continue
 		case "clear_error":
@@ -0,0 +1,408 @@
+// NeuroScript Version: 0.8.0
+// File version: 6
+// Purpose: Executes compiled step blocks with the same semantics and error positions as recExecuteSteps.
+// filename: pkg/interpreter/exec_compiled.go
+// nlines: 408
+// risk_rating: HIGH
+
+package interpreter
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aprice2704/neuroscript/pkg/lang"
+)
+
+// runCompiled is the compiled counterpart of recExecuteSteps. Any change to
+// control flow or error handling there must be mirrored here;
+// TestCompiledExecution_MatchesTreeWalker runs scripts down both paths to
+// catch drift.
+func (i *Interpreter) runCompiled(b *compiledBlock, isInHandler bool, activeError *lang.RuntimeError) (finalResult lang.Value, wasReturn bool, wasCleared bool, finalError error) {
+	finalResult = &lang.NilValue{}
+
+	for idx := range b.steps {
+		cs := &b.steps[idx]
+		step := cs.step
+		var stepResult lang.Value
+		var stepErr error
+
+		switch cs.kind {
+		case stepSet:
+			if cs.expr == nil {
+				stepResult, stepErr = i.executeSet(*step)
+				break
+			}
+			if len(step.LValues) == 0 {
+				stepErr = lang.NewRuntimeError(lang.ErrorCodeInternal, "SetStep LValues is empty", nil).WithPosition(step.GetPos())
+				break
+			}
+			rhsValue, evalErr := cs.expr(i)
+			if evalErr != nil {
+				stepErr = lang.WrapErrorWithPosition(evalErr, cs.exprNode.GetPos(), "evaluating value for SET statement")
+				break
+			}
+			stepResult, stepErr = i.assignSetValue(step, cs.exprNode, rhsValue)
+		case stepCall:
+			if cs.expr == nil {
+				stepResult, stepErr = i.executeCall(*step)
+			} else {
+				stepResult, stepErr = cs.expr(i)
+			}
+		case stepReturn:
+			if isInHandler {
+				stepErr = lang.NewRuntimeError(lang.ErrorCodeReturnViolation, "'return' is not permitted inside an on_error block", lang.ErrReturnViolation).WithPosition(step.GetPos())
+			} else {
+				var returnValue lang.Value
+				returnValue, stepErr = i.compiledReturn(cs)
+				if stepErr == nil {
+					finalResult = returnValue
+					i.lastCallResult = finalResult
+					return finalResult, true, false, nil
+				}
+			}
+		case stepEmit:
+			if cs.expr == nil {
+				stepResult = &lang.NilValue{}
+				break
+			}
+			val, err := cs.expr(i)
+			if err != nil {
+				stepErr = err
+				break
+			}
+			stepResult, stepErr = i.emitValue(*step, val)
+		case stepWhisper:
+			stepResult, stepErr = i.executeWhisper(*step)
+		case stepIf:
+			var ifReturned, ifCleared bool
+			var ifBlockResult lang.Value
+			ifBlockResult, ifReturned, ifCleared, stepErr = i.compiledIf(cs, isInHandler, activeError)
+			if stepErr == nil {
+				stepResult = ifBlockResult
+				if ifReturned {
+					i.lastCallResult = stepResult
+					return stepResult, true, false, nil
+				}
+				if ifCleared {
+					wasCleared = true
+					activeError = nil
+				}
+			}
+		case stepWhile:
+			var whileReturned, whileCleared bool
+			var whileBlockResult lang.Value
+			whileBlockResult, whileReturned, whileCleared, stepErr = i.compiledWhile(cs, isInHandler, activeError)
+			if stepErr == nil {
+				stepResult = whileBlockResult
+				if whileReturned {
+					i.lastCallResult = stepResult
+					return stepResult, true, wasCleared, nil
+				}
+				if whileCleared {
+					wasCleared = true
+					activeError = nil
+				}
+			}
+		case stepSwitch:
+			var switchReturned, switchCleared bool
+			var switchResult lang.Value
+			switchResult, switchReturned, switchCleared, stepErr = i.compiledSwitch(cs, isInHandler, activeError)
+			if stepErr == nil {
+				stepResult = switchResult
+				if switchReturned {
+					i.lastCallResult = stepResult
+					return stepResult, true, false, nil
+				}
+				if switchCleared {
+					wasCleared = true
+					activeError = nil
+				}
+			}
+		case stepTry:
+			var tryReturned, tryCleared bool
+			var tryResult lang.Value
+			tryResult, tryReturned, tryCleared, stepErr = i.compiledTry(cs, isInHandler, activeError)
+			if stepErr == nil {
+				stepResult = tryResult
+				if tryReturned {
+					i.lastCallResult = stepResult
+					return stepResult, true, false, nil
+				}
+				if tryCleared {
+					wasCleared = true
+					activeError = nil
+				}
+			}
+		case stepFor:
+			var forReturned, forCleared bool
+			var forResult lang.Value
+			forResult, forReturned, forCleared, stepErr = i.compiledFor(cs, isInHandler, activeError)
+			if stepErr == nil {
+				stepResult = forResult
+				if forReturned {
+					return forResult, true, false, nil
+				}
+				if forCleared {
+					wasCleared = true
+					activeError = nil
+				}
+			}
+		case stepMust:
+			if cs.expr == nil {
+				stepResult, stepErr = i.executeMust(*step)
+				break
+			}
+			val, err := cs.expr(i)
+			if err != nil {
+				stepErr = lang.WrapErrorWithPosition(err, cs.exprNode.GetPos(), "evaluating expression for 'must'")
+			} else if !lang.IsTruthy(val) {
+				stepErr = lang.ErrMustConditionFailed
+			} else {
+				stepResult = val
+			}
+		case stepFail:
+			stepErr = i.executeFail(*step)
+		case stepRaise:
+			stepErr = i.executeRaise(*step)
+		case stepOnError:
+			continue
+		case stepClearError:
+			var clearedNow bool
+			clearedNow, stepErr = i.executeClearError(*step, isInHandler)
+			if stepErr == nil && clearedNow {
+				wasCleared = true
+				activeError = nil
+			}
+		case stepAsk:
+			stepResult, stepErr = i.executeAsk(*step)
+		case stepPromptUser:
+			stepResult, stepErr = i.executePromptUser(*step)
+		case stepBreak:
+			stepErr = i.executeBreak(*step)
+		case stepContinue:
+			stepErr = i.executeContinue(*step)
+		case stepExpression:
+			if cs.expr != nil {
+				stepResult, stepErr = cs.expr(i)
+			} else {
+				stepResult = &lang.NilValue{}
+			}
+		default:
+			errMsg := fmt.Sprintf("unknown step type '%s'", step.Type)
+			stepErr = lang.NewRuntimeError(lang.ErrorCodeUnknownKeyword, errMsg, lang.ErrUnknownKeyword).WithPosition(step.GetPos())
+		}
+
+		if stepErr != nil {
+			rtErr := i.ensureRuntimeError(stepErr, step.GetPos(), cs.typeLower)
+
+			if errors.Is(rtErr.Unwrap(), lang.ErrBreak) || errors.Is(rtErr.Unwrap(), lang.ErrContinue) {
+				return nil, false, wasCleared, rtErr
+			}
+
+			if isInHandler {
+				return nil, false, false, rtErr
+			}
+
+			if len(i.state.errorHandlerStack) > 0 {
+				handlerBlock := i.state.errorHandlerStack[len(i.state.errorHandlerStack)-1]
+				handlerToExecute := handlerBlock[0]
+				i.SetVariable("system_error_message", lang.StringValue{Value: rtErr.Message})
+				_, _, handlerCleared, handlerErr := i.executeSteps(handlerToExecute.Body, true, rtErr)
+				if handlerErr != nil {
+					return nil, false, false, i.ensureRuntimeError(handlerErr, handlerToExecute.GetPos(), "ON_ERROR_HANDLER")
+				}
+				if handlerCleared {
+					continue
+				}
+				return nil, false, false, rtErr
+			}
+			return nil, false, wasCleared, rtErr
+		}
+
+		if cs.updatesLastResult {
+			finalResult = stepResult
+			i.lastCallResult = stepResult
+		}
+	}
+
+	return finalResult, false, wasCleared, nil
+}
+
+// compiledReturn mirrors executeReturn.
+func (i *Interpreter) compiledReturn(cs *compiledStep) (lang.Value, error) {
+	values := cs.step.Values
+	if len(cs.values) == 0 {
+		return &lang.NilValue{}, nil
+	}
+	if len(cs.values) == 1 {
+		v, err := cs.values[0](i)
+		if err != nil {
+			return nil, lang.WrapErrorWithPosition(err, values[0].GetPos(), "evaluating return expression")
+		}
+		return v, nil
+	}
+	results := make([]lang.Value, len(cs.values))
+	for idx, c := range cs.values {
+		v, err := c(i)
+		if err != nil {
+			return nil, lang.WrapErrorWithPosition(err, values[idx].GetPos(), fmt.Sprintf("evaluating return expression %d", idx+1))
+		}
+		results[idx] = v
+	}
+	return lang.ListValue{Value: results}, nil
+}
+
+// compiledIf mirrors executeIf.
+func (i *Interpreter) compiledIf(cs *compiledStep, isInHandler bool, activeError *lang.RuntimeError) (lang.Value, bool, bool, error) {
+	condResult, err := cs.cond(i)
+	if err != nil {
+		return nil, false, false, lang.WrapErrorWithPosition(err, cs.step.Cond.GetPos(), "evaluating IF condition")
+	}
+	if lang.IsTruthy(condResult) {
+		return i.runCompiled(cs.body, isInHandler, activeError)
+	} else if cs.elseBody != nil {
+		return i.runCompiled(cs.elseBody, isInHandler, activeError)
+	}
+	return &lang.NilValue{}, false, false, nil
+}
+
+// compiledSwitch mirrors executeSwitch.
+func (i *Interpreter) compiledSwitch(cs *compiledStep, isInHandler bool, activeError *lang.RuntimeError) (lang.Value, bool, bool, error) {
+	if cs.cond == nil {
+		// A malformed switch reports through the tree walker's diagnostics.
+		return i.executeSwitch(*cs.step, isInHandler, activeError)
+	}
+	sw := cs.step.SwitchStmt
+	subject, err := cs.cond(i)
+	if err != nil {
+		return nil, false, false, lang.WrapErrorWithPosition(err, sw.Subject.GetPos(), "evaluating SWITCH subject")
+	}
+	_, caseIdx, ok, err := selectSwitchBody(sw, subject, func(ci, vi int) (lang.Value, error) {
+		return cs.caseVals[ci][vi](i)
+	})
+	if err != nil {
+		return nil, false, false, err
+	}
+	if !ok {
+		return &lang.NilValue{}, false, false, nil
+	}
+	if caseIdx < 0 {
+		return i.runCompiled(cs.elseBody, isInHandler, activeError)
+	}
+	return i.runCompiled(cs.caseBodies[caseIdx], isInHandler, activeError)
+}
+
+// compiledTry mirrors executeTry.
+func (i *Interpreter) compiledTry(cs *compiledStep, isInHandler bool, activeError *lang.RuntimeError) (lang.Value, bool, bool, error) {
+	return i.runTry(*cs.step, func(which tryBlock) (lang.Value, bool, bool, error) {
+		b := cs.body
+		switch which {
+		case tryCatch:
+			b = cs.elseBody
+		case tryFinally:
+			b = cs.finallyBody
+		}
+		return i.runCompiled(b, isInHandler, activeError)
+	})
+}
+
+// compiledWhile mirrors executeWhile.
+func (i *Interpreter) compiledWhile(cs *compiledStep, isInHandler bool, activeError *lang.RuntimeError) (result lang.Value, wasReturn bool, wasCleared bool, err error) {
+	step := cs.step
+	if cs.cond == nil {
+		return nil, false, false, lang.NewRuntimeError(lang.ErrorCodeInternal, "WHILE step has nil Condition", nil).WithPosition(step.GetPos())
+	}
+
+	result = &lang.NilValue{}
+	for iteration := 0; ; iteration++ {
+		if iteration >= i.maxLoopIterations {
+			return nil, false, false, lang.NewRuntimeError(lang.ErrorCodeResourceExhaustion, fmt.Sprintf("exceeded max iterations (%d)", i.maxLoopIterations), lang.ErrMaxIterationsExceeded).WithPosition(step.GetPos())
+		}
+
+		condResult, evalErr := cs.cond(i)
+		if evalErr != nil {
+			return nil, false, wasCleared, lang.WrapErrorWithPosition(evalErr, step.Cond.GetPos(), "evaluating WHILE condition")
+		}
+		if !lang.IsTruthy(condResult) {
+			break
+		}
+
+		blockResult, blockReturned, blockCleared, blockErr := i.runCompiled(cs.body, isInHandler, activeError)
+		if blockCleared {
+			wasCleared = true
+			activeError = nil
+		}
+		if blockErr != nil {
+			if brk, cont := loopSignal(blockErr); brk {
+				break
+			} else if cont {
+				continue
+			}
+			return nil, false, wasCleared, blockErr
+		}
+		if blockReturned {
+			return blockResult, true, wasCleared, nil
+		}
+		result = blockResult
+	}
+	return result, false, wasCleared, nil
+}
+
+// compiledFor mirrors executeFor.
+func (i *Interpreter) compiledFor(cs *compiledStep, isInHandler bool, activeError *lang.RuntimeError) (result lang.Value, wasReturn bool, wasCleared bool, err error) {
+	step := cs.step
+	if cs.coll == nil || step.LoopVarName == "" {
+		// Malformed loops report through the tree walker's diagnostics.
+		return i.executeFor(*step, isInHandler, activeError)
+	}
+
+	collectionVal, evalErr := cs.coll(i)
+	if evalErr != nil {
+		return nil, false, wasCleared, lang.WrapErrorWithPosition(evalErr, step.Collection.GetPos(), fmt.Sprintf("evaluating collection for FOR EACH %s", step.LoopVarName))
+	}
+	itemsToIterate, iterErr := forEachItems(step.LoopVarName, collectionVal)
+	if iterErr != nil {
+		return nil, false, wasCleared, iterErr.WithPosition(step.Collection.GetPos())
+	}
+
+	result = &lang.NilValue{}
+	for iteration, item := range itemsToIterate {
+		if iteration >= i.maxLoopIterations {
+			return nil, false, false, lang.NewRuntimeError(lang.ErrorCodeResourceExhaustion, fmt.Sprintf("exceeded max iterations (%d)", i.maxLoopIterations), lang.ErrMaxIterationsExceeded).WithPosition(step.GetPos())
+		}
+
+		if setErr := i.SetVariable(step.LoopVarName, item); setErr != nil {
+			errMsg := fmt.Sprintf("setting loop variable '%s' in FOR EACH", step.LoopVarName)
+			return nil, false, wasCleared, lang.NewRuntimeError(lang.ErrorCodeInternal, errMsg, setErr).WithPosition(step.GetPos())
+		}
+
+		blockResult, blockReturned, blockCleared, blockErr := i.runCompiled(cs.body, isInHandler, activeError)
+		if blockCleared {
+			wasCleared = true
+			activeError = nil
+		}
+		if blockErr != nil {
+			if brk, cont := loopSignal(blockErr); brk {
+				break
+			} else if cont {
+				continue
+			}
+			return nil, false, wasCleared, blockErr
+		}
+		if blockReturned {
+			return blockResult, true, wasCleared, nil
+		}
+		result = blockResult
+	}
+	return result, false, wasCleared, nil
+}
+
+// loopSignal reports whether a loop body error is a break or continue signal.
+func loopSignal(err error) (isBreak, isContinue bool) {
+	var rtErr *lang.RuntimeError
+	if errors.As(err, &rtErr) {
+		return errors.Is(rtErr.Unwrap(), lang.ErrBreak), errors.Is(rtErr.Unwrap(), lang.ErrContinue)
+	}
+	return false, false
+}
@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 82
+// :: fileVersion: 83
 // :: description: Enhanced ensureRuntimeError to lookup and display procedure definition locations in stack traces.
-// :: latestChange: Added definition location lookup in stack trace generation.
+// :: latestChange: Whisper payloads and runtime error messages are scrubbed of revealed secrets.
 // :: filename: pkg/interpreter/exec_more.go
 // :: serialization: go
 
@@ -39,6 +39,9 @@ func (i *Interpreter) executeWhisper(step ast.Step) (lang.Value, error) {
 	if err != nil {
 		return nil, err
 	}
+	// Whispers leave the interpreter, so revealed secrets are scrubbed first.
+	handleVal = i.redactValue(handleVal)
+	dataVal = i.redactValue(dataVal)
 
 	if i.hostContext != nil && i.hostContext.WhisperFunc != nil {
 		i.hostContext.WhisperFunc(handleVal, dataVal)
@@ -124,5 +127,5 @@ func (i *Interpreter) ensureRuntimeError(err error, pos *types.Position, context
 		rtErr.Message = sb.String()
 	}
 
-	return rtErr
+	return i.redactRuntimeError(rtErr)
 }
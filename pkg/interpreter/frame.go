@@ -0,0 +1,71 @@
+// NeuroScript Version: 0.8.0
+// File version: 5
+// Purpose: Provides lightweight scope frames for procedure calls, event handlers and ask turns.
+// filename: pkg/interpreter/frame.go
+// nlines: 71
+// risk_rating: HIGH
+
+package interpreter
+
+import (
+	"strconv"
+	"sync/atomic"
+)
+
+// frameSeq numbers frames so each gets a distinct ID without a UUID.
+var frameSeq atomic.Uint64
+
+// newFrame creates a lightweight scope frame over the same interpreter for
+// running a procedure body, an event handler or an ask turn.
+//
+// A frame differs from fork() in what it does NOT do: its ID is the root's
+// plus a sequence number instead of a fresh UUID, it is never added to the
+// root's clone registry, it does not copy the root's globals (GetVariable
+// resolves them through the root, so a frame sees a global assigned after it
+// was created), and it reuses the parent's tool registry view when that view
+// is bound to the public API wrapper. All that remains per call is the struct
+// itself and a fresh state, both of which become garbage as soon as the call
+// returns.
+func (i *Interpreter) newFrame() *Interpreter {
+	root := i.rootInterpreter()
+	state := newInterpreterState()
+	state.knownProcedures = i.state.knownProcedures
+	state.sandboxDir = i.state.sandboxDir
+	state.strict = i.state.strict // closures and ask turns keep their file's strictness
+
+	frame := &Interpreter{
+		id:                  root.id + "/frame-" + strconv.FormatUint(frameSeq.Add(1), 10),
+		root:                root,
+		hostContext:         i.hostContext,
+		eventManager:        i.eventManager,
+		eventDepth:          i.eventDepth,
+		deadLetters:         i.deadLetters,
+		bufferManager:       i.bufferManager,
+		handleRegistry:      i.handleRegistry,
+		transientPrivateKey: i.transientPrivateKey,
+		maxLoopIterations:   i.maxLoopIterations,
+		modelStore:          i.modelStore,
+		providerRegistry:    i.providerRegistry,
+		ExecPolicy:          i.ExecPolicy,
+		accountStore:        i.accountStore,
+		capsuleStore:        i.capsuleStore,
+		secretKeys:          i.secretKeys,
+		secrets:             i.secrets,
+		parser:              i.parser,
+		astBuilder:          i.astBuilder,
+		aiWorker:            i.aiWorker,
+		PublicAPI:           i.PublicAPI,
+		turnCtx:             i.GetTurnContext(),
+		state:               state,
+	}
+
+	// Tools invoked through the public API always receive the wrapper as
+	// their runtime, so every frame can share one view. Without a wrapper the
+	// view must be bound to the frame so tools see its local variables.
+	if frame.PublicAPI != nil {
+		frame.tools = i.tools
+	} else {
+		frame.tools = i.tools.NewViewForInterpreter(frame)
+	}
+	return frame
+}
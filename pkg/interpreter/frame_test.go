@@ -0,0 +1,59 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Pins how scope frames see globals and how they are identified.
+// filename: pkg/interpreter/frame_test.go
+// nlines: 59
+// risk_rating: LOW
+
+package interpreter
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFrame_ReadsGlobalsLive(t *testing.T) {
+	interp := newCallBenchInterpreter(t)
+	if err := interp.SetInitialVariable("g", 1); err != nil {
+		t.Fatalf("SetInitialVariable failed: %v", err)
+	}
+	frame := interp.newFrame()
+
+	// Frames do not snapshot the root's globals: a global changed or added
+	// after the frame was created is seen by it.
+	if err := interp.SetInitialVariable("g", 2); err != nil {
+		t.Fatalf("SetInitialVariable failed: %v", err)
+	}
+	if err := interp.SetInitialVariable("late", "x"); err != nil {
+		t.Fatalf("SetInitialVariable failed: %v", err)
+	}
+	if v, ok := frame.GetVariable("g"); !ok || fmt.Sprint(v) != "2" {
+		t.Errorf("frame GetVariable(g) = %v, %v; want 2, true", v, ok)
+	}
+	if v, ok := frame.GetVariable("late"); !ok || fmt.Sprint(v) != "x" {
+		t.Errorf("frame GetVariable(late) = %v, %v; want x, true", v, ok)
+	}
+	if err := frame.SetVariable("g", nil); err == nil {
+		t.Error("a frame was allowed to assign a global")
+	}
+}
+
+func TestFrame_HasItsOwnIDAndFullState(t *testing.T) {
+	interp := newCallBenchInterpreter(t)
+	a, b := interp.newFrame(), interp.newFrame()
+	nested := a.newFrame()
+
+	ids := map[string]bool{interp.ID(): true}
+	for _, f := range []*Interpreter{a, b, nested} {
+		if ids[f.ID()] {
+			t.Errorf("frame ID %q is not unique", f.ID())
+		}
+		ids[f.ID()] = true
+		if f.state.globalVarNames == nil || f.state.globalConstants == nil {
+			t.Errorf("frame %q has nil state maps", f.ID())
+		}
+		if f.rootInterpreter() != interp {
+			t.Errorf("frame %q is not rooted at the interpreter", f.ID())
+		}
+	}
+}
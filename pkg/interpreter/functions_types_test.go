@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 7
+// :: fileVersion: 9
 // :: description: Enables all tests for built-in type-checking functions.
-// :: latestChange: Fixed unkeyed fields linter warning for lang.StringValue.
+// :: latestChange: Restored the whole-float is_int/is_float cases; integers are always is_int.
 // :: filename: pkg/interpreter/functions_types_test.go
 // :: serialization: go
 
@@ -35,10 +35,13 @@ func TestBuiltinTypeCheckFunctions(t *testing.T) {
 	}{
 		{"is_string", "v", lang.StringValue{Value: "hello"}, true},
 		{"is_number", "v", lang.NumberValue{Value: 123}, true},
+		{"is_number", "v_int", lang.IntValue{Value: 123}, true},
 		{"is_int", "v", lang.NumberValue{Value: 123.0}, true},
+		{"is_int", "v_int", lang.IntValue{Value: 123}, true},
 		{"is_int", "v_float", lang.NumberValue{Value: 123.45}, false}, // Test false case
 		{"is_float", "v", lang.NumberValue{Value: 123.45}, true},
-		{"is_float", "v_int", lang.NumberValue{Value: 123.0}, false}, // Test false case
+		{"is_float", "v_whole", lang.NumberValue{Value: 123.0}, false}, // Test false case
+		{"is_float", "v_int", lang.IntValue{Value: 123}, false},
 		{"is_bool", "v", lang.BoolValue{Value: true}, true},
 		{"is_list", "v", lang.NewListValue(nil), true},
 		{"is_map", "v", lang.NewMapValue(nil), true},
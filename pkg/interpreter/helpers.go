@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.8.0
-// File version: 6
+// File version: 7
 // Purpose: Slimmed down by removing or un-exporting convenience methods.
 // filename: pkg/interpreter/helpers.go
-// nlines: 30
+// nlines: 41
 // risk_rating: MEDIUM
 
 package interpreter
@@ -37,11 +37,5 @@ func (i *Interpreter) addProcedure(proc ast.Procedure) error {
 
 // getAllVariables returns a copy of all variables in the current scope for testing.
 func (i *Interpreter) getAllVariables() (map[string]lang.Value, error) {
-	i.state.variablesMu.RLock()
-	defer i.state.variablesMu.RUnlock()
-	clone := make(map[string]lang.Value)
-	for k, v := range i.state.variables {
-		clone[k] = v
-	}
-	return clone, nil
+	return i.state.copyLocals(), nil
 }
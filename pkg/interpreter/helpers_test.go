@@ -1,6 +1,6 @@
 // NeuroScript Version: 0.8.0
-// File version: 6
-// Purpose: Fixed call to register provider using provider.NewAdmin.
+// File version: 7
+// Purpose: Mock provider now records the last request it received.
 // filename: pkg/interpreter/helpers_test.go
 // nlines: 68
 
@@ -18,9 +18,11 @@ import (
 type mockAskProviderV3 struct {
 	ResponseToReturn *provider.AIResponse
 	ErrorToReturn    error
+	LastRequest      provider.AIRequest
 }
 
 func (m *mockAskProviderV3) Chat(ctx context.Context, req provider.AIRequest) (*provider.AIResponse, error) {
+	m.LastRequest = req
 	if m.ErrorToReturn != nil {
 		return nil, m.ErrorToReturn
 	}
@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 103
+// :: fileVersion: 115
 // :: description: Added AllowRedefinition boolean field to Interpreter struct.
-// :: latestChange: Added AllowRedefinition field to support symbol shadowing/redefinition feature.
+// :: latestChange: RunProcedureNamed takes the call's named argument nodes in source order.
 // :: filename: pkg/interpreter/interpreter.go
 // :: serialization: go
 
@@ -13,18 +13,21 @@ import (
 	"crypto/ed25519"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/aprice2704/neuroscript/pkg/account"
 	"github.com/aprice2704/neuroscript/pkg/agentmodel"
-
-	// "github.com/aprice2704/neuroscript/pkg/ast" // No longer needed here
+	"github.com/aprice2704/neuroscript/pkg/ast"
 	"github.com/aprice2704/neuroscript/pkg/capability"
 	"github.com/aprice2704/neuroscript/pkg/capsule"
+	"github.com/aprice2704/neuroscript/pkg/eval"
 	"github.com/aprice2704/neuroscript/pkg/interfaces"
 	"github.com/aprice2704/neuroscript/pkg/lang"
 	"github.com/aprice2704/neuroscript/pkg/parser"
 	"github.com/aprice2704/neuroscript/pkg/policy"
 	"github.com/aprice2704/neuroscript/pkg/provider"
+	"github.com/aprice2704/neuroscript/pkg/scheduler"
+	"github.com/aprice2704/neuroscript/pkg/secret"
 	"github.com/aprice2704/neuroscript/pkg/tool"
 	"github.com/google/uuid"
 )
@@ -32,6 +35,12 @@ import (
 // Statically assert that the concrete Interpreter type satisfies the tool.Runtime interface.
 var _ tool.Runtime = (*Interpreter)(nil)
 
+// The compiled path reads procedure locals by slot and calls bound tools.
+var (
+	_ eval.SlotRuntime = (*Interpreter)(nil)
+	_ eval.ToolBinder  = (*Interpreter)(nil)
+)
+
 // DefaultSelfHandle is the internal handle for the default whisper buffer.
 const DefaultSelfHandle = "default_self_buffer"
 
@@ -49,6 +58,16 @@ type Interpreter struct {
 	lastCallResult    lang.Value
 	maxLoopIterations int
 	bufferManager     *BufferManager
+	eventDepth        int // event handlers enclosing this frame; bounds chains of raised events
+	// eventQueue is set on the root by WithEventQueue; nil means EmitEvent is synchronous.
+	eventQueue *eventQueue
+	// timers is the root's timer scheduler, created on first use on timerClock.
+	timers           *scheduler.Scheduler
+	timerClock       scheduler.Clock
+	timerMinInterval time.Duration // shortest 'every' interval; zero is the scheduler default
+	timersMu         sync.Mutex
+	// deadLetters retries failed event handlers and keeps what they could not process.
+	deadLetters *deadLetterStore
 	// objectCache and objectCacheMu are OBSOLETE and replaced by handleRegistry
 	// objectCache         map[string]interface{}
 	// objectCacheMu       sync.Mutex
@@ -70,6 +89,12 @@ type Interpreter struct {
 	accountAdmin    interfaces.AccountAdmin
 	agentModelAdmin interfaces.AgentModelAdmin
 
+	// secretKeys holds host-supplied decryption keys by SecretRef.Enc; secrets
+	// remembers every value revealed so it can be scrubbed from output. Both
+	// are shared with every fork.
+	secretKeys map[string][]byte
+	secrets    *secret.Redactor
+
 	cloneRegistry   []*Interpreter
 	cloneRegistryMu sync.Mutex
 
@@ -99,6 +124,7 @@ func (i *Interpreter) ASTBuilder() *parser.ASTBuilder {
 // SetToolRegistry allows the public API wrapper to replace the tool registry.
 func (i *Interpreter) SetToolRegistry(r tool.ToolRegistry) {
 	i.tools = r
+	i.resetCompiled() // Compiled tool calls may have bound specs from the old registry.
 }
 
 // SetPublicAPI allows the public API wrapper to set a pointer to itself.
@@ -151,6 +177,7 @@ func NewInterpreter(opts ...InterpreterOption) *Interpreter {
 		capsuleStore: capsule.NewStore(capsule.NewRegistry(), capsule.BuiltInRegistry()),
 		// ---
 		cloneRegistry: make([]*Interpreter, 0),
+		secrets:       secret.NewRedactor(),
 	}
 	// Note: globalConstants map is initialized inside newInterpreterState() in state.go
 
@@ -183,6 +210,9 @@ func NewInterpreter(opts ...InterpreterOption) *Interpreter {
 	if i.ExecPolicy == nil {
 		i.ExecPolicy = policy.NewBuilder(policy.ContextNormal).Build()
 	}
+	if i.deadLetters == nil {
+		i.deadLetters = newDeadLetterStore(i, DeadLetterConfig{})
+	}
 
 	i.astBuilder.SetEventHandlerCallback(i.RegisterEventHandler)
 
@@ -225,6 +255,16 @@ func (i *Interpreter) RunProcedure(procName string, args ...lang.Value) (lang.Va
 	return result, err
 }
 
+// RunProcedureNamed runs a procedure with positional arguments followed by
+// arguments bound by parameter name. It satisfies eval.NamedArgRunner.
+func (i *Interpreter) RunProcedureNamed(procName string, args []lang.Value, namedArgs []*ast.NamedArgNode, named []lang.Value) (lang.Value, error) {
+	result, err := i.runProcedureNamed(procName, args, namedArgs, named)
+	if err == nil {
+		i.lastCallResult = result
+	}
+	return result, err
+}
+
 func (i *Interpreter) SetInitialVariable(name string, value any) error {
 	wrappedValue, err := lang.Wrap(value)
 	if err != nil {
@@ -247,6 +287,12 @@ func (i *Interpreter) GetGrantSet() *capability.GrantSet {
 	return &i.ExecPolicy.Grants
 }
 
+// Usage returns a snapshot of the resources (budget, network, filesystem and
+// per-tool calls) consumed so far under the active execution policy.
+func (i *Interpreter) Usage() capability.Counters {
+	return i.GetGrantSet().Snapshot()
+}
+
 func (i *Interpreter) rootInterpreter() *Interpreter {
 	root := i
 	for root.root != root {
@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.8.0
-// File version: 3
-// Purpose: Fixes event handler collision check by using HandlerName instead of Named.
+// File version: 7
+// Purpose: Load replaces the script's definitions and picks up its ':: strict:' header. Resets event handlers, patterns and guard counters together.
 // filename: pkg/interpreter/interpreter_load.go
-// nlines: 97
+// nlines: 134
 
 package interpreter
 
@@ -34,8 +34,9 @@ func (i *Interpreter) Load(tree *interfaces.Tree) error {
 	if tree == nil || tree.Root == nil {
 		i.Logger().Warn("Load called with a nil program AST.")
 		i.state.knownProcedures = make(map[string]*ast.Procedure)
-		i.eventManager.eventHandlers = make(map[string][]*ast.OnEventDecl)
+		i.eventManager.reset()
 		i.state.commands = []*ast.CommandNode{}
+		i.resetCompiled()
 		// Do not clear constants, as they are loaded separately
 		return nil
 	}
@@ -49,8 +50,10 @@ func (i *Interpreter) Load(tree *interfaces.Tree) error {
 
 	// Clear existing *script-loaded* definitions
 	i.state.knownProcedures = make(map[string]*ast.Procedure)
-	i.eventManager.eventHandlers = make(map[string][]*ast.OnEventDecl)
+	i.eventManager.reset()
 	i.state.commands = []*ast.CommandNode{}
+	i.resetCompiled()
+	markStrict(program)
 	// Note: We do NOT clear globalConstants, as they are set via tools, not Load.
 
 	i.state.variablesMu.RLock() // Lock for reading constants
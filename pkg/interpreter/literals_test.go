@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 10
+// :: fileVersion: 11
 // :: description: Tests evaluation of literals. Added severe interpolation test case.
-// :: latestChange: Fixed test expectation to match correct runtime string coercion for map spacing and nil values.
+// :: latestChange: Integer literals evaluate to IntValue; whole and exponent literals stay floats.
 // :: filename: pkg/interpreter/literals_test.go
 // :: serialization: go
 
@@ -39,10 +39,12 @@ func TestEvaluateLiterals(t *testing.T) {
 		script string
 		want   lang.Value
 	}{
-		{"Integer", "123", lang.NumberValue{Value: 123}},
+		{"Integer", "123", lang.IntValue{Value: 123}},
+		{"Whole float", "123.0", lang.NumberValue{Value: 123}},
+		{"Exponent is a float", "1e3", lang.NumberValue{Value: 1000}},
 		{"Float", "123.456", lang.NumberValue{Value: 123.456}},
 		{"Char function", "char(65)", lang.StringValue{Value: "A"}},
-		{"Ord function", "ord('A')", lang.NumberValue{Value: 65}},
+		{"Ord function", "ord('A')", lang.IntValue{Value: 65}},
 		{"Triple Backtick", bt3 + "raw string" + bt3, lang.StringValue{Value: "raw string"}},
 		{"Triple Single Quote", "'''raw content'''", lang.StringValue{Value: "raw content"}},
 		{"Double Bracket", "[[raw content]]", lang.StringValue{Value: "raw content"}},
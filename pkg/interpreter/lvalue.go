@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 29
+// :: fileVersion: 31
 // :: description: Fixed non-container overwrite behavior. Improved isNil checking.
-// :: latestChange: Removed `!isContainer` from vivification condition so invalid indexing properly fails in traverseAndSet.
+// :: latestChange: Negative list indexes in assignments count from the end.
 // :: filename: pkg/interpreter/lvalue.go
 // :: serialization: go
 
@@ -50,7 +50,12 @@ func (i *Interpreter) executeSet(step ast.Step) (lang.Value, error) {
 		return nil, lang.WrapErrorWithPosition(evalErr, rhsExpr.GetPos(),
 			"evaluating value for SET statement")
 	}
+	return i.assignSetValue(&step, rhsExpr, rhsValue)
+}
 
+// assignSetValue stores an evaluated right-hand side into the LValues of a
+// set step, destructuring a list when there are several targets.
+func (i *Interpreter) assignSetValue(step *ast.Step, rhsExpr ast.Expression, rhsValue lang.Value) (lang.Value, error) {
 	if len(step.LValues) > 1 {
 		list, ok := rhsValue.(lang.ListValue)
 		if !ok {
@@ -146,7 +151,7 @@ func (i *Interpreter) traverseAndSet(current lang.Value, accessors []*ast.Access
 		return i.traverseAndSet(child, remainingAccessors, valueToPlace)
 
 	case *lang.ListValue:
-		index, err := i.evaluateAccessorIndex(accessor)
+		index, err := i.evaluateAccessorIndex(accessor, len(c.Value))
 		if err != nil {
 			return err
 		}
@@ -190,7 +195,7 @@ func (i *Interpreter) buildNestedStructure(accessors []*ast.AccessorNode, finalV
 		}
 		return &lang.MapValue{Value: map[string]lang.Value{key: innerStructure}}, nil
 	}
-	index, err := i.evaluateAccessorIndex(accessor)
+	index, err := i.evaluateAccessorIndex(accessor, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -230,7 +235,10 @@ func (i *Interpreter) evaluateAccessorKey(accessor *ast.AccessorNode) (string, e
 	return key, nil
 }
 
-func (i *Interpreter) evaluateAccessorIndex(accessor *ast.AccessorNode) (int64, error) {
+// evaluateAccessorIndex resolves a list index on the left of an assignment to
+// a list of the given length. Negative indexes count from the end as they do
+// when reading; an index past the end is allowed, the list is padded.
+func (i *Interpreter) evaluateAccessorIndex(accessor *ast.AccessorNode, length int) (int64, error) {
 	indexVal, err := eval.Expression(i, accessor.Key)
 	if err != nil {
 		return 0, lang.WrapErrorWithPosition(err, accessor.Key.GetPos(), "evaluating list index")
@@ -240,7 +248,10 @@ func (i *Interpreter) evaluateAccessorIndex(accessor *ast.AccessorNode) (int64,
 		return 0, lang.NewRuntimeError(lang.ErrorCodeType, fmt.Sprintf("list index must be an integer, got %s", lang.TypeOf(indexVal)), lang.ErrListInvalidIndexType).WithPosition(accessor.Key.GetPos())
 	}
 	if index < 0 {
-		return 0, lang.NewRuntimeError(lang.ErrorCodeBounds, fmt.Sprintf("list index cannot be negative, got %d", index), lang.ErrListIndexOutOfBounds).WithPosition(accessor.Key.GetPos())
+		if index+int64(length) < 0 {
+			return 0, lang.NewRuntimeError(lang.ErrorCodeBounds, fmt.Sprintf("index %d out of bounds for length %d", index, length), lang.ErrListIndexOutOfBounds).WithPosition(accessor.Key.GetPos())
+		}
+		return index + int64(length), nil
 	}
 	return index, nil
 }
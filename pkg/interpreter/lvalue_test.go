@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 6
+// :: fileVersion: 8
 // :: description: Updated multi-assignment test to check returned values rather than internal sandbox variables.
-// :: latestChange: Multi-assignment test now returns x, y for assertion.
+// :: latestChange: Negative list indexes in assignments count from the end.
 // :: filename: pkg/interpreter/lvalue_test.go
 // :: serialization: go
 
@@ -99,7 +99,7 @@ func TestLValueAssignments(t *testing.T) {
 			t.Fatalf("Expected a map result, got %T", result)
 		}
 
-		port, _ := resultMap.Value["port"].(lang.NumberValue)
+		port, _ := resultMap.Value["port"].(lang.IntValue)
 		if port.Value != 8080 {
 			t.Errorf("Expected port 8080, got %v", port.Value)
 		}
@@ -137,6 +137,43 @@ func TestLValueAssignments(t *testing.T) {
 		}
 	})
 
+	t.Run("Negative list indexes count from the end", func(t *testing.T) {
+		h := NewTestHarness(t)
+		script := `
+			func main() means
+				set xs = [1, 2, 3]
+				set xs[-1] = 30
+				set xs[-3] = 10
+				set m = {"l": [1, 2]}
+				set m["l"][-1] = 20
+				return [xs, m["l"]]
+			endfunc
+		`
+		result, err := h.Interpreter.ExecuteScriptString("main", script, nil)
+		if err != nil {
+			t.Fatalf("Script execution failed: %v", err)
+		}
+		got, _ := lang.Unwrap(result).([]interface{})
+		want := []interface{}{
+			[]interface{}{int64(10), int64(2), int64(30)},
+			[]interface{}{int64(1), int64(20)},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+
+		script = `
+			func main() means
+				set ys = [1]
+				set ys[-2] = 0
+			endfunc
+		`
+		_, err = h.Interpreter.ExecuteScriptString("main", script, nil)
+		if err == nil || !strings.Contains(err.Error(), "out of bounds for length 1") {
+			t.Errorf("Expected an out of bounds error, got: %v", err)
+		}
+	})
+
 	t.Run("Error on multi-assignment count mismatch", func(t *testing.T) {
 		h := NewTestHarness(t)
 		script := `
@@ -190,7 +227,7 @@ func TestLValueAssignments(t *testing.T) {
 		y := resList.Value[1]
 
 		expectedX := lang.StringValue{Value: "a"}
-		expectedY := lang.NumberValue{Value: 10}
+		expectedY := lang.IntValue{Value: 10}
 
 		if !reflect.DeepEqual(x, expectedX) {
 			t.Errorf("Variable 'x' mismatch. Got: %#v, Want: %#v", x, expectedX)
@@ -0,0 +1,146 @@
+// NeuroScript Version: 0.8.0
+// File version: 2
+// Purpose: Tests named call arguments and optional parameter defaults for procedures and tools.
+// filename: pkg/interpreter/named_args_test.go
+// nlines: 146
+// risk_rating: LOW
+
+package interpreter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/tool"
+)
+
+const namedArgsScript = `
+func describe(needs item optional limit = 10, label = "item", flag returns out) means
+	return item + ":" + limit + ":" + label + ":" + flag
+endfunc
+
+func by_name(returns out) means
+	return describe("a", label: "thing")
+endfunc
+
+func all_by_name(returns out) means
+	return describe(flag: true, item: "b", limit: 3)
+endfunc
+
+func defaults_only(returns out) means
+	return describe("c")
+endfunc
+
+func unknown_name(returns out) means
+	return describe("d", colour: "red")
+endfunc
+
+func twice(returns out) means
+	return describe("e", item: "f")
+endfunc
+
+func several_bad(returns out) means
+	return describe("g", zeta: 1, alpha: 2, item: "h")
+endfunc
+
+func builtin_named(returns out) means
+	return len(value: "abc")
+endfunc
+
+func tool_named(returns out) means
+	return tool.demo.join("x", sep: "+")
+endfunc
+
+func tool_default(returns out) means
+	return tool.demo.join("x")
+endfunc
+
+func tool_unknown(returns out) means
+	return tool.demo.join("x", glue: "+")
+endfunc
+`
+
+// registerJoinTool registers tool.demo.join(a, b, sep) where b defaults to
+// "y" and sep to "-".
+func registerJoinTool(t *testing.T, interp *Interpreter) {
+	t.Helper()
+	_, err := interp.ToolRegistry().RegisterTool(tool.ToolImplementation{
+		Spec: tool.ToolSpec{
+			Name:  "join",
+			Group: "demo",
+			Args: []tool.ArgSpec{
+				{Name: "a", Type: tool.ArgTypeString, Required: true},
+				{Name: "b", Type: tool.ArgTypeString, DefaultValue: "y"},
+				{Name: "sep", Type: tool.ArgTypeString, DefaultValue: "-"},
+			},
+			ReturnType: tool.ArgTypeString,
+		},
+		Func: func(_ tool.Runtime, args []interface{}) (interface{}, error) {
+			return fmt.Sprintf("%v%v%v", args[0], args[2], args[1]), nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("register tool.demo.join: %v", err)
+	}
+}
+
+func TestNamedArgs_ProceduresAndTools(t *testing.T) {
+	cases := []struct {
+		proc    string
+		want    string
+		wantErr string
+	}{
+		{proc: "by_name", want: "a:10:thing:"},
+		{proc: "all_by_name", want: "b:3:item:true"},
+		{proc: "defaults_only", want: "c:10:item:"},
+		{proc: "unknown_name", wantErr: "procedure 'describe' has no parameter 'colour'"},
+		{proc: "twice", wantErr: "argument 'item' given more than once in call to 'describe'"},
+		{proc: "builtin_named", wantErr: "function 'len' does not accept named arguments"},
+		{proc: "tool_named", want: "x+y"},
+		{proc: "tool_default", want: "x-y"},
+		{proc: "tool_unknown", wantErr: "tool 'tool.demo.join' has no argument 'glue'"},
+	}
+	modes := map[string][]InterpreterOption{"compiled": nil, "treewalk": {WithTreeWalker()}}
+	for modeName, opts := range modes {
+		interp := newScriptInterpreter(t, namedArgsScript, opts...)
+		registerJoinTool(t, interp)
+		for _, c := range cases {
+			t.Run(modeName+"/"+c.proc, func(t *testing.T) {
+				got, err := interp.RunProcedure(c.proc)
+				if c.wantErr != "" {
+					if err == nil || !strings.Contains(err.Error(), c.wantErr) {
+						t.Fatalf("expected error containing %q, got %v", c.wantErr, err)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if s, _ := lang.ToString(got); s != c.want {
+					t.Errorf("got %q, want %q", s, c.want)
+				}
+			})
+		}
+	}
+}
+
+func TestNamedArgs_ProcedureErrorsFollowSourceOrder(t *testing.T) {
+	for _, opts := range [][]InterpreterOption{nil, {WithTreeWalker()}} {
+		interp := newScriptInterpreter(t, namedArgsScript, opts...)
+		// The error names and points at the first bad argument in the source;
+		// binding from a map made the reported name vary from run to run.
+		for run := 0; run < 20; run++ {
+			_, err := interp.RunProcedure("several_bad")
+			var rtErr *lang.RuntimeError
+			if !errors.As(err, &rtErr) || !strings.Contains(err.Error(), "has no parameter 'zeta'") {
+				t.Fatalf("run %d: expected the first bad name 'zeta' to be reported, got %v", run, err)
+			}
+			if rtErr.Position == nil || rtErr.Position.Line != 27 || rtErr.Position.Column != 23 {
+				t.Fatalf("run %d: expected the error at zeta (27:23), got %v", run, rtErr.Position)
+			}
+		}
+	}
+}
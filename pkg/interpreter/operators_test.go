@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.8.0
-// File version: 9.0.0
-// Purpose: Corrected the 'Concat with nil' test case to expect an empty string, aligning with the user's preference.
+// File version: 12.0.0
+// Purpose: Integer '/' is true division, exact results stay integers; adds promotion, overflow and exact mixed comparison cases.
 // filename: pkg/interpreter/operators_test.go
-// nlines: 191
+// nlines: 259
 // risk_rating: LOW
 
 package interpreter_test
@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/tool"
 )
 
 // runOperatorTest is a helper for this file, using the TestHarness to run a single expression.
@@ -41,11 +42,23 @@ func TestPerformArithmetic(t *testing.T) {
 		wantErr  error
 	}{
 		// Standard Arithmetic
-		{"Subtract", "10 - 4", lang.NumberValue{Value: 6}, nil},
-		{"Multiply", "5 * 3", lang.NumberValue{Value: 15}, nil},
-		{"Divide", "20 / 4", lang.NumberValue{Value: 5}, nil},
-		{"Power", "2 ** 3", lang.NumberValue{Value: 8}, nil},
-		{"Modulo", "10 % 3", lang.NumberValue{Value: 1}, nil},
+		{"Subtract", "10 - 4", lang.IntValue{Value: 6}, nil},
+		{"Multiply", "5 * 3", lang.IntValue{Value: 15}, nil},
+		{"Divide", "20 / 4", lang.IntValue{Value: 5}, nil},
+		{"Power", "2 ** 3", lang.IntValue{Value: 8}, nil},
+		{"Modulo", "10 % 3", lang.IntValue{Value: 1}, nil},
+
+		// Integer semantics and promotion
+		{"Inexact integer division is a float", "7 / 2", lang.NumberValue{Value: 3.5}, nil},
+		{"Inexact negative integer division", "-7 / 2", lang.NumberValue{Value: -3.5}, nil},
+		{"Inexact division of 20 by 3", "20 / 3", lang.NumberValue{Value: 20.0 / 3}, nil},
+		{"Exact integer division stays an integer", "9007199254740993 / 1", lang.IntValue{Value: 9007199254740993}, nil},
+		{"Modulo takes sign of dividend", "-7 % 3", lang.IntValue{Value: -1}, nil},
+		{"Float division", "7.0 / 2", lang.NumberValue{Value: 3.5}, nil},
+		{"Mixed operands promote to float", "3 * 0.5", lang.NumberValue{Value: 1.5}, nil},
+		{"Negative exponent is a float", "2 ** (0 - 1)", lang.NumberValue{Value: 0.5}, nil},
+		{"Exact above 2^53", "9007199254740993 - 0", lang.IntValue{Value: 9007199254740993}, nil},
+		{"Max int", "9223372036854775806 + 1", lang.IntValue{Value: 9223372036854775807}, nil},
 
 		// String Repetition
 		{"String repetition", `"go" * 3`, lang.StringValue{Value: "gogogo"}, nil},
@@ -54,6 +67,10 @@ func TestPerformArithmetic(t *testing.T) {
 
 		// Error Cases
 		{"Division by zero", "10 / 0", nil, lang.ErrDivisionByZero},
+		{"Modulo by zero", "10 % 0", nil, lang.ErrDivisionByZero},
+		{"Addition overflow", "9223372036854775807 + 1", nil, lang.ErrIntegerOverflow},
+		{"Multiplication overflow", "4294967296 * 4294967296", nil, lang.ErrIntegerOverflow},
+		{"Power overflow", "2 ** 63", nil, lang.ErrIntegerOverflow},
 		{"Invalid type", `"a" - 1`, nil, lang.ErrInvalidOperandType}, // Changed from '*' to '-' to keep test valid
 	}
 
@@ -90,7 +107,8 @@ func TestPerformStringConcatOrNumericAdd(t *testing.T) {
 		scriptOp string
 		want     lang.Value
 	}{
-		{"Add numbers", "5 + 10", lang.NumberValue{Value: 15}},
+		{"Add numbers", "5 + 10", lang.IntValue{Value: 15}},
+		{"Add int and float", "5 + 0.5", lang.NumberValue{Value: 5.5}},
 		{"Concat strings", `"hello " + "world"`, lang.StringValue{Value: "hello world"}},
 		{"Concat string and number", `"age: " + 30`, lang.StringValue{Value: "age: 30"}},
 		{"Concat number and string", `30 + " years"`, lang.StringValue{Value: "30 years"}},
@@ -167,9 +185,9 @@ func TestPerformBitwise(t *testing.T) {
 		want     lang.Value
 		wantErr  error
 	}{
-		{"AND", "5 & 3", lang.NumberValue{Value: 1}, nil}, // 101 & 011 = 001
-		{"OR", "5 | 3", lang.NumberValue{Value: 7}, nil},  // 101 | 011 = 111
-		{"XOR", "5 ^ 3", lang.NumberValue{Value: 6}, nil}, // 101 ^ 011 = 110
+		{"AND", "5 & 3", lang.IntValue{Value: 1}, nil}, // 101 & 011 = 001
+		{"OR", "5 | 3", lang.IntValue{Value: 7}, nil},  // 101 | 011 = 111
+		{"XOR", "5 ^ 3", lang.IntValue{Value: 6}, nil}, // 101 ^ 011 = 110
 		{"Invalid type (float)", "5.5 & 3", nil, lang.ErrInvalidOperandTypeInteger},
 		{"Invalid type (string)", `"a" | 3`, nil, lang.ErrInvalidOperandTypeInteger},
 	}
@@ -200,3 +218,42 @@ func TestPerformBitwise(t *testing.T) {
 		})
 	}
 }
+
+func TestLogicalOperators_ShortCircuit(t *testing.T) {
+	h := NewTestHarness(t)
+	calls := 0
+	touch := tool.ToolImplementation{
+		Spec: tool.ToolSpec{Name: "Touch", Group: "testing.shortcircuit", ReturnType: "bool"},
+		Func: func(rt tool.Runtime, args []interface{}) (interface{}, error) {
+			calls++
+			return true, nil
+		},
+	}
+	if _, err := h.Interpreter.ToolRegistry().RegisterTool(touch); err != nil {
+		t.Fatalf("Failed to register tool: %v", err)
+	}
+
+	script := `
+func main(returns result) means
+	set m = nil
+	set guarded = m != nil and m["k"] == 1
+	set skippedAnd = false and tool.testing.shortcircuit.Touch()
+	set skippedOr = true or tool.testing.shortcircuit.Touch()
+	set calledAnd = true and tool.testing.shortcircuit.Touch()
+	return [guarded, skippedAnd, skippedOr, calledAnd]
+endfunc`
+	got, err := h.Interpreter.ExecuteScriptString("main", script, nil)
+	if err != nil {
+		t.Fatalf("Script failed: %v", err)
+	}
+	want := lang.ListValue{Value: []lang.Value{
+		lang.BoolValue{Value: false}, lang.BoolValue{Value: false},
+		lang.BoolValue{Value: true}, lang.BoolValue{Value: true},
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected result: %#v, got: %#v", want, got)
+	}
+	if calls != 1 {
+		t.Errorf("Touch called %d times, want 1 (only the non-skipped operand)", calls)
+	}
+}
@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 22
+// :: fileVersion: 27
 // :: description: Adds WithAllowRedefinition to supported options.
-// :: latestChange: Added WithAllowRedefinition to allow symbol shadowing/overwriting.
+// :: latestChange: Removed treeWalkerDefault; tests pick the execution path per interpreter.
 // :: filename: pkg/interpreter/options.go
 // :: serialization: go
 
@@ -66,6 +66,18 @@ func WithAITranscriptWriter(w io.Writer) InterpreterOption {
 	}
 }
 
+// WithSecretKey supplies the private key used to decrypt secrets of the given
+// encryption type ("age" or "sealedbox"). Keys stay with the host; scripts can
+// only obtain the decrypted values, never the key.
+func WithSecretKey(enc string, key []byte) InterpreterOption {
+	return func(i *Interpreter) {
+		if i.secretKeys == nil {
+			i.secretKeys = make(map[string][]byte)
+		}
+		i.secretKeys[enc] = append([]byte(nil), key...)
+	}
+}
+
 // WithoutStandardTools is an option that prevents the automatic registration
 // of the standard tool library.
 func WithoutStandardTools() InterpreterOption {
@@ -74,6 +86,23 @@ func WithoutStandardTools() InterpreterOption {
 	}
 }
 
+// WithTreeWalker makes the interpreter execute the AST directly instead of
+// compiling step lists first. It exists to compare the two paths and as a
+// fallback should the compiled path ever misbehave.
+func WithTreeWalker() InterpreterOption {
+	return func(i *Interpreter) {
+		i.state.treeWalker = true
+	}
+}
+
+// WithStrictVariables makes reading a variable that was never set a runtime
+// error instead of yielding nil. A script can also opt in with ':: strict: true'.
+func WithStrictVariables() InterpreterOption {
+	return func(i *Interpreter) {
+		i.state.strictVariables = true
+	}
+}
+
 func WithSandboxDir(path string) InterpreterOption {
 	return func(i *Interpreter) {
 		i.SetSandboxDir(path)
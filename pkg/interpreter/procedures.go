@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 53
+// :: fileVersion: 58
 // :: description: Fixes bug where optional parameters were not bound to the execution scope.
-// :: latestChange: Iterate over proc.OptionalParams and bind arguments or fallback to nil.
+// :: latestChange: A procedure's frame is strict when its file is.
 // :: filename: pkg/interpreter/procedures.go
 // :: serialization: go
 
@@ -20,6 +20,12 @@ const maxCallDepth = 500 // Prevents stack overflow
 // runProcedure executes a defined procedure with the given arguments.
 // This is the internal implementation.
 func (i *Interpreter) runProcedure(procName string, args ...lang.Value) (lang.Value, error) {
+	return i.runProcedureNamed(procName, args, nil, nil)
+}
+
+// runProcedureNamed runs a procedure with positional arguments followed by
+// arguments bound by parameter name; named holds the values of namedArgs.
+func (i *Interpreter) runProcedureNamed(procName string, args []lang.Value, namedArgs []*ast.NamedArgNode, named []lang.Value) (lang.Value, error) {
 	// FIX: Implement provider-aware procedure lookup, just like GetVariable.
 	// 1. Check local procedures
 	proc, exists := i.state.knownProcedures[procName]
@@ -55,32 +61,91 @@ func (i *Interpreter) runProcedure(procName string, args ...lang.Value) (lang.Va
 		)
 	}
 
-	procInterpreter := i.fork() // Use fork() to create the sandboxed environment
+	procInterpreter := i.newFrame() // A lightweight scope frame; see frame.go
 	procInterpreter.state.currentProcName = procName
+	procInterpreter.state.strict = proc.Strict
 	procInterpreter.state.stackFrames = append(i.state.stackFrames, procName)
 
 	if len(proc.ErrorHandlers) > 0 {
 		procInterpreter.state.errorHandlerStack = append(procInterpreter.state.errorHandlerStack, proc.ErrorHandlers)
 	}
 
-	for idx, paramName := range proc.RequiredParams {
-		if idx < len(args) {
-			procInterpreter.SetVariable(paramName, args[idx])
-		} else {
-			procInterpreter.SetVariable(paramName, &lang.NilValue{})
-		}
+	bindings, err := bindProcedureArgs(proc, args, namedArgs, named)
+	if err != nil {
+		return nil, err
 	}
 
-	for idx, optParam := range proc.OptionalParams {
-		argIdx := len(proc.RequiredParams) + idx
-		if argIdx < len(args) {
-			procInterpreter.SetVariable(optParam.Name, args[argIdx])
-		} else {
-			procInterpreter.SetVariable(optParam.Name, &lang.NilValue{})
+	// On the compiled path the frame adopts the body's slot layout before the
+	// parameters are bound, so they land in their slots.
+	var body *compiledBlock
+	if !i.rootInterpreter().state.treeWalker {
+		body = procInterpreter.compiled(proc.Steps)
+		if body.layout != nil {
+			procInterpreter.state.useLayout(body.layout)
 		}
 	}
+	for idx, paramName := range proc.RequiredParams {
+		procInterpreter.SetVariable(paramName, bindings[idx])
+	}
+	for idx, optParam := range proc.OptionalParams {
+		procInterpreter.SetVariable(optParam.Name, bindings[len(proc.RequiredParams)+idx])
+	}
 
+	if body != nil {
+		result, _, _, err := procInterpreter.runCompiled(body, false, nil)
+		return result, err
+	}
 	result, _, _, err := procInterpreter.executeSteps(proc.Steps, false, nil)
-
 	return result, err
 }
+
+// bindProcedureArgs returns one value per parameter, required then optional.
+// Named arguments are bound in source order, so the first bad one is the one
+// reported. Unfilled required parameters are nil, as before named arguments
+// existed; unfilled optional ones take their declared default.
+func bindProcedureArgs(proc *ast.Procedure, args []lang.Value, namedArgs []*ast.NamedArgNode, named []lang.Value) ([]lang.Value, error) {
+	numRequired := len(proc.RequiredParams)
+	bindings := make([]lang.Value, numRequired+len(proc.OptionalParams))
+	copy(bindings, args)
+	bound := make([]bool, len(bindings))
+	for idx := range args {
+		if idx < len(bound) {
+			bound[idx] = true
+		}
+	}
+
+	for n, na := range namedArgs {
+		name := na.Name
+		idx := -1
+		for pIdx, paramName := range proc.RequiredParams {
+			if paramName == name {
+				idx = pIdx
+			}
+		}
+		for pIdx, optParam := range proc.OptionalParams {
+			if optParam.Name == name {
+				idx = numRequired + pIdx
+			}
+		}
+		if idx < 0 {
+			return nil, lang.NewRuntimeError(lang.ErrorCodeArgMismatch, fmt.Sprintf("procedure '%s' has no parameter '%s'", proc.Name(), name), lang.ErrArgumentMismatch).WithPosition(na.GetPos())
+		}
+		if bound[idx] {
+			return nil, lang.NewRuntimeError(lang.ErrorCodeArgMismatch, fmt.Sprintf("argument '%s' given more than once in call to '%s'", name, proc.Name()), lang.ErrArgumentMismatch).WithPosition(na.GetPos())
+		}
+		bindings[idx] = named[n]
+		bound[idx] = true
+	}
+
+	for idx := range bindings {
+		if bindings[idx] != nil {
+			continue
+		}
+		if idx >= numRequired && proc.OptionalParams[idx-numRequired].Default != nil {
+			bindings[idx] = proc.OptionalParams[idx-numRequired].Default
+		} else {
+			bindings[idx] = &lang.NilValue{}
+		}
+	}
+	return bindings, nil
+}
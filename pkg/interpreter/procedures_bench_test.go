@@ -0,0 +1,178 @@
+// NeuroScript Version: 0.8.0
+// File version: 2
+// Purpose: Benchmarks procedure call overhead and checks that calls do not grow root-held state.
+// filename: pkg/interpreter/procedures_bench_test.go
+// nlines: 179
+// risk_rating: LOW
+
+package interpreter
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/interfaces"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/logging"
+	"github.com/aprice2704/neuroscript/pkg/policy"
+)
+
+const callBenchScript = `
+func inc(needs n returns r) means
+	return n + 1
+endfunc
+
+func countdown(needs n returns r) means
+	if n <= 0
+		return 0
+	endif
+	return countdown(n - 1)
+endfunc
+
+on event "bench:tick" do
+	set x = 1
+endon
+`
+
+// newCallBenchInterpreter builds a quiet interpreter with callBenchScript loaded.
+func newCallBenchInterpreter(tb testing.TB) *Interpreter {
+	tb.Helper()
+	return newScriptInterpreter(tb, callBenchScript)
+}
+
+// newScriptInterpreter builds a quiet interpreter with script loaded.
+func newScriptInterpreter(tb testing.TB, script string, opts ...InterpreterOption) *Interpreter {
+	tb.Helper()
+	hostCtx := &HostContext{
+		Logger:      logging.NewNoOpLogger(),
+		Stdout:      io.Discard,
+		Stderr:      io.Discard,
+		EmitFunc:    func(lang.Value) {},
+		WhisperFunc: func(lang.Value, lang.Value) {},
+	}
+	opts = append([]InterpreterOption{
+		WithHostContext(hostCtx),
+		WithExecPolicy(policy.NewBuilder(policy.ContextNormal).Allow("*").Build()),
+	}, opts...)
+	interp := NewInterpreter(opts...)
+	tree, err := interp.Parser().Parse(script)
+	if err != nil {
+		tb.Fatalf("parse: %v", err)
+	}
+	program, _, err := interp.ASTBuilder().Build(tree)
+	if err != nil {
+		tb.Fatalf("build: %v", err)
+	}
+	if err := interp.Load(&interfaces.Tree{Root: program}); err != nil {
+		tb.Fatalf("load: %v", err)
+	}
+	return interp
+}
+
+// heapInUse returns the live heap after a forced collection.
+func heapInUse() uint64 {
+	runtime.GC()
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+	return ms.HeapAlloc
+}
+
+// reportRetained reports how many heap bytes per operation survived a GC,
+// which is what a long-running host would leak.
+func reportRetained(b *testing.B, before uint64) {
+	after := heapInUse()
+	retained := 0.0
+	if after > before {
+		retained = float64(after-before) / float64(b.N)
+	}
+	b.ReportMetric(retained, "retained-B/op")
+}
+
+func TestProcedureCalls_DoNotGrowCloneRegistry(t *testing.T) {
+	interp := newCallBenchInterpreter(t)
+
+	for n := 0; n < 200; n++ {
+		if _, err := interp.RunProcedure("inc", lang.NumberValue{Value: float64(n)}); err != nil {
+			t.Fatalf("inc failed: %v", err)
+		}
+		interp.EmitEvent("bench:tick", "test", nil)
+	}
+	if _, err := interp.RunProcedure("countdown", lang.NumberValue{Value: 100}); err != nil {
+		t.Fatalf("countdown failed: %v", err)
+	}
+
+	if got := len(interp.cloneRegistry); got != 0 {
+		t.Errorf("procedure calls and event handlers registered %d clones, want 0", got)
+	}
+
+	for n := 0; n < 2*maxCloneRegistry; n++ {
+		interp.fork()
+	}
+	if got := len(interp.cloneRegistry); got != maxCloneRegistry {
+		t.Errorf("clone registry holds %d forks, want it bounded at %d", got, maxCloneRegistry)
+	}
+}
+
+func BenchmarkProcedureCall(b *testing.B) {
+	interp := newCallBenchInterpreter(b)
+	arg := lang.NumberValue{Value: 1}
+	before := heapInUse()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if _, err := interp.RunProcedure("inc", arg); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	reportRetained(b, before)
+}
+
+func BenchmarkRecursiveProcedure(b *testing.B) {
+	for _, depth := range []int{10, 100} {
+		b.Run(fmt.Sprintf("depth=%d", depth), func(b *testing.B) {
+			interp := newCallBenchInterpreter(b)
+			arg := lang.NumberValue{Value: float64(depth)}
+			before := heapInUse()
+			b.ReportAllocs()
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				if _, err := interp.RunProcedure("countdown", arg); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.StopTimer()
+			reportRetained(b, before)
+		})
+	}
+}
+
+func BenchmarkEventHandler(b *testing.B) {
+	interp := newCallBenchInterpreter(b)
+	before := heapInUse()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		interp.EmitEvent("bench:tick", "bench", nil)
+	}
+	b.StopTimer()
+	reportRetained(b, before)
+}
+
+func BenchmarkFork(b *testing.B) {
+	interp := newCallBenchInterpreter(b)
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		interp.fork()
+	}
+}
+
+func BenchmarkFrame(b *testing.B) {
+	interp := newCallBenchInterpreter(b)
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		interp.newFrame()
+	}
+}
@@ -0,0 +1,149 @@
+// NeuroScript Version: 0.8.0
+// File version: 2
+// Purpose: Tests 'raise event': delivery to handlers, the recorded source, the bus grant and the depth guard.
+// filename: pkg/interpreter/raise_event_test.go
+// nlines: 149
+// risk_rating: LOW
+
+package interpreter
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/policy"
+)
+
+const raiseScript = `
+on event "orders.created" as ev do
+	emit "handled " + ev.payload[0].Payload.id + " from " + ev.payload[0].AgentID
+endon
+
+on event "loop.ping" do
+	emit "ping"
+	raise event "loop.ping"
+endon
+
+func place_order() means
+	raise event "orders.created" with {"id": "A1"}
+	emit "after"
+endfunc
+
+func nobody_listens() means
+	raise event "orders.cancelled" with {"id": "A2"}
+endfunc
+
+func charge() means
+	emit "payload evaluated"
+	return {"id": "A3"}
+endfunc
+
+func not_granted() means
+	raise event "billing.charged" with charge()
+endfunc
+
+func bad_payload() means
+	raise event "orders.created" with [1, 2]
+endfunc
+
+func bad_name() means
+	raise event 42
+endfunc
+
+func start_loop() means
+	raise event "loop.ping"
+endfunc
+`
+
+// newRaiseInterpreter loads raiseScript with grants for the orders and loop
+// events only, capturing emits and handler errors.
+func newRaiseInterpreter(t *testing.T, opts ...InterpreterOption) (*Interpreter, *[]string, *[]error) {
+	t.Helper()
+	var emitted []string
+	var handlerErrs []error
+	pol := policy.NewBuilder(policy.ContextNormal).
+		Allow("*").
+		Grant("bus:write:orders.*").
+		Grant("bus:write:loop.*").
+		Build()
+	interp := newScriptInterpreter(t, raiseScript, append(opts, WithExecPolicy(pol))...)
+	interp.hostContext.EmitFunc = func(v lang.Value) {
+		s, _ := lang.ToString(v)
+		emitted = append(emitted, s)
+	}
+	interp.hostContext.EventHandlerErrorCallback = func(eventName, source string, err *lang.RuntimeError) {
+		handlerErrs = append(handlerErrs, err)
+	}
+	return interp, &emitted, &handlerErrs
+}
+
+func TestRaiseEvent(t *testing.T) {
+	modes := map[string][]InterpreterOption{"compiled": nil, "treewalk": {WithTreeWalker()}}
+	for modeName, opts := range modes {
+		t.Run(modeName+"/delivers before the next statement", func(t *testing.T) {
+			interp, emitted, _ := newRaiseInterpreter(t, opts...)
+			if _, err := interp.RunProcedure("place_order"); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			want := []string{"handled A1 from place_order", "after"}
+			if strings.Join(*emitted, "|") != strings.Join(want, "|") {
+				t.Errorf("got emits %q, want %q", *emitted, want)
+			}
+		})
+
+		t.Run(modeName+"/no handlers", func(t *testing.T) {
+			interp, emitted, _ := newRaiseInterpreter(t, opts...)
+			if _, err := interp.RunProcedure("nobody_listens"); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(*emitted) != 0 {
+				t.Errorf("expected no emits, got %q", *emitted)
+			}
+		})
+
+		t.Run(modeName+"/requires the bus grant", func(t *testing.T) {
+			interp, emitted, _ := newRaiseInterpreter(t, opts...)
+			_, err := interp.RunProcedure("not_granted")
+			if !errors.Is(err, policy.ErrCapability) {
+				t.Fatalf("expected ErrCapability, got %v", err)
+			}
+			if len(*emitted) != 0 {
+				t.Errorf("the payload should not be evaluated without the grant, got emits %q", *emitted)
+			}
+			if !strings.Contains(err.Error(), "bus:write:billing.charged") {
+				t.Errorf("error should name the missing grant, got %v", err)
+			}
+		})
+
+		t.Run(modeName+"/payload must be a map", func(t *testing.T) {
+			interp, _, _ := newRaiseInterpreter(t, opts...)
+			_, err := interp.RunProcedure("bad_payload")
+			if !errors.Is(err, lang.ErrInvalidArgument) || !strings.Contains(err.Error(), "payload must be a map") {
+				t.Fatalf("expected a payload type error, got %v", err)
+			}
+		})
+
+		t.Run(modeName+"/name must be a string", func(t *testing.T) {
+			interp, _, _ := newRaiseInterpreter(t, opts...)
+			_, err := interp.RunProcedure("bad_name")
+			if !errors.Is(err, lang.ErrInvalidArgument) || !strings.Contains(err.Error(), "event name must be") {
+				t.Fatalf("expected an event name type error, got %v", err)
+			}
+		})
+
+		t.Run(modeName+"/handler loops stop at the depth limit", func(t *testing.T) {
+			interp, emitted, handlerErrs := newRaiseInterpreter(t, opts...)
+			if _, err := interp.RunProcedure("start_loop"); err != nil {
+				t.Fatalf("the raiser should not see handler errors, got %v", err)
+			}
+			if len(*emitted) != maxEventDepth {
+				t.Errorf("expected %d handler runs, got %d", maxEventDepth, len(*emitted))
+			}
+			if len(*handlerErrs) != 1 || !errors.Is((*handlerErrs)[0], lang.ErrMaxEventDepthExceeded) {
+				t.Fatalf("expected one ErrMaxEventDepthExceeded handler error, got %v", *handlerErrs)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+// :: product: FDM/NS
+// :: majorVersion: 1
+// :: fileVersion: 4
+// :: description: Decrypts SecretRefs with host-supplied keys and scrubs revealed values from emit, transcript, whispers, errors and logs.
+// :: latestChange: Added RedactSecrets so hosts can scrub values returned by the script.
+// :: filename: pkg/interpreter/secrets.go
+// :: serialization: go
+
+package interpreter
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/interfaces"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/secret"
+)
+
+// DecodeSecret decrypts ref with the key the host registered for ref.Enc and
+// records the plaintext so that it is redacted wherever the interpreter
+// writes to the outside world.
+func (i *Interpreter) DecodeSecret(ref *ast.SecretRef) (string, error) {
+	if ref == nil {
+		return "", lang.NewRuntimeError(lang.ErrorCodeSecretDecryption, "cannot decode a nil secret reference", nil)
+	}
+	if i.secrets == nil {
+		// Without a redactor the value could leak, so refuse to reveal it.
+		return "", lang.NewRuntimeError(lang.ErrorCodeInternal, "interpreter has no secret redactor", nil)
+	}
+	var key []byte
+	if ref.Enc != secret.EncNone {
+		k, ok := i.secretKeys[ref.Enc]
+		if !ok {
+			return "", lang.NewRuntimeError(lang.ErrorCodeSecretDecryption,
+				fmt.Sprintf("no %q key configured for secret %q", ref.Enc, ref.Path), secret.ErrSecretKey)
+		}
+		key = k
+	}
+	plain, err := secret.Decode(ref, key)
+	if err != nil {
+		code := lang.ErrorCodeSecretDecryption
+		if errors.Is(err, secret.ErrSecretUnsupported) {
+			code = lang.ErrorCodeInvalidValue
+		}
+		return "", lang.NewRuntimeError(code, fmt.Sprintf("cannot decode secret %q", ref.Path), err)
+	}
+	i.secrets.Add(plain)
+	return plain, nil
+}
+
+// redactValue replaces a value whose string form contains a revealed secret
+// with the redacted string form. Other values pass through unchanged.
+func (i *Interpreter) redactValue(v lang.Value) lang.Value {
+	r := i.secrets
+	if v == nil || r.Empty() {
+		return v
+	}
+	s := v.String()
+	if red := r.Redact(s); red != s {
+		return lang.StringValue{Value: red}
+	}
+	return v
+}
+
+// RedactSecrets scrubs every value revealed by tool.secret.Decode from s.
+// Hosts use it before printing or logging values the script returned.
+func (i *Interpreter) RedactSecrets(s string) string {
+	return i.redactString(s)
+}
+
+// redactString scrubs revealed secrets from s.
+func (i *Interpreter) redactString(s string) string {
+	return i.secrets.Redact(s)
+}
+
+// redactRuntimeError scrubs revealed secrets from the message and wrapped
+// error of rtErr, so that errors handed back to the host or reported for
+// event handlers do not carry them.
+func (i *Interpreter) redactRuntimeError(rtErr *lang.RuntimeError) *lang.RuntimeError {
+	if rtErr == nil || i.secrets.Empty() {
+		return rtErr
+	}
+	rtErr.Message = i.redactString(rtErr.Message)
+	rtErr.Wrapped = secret.RedactError(rtErr.Wrapped, i.redactString)
+	return rtErr
+}
+
+// redactingLogger scrubs revealed secrets from messages and string-like
+// arguments before they reach the host logger.
+type redactingLogger struct {
+	interfaces.Logger
+	r *secret.Redactor
+}
+
+func (l redactingLogger) args(args []any) []any {
+	out := make([]any, len(args))
+	for j, a := range args {
+		switch v := a.(type) {
+		case string:
+			out[j] = l.r.Redact(v)
+		case error:
+			out[j] = l.r.Redact(v.Error())
+		case fmt.Stringer:
+			out[j] = l.r.Redact(v.String())
+		default:
+			out[j] = a
+		}
+	}
+	return out
+}
+
+func (l redactingLogger) Debug(msg string, args ...any) {
+	l.Logger.Debug(l.r.Redact(msg), l.args(args)...)
+}
+func (l redactingLogger) Info(msg string, args ...any) {
+	l.Logger.Info(l.r.Redact(msg), l.args(args)...)
+}
+func (l redactingLogger) Warn(msg string, args ...any) {
+	l.Logger.Warn(l.r.Redact(msg), l.args(args)...)
+}
+func (l redactingLogger) Error(msg string, args ...any) {
+	l.Logger.Error(l.r.Redact(msg), l.args(args)...)
+}
+func (l redactingLogger) Debugf(format string, args ...any) {
+	l.Logger.Debugf("%s", l.r.Redact(fmt.Sprintf(format, args...)))
+}
+func (l redactingLogger) Infof(format string, args ...any) {
+	l.Logger.Infof("%s", l.r.Redact(fmt.Sprintf(format, args...)))
+}
+func (l redactingLogger) Warnf(format string, args ...any) {
+	l.Logger.Warnf("%s", l.r.Redact(fmt.Sprintf(format, args...)))
+}
+func (l redactingLogger) Errorf(format string, args ...any) {
+	l.Logger.Errorf("%s", l.r.Redact(fmt.Sprintf(format, args...)))
+}
@@ -0,0 +1,150 @@
+// :: product: FDM/NS
+// :: majorVersion: 1
+// :: fileVersion: 3
+// :: description: Tests that revealed secrets are scrubbed from logs, whispers, errors, ask prompts and the AI transcript.
+// :: latestChange: Covers the prompt handed to an AEIOU service and RedactSecrets.
+// :: filename: pkg/interpreter/secrets_test.go
+// :: serialization: go
+
+package interpreter
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/interfaces"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/logging"
+	"github.com/aprice2704/neuroscript/pkg/secret"
+)
+
+// recordingLogger captures formatted log lines.
+type recordingLogger struct {
+	*logging.NoOpLogger
+	lines []string
+}
+
+func (l *recordingLogger) Info(msg string, args ...any) {
+	l.lines = append(l.lines, fmt.Sprint(append([]any{msg}, args...)...))
+}
+
+func (l *recordingLogger) Errorf(format string, args ...any) {
+	l.lines = append(l.lines, fmt.Sprintf(format, args...))
+}
+
+func TestDecodeSecret_RedactsLogs(t *testing.T) {
+	rec := &recordingLogger{NoOpLogger: logging.NewNoOpLogger()}
+	hostCtx := &HostContext{Logger: rec, Stdout: &bytes.Buffer{}, Stdin: &bytes.Buffer{}, Stderr: &bytes.Buffer{}}
+	interp := NewInterpreter(WithHostContext(hostCtx))
+
+	plain, err := interp.DecodeSecret(&ast.SecretRef{Path: "p", Enc: secret.EncNone, Raw: []byte("tok-42")})
+	if err != nil || plain != "tok-42" {
+		t.Fatalf("DecodeSecret() = %q, %v", plain, err)
+	}
+
+	fork := interp.fork()
+	fork.Logger().Info("calling with tok-42", "key", "tok-42", "err", fmt.Errorf("bad tok-42"))
+	fork.Logger().Errorf("value was %s", "tok-42")
+	for _, line := range rec.lines {
+		if strings.Contains(line, "tok-42") {
+			t.Errorf("log line leaked the secret: %q", line)
+		}
+	}
+	if len(rec.lines) != 2 {
+		t.Fatalf("expected 2 log lines, got %d", len(rec.lines))
+	}
+	if got := interp.redactString("prompt tok-42"); got != "prompt "+secret.Redacted {
+		t.Errorf("redactString() = %q", got)
+	}
+}
+
+func TestDecodeSecret_RedactsWhispersAndErrors(t *testing.T) {
+	var whispered []string
+	hostCtx := &HostContext{
+		Logger: logging.NewNoOpLogger(), Stdout: &bytes.Buffer{}, Stdin: &bytes.Buffer{}, Stderr: &bytes.Buffer{},
+		WhisperFunc: func(handle, data lang.Value) {
+			whispered = append(whispered, handle.String(), data.String())
+		},
+	}
+	interp := NewInterpreter(WithHostContext(hostCtx))
+	plain, err := interp.DecodeSecret(&ast.SecretRef{Path: "p", Enc: secret.EncNone, Raw: []byte("tok-42")})
+	if err != nil {
+		t.Fatalf("DecodeSecret() failed: %v", err)
+	}
+	if err := interp.SetVariable("tok", lang.StringValue{Value: "key=" + plain}); err != nil {
+		t.Fatalf("SetVariable() failed: %v", err)
+	}
+
+	step := ast.Step{Type: "whisper", WhisperStmt: &ast.WhisperStmt{
+		Handle: &ast.StringLiteralNode{Value: "chan-tok-42"},
+		Value:  &ast.VariableNode{Name: "tok"},
+	}}
+	if _, err := interp.executeWhisper(step); err != nil {
+		t.Fatalf("executeWhisper() failed: %v", err)
+	}
+	if len(whispered) != 2 {
+		t.Fatalf("expected one whisper, got %v", whispered)
+	}
+	for _, s := range whispered {
+		if strings.Contains(s, "tok-42") {
+			t.Errorf("whisper leaked the secret: %q", s)
+		}
+	}
+
+	wrapped := errors.New("bad token tok-42")
+	rtErr := interp.ensureRuntimeError(lang.NewRuntimeError(lang.ErrorCodeGeneric, "rejected tok-42", wrapped), nil, "test")
+	if msg := rtErr.Error(); strings.Contains(msg, "tok-42") {
+		t.Errorf("runtime error leaked the secret: %q", msg)
+	}
+	if !errors.Is(rtErr, wrapped) {
+		t.Error("redacted runtime error no longer wraps the original error")
+	}
+}
+
+// promptRecorder is an AEIOU orchestrator that records the prompt it is given.
+type promptRecorder struct {
+	prompt string
+}
+
+func (p *promptRecorder) RunAskLoop(_ any, _ string, initialPrompt string) (any, error) {
+	p.prompt = initialPrompt
+	return lang.StringValue{Value: "ok"}, nil
+}
+
+func (p *promptRecorder) ListActiveLoops() []interfaces.ActiveLoopInfo { return nil }
+
+func (p *promptRecorder) CancelLoop(string) error { return nil }
+
+func TestDecodeSecret_RedactsAskPrompt(t *testing.T) {
+	service := &promptRecorder{}
+	hostCtx := &HostContext{
+		Logger: logging.NewNoOpLogger(), Stdout: &bytes.Buffer{}, Stdin: &bytes.Buffer{}, Stderr: &bytes.Buffer{},
+		ServiceRegistry: map[string]any{interfaces.AeiouServiceKey: service},
+	}
+	interp := NewInterpreter(WithHostContext(hostCtx))
+	plain, err := interp.DecodeSecret(&ast.SecretRef{Path: "p", Enc: secret.EncNone, Raw: []byte("tok-42")})
+	if err != nil {
+		t.Fatalf("DecodeSecret() failed: %v", err)
+	}
+	if err := interp.SetVariable("tok", lang.StringValue{Value: "use " + plain}); err != nil {
+		t.Fatalf("SetVariable() failed: %v", err)
+	}
+
+	step := ast.Step{Type: "ask", AskStmt: &ast.AskStmt{
+		AgentModelExpr: &ast.StringLiteralNode{Value: "agent"},
+		PromptExpr:     &ast.VariableNode{Name: "tok"},
+	}}
+	if _, err := interp.executeAsk(step); err != nil {
+		t.Fatalf("executeAsk() failed: %v", err)
+	}
+	if service.prompt != "use "+secret.Redacted {
+		t.Errorf("the AEIOU service received prompt %q, want it scrubbed", service.prompt)
+	}
+	if got := interp.RedactSecrets("result tok-42"); got != "result "+secret.Redacted {
+		t.Errorf("RedactSecrets() = %q", got)
+	}
+}
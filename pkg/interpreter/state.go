@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 17
+// :: fileVersion: 19
 // :: description: Updates GetVariable to safely coerce custom primitive types returned by the host.
-// :: latestChange: Added reflection-based coercion for custom string/int types (like nodes.FieldName) when lang.Wrap fails.
+// :: latestChange: Locals of compiled procedure frames are read from and written to their slots.
 // :: filename: pkg/interpreter/state.go
 // :: serialization: go
 
@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/aprice2704/neuroscript/pkg/eval"
 	"github.com/aprice2704/neuroscript/pkg/interfaces"
 	"github.com/aprice2704/neuroscript/pkg/lang"
 	"github.com/google/generative-ai-go/genai"
@@ -43,10 +44,7 @@ func (i *Interpreter) SetVariable(name string, value lang.Value) error {
 
 	i.state.variablesMu.Lock()
 	defer i.state.variablesMu.Unlock()
-	if i.state.variables == nil {
-		i.state.variables = make(map[string]lang.Value)
-	}
-	i.state.variables[name] = value
+	i.state.storeLocal(name, value)
 	return nil
 }
 
@@ -56,7 +54,7 @@ func (i *Interpreter) SetVariable(name string, value lang.Value) error {
 func (i *Interpreter) GetVariable(name string) (lang.Value, bool) {
 	// 1. Check local scope
 	i.state.variablesMu.RLock()
-	val, exists := i.state.variables[name]
+	val, exists := i.state.loadLocal(name)
 	i.state.variablesMu.RUnlock()
 	if exists {
 		return val, true
@@ -105,7 +103,7 @@ func (i *Interpreter) GetVariable(name string) (lang.Value, bool) {
 					case reflect.String:
 						return lang.StringValue{Value: reflect.ValueOf(valAny).String()}, true
 					case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-						return lang.NumberValue{Value: float64(reflect.ValueOf(valAny).Int())}, true
+						return lang.IntValue{Value: reflect.ValueOf(valAny).Int()}, true
 					case reflect.Float32, reflect.Float64:
 						return lang.NumberValue{Value: reflect.ValueOf(valAny).Float()}, true
 					case reflect.Bool:
@@ -120,6 +118,19 @@ func (i *Interpreter) GetVariable(name string) (lang.Value, bool) {
 	return nil, false
 }
 
+// SlotValue reads a slotted local of a procedure frame. It satisfies
+// eval.SlotRuntime; see compileSteps for how layouts are built.
+func (i *Interpreter) SlotValue(layout *eval.SlotLayout, slot int) (lang.Value, bool) {
+	i.state.variablesMu.RLock()
+	if i.state.layout != layout || !i.state.slotSet[slot] {
+		i.state.variablesMu.RUnlock()
+		return nil, false
+	}
+	val := i.state.slots[slot]
+	i.state.variablesMu.RUnlock()
+	return val, true
+}
+
 // GetVar satisfies the tool.Runtime interface.
 func (i *Interpreter) GetVar(name string) (any, bool) {
 	val, exists := i.GetVariable(name)
@@ -1,13 +1,14 @@
 // NeuroScript Version: 0.8.0
-// File version: 10
-// Purpose: Adds globalConstants map to interpreterState, fixing compile errors.
+// File version: 16
+// Purpose: Adds globalConstants map to interpreterState, fixing compile errors. Holds the root's compile cache. Looks up event handlers by name or '*' pattern and tracks guard counters. Keeps procedure locals in slots.
 // filename: pkg/interpreter/state_2.go
-// nlines: 71
+// nlines: 221
 // risk_rating: MEDIUM
 
 package interpreter
 
 import (
+	"strings"
 	"sync"
 
 	"github.com/aprice2704/neuroscript/pkg/ast"
@@ -23,12 +24,33 @@ type interpreterState struct {
 	commands          []*ast.CommandNode
 	stackFrames       []string
 	currentProcName   string
+	strict            bool // the running code comes from a ':: strict: true' file
 	errorHandlerStack [][]*ast.Step
 	sandboxDir        string
 	vectorIndex       map[string][]float32
 	globalVarNames    map[string]bool
 	globalConstants   map[string]lang.Value // ADDED: For tool-defined global constants
 
+	// --- Slotted Locals (Procedure Frames Only) ---
+	// A frame running a compiled procedure body keeps the names in the
+	// body's layout in slots, guarded by variablesMu; slotSet marks the
+	// slots that hold a value. Other names stay in variables.
+	layout  *eval.SlotLayout
+	slots   []lang.Value
+	slotSet []bool
+
+	// --- Compiled Execution (Root Only) ---
+	// compiled caches the executable form of step lists; treeWalker makes
+	// executeSteps walk the AST directly instead.
+	compiled   map[compileKey]*compiledBlock
+	compiledMu sync.RWMutex
+	treeWalker bool
+
+	// --- Strict Variables (Root Only) ---
+	// strictVariables is set by WithStrictVariables and applies to every
+	// script; a ':: strict: true' header only affects its own file.
+	strictVariables bool
+
 	// --- Provider State (Root Only) ---
 	// REMOVED: providers map and providersMu
 	// This is now handled by the root-level, injected provider.Registry.
@@ -38,6 +60,11 @@ type interpreterState struct {
 type EventManager struct {
 	eventHandlers   map[string][]*ast.OnEventDecl
 	eventHandlersMu sync.RWMutex
+	// patterns lists the registered names ending in '*', in registration
+	// order; guards holds the filter counters of handlers with a 'where'.
+	patterns   []string
+	guards     map[*ast.OnEventDecl]*eventGuardCounter
+	guardOrder []*eventGuardCounter
 }
 
 func newInterpreterState() *interpreterState {
@@ -55,12 +82,61 @@ func newInterpreterState() *interpreterState {
 func (s *interpreterState) setVariable(name string, value lang.Value) {
 	s.variablesMu.Lock()
 	defer s.variablesMu.Unlock()
+	s.storeLocal(name, value)
+}
+
+// useLayout gives the frame one empty slot per name in layout.
+func (s *interpreterState) useLayout(layout *eval.SlotLayout) {
+	s.variablesMu.Lock()
+	defer s.variablesMu.Unlock()
+	s.layout = layout
+	s.slots = make([]lang.Value, layout.Len())
+	s.slotSet = make([]bool, layout.Len())
+}
+
+// loadLocal reads a variable of this scope. The caller holds variablesMu.
+func (s *interpreterState) loadLocal(name string) (lang.Value, bool) {
+	if s.layout != nil {
+		if idx, ok := s.layout.Lookup(name); ok {
+			return s.slots[idx], s.slotSet[idx]
+		}
+	}
+	val, exists := s.variables[name]
+	return val, exists
+}
+
+// storeLocal writes a variable of this scope. The caller holds variablesMu.
+func (s *interpreterState) storeLocal(name string, value lang.Value) {
+	if s.layout != nil {
+		if idx, ok := s.layout.Lookup(name); ok {
+			s.slots[idx], s.slotSet[idx] = value, true
+			return
+		}
+	}
 	if s.variables == nil {
 		s.variables = make(map[string]lang.Value)
 	}
 	s.variables[name] = value
 }
 
+// copyLocals returns every variable of this scope, slotted or not.
+func (s *interpreterState) copyLocals() map[string]lang.Value {
+	s.variablesMu.RLock()
+	defer s.variablesMu.RUnlock()
+	out := make(map[string]lang.Value, len(s.variables)+len(s.slots))
+	for k, v := range s.variables {
+		out[k] = v
+	}
+	if s.layout != nil {
+		s.layout.Each(func(name string, idx int) {
+			if s.slotSet[idx] {
+				out[name] = s.slots[idx]
+			}
+		})
+	}
+	return out
+}
+
 // setGlobalVariable sets a variable and marks it as global in a thread-safe manner.
 func (s *interpreterState) setGlobalVariable(name string, value lang.Value) {
 	s.variablesMu.Lock()
@@ -78,9 +154,50 @@ func (s *interpreterState) setGlobalVariable(name string, value lang.Value) {
 func newEventManager() *EventManager {
 	return &EventManager{
 		eventHandlers: make(map[string][]*ast.OnEventDecl),
+		guards:        make(map[*ast.OnEventDecl]*eventGuardCounter),
 	}
 }
 
+// reset forgets every registered handler, pattern and guard counter.
+func (em *EventManager) reset() {
+	em.eventHandlersMu.Lock()
+	defer em.eventHandlersMu.Unlock()
+	em.eventHandlers = make(map[string][]*ast.OnEventDecl)
+	em.patterns = nil
+	em.guards = make(map[*ast.OnEventDecl]*eventGuardCounter)
+	em.guardOrder = nil
+}
+
+// handlersFor returns the handlers registered for an event name: those
+// registered under the exact name, then those of every matching pattern such
+// as "fdm.node.*", in the order the patterns were first registered.
+func (em *EventManager) handlersFor(eventName string) []*ast.OnEventDecl {
+	em.eventHandlersMu.RLock()
+	defer em.eventHandlersMu.RUnlock()
+	handlers := em.eventHandlers[eventName]
+	for _, pattern := range em.patterns {
+		if pattern == eventName || !matchEventPattern(pattern, eventName) {
+			continue
+		}
+		// Copy before appending so the registered slice is never shared.
+		handlers = append(handlers[:len(handlers):len(handlers)], em.eventHandlers[pattern]...)
+	}
+	return handlers
+}
+
+// matchEventPattern reports whether a handler registered under pattern, a
+// name ending in '*', receives the named event.
+func matchEventPattern(pattern, eventName string) bool {
+	return strings.HasPrefix(eventName, strings.TrimSuffix(pattern, "*"))
+}
+
+// guardCounter returns the filter counter of a guarded handler.
+func (em *EventManager) guardCounter(decl *ast.OnEventDecl) *eventGuardCounter {
+	em.eventHandlersMu.RLock()
+	defer em.eventHandlersMu.RUnlock()
+	return em.guards[decl]
+}
+
 func (em *EventManager) register(decl *ast.OnEventDecl, i *Interpreter) error {
 	em.eventHandlersMu.Lock()
 	defer em.eventHandlersMu.Unlock()
@@ -91,6 +208,14 @@ func (em *EventManager) register(decl *ast.OnEventDecl, i *Interpreter) error {
 	}
 
 	eventNameStr, _ := lang.ToString(eventName)
+	if _, seen := em.eventHandlers[eventNameStr]; !seen && strings.HasSuffix(eventNameStr, "*") {
+		em.patterns = append(em.patterns, eventNameStr)
+	}
 	em.eventHandlers[eventNameStr] = append(em.eventHandlers[eventNameStr], decl)
+	if decl.GuardExpr != nil {
+		counter := &eventGuardCounter{event: eventNameStr, decl: decl}
+		em.guards[decl] = counter
+		em.guardOrder = append(em.guardOrder, counter)
+	}
 	return nil
 }
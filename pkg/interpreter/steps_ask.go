@@ -1,9 +1,9 @@
 // NeuroScript Major Version: 1
-// File version: 86
-// Purpose: Refactored to handle 'any' return value from the AEIOU service hook, fixing import cycle.
-// Latest change: Added WARN log to dump ALL keys from the ServiceRegistry map.
+// File version: 94
+// Purpose: Executes 'ask' statements, applying per-call 'with' overrides to a copy of the agent model before calling its provider.
+// Latest change: 'with' options go to services implementing AeiouOrchestratorWithOptions; other services refuse them.
 // filename: pkg/interpreter/steps_ask.go
-// nlines: 219
+// nlines: 265
 // risk_rating: HIGH
 
 package interpreter
@@ -50,6 +50,14 @@ func (i *Interpreter) executeAsk(step ast.Step) (lang.Value, error) {
 		return nil, lang.WrapErrorWithPosition(err, node.PromptExpr.GetPos(), "evaluating prompt for ask")
 	}
 	initialPrompt, _ := lang.ToString(promptVal)
+	// Revealed secrets are scrubbed before the prompt leaves, whether it goes
+	// to an external orchestrator or to a provider directly.
+	initialPrompt = i.redactString(initialPrompt)
+
+	options, err := i.evaluateAskOptions(node)
+	if err != nil {
+		return nil, err
+	}
 
 	var finalResult lang.Value
 
@@ -121,8 +129,17 @@ func (i *Interpreter) executeAsk(step ast.Step) (lang.Value, error) {
 		// ---
 
 		// --- HOOK: All checks passed. Delegate to external service. ---
+		// 'with' options need a service that can honour them; dropping them
+		// would silently change what the script asked for.
 		var rawResult any
-		rawResult, err = orchestrator.RunAskLoop(i.PublicAPI, agentName, initialPrompt)
+		if withOpts, ok := orchestrator.(interfaces.AeiouOrchestratorWithOptions); ok {
+			rawResult, err = withOpts.RunAskLoopWithOptions(i.PublicAPI, agentName, initialPrompt, options)
+		} else if options != nil {
+			return nil, lang.NewRuntimeError(lang.ErrorCodeNotImplemented,
+				fmt.Sprintf("the registered AEIOU service (%T) does not accept ask 'with' options", service), nil).WithPosition(node.WithOptions.GetPos())
+		} else {
+			rawResult, err = orchestrator.RunAskLoop(i.PublicAPI, agentName, initialPrompt)
+		}
 		if err != nil {
 			// --- INSTRUMENTATION ---
 			i.Logger().Warn("[steps_ask] executeAsk: External service RunAskLoop FAILED.", "error", err)
@@ -153,7 +170,7 @@ func (i *Interpreter) executeAsk(step ast.Step) (lang.Value, error) {
 		// --- INSTRUMENTATION ---
 		i.Logger().Warn("[steps_ask] executeAsk: No HostContext.ServiceRegistry found. FALLING BACK to legacy 'ask'. (This is normal if not in FDM.)")
 		// ---
-		finalResult, err = i.executeLegacyAsk(node, agentName, initialPrompt)
+		finalResult, err = i.executeLegacyAsk(node, agentName, initialPrompt, options)
 	}
 
 	if err != nil {
@@ -169,8 +186,9 @@ func (i *Interpreter) executeAsk(step ast.Step) (lang.Value, error) {
 	return finalResult, nil
 }
 
-// executeLegacyAsk contains the original v1 'ask' logic.
-func (i *Interpreter) executeLegacyAsk(node *ast.AskStmt, agentName, initialPrompt string) (lang.Value, error) {
+// executeLegacyAsk contains the original v1 'ask' logic. Any 'with' options
+// are merged over a copy of the registered AgentModel for this call only.
+func (i *Interpreter) executeLegacyAsk(node *ast.AskStmt, agentName, initialPrompt string, options map[string]any) (lang.Value, error) {
 	// 2. Retrieve AgentModel and Provider
 	agentModelObj, found := i.AgentModels().Get(agentName)
 	if !found {
@@ -180,6 +198,11 @@ func (i *Interpreter) executeLegacyAsk(node *ast.AskStmt, agentName, initialProm
 	if !ok {
 		return nil, lang.NewRuntimeError(lang.ErrorCodeInternal, fmt.Sprintf("internal error: retrieved AgentModel for '%s' is not of type types.AgentModel, but %T", agentName, agentModelObj), nil).WithPosition(node.GetPos())
 	}
+	if options != nil {
+		if err := applyAskOptions(&agentModel, options); err != nil {
+			return nil, lang.NewRuntimeError(lang.ErrorCodeInvalidValue, "invalid ask 'with' options", err).WithPosition(node.WithOptions.GetPos())
+		}
+	}
 
 	prov, provExists := i.GetProvider(agentModel.Provider)
 	if !provExists {
@@ -202,8 +225,9 @@ func (i *Interpreter) executeLegacyAsk(node *ast.AskStmt, agentName, initialProm
 		agentModel.APIKey = acc.APIKey // Inject the API key into the model copy
 	}
 
-	// 3. Initialize LLM Connection
-	conn, err := llmconn.New(&agentModel, prov, i.hostContext.Emitter)
+	// 3. Initialize LLM Connection, metered against the policy's spend and network limits.
+	grants := i.GetGrantSet()
+	conn, err := llmconn.New(&agentModel, prov, i.hostContext.Emitter, llmconn.WithBudget(grants), llmconn.WithNetMeter(grants), llmconn.WithRedactor(i.secrets))
 	if err != nil {
 		return nil, lang.NewRuntimeError(lang.ErrorCodeConfiguration, "failed to create LLM connection", err).WithPosition(node.GetPos())
 	}
@@ -0,0 +1,52 @@
+// :: product: FDM/NS
+// :: majorVersion: 1
+// :: fileVersion: 1
+// :: description: Tests that 'ask' turns are charged against ExecPolicy spend budgets.
+// :: latestChange: Initial tests.
+// :: filename: pkg/interpreter/steps_ask_budget_test.go
+// :: serialization: go
+
+package interpreter_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/aeiou"
+	"github.com/aprice2704/neuroscript/pkg/interfaces"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/provider"
+)
+
+func TestAskBudgetEnforcement(t *testing.T) {
+	h, mockProv := setupAskTest(t)
+	if err := h.Interpreter.AgentModelsAdmin().Register("metered_agent", map[string]any{
+		"provider":        "mock_ask_provider",
+		"model":           "test-model",
+		"budget_currency": "CAD",
+	}); err != nil {
+		t.Fatalf("Failed to register metered agent: %v", err)
+	}
+	h.Interpreter.ExecPolicy.Grants.Limits.BudgetPerRunCents = map[string]int{"CAD": 50}
+
+	env := &aeiou.Envelope{UserData: "{}", Actions: "command\n emit \"<<<LOOP:DONE>>>\"\nendcommand"}
+	respText, _ := env.Compose()
+	mockProv.ResponseToReturn = &provider.AIResponse{TextContent: respText, Cost: 0.30}
+
+	script := `command
+		ask "metered_agent", "first"
+		ask "metered_agent", "second"
+	endcommand`
+	tree, _ := h.Parser.Parse(script)
+	program, _, _ := h.ASTBuilder.Build(tree)
+	h.Interpreter.Load(&interfaces.Tree{Root: program})
+
+	_, err := h.Interpreter.Execute(program)
+	var rtErr *lang.RuntimeError
+	if !errors.As(err, &rtErr) || rtErr.Code != lang.ErrorCodeBudgetExceeded {
+		t.Fatalf("expected ErrorCodeBudgetExceeded on the second ask, got %v", err)
+	}
+	if got := h.Interpreter.GetGrantSet().Counters.BudgetSpentCents["CAD"]; got != 60 {
+		t.Errorf("spent = %d cents, want 60", got)
+	}
+}
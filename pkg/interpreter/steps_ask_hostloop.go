@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 31
+// :: fileVersion: 36
 // :: description: Backported V4 features: Self-correction loop, explosive output tripwire, and split-emit fallback.
-// :: latestChange: Integrated CleanLLMPayload, maxTurnBytes tripwire, and error feedback injection.
+// :: latestChange: ACTIONS blocks run in a lightweight scope frame instead of a fork.
 // :: filename: pkg/interpreter/steps_ask_hostloop.go
 // :: serialization: go
 
@@ -10,10 +10,12 @@ package interpreter
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/aprice2704/neuroscript/pkg/aeiou"
+	"github.com/aprice2704/neuroscript/pkg/capability"
 	"github.com/aprice2704/neuroscript/pkg/lang"
 	"github.com/aprice2704/neuroscript/pkg/llmconn"
 	"github.com/aprice2704/neuroscript/pkg/types"
@@ -58,7 +60,7 @@ func (i *Interpreter) runAskHostLoop(pos *types.Position, agentModel *types.Agen
 			if composedPrompt, err := turnEnvelope.Compose(); err == nil {
 				transcriptHeader := fmt.Sprintf("--- PROMPT (SID: %s, Turn: %d) ---\n", sessionID, turn)
 				transcriptFooter := "\n--- END PROMPT ---\n\n"
-				_, _ = i.hostContext.AITranscript.Write([]byte(transcriptHeader + composedPrompt + transcriptFooter))
+				_, _ = i.hostContext.AITranscript.Write([]byte(transcriptHeader + i.redactString(composedPrompt) + transcriptFooter))
 			} else {
 				i.Logger().Error("Failed to compose envelope for transcript", "sid", sessionID, "turn", turn, "error", err)
 			}
@@ -66,12 +68,24 @@ func (i *Interpreter) runAskHostLoop(pos *types.Position, agentModel *types.Agen
 
 		aiResp, err := conn.Converse(turnCtxForLLM, turnEnvelope)
 		if err != nil {
+			if errors.Is(err, capability.ErrBudgetExceeded) {
+				return nil, lang.NewRuntimeError(lang.ErrorCodeBudgetExceeded, "ask turn refused: spend budget exhausted", err).WithPosition(pos)
+			}
+			if errors.Is(err, capability.ErrNetExceeded) {
+				return nil, lang.NewRuntimeError(lang.ErrorCodeResourceExhaustion, "ask turn refused: network limit exhausted", err).WithPosition(pos)
+			}
 			if _, ok := err.(*lang.RuntimeError); !ok {
 				return nil, lang.NewRuntimeError(lang.ErrorCodeInternal, "AI provider conversation failed", err).WithPosition(pos)
 			}
 			return nil, err
 		}
 
+		if turn == 1 {
+			for _, w := range aiResp.Warnings {
+				i.Logger().Warn("Ask loop: provider ignored a generation setting", "agent", agentModel.Name, "warning", w)
+			}
+		}
+
 		// --- Explosive Output Tripwire ---
 		if len(aiResp.TextContent) > maxTurnBytes {
 			i.Logger().Warn("Ask loop: Response size limit exceeded", "sid", sessionID, "turn", turn, "size", len(aiResp.TextContent))
@@ -101,7 +115,7 @@ func (i *Interpreter) runAskHostLoop(pos *types.Position, agentModel *types.Agen
 			continue
 		}
 
-		execInterp := i.fork()
+		execInterp := i.newFrame()
 		execInterp.SetTurnContext(turnCtxForLLM)
 
 		var actionEmits []string
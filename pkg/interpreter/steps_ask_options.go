@@ -0,0 +1,115 @@
+// :: product: FDM/NS
+// :: majorVersion: 1
+// :: fileVersion: 3
+// :: description: Evaluates and applies the 'ask ... with <options>' clause to a per-call AgentModel copy.
+// :: latestChange: A temperature option is marked explicit, so 'temperature: 0' reaches the provider.
+// :: filename: pkg/interpreter/steps_ask_options.go
+// :: serialization: go
+
+package interpreter
+
+import (
+	"fmt"
+
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/eval"
+	"github.com/aprice2704/neuroscript/pkg/json_lite"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/types"
+)
+
+// askOptionsShape is the Shape-Lite definition that the map supplied to
+// 'ask ... with <options>' must satisfy. Every key is optional; unknown keys
+// are rejected so that typos do not silently fall back to model defaults.
+var askOptionsShape = func() *json_lite.Shape {
+	s, err := json_lite.ParseShape(map[string]any{
+		"temperature?":       "float",
+		"max_turns?":         "int",
+		"max_output_tokens?": "int",
+		"stop_sequences[]?":  "string",
+		"system_capsule?":    "string",
+		"response_format?":   "string",
+	})
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse ask options shape: %v", err))
+	}
+	return s
+}()
+
+// evaluateAskOptions evaluates the 'with' expression of an ask statement and
+// validates it against askOptionsShape. A nil expression yields nil options.
+func (i *Interpreter) evaluateAskOptions(node *ast.AskStmt) (map[string]any, error) {
+	if node.WithOptions == nil {
+		return nil, nil
+	}
+	val, err := eval.Expression(i, node.WithOptions)
+	if err != nil {
+		return nil, lang.WrapErrorWithPosition(err, node.WithOptions.GetPos(), "evaluating 'with' options for ask")
+	}
+	unwrapped := lang.Unwrap(val)
+	if unwrapped == nil {
+		return nil, nil
+	}
+	opts, ok := unwrapped.(map[string]any)
+	if !ok {
+		return nil, lang.NewRuntimeError(lang.ErrorCodeType,
+			fmt.Sprintf("ask 'with' options must be a map, got %s", lang.TypeOf(val)), nil).WithPosition(node.WithOptions.GetPos())
+	}
+	if err := askOptionsShape.Validate(opts, nil); err != nil {
+		return nil, lang.NewRuntimeError(lang.ErrorCodeInvalidValue, "invalid ask 'with' options", err).WithPosition(node.WithOptions.GetPos())
+	}
+	return opts, nil
+}
+
+// applyAskOptions merges validated options over a copy of the agent model.
+// The caller owns the copy; the registered model is never modified.
+func applyAskOptions(model *types.AgentModel, opts map[string]any) error {
+	if v, ok := numericOption(opts, "temperature"); ok {
+		if v < 0 {
+			return fmt.Errorf("temperature must be non-negative, got %v", v)
+		}
+		model.Generation.Temperature = v
+		model.Generation.Explicit |= types.GenTemperature
+	}
+	if v, ok := numericOption(opts, "max_turns"); ok {
+		if v < 1 {
+			return fmt.Errorf("max_turns must be at least 1, got %v", v)
+		}
+		model.MaxTurns = int(v)
+	}
+	if v, ok := numericOption(opts, "max_output_tokens"); ok {
+		if v < 1 {
+			return fmt.Errorf("max_output_tokens must be at least 1, got %v", v)
+		}
+		model.Generation.MaxOutputTokens = int(v)
+	}
+	if raw, ok := opts["stop_sequences"].([]any); ok {
+		stops := make([]string, 0, len(raw))
+		for _, s := range raw {
+			stops = append(stops, s.(string))
+		}
+		model.Generation.StopSequences = stops
+	}
+	if v, ok := opts["system_capsule"].(string); ok {
+		model.SystemCapsule = v
+	}
+	if v, ok := opts["response_format"].(string); ok {
+		switch types.ResponseFormat(v) {
+		case types.ResponseFormatText, types.ResponseFormatJSON:
+			model.Generation.ResponseFormat = types.ResponseFormat(v)
+		default:
+			return fmt.Errorf("response_format must be %q or %q, got %q", types.ResponseFormatText, types.ResponseFormatJSON, v)
+		}
+	}
+	return nil
+}
+
+// numericOption reads a numeric option, which unwraps to int64 for integer
+// literals and float64 otherwise.
+func numericOption(opts map[string]any, key string) (float64, bool) {
+	v, present := opts[key]
+	if !present {
+		return 0, false
+	}
+	return lang.ToFloat64(v)
+}
@@ -0,0 +1,130 @@
+// :: product: FDM/NS
+// :: majorVersion: 1
+// :: fileVersion: 2
+// :: description: Tests for the 'ask ... with <options>' per-call overrides.
+// :: latestChange: The unmodified-model test uses non-default settings and checks the stored model.
+// :: filename: pkg/interpreter/steps_ask_options_test.go
+// :: serialization: go
+
+package interpreter_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/aeiou"
+	"github.com/aprice2704/neuroscript/pkg/interfaces"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/provider"
+	"github.com/aprice2704/neuroscript/pkg/types"
+)
+
+func runAskOptionsScript(t *testing.T, script string) (*mockAskProviderV3, error) {
+	t.Helper()
+	h, mockProv := setupAskTest(t)
+	env := &aeiou.Envelope{UserData: "{}", Actions: `command
+		emit "ok"
+		emit "<<<LOOP:DONE>>>"
+	endcommand`}
+	respText, _ := env.Compose()
+	mockProv.ResponseToReturn = &provider.AIResponse{TextContent: respText}
+
+	tree, err := h.Parser.Parse(script)
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	program, _, err := h.ASTBuilder.Build(tree)
+	if err != nil {
+		t.Fatalf("build failed: %v", err)
+	}
+	h.Interpreter.Load(&interfaces.Tree{Root: program})
+	_, err = h.Interpreter.Execute(program)
+	return mockProv, err
+}
+
+func TestAskWithOptions(t *testing.T) {
+	t.Run("Overrides flow into the provider request", func(t *testing.T) {
+		script := `command
+			ask "test_agent", "hi" with {"temperature": 0.25, "max_output_tokens": 64, "stop_sequences": ["END"], "response_format": "json_object"} into r
+		endcommand`
+		mockProv, err := runAskOptionsScript(t, script)
+		if err != nil {
+			t.Fatalf("execute failed: %v", err)
+		}
+		req := mockProv.LastRequest
+		if req.Temperature != 0.25 {
+			t.Errorf("Temperature: got %v, want 0.25", req.Temperature)
+		}
+		if req.MaxOutputTokens != 64 {
+			t.Errorf("MaxOutputTokens: got %d, want 64", req.MaxOutputTokens)
+		}
+		if !reflect.DeepEqual(req.StopSequences, []string{"END"}) {
+			t.Errorf("StopSequences: got %v, want [END]", req.StopSequences)
+		}
+		if req.ResponseFormat != types.ResponseFormatJSON {
+			t.Errorf("ResponseFormat: got %q, want %q", req.ResponseFormat, types.ResponseFormatJSON)
+		}
+	})
+
+	t.Run("Overrides do not modify the registered model", func(t *testing.T) {
+		h, mockProv := setupAskTest(t)
+		env := &aeiou.Envelope{UserData: "{}", Actions: "command\n emit \"<<<LOOP:DONE>>>\"\nendcommand"}
+		respText, _ := env.Compose()
+		mockProv.ResponseToReturn = &provider.AIResponse{TextContent: respText}
+		tuned := map[string]any{"provider": "mock_ask_provider", "model": "test-model", "temperature": 0.5, "max_turns": 3}
+		if err := h.Interpreter.AgentModelsAdmin().Register("tuned_agent", tuned); err != nil {
+			t.Fatalf("Failed to register agent model: %v", err)
+		}
+
+		script := `command
+			ask "tuned_agent", "one" with {"temperature": 0.9, "max_turns": 7}
+			ask "tuned_agent", "two"
+		endcommand`
+		tree, _ := h.Parser.Parse(script)
+		program, _, _ := h.ASTBuilder.Build(tree)
+		h.Interpreter.Load(&interfaces.Tree{Root: program})
+		if _, err := h.Interpreter.Execute(program); err != nil {
+			t.Fatalf("execute failed: %v", err)
+		}
+		if mockProv.LastRequest.Temperature != 0.5 {
+			t.Errorf("second ask should use the registered temperature 0.5, got %v", mockProv.LastRequest.Temperature)
+		}
+		got, _ := h.Interpreter.AgentModels().Get("tuned_agent")
+		if model, ok := got.(types.AgentModel); !ok || model.Generation.Temperature != 0.5 || model.MaxTurns != 3 {
+			t.Errorf("registered model was modified: %+v", got)
+		}
+	})
+
+	t.Run("Unknown option is rejected", func(t *testing.T) {
+		_, err := runAskOptionsScript(t, "command\n"+`ask "test_agent", "hi" with {"temprature": 0.5}`+"\nendcommand")
+		var rtErr *lang.RuntimeError
+		if !errors.As(err, &rtErr) || rtErr.Code != lang.ErrorCodeInvalidValue {
+			t.Fatalf("expected InvalidValue error, got %v", err)
+		}
+	})
+
+	t.Run("Wrongly typed option is rejected", func(t *testing.T) {
+		_, err := runAskOptionsScript(t, "command\n"+`ask "test_agent", "hi" with {"max_turns": "lots"}`+"\nendcommand")
+		var rtErr *lang.RuntimeError
+		if !errors.As(err, &rtErr) || rtErr.Code != lang.ErrorCodeInvalidValue {
+			t.Fatalf("expected InvalidValue error, got %v", err)
+		}
+	})
+
+	t.Run("Non-map options are rejected", func(t *testing.T) {
+		_, err := runAskOptionsScript(t, "command\n"+`ask "test_agent", "hi" with "hot"`+"\nendcommand")
+		var rtErr *lang.RuntimeError
+		if !errors.As(err, &rtErr) || rtErr.Code != lang.ErrorCodeType {
+			t.Fatalf("expected Type error, got %v", err)
+		}
+	})
+
+	t.Run("Bad response_format is rejected", func(t *testing.T) {
+		_, err := runAskOptionsScript(t, "command\n"+`ask "test_agent", "hi" with {"response_format": "yaml"}`+"\nendcommand")
+		var rtErr *lang.RuntimeError
+		if !errors.As(err, &rtErr) || rtErr.Code != lang.ErrorCodeInvalidValue {
+			t.Fatalf("expected InvalidValue error, got %v", err)
+		}
+	})
+}
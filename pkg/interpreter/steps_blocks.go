@@ -1,5 +1,5 @@
 // NeuroScript Version: 0.8.0
-// File version: 47
+// File version: 48
 // Purpose: Re-plumbed all expression evaluation to use the external 'eval' package.
 // filename: pkg/interpreter/steps_blocks.go
 // nlines: 200
@@ -98,27 +98,9 @@ func (i *Interpreter) executeFor(step ast.Step, isInHandler bool, activeError *l
 		return nil, false, wasCleared, lang.WrapErrorWithPosition(evalErr, step.Collection.GetPos(), fmt.Sprintf("evaluating collection for FOR EACH %s", step.LoopVarName))
 	}
 
-	var itemsToIterate []lang.Value
-	switch c := collectionVal.(type) {
-	case *lang.ListValue:
-		itemsToIterate = c.Value
-	case lang.ListValue:
-		itemsToIterate = c.Value
-	case *lang.MapValue:
-		itemsToIterate = make([]lang.Value, 0, len(c.Value))
-		for _, v := range c.Value {
-			itemsToIterate = append(itemsToIterate, v)
-		}
-	case lang.StringValue:
-		itemsToIterate = make([]lang.Value, 0, len(c.Value))
-		for _, charRune := range c.Value {
-			itemsToIterate = append(itemsToIterate, lang.StringValue{Value: string(charRune)})
-		}
-	case *lang.NilValue:
-		itemsToIterate = []lang.Value{}
-	default:
-		errMsg := fmt.Sprintf("cannot iterate over type %s for FOR EACH %s", lang.TypeOf(collectionVal), step.LoopVarName)
-		return nil, false, wasCleared, lang.NewRuntimeError(lang.ErrorCodeType, errMsg, nil).WithPosition(step.Collection.GetPos())
+	itemsToIterate, iterErr := forEachItems(step.LoopVarName, collectionVal)
+	if iterErr != nil {
+		return nil, false, wasCleared, iterErr.WithPosition(step.Collection.GetPos())
 	}
 
 	result = &lang.NilValue{}
@@ -162,3 +144,31 @@ func (i *Interpreter) executeFor(step ast.Step, isInHandler bool, activeError *l
 endForLoop:
 	return result, false, wasCleared, nil
 }
+
+// forEachItems expands a FOR EACH collection into the values to iterate.
+func forEachItems(loopVarName string, collectionVal lang.Value) ([]lang.Value, *lang.RuntimeError) {
+	var itemsToIterate []lang.Value
+	switch c := collectionVal.(type) {
+	case *lang.ListValue:
+		itemsToIterate = c.Value
+	case lang.ListValue:
+		itemsToIterate = c.Value
+	case *lang.MapValue:
+		itemsToIterate = make([]lang.Value, 0, len(c.Value))
+		for _, v := range c.Value {
+			itemsToIterate = append(itemsToIterate, v)
+		}
+	case lang.StringValue:
+		itemsToIterate = make([]lang.Value, 0, len(c.Value))
+		for _, charRune := range c.Value {
+			itemsToIterate = append(itemsToIterate, lang.StringValue{Value: string(charRune)})
+		}
+	case *lang.NilValue:
+		itemsToIterate = []lang.Value{}
+	default:
+		errMsg := fmt.Sprintf("cannot iterate over type %s for FOR EACH %s", lang.TypeOf(collectionVal), loopVarName)
+		return nil, lang.NewRuntimeError(lang.ErrorCodeType, errMsg, nil)
+	}
+
+	return itemsToIterate, nil
+}
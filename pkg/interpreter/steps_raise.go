@@ -0,0 +1,99 @@
+// NeuroScript Version: 0.8.0
+// File version: 2
+// Purpose: Executes 'raise event', delivering script-raised events to this interpreter's 'on event' handlers.
+// filename: pkg/interpreter/steps_raise.go
+// nlines: 99
+// risk_rating: HIGH
+
+package interpreter
+
+import (
+	"fmt"
+
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/capability"
+	"github.com/aprice2704/neuroscript/pkg/eval"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/policy"
+)
+
+// maxEventDepth bounds how deeply raised events may nest, so handlers that
+// raise each other's events fail instead of recursing forever.
+const maxEventDepth = 32
+
+// raiseCapability is the grant a script needs to raise the named event.
+func raiseCapability(eventName string) capability.Capability {
+	return capability.New(capability.ResBus, capability.VerbWrite, eventName)
+}
+
+// executeRaise handles the "raise" statement: Values[0] is the event name and
+// the optional Values[1] the payload map. Handlers run synchronously, as for
+// host-emitted events, and their errors go to the host's
+// EventHandlerErrorCallback rather than back to the raiser.
+func (i *Interpreter) executeRaise(step ast.Step) error {
+	if len(step.Values) == 0 || step.Values[0] == nil {
+		return lang.NewRuntimeError(lang.ErrorCodeSyntax, "'raise event' requires an event name", lang.ErrInvalidArgument).WithPosition(step.GetPos())
+	}
+	nameVal, err := eval.Expression(i, step.Values[0])
+	if err != nil {
+		return lang.WrapErrorWithPosition(err, step.Values[0].GetPos(), "evaluating event name for 'raise'")
+	}
+	name, ok := nameVal.(lang.StringValue)
+	if !ok || name.Value == "" {
+		return lang.NewRuntimeError(lang.ErrorCodeType,
+			fmt.Sprintf("event name must be a non-empty string, got %s", lang.TypeOf(nameVal)),
+			lang.ErrInvalidArgument).WithPosition(step.Values[0].GetPos())
+	}
+	eventName := name.Value
+
+	// The grant is checked before the payload is built, so a refused raise
+	// has no side effects from evaluating it.
+	need := raiseCapability(eventName)
+	if i.ExecPolicy == nil || !i.ExecPolicy.Grants.Check(need) {
+		return lang.NewRuntimeError(lang.ErrorCodePolicy,
+			fmt.Sprintf("permission denied to raise event '%s': requires grant %s", eventName, need.String()),
+			policy.ErrCapability).WithPosition(step.GetPos())
+	}
+
+	var payload lang.Value = lang.NewMapValue(nil)
+	if len(step.Values) > 1 && step.Values[1] != nil {
+		payload, err = eval.Expression(i, step.Values[1])
+		if err != nil {
+			return lang.WrapErrorWithPosition(err, step.Values[1].GetPos(), "evaluating payload for 'raise'")
+		}
+		if lang.TypeOf(payload) != lang.TypeMap {
+			return lang.NewRuntimeError(lang.ErrorCodeType,
+				fmt.Sprintf("event payload must be a map, got %s", lang.TypeOf(payload)),
+				lang.ErrInvalidArgument).WithPosition(step.Values[1].GetPos())
+		}
+	}
+
+	if i.eventDepth >= maxEventDepth {
+		return lang.NewRuntimeError(lang.ErrorCodeResourceExhaustion,
+			fmt.Sprintf("maximum event depth of %d exceeded raising '%s'", maxEventDepth, eventName),
+			lang.ErrMaxEventDepthExceeded).WithPosition(step.GetPos())
+	}
+
+	handlers := i.eventManager.handlersFor(eventName)
+	if len(handlers) == 0 {
+		i.Logger().Debug("Event raised but no handlers were registered for it", "event_name", eventName)
+		return nil
+	}
+	if i.hostContext == nil || i.hostContext.EmitFunc == nil || i.hostContext.WhisperFunc == nil {
+		return lang.NewRuntimeError(lang.ErrorCodeConfiguration,
+			fmt.Sprintf("cannot raise event '%s': the host has not configured event I/O", eventName),
+			lang.ErrConfiguration).WithPosition(step.GetPos())
+	}
+
+	i.dispatchEvent(eventName, i.eventSource(), payload, handlers)
+	return nil
+}
+
+// eventSource names the raiser of an event: the running procedure, or the
+// interpreter itself at top level.
+func (i *Interpreter) eventSource() string {
+	if i.state.currentProcName != "" {
+		return i.state.currentProcName
+	}
+	return i.id
+}
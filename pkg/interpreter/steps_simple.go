@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.8.0
-// File version: 42
-// Purpose: Re-plumbed all expression evaluation to use the external 'eval' package.
+// File version: 45
+// Purpose: Re-plumbed all expression evaluation to use the external 'eval' package. Emit redacts revealed secrets. 'fail' rethrows caught error values.
 // filename: pkg/interpreter/steps_simple.go
-// nlines: 180
+// nlines: 154
 // risk_rating: HIGH
 
 package interpreter
@@ -51,6 +51,12 @@ func (i *Interpreter) executeEmit(step ast.Step) (lang.Value, error) {
 	if err != nil {
 		return nil, err
 	}
+	return i.emitValue(step, val)
+}
+
+// emitValue redacts an evaluated emit value and hands it to the host.
+func (i *Interpreter) emitValue(step ast.Step, val lang.Value) (lang.Value, error) {
+	val = i.redactValue(val)
 
 	if i.hostContext != nil && i.hostContext.EmitFunc != nil {
 		i.hostContext.EmitFunc(val)
@@ -109,6 +115,14 @@ func (i *Interpreter) executeFail(step ast.Step) error {
 			evalFailMsg := fmt.Sprintf("error evaluating message/code for FAIL statement: %s", err.Error())
 			return lang.NewRuntimeError(errCode, evalFailMsg, err).WithPosition(finalPos)
 		}
+		if ev, ok := failValue.(lang.ErrorValue); ok {
+			// Rethrow: keep the caught error's code, message and position.
+			rethrown := lang.RuntimeErrorFromErrorValue(ev)
+			if rethrown.Position == nil {
+				rethrown.Position = finalPos
+			}
+			return rethrown
+		}
 		errMsg, _ = lang.ToString(failValue)
 	}
 	return lang.NewRuntimeError(errCode, errMsg, wrappedErr).WithPosition(finalPos)
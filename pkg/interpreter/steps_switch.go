@@ -0,0 +1,111 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Executes 'switch' steps: value, membership ('case in') and type ('case typeof') cases.
+// filename: pkg/interpreter/steps_switch.go
+// nlines: 111
+// risk_rating: MEDIUM
+
+package interpreter
+
+import (
+	"fmt"
+
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/eval"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+)
+
+// switchValueMatches reports whether one case value selects the subject.
+// Value cases use the language's '==' semantics; 'case in' accepts a list
+// (element membership) or a map (key membership); 'case typeof' compares the
+// subject's type name with a string.
+func switchValueMatches(kind ast.SwitchCaseKind, subject, caseVal lang.Value) (bool, error) {
+	switch kind {
+	case ast.CaseIn:
+		var elems []lang.Value
+		switch coll := caseVal.(type) {
+		case lang.ListValue:
+			elems = coll.Value
+		case *lang.ListValue:
+			elems = coll.Value
+		case lang.MapValue:
+			key, ok := lang.ToString(subject)
+			_, found := coll.Value[key]
+			return ok && found, nil
+		case *lang.MapValue:
+			key, ok := lang.ToString(subject)
+			_, found := coll.Value[key]
+			return ok && found, nil
+		default:
+			return false, lang.NewRuntimeError(lang.ErrorCodeType, fmt.Sprintf("'case in' needs a list or map, got %s", lang.TypeOf(caseVal)), lang.ErrInvalidArgument)
+		}
+		for _, el := range elems {
+			if eq, err := lang.PerformBinaryOperation("==", subject, el); err == nil && lang.IsTruthy(eq) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case ast.CaseTypeOf:
+		name, ok := caseVal.(lang.StringValue)
+		if !ok {
+			return false, lang.NewRuntimeError(lang.ErrorCodeType, fmt.Sprintf("'case typeof' needs a type name string, got %s", lang.TypeOf(caseVal)), lang.ErrInvalidArgument)
+		}
+		return string(lang.TypeOf(subject)) == name.Value, nil
+	default:
+		eq, err := lang.PerformBinaryOperation("==", subject, caseVal)
+		if err != nil {
+			return false, err
+		}
+		return lang.IsTruthy(eq), nil
+	}
+}
+
+// selectSwitchBody evaluates the cases in order and returns the body of the
+// first that matches, or the default. ok is false when nothing applies.
+// evalValue evaluates value vi of case ci, letting the tree walker and the
+// compiled form share the matching rules.
+func selectSwitchBody(sw *ast.SwitchStmt, subject lang.Value, evalValue func(ci, vi int) (lang.Value, error)) (body []ast.Step, caseIdx int, ok bool, err error) {
+	for ci, c := range sw.Cases {
+		for vi, valExpr := range c.Values {
+			caseVal, evalErr := evalValue(ci, vi)
+			if evalErr != nil {
+				return nil, 0, false, lang.WrapErrorWithPosition(evalErr, valExpr.GetPos(), "evaluating SWITCH case value")
+			}
+			matched, matchErr := switchValueMatches(c.CaseKind, subject, caseVal)
+			if matchErr != nil {
+				return nil, 0, false, lang.WrapErrorWithPosition(matchErr, valExpr.GetPos(), "matching SWITCH case")
+			}
+			if matched {
+				return c.Body, ci, true, nil
+			}
+		}
+	}
+	if sw.Default != nil {
+		return sw.Default, -1, true, nil
+	}
+	return nil, 0, false, nil
+}
+
+// executeSwitch handles the "switch" step.
+func (i *Interpreter) executeSwitch(step ast.Step, isInHandler bool, activeError *lang.RuntimeError) (result lang.Value, wasReturn bool, wasCleared bool, err error) {
+	sw := step.SwitchStmt
+	if sw == nil || sw.Subject == nil {
+		return nil, false, false, lang.NewRuntimeError(lang.ErrorCodeInternal, "SWITCH step has nil subject", nil).WithPosition(step.GetPos())
+	}
+
+	subject, evalErr := eval.Expression(i, sw.Subject)
+	if evalErr != nil {
+		return nil, false, false, lang.WrapErrorWithPosition(evalErr, sw.Subject.GetPos(), "evaluating SWITCH subject")
+	}
+
+	body, _, ok, selErr := selectSwitchBody(sw, subject, func(ci, vi int) (lang.Value, error) {
+		return eval.Expression(i, sw.Cases[ci].Values[vi])
+	})
+	if selErr != nil {
+		return nil, false, false, selErr
+	}
+	if !ok {
+		return &lang.NilValue{}, false, false, nil
+	}
+	return i.executeBlock(body, step.GetPos(), "SWITCH_CASE", isInHandler, activeError, 0)
+}
@@ -0,0 +1,109 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Executes 'try' steps: catching errors as error values and always running 'finally'.
+// filename: pkg/interpreter/steps_try.go
+// nlines: 109
+// risk_rating: HIGH
+
+package interpreter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+)
+
+// tryBlock names one of the blocks of a try statement.
+type tryBlock int
+
+const (
+	tryBody tryBlock = iota
+	tryCatch
+	tryFinally
+)
+
+// runTry implements try/catch/finally on top of run, which executes one of
+// the statement's blocks; this lets the tree walker and the compiled form
+// share the semantics.
+//
+// While a body with a catch clause runs, the frame's 'on error' handlers are
+// suspended so the catch sees the error first. break and continue are not
+// errors and pass through the catch. The finally block runs however the body
+// and catch end; an error or return from finally replaces their outcome.
+func (i *Interpreter) runTry(step ast.Step, run func(tryBlock) (lang.Value, bool, bool, error)) (result lang.Value, wasReturn bool, wasCleared bool, err error) {
+	try := step.TryStmt
+	if try == nil {
+		return nil, false, false, lang.NewRuntimeError(lang.ErrorCodeInternal, "TRY step has nil TryStmt", nil).WithPosition(step.GetPos())
+	}
+
+	if try.Catch == nil {
+		result, wasReturn, wasCleared, err = run(tryBody)
+	} else {
+		handlers := i.state.errorHandlerStack
+		i.state.errorHandlerStack = nil
+		result, wasReturn, wasCleared, err = run(tryBody)
+		i.state.errorHandlerStack = handlers
+
+		if err != nil && !errors.Is(err, lang.ErrBreak) && !errors.Is(err, lang.ErrContinue) {
+			result, wasReturn, wasCleared, err = i.runCatch(step, err, run)
+		}
+	}
+
+	if try.Finally != nil {
+		finResult, finReturned, finCleared, finErr := run(tryFinally)
+		if finErr != nil {
+			return nil, false, false, finErr
+		}
+		if finReturned {
+			return finResult, true, false, nil
+		}
+		wasCleared = wasCleared || finCleared
+	}
+	return result, wasReturn, wasCleared, err
+}
+
+// runCatch binds the caught error, if the catch clause names a variable, and
+// runs the catch block.
+func (i *Interpreter) runCatch(step ast.Step, caught error, run func(tryBlock) (lang.Value, bool, bool, error)) (lang.Value, bool, bool, error) {
+	rtErr := i.ensureRuntimeError(caught, step.GetPos(), "try")
+	if name := step.TryStmt.CatchVar; name != "" {
+		plain := *rtErr
+		plain.Message = undecoratedMessage(rtErr.Message)
+		if setErr := i.SetVariable(name, lang.NewErrorValueFromRuntimeError(&plain)); setErr != nil {
+			errMsg := fmt.Sprintf("setting catch variable '%s' in TRY", name)
+			return nil, false, false, lang.NewRuntimeError(lang.ErrorCodeInternal, errMsg, setErr).WithPosition(step.GetPos())
+		}
+	}
+	return run(tryCatch)
+}
+
+// executeTry handles the "try" step.
+func (i *Interpreter) executeTry(step ast.Step, isInHandler bool, activeError *lang.RuntimeError) (lang.Value, bool, bool, error) {
+	return i.runTry(step, func(which tryBlock) (lang.Value, bool, bool, error) {
+		var steps []ast.Step
+		switch which {
+		case tryBody:
+			steps = step.TryStmt.Body
+		case tryCatch:
+			steps = step.TryStmt.Catch
+		case tryFinally:
+			steps = step.TryStmt.Finally
+		}
+		return i.executeBlock(steps, step.GetPos(), "TRY", isInHandler, activeError, 0)
+	})
+}
+
+// undecoratedMessage strips the position and stack trace that
+// ensureRuntimeError appends, leaving the message the script raised.
+func undecoratedMessage(msg string) string {
+	if idx := strings.Index(msg, "\n  at "); idx >= 0 {
+		msg = msg[:idx]
+	}
+	if idx := strings.Index(msg, "\nStack Trace:"); idx >= 0 {
+		msg = msg[:idx]
+	}
+	return msg
+}
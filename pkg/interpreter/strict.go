@@ -0,0 +1,49 @@
+// NeuroScript Version: 0.8.0
+// File version: 3
+// Purpose: Strict variable mode: reads of undefined variables become runtime errors.
+// filename: pkg/interpreter/strict.go
+// nlines: 49
+// risk_rating: LOW
+
+package interpreter
+
+import (
+	"strings"
+
+	"github.com/aprice2704/neuroscript/pkg/ast"
+)
+
+// StrictMetadataKey is the file header key that opts a script into strict
+// variable mode, as in ':: strict: true'.
+const StrictMetadataKey = "strict"
+
+// StrictVariables reports whether reading an undefined variable is an error
+// for the code this interpreter is running: everywhere under
+// WithStrictVariables, otherwise only in code from a strict file.
+// It satisfies eval.StrictRuntime.
+func (i *Interpreter) StrictVariables() bool {
+	return i.rootInterpreter().state.strictVariables || i.state.strict
+}
+
+// IsStrictProgram reports whether program has a ':: strict: true' header.
+func IsStrictProgram(program *ast.Program) bool {
+	return strings.EqualFold(strings.TrimSpace(program.Metadata[StrictMetadataKey]), "true")
+}
+
+// markStrict records a program's ':: strict: true' header on its procedures,
+// event handlers and commands, so strictness travels with the code and does
+// not leak into other files loaded alongside it.
+func markStrict(program *ast.Program) {
+	if !IsStrictProgram(program) {
+		return
+	}
+	for _, proc := range program.Procedures {
+		proc.Strict = true
+	}
+	for _, decl := range program.Events {
+		decl.Strict = true
+	}
+	for _, cmd := range program.Commands {
+		cmd.Strict = true
+	}
+}
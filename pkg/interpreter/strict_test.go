@@ -0,0 +1,94 @@
+// NeuroScript Version: 0.8.0
+// File version: 2
+// Purpose: Tests strict variable mode enabled by option and by the ':: strict: true' file header, which applies to its own file only.
+// filename: pkg/interpreter/strict_test.go
+// nlines: 94
+// risk_rating: LOW
+
+package interpreter
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/interfaces"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+)
+
+const strictTypoScript = `
+func typo(returns r) means
+	set count = 1
+	return cuont + 1
+endfunc
+`
+
+func TestStrictVariables(t *testing.T) {
+	cases := []struct {
+		name   string
+		script string
+		opts   []InterpreterOption
+		strict bool
+		line   int
+	}{
+		{name: "lax by default", script: strictTypoScript},
+		{name: "option", script: strictTypoScript, opts: []InterpreterOption{WithStrictVariables()}, strict: true, line: 4},
+		{name: "option tree walker", script: strictTypoScript, opts: []InterpreterOption{WithStrictVariables(), WithTreeWalker()}, strict: true, line: 4},
+		{name: "file header", script: ":: strict: true\n" + strictTypoScript, strict: true, line: 5},
+		{name: "file header false", script: ":: strict: false\n" + strictTypoScript},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			interp := newScriptInterpreter(t, c.script, c.opts...)
+			_, err := interp.RunProcedure("typo")
+			if !c.strict {
+				// Lax mode: the typo reads as nil and the addition fails on type, not on the name.
+				var rtErr *lang.RuntimeError
+				if errors.As(err, &rtErr) && rtErr.Code == lang.ErrorCodeUndefinedVariable {
+					t.Fatalf("lax mode raised an undefined-variable error: %v", err)
+				}
+				return
+			}
+			var rtErr *lang.RuntimeError
+			if !errors.As(err, &rtErr) || rtErr.Code != lang.ErrorCodeUndefinedVariable {
+				t.Fatalf("expected an undefined-variable error, got %v", err)
+			}
+			if rtErr.Position == nil || rtErr.Position.Line != c.line {
+				t.Errorf("expected the error at line %d, got position %v", c.line, rtErr.Position)
+			}
+		})
+	}
+}
+
+func TestStrictVariables_HeaderIsPerFile(t *testing.T) {
+	interp := newScriptInterpreter(t, `:: strict: true
+
+func strictTypo(returns r) means
+	return nope
+endfunc
+`)
+	// Two lax files: a library and a command block.
+	for _, src := range []string{"func laxTypo(returns r) means\n\treturn nope\nendfunc\n", "command\n\tset v = alsoNope\nendcommand\n"} {
+		tree, err := interp.Parser().Parse(src)
+		if err != nil {
+			t.Fatalf("parse: %v", err)
+		}
+		program, _, err := interp.ASTBuilder().Build(tree)
+		if err != nil {
+			t.Fatalf("build: %v", err)
+		}
+		if err := interp.AppendScript(&interfaces.Tree{Root: program}); err != nil {
+			t.Fatalf("append: %v", err)
+		}
+	}
+
+	var rtErr *lang.RuntimeError
+	if _, err := interp.RunProcedure("strictTypo"); !errors.As(err, &rtErr) || rtErr.Code != lang.ErrorCodeUndefinedVariable {
+		t.Errorf("the strict file's procedure: expected an undefined-variable error, got %v", err)
+	}
+	if _, err := interp.RunProcedure("laxTypo"); err != nil {
+		t.Errorf("the lax file's procedure became strict: %v", err)
+	}
+	if _, err := interp.ExecuteCommands(); err != nil {
+		t.Errorf("the lax file's command became strict: %v", err)
+	}
+}
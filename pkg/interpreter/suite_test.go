@@ -1,6 +1,6 @@
 // NeuroScript Version: 0.8.0
-// File version: 11.0.0
-// Purpose: Corrected the error handling logic to properly check for a RuntimeError without a redundant type assertion.
+// File version: 12.0.0
+// Purpose: Corrected the error handling logic to properly check for a RuntimeError without a redundant type assertion. Integer loops produce IntValue.
 // filename: pkg/interpreter/interpreter_suite_test.go
 package interpreter_test
 
@@ -52,7 +52,7 @@ func TestExecuteStepsBlocksAndLoops(t *testing.T) {
 		}
 		t.Logf("[DEBUG] Turn 2: Script executed.")
 		val, _ := h.Interpreter.GetVariable("i")
-		expected := lang.NumberValue{Value: 3}
+		expected := lang.IntValue{Value: 3}
 		if !reflect.DeepEqual(val, expected) {
 			t.Errorf("Variable mismatch. Got: %#v, Want: %#v", val, expected)
 		}
@@ -77,7 +77,7 @@ func TestExecuteStepsBlocksAndLoops(t *testing.T) {
 		}
 		t.Logf("[DEBUG] Turn 2: Script executed.")
 		val, _ := h.Interpreter.GetVariable("sum")
-		expected := lang.NumberValue{Value: 60}
+		expected := lang.IntValue{Value: 60}
 		if !reflect.DeepEqual(val, expected) {
 			t.Errorf("Variable mismatch. Got: %#v, Want: %#v", val, expected)
 		}
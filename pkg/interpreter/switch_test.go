@@ -0,0 +1,141 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Tests 'else if' chains and switch statements in both execution modes.
+// filename: pkg/interpreter/switch_test.go
+// nlines: 141
+// risk_rating: LOW
+
+package interpreter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/lang"
+)
+
+const switchScript = `
+func grade(needs n returns out) means
+	if n >= 90
+		return "A"
+	else if n >= 80
+		return "B"
+	else if n >= 70
+		return "C"
+	else
+		return "F"
+	endif
+endfunc
+
+func else_if_no_else(returns out) means
+	set out = "none"
+	if false
+		set out = "first"
+	else if false
+		set out = "second"
+	endif
+	return out
+endfunc
+
+func classify(needs ev returns out) means
+	switch ev["kind"]
+		case "start", "resume"
+			return "go"
+		case in ["stop", "halt"]
+			return "stop"
+		case in {"pause": true}
+			return "wait"
+		case typeof "number"
+			return "numeric"
+		default
+			return "unknown"
+	endswitch
+endfunc
+
+func first_match_wins(returns out) means
+	switch 2
+		case 1, 2
+			return "first"
+		case 2
+			return "second"
+	endswitch
+endfunc
+
+func no_match_no_default(returns out) means
+	set out = "untouched"
+	switch "x"
+		case "y"
+			set out = "changed"
+	endswitch
+	return out
+endfunc
+
+func break_in_loop(returns out) means
+	set out = 0
+	for each n in [1, 2, 3, 4]
+		switch n
+			case 3
+				break
+			default
+				set out = out + n
+		endswitch
+	endfor
+	return out
+endfunc
+
+func bad_in(returns out) means
+	switch 1
+		case in 5
+			return "never"
+	endswitch
+endfunc
+`
+
+func TestElseIfAndSwitch(t *testing.T) {
+	cases := []struct {
+		name    string
+		proc    string
+		args    []lang.Value
+		want    string
+		wantErr string
+	}{
+		{name: "grade_a", proc: "grade", args: []lang.Value{lang.NumberValue{Value: 95}}, want: "A"},
+		{name: "grade_c", proc: "grade", args: []lang.Value{lang.NumberValue{Value: 75}}, want: "C"},
+		{name: "grade_f", proc: "grade", args: []lang.Value{lang.NumberValue{Value: 10}}, want: "F"},
+		{name: "else_if_no_else", proc: "else_if_no_else", want: "none"},
+		{name: "values", proc: "classify", args: []lang.Value{kindEvent(lang.StringValue{Value: "resume"})}, want: "go"},
+		{name: "in_list", proc: "classify", args: []lang.Value{kindEvent(lang.StringValue{Value: "halt"})}, want: "stop"},
+		{name: "in_map", proc: "classify", args: []lang.Value{kindEvent(lang.StringValue{Value: "pause"})}, want: "wait"},
+		{name: "typeof", proc: "classify", args: []lang.Value{kindEvent(lang.NumberValue{Value: 3})}, want: "numeric"},
+		{name: "default", proc: "classify", args: []lang.Value{kindEvent(lang.StringValue{Value: "other"})}, want: "unknown"},
+		{name: "first_match_wins", proc: "first_match_wins", want: "first"},
+		{name: "no_match_no_default", proc: "no_match_no_default", want: "untouched"},
+		{name: "break_in_loop", proc: "break_in_loop", want: "3"},
+		{name: "bad_in", proc: "bad_in", wantErr: "'case in' needs a list or map"},
+	}
+	modes := map[string][]InterpreterOption{"compiled": nil, "treewalk": {WithTreeWalker()}}
+	for modeName, opts := range modes {
+		interp := newScriptInterpreter(t, switchScript, opts...)
+		for _, c := range cases {
+			t.Run(modeName+"/"+c.name, func(t *testing.T) {
+				got, err := interp.RunProcedure(c.proc, c.args...)
+				if c.wantErr != "" {
+					if err == nil || !strings.Contains(err.Error(), c.wantErr) {
+						t.Fatalf("expected error containing %q, got %v", c.wantErr, err)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if s, _ := lang.ToString(got); s != c.want {
+					t.Errorf("got %q, want %q", s, c.want)
+				}
+			})
+		}
+	}
+}
+
+func kindEvent(kind lang.Value) lang.Value {
+	return lang.NewMapValue(map[string]lang.Value{"kind": kind})
+}
@@ -0,0 +1,96 @@
+// NeuroScript Version: 0.8.0
+// File version: 3
+// Purpose: Connects the root interpreter's timer scheduler to its EventManager: due timers fire ordinary named events.
+// filename: pkg/interpreter/timers.go
+// nlines: 96
+// risk_rating: HIGH
+
+package interpreter
+
+import (
+	"context"
+	"time"
+
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/scheduler"
+)
+
+// timerSourcePrefix starts the source of every timer-fired event; the
+// timer's ID follows it.
+const timerSourcePrefix = "timer:"
+
+// WithClock sets the clock the timer scheduler and handler retries run on.
+// Tests pass a *scheduler.FakeClock and advance it by hand.
+func WithClock(clock scheduler.Clock) InterpreterOption {
+	return func(i *Interpreter) {
+		i.timerClock = clock
+	}
+}
+
+// WithTimerMinInterval sets the shortest 'every' interval scripts may
+// schedule. Zero keeps scheduler.DefaultMinInterval.
+func WithTimerMinInterval(d time.Duration) InterpreterOption {
+	return func(i *Interpreter) {
+		i.timerMinInterval = d
+	}
+}
+
+// schedulerConfig is the configuration the root's scheduler is built with.
+func (i *Interpreter) schedulerConfig() scheduler.Config {
+	return scheduler.Config{Clock: i.timerClock, MinInterval: i.timerMinInterval}
+}
+
+// Scheduler returns the root interpreter's timer scheduler, creating it on
+// first use. Once StopScheduler has been called it refuses new timers.
+func (i *Interpreter) Scheduler() *scheduler.Scheduler {
+	root := i.rootInterpreter()
+	root.timersMu.Lock()
+	defer root.timersMu.Unlock()
+	if root.timers == nil {
+		root.timers = scheduler.New(root.schedulerConfig(), root.fireTimer)
+	}
+	return root.timers
+}
+
+// StopScheduler cancels every timer and waits for events already firing to
+// be handed over. It returns ctx.Err() if ctx ends first. Events handed to
+// an event queue are not waited for; use ShutdownEvents for those.
+func (i *Interpreter) StopScheduler(ctx context.Context) error {
+	root := i.rootInterpreter()
+	root.timersMu.Lock()
+	if root.timers == nil {
+		// Nothing was ever scheduled; make sure nothing can be.
+		root.timers = scheduler.New(root.schedulerConfig(), root.fireTimer)
+	}
+	timers := root.timers
+	root.timersMu.Unlock()
+	return timers.Stop(ctx)
+}
+
+// Timers describes the active timers, soonest first.
+func (i *Interpreter) Timers() []scheduler.Info {
+	return i.Scheduler().List()
+}
+
+// fireTimer delivers a due timer's event like EmitEvent, but reports a host
+// without event I/O in the log instead of panicking on the timer goroutine.
+func (i *Interpreter) fireTimer(info scheduler.Info, payload lang.Value) {
+	if payload == nil {
+		payload = lang.NewMapValue(nil)
+	}
+	source := timerSourcePrefix + info.ID
+	handlers := i.eventManager.handlersFor(info.Event)
+	if len(handlers) == 0 {
+		i.Logger().Debug("Timer fired but no handlers were registered for its event", "event_name", info.Event, "timer", info.ID)
+		return
+	}
+	if i.hostContext == nil || i.hostContext.EmitFunc == nil || i.hostContext.WhisperFunc == nil {
+		i.Logger().Error("Timer fired but the host has not configured event I/O", "event_name", info.Event, "timer", info.ID)
+		return
+	}
+	if q := i.eventQueue; q != nil {
+		q.enqueue(info.Event, source, payload, handlers)
+		return
+	}
+	i.dispatchEvent(info.Event, source, payload, handlers)
+}
@@ -0,0 +1,121 @@
+// NeuroScript Version: 0.8.0
+// File version: 2
+// Purpose: Tests that the root's timer scheduler fires ordinary named events into the EventManager, that StopScheduler stops it, and the minimum 'every' interval.
+// filename: pkg/interpreter/timers_test.go
+// nlines: 121
+// risk_rating: LOW
+
+package interpreter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/scheduler"
+)
+
+const timerScript = `
+on event "poll" as ev do
+	emit "poll " + ev.payload[0].AgentID + " " + ev.payload[0].Payload.inbox
+endon
+
+on event "tick" do
+	emit "tick"
+endon
+`
+
+func newTimerInterpreter(t *testing.T, opts ...InterpreterOption) (*Interpreter, *scheduler.FakeClock, *[]string) {
+	t.Helper()
+	clock := scheduler.NewFakeClock(time.Date(2026, 3, 14, 10, 0, 0, 0, time.UTC))
+	var emitted []string
+	interp := newScriptInterpreter(t, timerScript, append([]InterpreterOption{WithClock(clock)}, opts...)...)
+	interp.hostContext.EmitFunc = func(v lang.Value) {
+		s, _ := lang.ToString(v)
+		emitted = append(emitted, s)
+	}
+	return interp, clock, &emitted
+}
+
+func TestTimers_FireNamedEvents(t *testing.T) {
+	interp, clock, emitted := newTimerInterpreter(t)
+	payload, _ := lang.Wrap(map[string]any{"inbox": "support"})
+	if _, err := interp.Scheduler().Schedule(scheduler.Spec{ID: "inbox", Event: "poll", Kind: scheduler.KindEvery, Delay: time.Minute, Payload: payload}); err != nil {
+		t.Fatalf("Schedule: %v", err)
+	}
+	if _, err := interp.newFrame().Scheduler().Schedule(scheduler.Spec{Event: "tick", Kind: scheduler.KindAfter, Delay: 90 * time.Second}); err != nil {
+		t.Fatalf("Schedule from a frame: %v", err)
+	}
+	if _, err := interp.Scheduler().Schedule(scheduler.Spec{Event: "unhandled", Kind: scheduler.KindAfter}); err != nil {
+		t.Fatalf("Schedule: %v", err)
+	}
+
+	clock.Advance(2 * time.Minute)
+	want := "[poll timer:inbox support tick poll timer:inbox support]"
+	if got := fmt.Sprint(*emitted); got != want {
+		t.Errorf("got emits %s, want %s", got, want)
+	}
+	if timers := interp.Timers(); len(timers) != 1 || timers[0].ID != "inbox" || timers[0].Fired != 2 {
+		t.Errorf("unexpected timers %+v", timers)
+	}
+}
+
+func TestTimers_ThroughEventQueue(t *testing.T) {
+	interp, clock, emitted := newTimerInterpreter(t, WithEventQueue(EventQueueConfig{Workers: 1}))
+	t.Cleanup(func() { _ = interp.ShutdownEvents(context.Background()) })
+	if _, err := interp.Scheduler().Schedule(scheduler.Spec{Event: "tick", Kind: scheduler.KindEvery, Delay: time.Second}); err != nil {
+		t.Fatalf("Schedule: %v", err)
+	}
+	clock.Advance(3 * time.Second)
+	if err := interp.DrainEvents(context.Background()); err != nil {
+		t.Fatalf("DrainEvents: %v", err)
+	}
+	if got := fmt.Sprint(*emitted); got != "[tick tick tick]" {
+		t.Errorf("got emits %s, want [tick tick tick]", got)
+	}
+}
+
+func TestTimers_StopScheduler(t *testing.T) {
+	interp, clock, emitted := newTimerInterpreter(t)
+	if _, err := interp.Scheduler().Schedule(scheduler.Spec{Event: "tick", Kind: scheduler.KindEvery, Delay: time.Second}); err != nil {
+		t.Fatalf("Schedule: %v", err)
+	}
+	if err := interp.StopScheduler(context.Background()); err != nil {
+		t.Fatalf("StopScheduler: %v", err)
+	}
+	clock.Advance(time.Minute)
+	if len(*emitted) != 0 {
+		t.Errorf("a stopped scheduler fired: %q", *emitted)
+	}
+	if _, err := interp.Scheduler().Schedule(scheduler.Spec{Event: "tick", Kind: scheduler.KindAfter}); !errors.Is(err, scheduler.ErrStopped) {
+		t.Errorf("got %v, want scheduler.ErrStopped", err)
+	}
+
+	fresh, _, _ := newTimerInterpreter(t)
+	if err := fresh.StopScheduler(context.Background()); err != nil {
+		t.Fatalf("StopScheduler before any timer: %v", err)
+	}
+	if !fresh.Scheduler().Stopped() {
+		t.Error("stopping an unused scheduler did not keep new timers out")
+	}
+}
+
+func TestTimers_MinInterval(t *testing.T) {
+	interp, _, _ := newTimerInterpreter(t)
+	fast := scheduler.Spec{Event: "tick", Kind: scheduler.KindEvery, Delay: 100 * time.Millisecond}
+	if _, err := interp.Scheduler().Schedule(fast); !errors.Is(err, scheduler.ErrInvalidSpec) {
+		t.Errorf("got %v, want scheduler.ErrInvalidSpec below the default minimum", err)
+	}
+
+	lowered, clock, emitted := newTimerInterpreter(t, WithTimerMinInterval(50*time.Millisecond))
+	if _, err := lowered.newFrame().Scheduler().Schedule(fast); err != nil {
+		t.Fatalf("Schedule with a lowered minimum: %v", err)
+	}
+	clock.Advance(200 * time.Millisecond)
+	if got := fmt.Sprint(*emitted); got != "[tick tick]" {
+		t.Errorf("got emits %s, want [tick tick]", got)
+	}
+}
@@ -0,0 +1,185 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Tests try/catch/finally: bound error values, rethrow and finally on every exit path.
+// filename: pkg/interpreter/try_test.go
+// nlines: 185
+// risk_rating: LOW
+
+package interpreter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/lang"
+)
+
+const tryScript = `
+func caught_message(returns out) means
+	try
+		fail "boom"
+	catch err
+		return typeof(err) + ":" + err["message"] + ":" + err["code"]
+	endtry
+endfunc
+
+func caught_position(returns out) means
+	try
+		set x = 1
+		fail "here"
+	catch err
+		return err["position"]["line"]
+	endtry
+endfunc
+
+func catch_without_name(returns out) means
+	set r = "start"
+	try
+		must false
+	catch
+		set r = "caught"
+	endtry
+	return r
+endfunc
+
+func finally_normal(returns out) means
+	set trail = "a"
+	try
+		set trail = trail + "b"
+	catch err
+		set trail = trail + "x"
+	finally
+		set trail = trail + "c"
+	endtry
+	return trail
+endfunc
+
+func finally_on_return(returns out) means
+	try
+		return "body"
+	finally
+		fail "finally ran"
+	endtry
+endfunc
+
+func finally_return_wins(returns out) means
+	try
+		return "body"
+	finally
+		return "finally"
+	endtry
+endfunc
+
+func finally_on_break(returns out) means
+	set n = 0
+	for each i in [1, 2, 3]
+		try
+			break
+		catch err
+			return "break was caught"
+		finally
+			set n = n + 1
+		endtry
+	endfor
+	return n
+endfunc
+
+func finally_on_error(returns out) means
+	set cleaned = false
+	try
+		try
+			fail "inner"
+		finally
+			set cleaned = true
+		endtry
+	catch err
+		return err["message"] + ":" + cleaned
+	endtry
+endfunc
+
+func rethrow(returns out) means
+	try
+		try
+			fail "first"
+		catch first
+			fail first
+		endtry
+	catch second
+		return second["message"] + ":" + second["position"]["line"]
+	endtry
+endfunc
+
+func uncaught_rethrow(returns out) means
+	try
+		fail "original"
+	catch err
+		fail err
+	endtry
+endfunc
+
+func catch_before_handler(returns out) means
+	on error do
+		fail "handler ran"
+	endon
+	try
+		fail "x"
+	catch err
+		set r = "caught"
+	endtry
+	return r
+endfunc
+
+func handler_after_catch(returns out) means
+	on error do
+		clear_error
+	endon
+	try
+		fail "x"
+	catch err
+		fail "from catch"
+	endtry
+	return "handled"
+endfunc
+`
+
+func TestTryCatchFinally(t *testing.T) {
+	cases := []struct {
+		proc    string
+		want    string
+		wantErr string
+	}{
+		{proc: "caught_message", want: "error:boom:1"},
+		{proc: "caught_position", want: "13"},
+		{proc: "catch_without_name", want: "caught"},
+		{proc: "finally_normal", want: "abc"},
+		{proc: "finally_on_return", wantErr: "finally ran"},
+		{proc: "finally_return_wins", want: "finally"},
+		{proc: "finally_on_break", want: "1"},
+		{proc: "finally_on_error", want: "inner:true"},
+		{proc: "rethrow", want: "first:87"},
+		{proc: "uncaught_rethrow", wantErr: "original"},
+		{proc: "catch_before_handler", want: "caught"},
+		{proc: "handler_after_catch", want: "handled"},
+	}
+	modes := map[string][]InterpreterOption{"compiled": nil, "treewalk": {WithTreeWalker()}}
+	for modeName, opts := range modes {
+		interp := newScriptInterpreter(t, tryScript, opts...)
+		for _, c := range cases {
+			t.Run(modeName+"/"+c.proc, func(t *testing.T) {
+				got, err := interp.RunProcedure(c.proc)
+				if c.wantErr != "" {
+					if err == nil || !strings.Contains(err.Error(), c.wantErr) {
+						t.Fatalf("expected error containing %q, got %v", c.wantErr, err)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if s, _ := lang.ToString(got); s != c.want {
+					t.Errorf("got %q, want %q", s, c.want)
+				}
+			})
+		}
+	}
+}
@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.5.2
-// File version: 17
-// Purpose: Implements shape validation with options for case-insensitivity and float-to-int coercion.
+// File version: 18
+// Purpose: Implements shape validation with options for case-insensitivity and float-to-int coercion. Ints satisfy "float".
 // filename: pkg/json-lite/shape.go
-// nlines: 302
+// nlines: 303
 // risk_rating: MEDIUM
 
 package json_lite
@@ -252,6 +252,11 @@ func validatePrimitive(value any, shapeType string, path string) error {
 		}
 	}
 
+	// Integers widen to floats without loss of meaning.
+	if shapeType == "float" && typeName == "int" {
+		return nil
+	}
+
 	if typeName != shapeType {
 		return fmt.Errorf("%w: at path '%s', expected type '%s' but got '%s'", ErrValidationTypeMismatch, path, shapeType, typeName)
 	}
@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.5.2
-// File version: 1
+// File version: 2
 // Purpose: Defines shared string constants for map keys and other literals used across the interpreter.
 // filename: pkg/lang/constants.go
-// nlines: 20
+// nlines: 27
 // risk_rating: LOW
 
 package lang
@@ -22,4 +22,7 @@ const (
 	ErrorKeyCode	= "code"
 	// ErrorKeyDetails is the key for an error's details in an ErrorValue map.
 	ErrorKeyDetails	= "details"
+	// ErrorKeyPosition is the key for where an error was raised, a map of
+	// line, column and file, in an ErrorValue map.
+	ErrorKeyPosition	= "position"
 )
\ No newline at end of file
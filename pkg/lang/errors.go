@@ -1,8 +1,8 @@
 // filename: pkg/lang/errors.go
 // NeuroScript Version: 0.5.2
-// File version: 7
-// Purpose: Added the ErrInvalidUTF8 sentinel error for centralization.
-// nlines: 232
+// File version: 12
+// Purpose: Adds ErrDeadLetterNotFound, ErrDeadLetterHandlerGone and ErrDeadLetterBusy for replaying events whose handlers failed.
+// nlines: 438
 // risk_rating: LOW
 
 package lang
@@ -120,9 +120,11 @@ const (
 	ErrorCodeControlFlow          ErrorCode = 39
 	ErrProviderNotFound           ErrorCode = 40
 
-	ErrorCodeInvalidValue   ErrorCode = 41
-	ErrorCodeDuplicate      ErrorCode = 42
-	ErrorCodeWriteViolation ErrorCode = 43 // Added for read-only global enforcement
+	ErrorCodeInvalidValue      ErrorCode = 41
+	ErrorCodeDuplicate         ErrorCode = 42
+	ErrorCodeWriteViolation    ErrorCode = 43 // Added for read-only global enforcement
+	ErrorCodeIntegerOverflow   ErrorCode = 44
+	ErrorCodeUndefinedVariable ErrorCode = 45
 
 	// --- SECURITY codes (99 900-99 999).  Stable for signing / IR play-books. ----
 	SecurityBase ErrorCode = 99900
@@ -288,10 +290,18 @@ var (
 	ErrLLMError             = errors.New("LLM interaction failed")
 	ErrLLMNotConfigured     = errors.New("LLM client not configured in interpreter")
 	ErrDivisionByZero       = errors.New("division by zero")
+	ErrIntegerOverflow      = errors.New("integer overflow")
 	ErrMustConditionFailed  = errors.New("must condition evaluated to false")
 	ErrAssignCountMismatch  = errors.New("assignment count mismatch")
 	ErrMultiAssignNonList   = errors.New("multiple assignment requires a list value on the right-hand side")
 
+	// Event Errors
+	ErrMaxEventDepthExceeded = errors.New("maximum event depth exceeded")
+	ErrGuardCallNotAllowed   = errors.New("tool and procedure calls are not allowed in event guards")
+	ErrDeadLetterNotFound    = errors.New("dead letter not found")
+	ErrDeadLetterHandlerGone = errors.New("the handler of the dead letter is no longer registered")
+	ErrDeadLetterBusy        = errors.New("the dead letter is already being replayed")
+
 	// AI WM Errors
 	ErrAuthDetailsMissing    = errors.New("authentication details are missing")
 	ErrAPIKeyNotFound        = errors.New("API key not found though configuration implies one should exist")
@@ -1,6 +1,6 @@
 // NeuroScript Version: 0.6.0
-// File version: 3
-// Purpose: Defines additional error codes and adds a sentinel error for policy violations.
+// File version: 4
+// Purpose: Defines additional error codes and adds a sentinel error for policy violations. Adds ErrorCodeBudgetExceeded.
 // filename: pkg/lang/errors_more.go
 // nlines: 20
 // risk_rating: LOW
@@ -19,6 +19,9 @@ const (
 	ErrorCodePolicy ErrorCode = 1003
 	// ErrorCodeProviderNotFound indicates a configured AI provider could not be found.
 	ErrorCodeProviderNotFound ErrorCode = 1004
+	// ErrorCodeBudgetExceeded indicates an LLM call was refused or failed because
+	// it would exceed (or did exceed) a per-call or per-run spend budget.
+	ErrorCodeBudgetExceeded ErrorCode = 1005
 )
 
 var (
@@ -0,0 +1,218 @@
+// NeuroScript Version: 0.8.0
+// File version: 4
+// Purpose: Exact 64-bit integer arithmetic with overflow detection for IntValue operands, and exact int/float comparison.
+// filename: pkg/lang/operators_int.go
+// nlines: 218
+// risk_rating: HIGH
+
+package lang
+
+import (
+	"fmt"
+	"math"
+)
+
+// intPair returns both operands as int64 when both are IntValues. Any other
+// combination is promoted to float64 arithmetic by the caller.
+func intPair(left, right Value) (int64, int64, bool) {
+	l, lok := left.(IntValue)
+	r, rok := right.(IntValue)
+	if !lok || !rok {
+		return 0, 0, false
+	}
+	return l.Value, r.Value, true
+}
+
+func overflowError(op string, l, r int64) error {
+	return NewRuntimeError(ErrorCodeIntegerOverflow, fmt.Sprintf("integer overflow: %d %s %d", l, op, r), ErrIntegerOverflow)
+}
+
+func addInt(a, b int64) (int64, bool) {
+	c := a + b
+	if (b > 0 && c < a) || (b < 0 && c > a) {
+		return 0, false
+	}
+	return c, true
+}
+
+func subInt(a, b int64) (int64, bool) {
+	c := a - b
+	if (b > 0 && c > a) || (b < 0 && c < a) {
+		return 0, false
+	}
+	return c, true
+}
+
+func mulInt(a, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	if (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		return 0, false
+	}
+	c := a * b
+	if c/b != a {
+		return 0, false
+	}
+	return c, true
+}
+
+// powInt raises base to a non-negative exponent by repeated squaring.
+func powInt(base, exp int64) (int64, bool) {
+	result := int64(1)
+	for exp > 0 {
+		if exp&1 == 1 {
+			var ok bool
+			if result, ok = mulInt(result, base); !ok {
+				return 0, false
+			}
+		}
+		exp >>= 1
+		if exp > 0 {
+			var ok bool
+			if base, ok = mulInt(base, base); !ok {
+				return 0, false
+			}
+		}
+	}
+	return result, true
+}
+
+// performIntArithmetic applies a binary arithmetic operator to two integers.
+// '/' is true division: the result is an integer only when the division is
+// exact, and a float otherwise. '%' takes the sign of the dividend, as in Go.
+// A negative exponent has no integer result and yields a float.
+func performIntArithmetic(op string, l, r int64) (Value, error) {
+	var (
+		result int64
+		ok     = true
+	)
+	switch op {
+	case "+":
+		result, ok = addInt(l, r)
+	case "-":
+		result, ok = subInt(l, r)
+	case "*":
+		result, ok = mulInt(l, r)
+	case "/":
+		if r == 0 {
+			return nil, NewRuntimeError(ErrorCodeDivisionByZero, "division by zero", ErrDivisionByZero)
+		}
+		if l%r != 0 {
+			return NumberValue{Value: float64(l) / float64(r)}, nil
+		}
+		if l == math.MinInt64 && r == -1 {
+			return nil, overflowError(op, l, r)
+		}
+		result = l / r
+	case "%":
+		if r == 0 {
+			return nil, NewRuntimeError(ErrorCodeDivisionByZero, "modulo by zero", ErrDivisionByZero)
+		}
+		result = l % r
+	case "**":
+		if r < 0 {
+			return NumberValue{Value: math.Pow(float64(l), float64(r))}, nil
+		}
+		result, ok = powInt(l, r)
+	default:
+		return nil, fmt.Errorf("unknown arithmetic op '%s': %w", op, ErrUnsupportedOperator)
+	}
+	if !ok {
+		return nil, overflowError(op, l, r)
+	}
+	return IntValue{Value: result}, nil
+}
+
+// compareInts applies an ordering operator to two integers.
+func compareInts(op string, l, r int64) (Value, error) {
+	switch op {
+	case "<":
+		return BoolValue{Value: l < r}, nil
+	case ">":
+		return BoolValue{Value: l > r}, nil
+	case "<=":
+		return BoolValue{Value: l <= r}, nil
+	case ">=":
+		return BoolValue{Value: l >= r}, nil
+	}
+	return nil, fmt.Errorf("unknown comparison op '%s'", op)
+}
+
+// twoPow63 is 2^63, the first float64 above every int64.
+const twoPow63 = 9223372036854775808.0
+
+// cmpIntFloat compares an integer with a float exactly, without rounding the
+// integer to float64 first. It returns -1, 0 or 1 as i is less than, equal to
+// or greater than f; ok is false when f is NaN and the two are unordered.
+func cmpIntFloat(i int64, f float64) (c int, ok bool) {
+	switch {
+	case math.IsNaN(f):
+		return 0, false
+	case f >= twoPow63:
+		return -1, true
+	case f < -twoPow63:
+		return 1, true
+	}
+	// f is now within the int64 range, so its integer part converts exactly.
+	whole := math.Trunc(f)
+	t := int64(whole)
+	switch {
+	case i < t:
+		return -1, true
+	case i > t:
+		return 1, true
+	case f > whole:
+		return -1, true
+	case f < whole:
+		return 1, true
+	}
+	return 0, true
+}
+
+// CompareIntFloat orders an integer against a float exactly, returning -1,
+// 0 or 1 as i is less than, equal to or greater than f. ok is false when f
+// is NaN. Code outside the operators that sorts or matches numbers uses it
+// instead of rounding the integer to float64.
+func CompareIntFloat(i int64, f float64) (c int, ok bool) {
+	return cmpIntFloat(i, f)
+}
+
+// mixedIntFloat returns the operands of a comparison between an integer and a
+// float as an integer, a float and whether the integer was on the left.
+func mixedIntFloat(left, right Value) (i int64, f float64, intLeft, ok bool) {
+	if l, lok := left.(IntValue); lok {
+		if r, rok := right.(NumberValue); rok {
+			return l.Value, r.Value, true, true
+		}
+	}
+	if l, lok := left.(NumberValue); lok {
+		if r, rok := right.(IntValue); rok {
+			return r.Value, l.Value, false, true
+		}
+	}
+	return 0, 0, false, false
+}
+
+// compareIntFloat applies an ordering operator to an integer and a float
+// exactly. A NaN operand makes every ordering false.
+func compareIntFloat(op string, i int64, f float64, intLeft bool) (Value, error) {
+	c, ok := cmpIntFloat(i, f)
+	if !ok {
+		return BoolValue{Value: false}, nil
+	}
+	if !intLeft {
+		c = -c
+	}
+	switch op {
+	case "<":
+		return BoolValue{Value: c < 0}, nil
+	case ">":
+		return BoolValue{Value: c > 0}, nil
+	case "<=":
+		return BoolValue{Value: c <= 0}, nil
+	case ">=":
+		return BoolValue{Value: c >= 0}, nil
+	}
+	return nil, fmt.Errorf("unknown comparison op '%s'", op)
+}
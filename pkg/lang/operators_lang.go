@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.8.0
-// File version: 6
-// Purpose: Fixes nil equality checks and prevents stringification of collection addition.
+// File version: 9
+// Purpose: Integer operands use exact arithmetic; mixed int/float arithmetic promotes to float, while mixed comparisons are exact.
 // filename: pkg/lang/operators_lang.go
-// nlines: 260
+// nlines: 421
 // risk_rating: MEDIUM
 
 package lang
@@ -20,6 +20,38 @@ func typeErrorForOp(op string, left, right Value) error {
 	return fmt.Errorf("operator '%s' cannot be applied to types %s and %s: %w", op, TypeOf(left), TypeOf(right), ErrInvalidOperandType)
 }
 
+// ShortCircuit reports whether the left operand alone decides a logical
+// 'and' or 'or'. If decided is true, result is the value of the whole
+// expression and the right operand must not be evaluated.
+//
+// A non-fuzzy left operand decides by truthiness and yields a BoolValue:
+// falsy for 'and', truthy for 'or'. A fuzzy left operand decides only at the
+// extreme where min/max can no longer change (0 for 'and', 1 for 'or') and
+// yields that FuzzyValue; any other fuzzy value needs the right operand.
+func ShortCircuit(op string, left Value) (result Value, decided bool) {
+	opLower := strings.ToLower(op)
+	if opLower != "and" && opLower != "or" {
+		return nil, false
+	}
+	if f, ok := left.(FuzzyValue); ok {
+		switch {
+		case opLower == "and" && f.GetValue() == 0:
+			return NewFuzzyValue(0), true
+		case opLower == "or" && f.GetValue() == 1:
+			return NewFuzzyValue(1), true
+		}
+		return nil, false
+	}
+	truthy := IsTruthy(left)
+	if opLower == "and" && !truthy {
+		return BoolValue{Value: false}, true
+	}
+	if opLower == "or" && truthy {
+		return BoolValue{Value: true}, true
+	}
+	return nil, false
+}
+
 // PerformBinaryOperation performs infix binary operations.
 func PerformBinaryOperation(op string, left, right Value) (Value, error) {
 	opLower := strings.ToLower(op)
@@ -80,6 +112,12 @@ func PerformUnaryOperation(op string, operand Value) (Value, error) {
 		return BoolValue{Value: !IsTruthy(operand)}, nil
 
 	case "-":
+		if iv, ok := operand.(IntValue); ok {
+			if iv.Value == math.MinInt64 {
+				return nil, NewRuntimeError(ErrorCodeIntegerOverflow, fmt.Sprintf("integer overflow: -(%d)", iv.Value), ErrIntegerOverflow)
+			}
+			return IntValue{Value: -iv.Value}, nil
+		}
 		num, ok := ToNumeric(operand)
 		if !ok {
 			return nil, fmt.Errorf("%w: unary operator '-' needs number, got %s", ErrInvalidOperandTypeNumeric, TypeOf(operand))
@@ -90,7 +128,7 @@ func PerformUnaryOperation(op string, operand Value) (Value, error) {
 		if !isInt {
 			return nil, fmt.Errorf("%w: unary operator '~' needs integer, got %s", ErrInvalidOperandTypeInteger, TypeOf(operand))
 		}
-		return NumberValue{Value: float64(^iVal)}, nil
+		return IntValue{Value: ^iVal}, nil
 	case "no":
 		return BoolValue{Value: IsZeroValue(operand)}, nil
 	case "some":
@@ -107,22 +145,22 @@ func performArithmetic(left, right Value, op string) (Value, error) {
 	if op == "*" {
 		// Case 1: string * int
 		if s, ok := left.(StringValue); ok {
-			if n, ok := right.(NumberValue); ok {
-				if n.Value == math.Trunc(n.Value) && n.Value >= 0 {
-					return StringValue{Value: strings.Repeat(s.Value, int(n.Value))}, nil
-				}
+			if n, ok := repeatCount(right); ok {
+				return StringValue{Value: strings.Repeat(s.Value, n)}, nil
 			}
 		}
 		// Case 2: int * string
 		if s, ok := right.(StringValue); ok {
-			if n, ok := left.(NumberValue); ok {
-				if n.Value == math.Trunc(n.Value) && n.Value >= 0 {
-					return StringValue{Value: strings.Repeat(s.Value, int(n.Value))}, nil
-				}
+			if n, ok := repeatCount(left); ok {
+				return StringValue{Value: strings.Repeat(s.Value, n)}, nil
 			}
 		}
 	}
 
+	if l, r, ok := intPair(left, right); ok {
+		return performIntArithmetic(op, l, r)
+	}
+
 	leftNum, leftOk := ToNumeric(left)
 	rightNum, rightOk := ToNumeric(right)
 
@@ -156,7 +194,26 @@ func performArithmetic(left, right Value, op string) (Value, error) {
 	return nil, fmt.Errorf("unknown arithmetic op '%s': %w", op, ErrUnsupportedOperator)
 }
 
+// repeatCount returns a non-negative whole number usable as a string
+// repetition count.
+func repeatCount(v Value) (int, bool) {
+	switch n := v.(type) {
+	case IntValue:
+		if n.Value >= 0 && n.Value <= math.MaxInt32 {
+			return int(n.Value), true
+		}
+	case NumberValue:
+		if n.Value == math.Trunc(n.Value) && n.Value >= 0 && n.Value <= math.MaxInt32 {
+			return int(n.Value), true
+		}
+	}
+	return 0, false
+}
+
 func performStringConcatOrNumericAdd(left, right Value) (Value, error) {
+	if l, r, ok := intPair(left, right); ok {
+		return performIntArithmetic("+", l, r)
+	}
 	leftNum, isLeftNum := ToNumeric(left)
 	rightNum, isRightNum := ToNumeric(right)
 	if isLeftNum && isRightNum {
@@ -211,6 +268,13 @@ func areValuesEqual(left, right Value) bool {
 		return leftIsNil && rightIsNil // Both must be nil to be equal
 	}
 
+	// An integer and a float are equal only if they denote the same number;
+	// rounding the integer to float64 would equate 2^53+1 with 2^53.
+	if i, f, _, ok := mixedIntFloat(left, right); ok {
+		c, ordered := cmpIntFloat(i, f)
+		return ordered && c == 0
+	}
+
 	// Handle core types directly for clarity and correctness.
 	switch lVal := left.(type) {
 	case StringValue:
@@ -218,13 +282,21 @@ func areValuesEqual(left, right Value) bool {
 			return lVal.Value == rVal.Value
 		}
 		// Try numeric conversion for cross-type comparison, e.g., "5" == 5
-		if _, ok := right.(NumberValue); ok {
+		if isNumberValue(right) {
 			lNum, lOk := ToFloat64(lVal)
 			rNum, rOk := ToFloat64(right)
 			return lOk && rOk && lNum == rNum
 		}
 		return false
 
+	case IntValue:
+		if rVal, ok := right.(IntValue); ok {
+			return lVal.Value == rVal.Value
+		}
+		lNum, lOk := ToFloat64(lVal)
+		rNum, rOk := ToFloat64(right)
+		return lOk && rOk && lNum == rNum
+
 	case NumberValue:
 		// Allow comparison with strings that can be numbers
 		lNum, lOk := ToFloat64(lVal)
@@ -282,6 +354,12 @@ func performComparison(left, right Value, op string) (Value, error) {
 			return BoolValue{Value: lVal.GetValue() >= rVal.Value}, nil
 		}
 	}
+	if l, r, ok := intPair(left, right); ok {
+		return compareInts(op, l, r)
+	}
+	if i, f, intLeft, ok := mixedIntFloat(left, right); ok {
+		return compareIntFloat(op, i, f, intLeft)
+	}
 	leftF, leftOk := ToNumeric(left)
 	rightF, rightOk := ToNumeric(right)
 	if !leftOk || !rightOk {
@@ -311,15 +389,24 @@ func performBitwise(left, right Value, op string) (Value, error) {
 	}
 	switch op {
 	case "&":
-		return NumberValue{Value: float64(leftI & rightI)}, nil
+		return IntValue{Value: leftI & rightI}, nil
 	case "|":
-		return NumberValue{Value: float64(leftI | rightI)}, nil
+		return IntValue{Value: leftI | rightI}, nil
 	case "^":
-		return NumberValue{Value: float64(leftI ^ rightI)}, nil
+		return IntValue{Value: leftI ^ rightI}, nil
 	}
 	return nil, fmt.Errorf("unknown bitwise op '%s'", op)
 }
 
+// isNumberValue reports whether v is an integer or float number.
+func isNumberValue(v Value) bool {
+	switch v.(type) {
+	case NumberValue, IntValue:
+		return true
+	}
+	return false
+}
+
 func toFuzzy(v Value) (FuzzyValue, bool) {
 	if f, ok := v.(FuzzyValue); ok {
 		return f, true
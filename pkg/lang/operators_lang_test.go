@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.8.0
-// File version: 3
+// File version: 4
 // Purpose: Corrects error assertions for nil operands and outdated operator logic.
 // filename: pkg/lang/operators_lang_test.go
-// nlines: 202
+// nlines: 183
 // risk_rating: LOW
 
 package lang
@@ -50,6 +50,17 @@ func TestPerformBinaryOperation(t *testing.T) {
 		{name: `number > number`, op: ">", left: NumberValue{10}, right: NumberValue{5}, expected: BoolValue{true}},
 		{name: `number <= number`, op: "<=", left: NumberValue{5}, right: NumberValue{5}, expected: BoolValue{true}},
 
+		// --- Mixed int/float comparison is exact ---
+		{name: `int == float (true)`, op: "==", left: IntValue{5}, right: NumberValue{5}, expected: BoolValue{true}},
+		{name: `int == float above 2^53 (false)`, op: "==", left: IntValue{9007199254740993}, right: NumberValue{9007199254740992}, expected: BoolValue{false}},
+		{name: `float == int above 2^53 (false)`, op: "==", left: NumberValue{9007199254740992}, right: IntValue{9007199254740993}, expected: BoolValue{false}},
+		{name: `int != float above 2^53 (true)`, op: "!=", left: IntValue{9007199254740993}, right: NumberValue{9007199254740992}, expected: BoolValue{true}},
+		{name: `int > float above 2^53`, op: ">", left: IntValue{9007199254740993}, right: NumberValue{9007199254740992}, expected: BoolValue{true}},
+		{name: `float < int above 2^53`, op: "<", left: NumberValue{9007199254740992}, right: IntValue{9007199254740993}, expected: BoolValue{true}},
+		{name: `max int < 2^63 float`, op: "<", left: IntValue{9223372036854775807}, right: NumberValue{9223372036854775808}, expected: BoolValue{true}},
+		{name: `int < float with fraction`, op: "<", left: IntValue{-3}, right: NumberValue{-2.5}, expected: BoolValue{true}},
+		{name: `int >= float with fraction`, op: ">=", left: IntValue{3}, right: NumberValue{2.5}, expected: BoolValue{true}},
+
 		// --- Arithmetic ---
 		{name: `number + number`, op: "+", left: NumberValue{2}, right: NumberValue{3}, expected: NumberValue{5}},
 		{name: `string + number`, op: "+", left: StringValue{"a"}, right: NumberValue{3}, expected: StringValue{"a3"}},
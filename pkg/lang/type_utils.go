@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 18
+// :: fileVersion: 20
 // :: description: Patched IsTruthy and IsZeroValue to unwrap interfaces and recursively check for typed nils and empty collections. Fixed non-nil pointer tests. Added depth limit to recursion.
-// :: latestChange: Added max depth to isDeepTruthy to prevent infinite loops from pathological self-referential pointers/interfaces.
+// :: latestChange: IntValue support; ToInt64 converts integers exactly instead of via float64.
 // :: filename: pkg/lang/type_utils.go
 // :: serialization: go
 
@@ -10,6 +10,7 @@ package lang
 
 import (
 	"fmt"
+	"math"
 	"reflect"
 	"strconv"
 	"strings"
@@ -108,6 +109,8 @@ func UnwrapValue(v interface{}) interface{} {
 		return val.Value
 	case NumberValue:
 		return val.Value
+	case IntValue:
+		return val.Value
 	case BoolValue:
 		return val.Value
 	case NilValue:
@@ -164,6 +167,13 @@ func UnwrapValue(v interface{}) interface{} {
 
 // toFloat64 attempts conversion to float64 from various raw and wrapped types.
 func ToFloat64(val interface{}) (float64, bool) {
+	// Fast path for the common case; avoids boxing the unwrapped float.
+	switch n := val.(type) {
+	case NumberValue:
+		return n.Value, true
+	case IntValue:
+		return float64(n.Value), true
+	}
 	nativeVal := UnwrapValue(val)
 	if nativeVal == nil {
 		return 0, false
@@ -199,13 +209,36 @@ func ToFloat64(val interface{}) (float64, bool) {
 }
 
 // toInt64 attempts lossless conversion to int64 from various raw and wrapped types.
+// Integers convert exactly; floats and numeric strings only when they are whole
+// numbers within int64 range.
 func ToInt64(val interface{}) (int64, bool) {
+	switch v := UnwrapValue(val).(type) {
+	case int64:
+		return v, true
+	case int:
+		return int64(v), true
+	case string:
+		if i, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64); err == nil {
+			return i, true
+		}
+	default:
+		rv := reflect.ValueOf(v)
+		switch rv.Kind() {
+		case reflect.Int8, reflect.Int16, reflect.Int32:
+			return rv.Int(), true
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			if u := rv.Uint(); u <= math.MaxInt64 {
+				return int64(u), true
+			}
+			return 0, false
+		}
+	}
 	f, ok := ToFloat64(val)
 	if !ok {
 		return 0, false
 	}
-	// Check if the float has a fractional part
-	if f != float64(int64(f)) {
+	// Reject fractions and values outside int64 (2^63 itself is not representable).
+	if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
 		return 0, false
 	}
 	return int64(f), true
@@ -1,6 +1,6 @@
 // NeuroScript Version: 0.7.1
-// File version: 1
-// Purpose: Provides a canonical unwrapper that preserves integer types for shape validation.
+// File version: 2
+// Purpose: Provides a canonical unwrapper that preserves integer types for shape validation. IntValue unwraps to int64.
 // filename: pkg/lang/unwrapper.go
 // nlines: 40
 // risk_rating: LOW
@@ -17,6 +17,8 @@ func UnwrapForShapeValidation(v Value) interface{} {
 		return nil
 	}
 	switch tv := v.(type) {
+	case IntValue:
+		return tv.Value
 	case NumberValue:
 		// If the number is a whole number, return it as an int64.
 		if tv.Value == math.Trunc(tv.Value) {
@@ -1,15 +1,17 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 11
+// :: fileVersion: 12
 // :: description: Corrects 'core.Wrap' error message to 'lang.Wrap' and adds reflection-based wrapping for numeric slices. Added *ListValue unwrapping.
-// :: latestChange: Added explicit *ListValue support in Wrap and Unwrap to handle pointer types from lvalue assignments.
+// :: latestChange: Go integers and integral json.Number values wrap to IntValue; IntValue unwraps to int64.
 // :: filename: pkg/lang/value_helpers.go
 // :: serialization: go
 
 package lang
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
 	"os"
 	"reflect"
 	"time"
@@ -49,11 +51,21 @@ func Wrap(x any) (Value, error) {
 	case bool:
 		return BoolValue{Value: v}, nil
 	case int:
-		return NumberValue{Value: float64(v)}, nil
+		return IntValue{Value: int64(v)}, nil
 	case int64:
-		return NumberValue{Value: float64(v)}, nil
+		return IntValue{Value: v}, nil
 	case float64:
 		return NumberValue{Value: v}, nil
+	case json.Number:
+		// Decoders using UseNumber keep "3" and "3.0" apart; honour that.
+		if i, err := v.Int64(); err == nil {
+			return IntValue{Value: i}, nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return nil, fmt.Errorf("lang.Wrap: invalid JSON number %q: %w", v.String(), err)
+		}
+		return NumberValue{Value: f}, nil
 	case time.Time:
 		return TimedateValue{Value: v}, nil
 
@@ -81,8 +93,16 @@ func Wrap(x any) (Value, error) {
 		return MapValue{Value: newMap}, nil
 
 	default:
-		// Use reflection to handle common slice types.
+		// Use reflection to handle other numeric kinds and common slice types.
 		val := reflect.ValueOf(x)
+		switch val.Kind() {
+		case reflect.Int8, reflect.Int16, reflect.Int32:
+			return IntValue{Value: val.Int()}, nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return wrapUint(val.Uint()), nil
+		case reflect.Float32:
+			return NumberValue{Value: val.Float()}, nil
+		}
 		if val.Kind() == reflect.Slice {
 			elems := make([]Value, val.Len())
 			elemType := val.Type().Elem()
@@ -96,13 +116,13 @@ func Wrap(x any) (Value, error) {
 
 			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 				for i := 0; i < val.Len(); i++ {
-					elems[i] = NumberValue{Value: float64(val.Index(i).Int())}
+					elems[i] = IntValue{Value: val.Index(i).Int()}
 				}
 				return ListValue{Value: elems}, nil
 
 			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
 				for i := 0; i < val.Len(); i++ {
-					elems[i] = NumberValue{Value: float64(val.Index(i).Uint())}
+					elems[i] = wrapUint(val.Index(i).Uint())
 				}
 				return ListValue{Value: elems}, nil
 
@@ -125,6 +145,15 @@ func Wrap(x any) (Value, error) {
 	}
 }
 
+// wrapUint wraps an unsigned integer, falling back to a float only when it
+// does not fit in an int64.
+func wrapUint(u uint64) Value {
+	if u <= math.MaxInt64 {
+		return IntValue{Value: int64(u)}
+	}
+	return NumberValue{Value: float64(u)}
+}
+
 // Unwrap converts a wrapper back to its underlying primitive Go form.
 // (It is intentionally lossy – metadata on wrappers is dropped.)
 func Unwrap(v Value) any {
@@ -144,6 +173,8 @@ func Unwrap(v Value) any {
 		return t.Value
 	case NumberValue:
 		return t.Value
+	case IntValue:
+		return t.Value
 	case TimedateValue:
 		return t.Value
 	case FuzzyValue:
@@ -1,9 +1,9 @@
 // NeuroScript Version: 0.5.2
-// File version: 15
+// File version: 17
 // Purpose: Adds nil-safety to (*MapValue).String() method. Added concrete HandleValue type.
-// Latest change: Added HandleValue struct and its methods.
+// Latest change: Error values carry the raising position and convert back to runtime errors for rethrow.
 // filename: pkg/lang/values.go
-// nlines: 279
+// nlines: 304
 // risk_rating: HIGH
 
 package lang
@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/aprice2704/neuroscript/pkg/interfaces"
+	"github.com/aprice2704/neuroscript/pkg/types"
 )
 
 // Value is the interface that all NeuroScript runtime values must implement.
@@ -47,6 +48,15 @@ func (v NumberValue) Type() NeuroScriptType { return TypeNumber }
 func (v NumberValue) String() string        { return strconv.FormatFloat(v.Value, 'f', -1, 64) }
 func (v NumberValue) IsTruthy() bool        { return v.Value != 0 }
 
+// IntValue is an exact 64-bit integer. It shares the 'number' type name with
+// NumberValue so existing typeof checks keep working; is_int and is_float
+// tell the two apart.
+type IntValue struct{ Value int64 }
+
+func (v IntValue) Type() NeuroScriptType { return TypeNumber }
+func (v IntValue) String() string        { return strconv.FormatInt(v.Value, 10) }
+func (v IntValue) IsTruthy() bool        { return v.Value != 0 }
+
 type BoolValue struct{ Value bool }
 
 func (v BoolValue) Type() NeuroScriptType { return TypeBoolean }
@@ -254,9 +264,41 @@ func NewErrorValueFromRuntimeError(re *RuntimeError) ErrorValue {
 		detailsVal = StringValue{Value: re.Wrapped.Error()}
 	}
 
-	return NewErrorValue(
+	ev := NewErrorValue(
 		strconv.Itoa(int(re.Code)),
 		re.Message,
 		detailsVal,
 	)
+	if re.Position != nil {
+		ev.Value[ErrorKeyPosition] = NewMapValue(map[string]Value{
+			"line":   IntValue{Value: int64(re.Position.Line)},
+			"column": IntValue{Value: int64(re.Position.Column)},
+			"file":   StringValue{Value: re.Position.File},
+		})
+	}
+	return ev
+}
+
+// RuntimeErrorFromErrorValue is the inverse of NewErrorValueFromRuntimeError,
+// used when a script rethrows a caught error with 'fail'. Codes that are not
+// runtime error codes (e.g. from Error.New) become ErrorCodeFailStatement.
+func RuntimeErrorFromErrorValue(ev ErrorValue) *RuntimeError {
+	code := ErrorCodeFailStatement
+	if n, ok := ToInt64(ev.Value[ErrorKeyCode]); ok {
+		code = ErrorCode(n)
+	}
+	msg := "fail statement executed"
+	if m, ok := ev.Value[ErrorKeyMessage]; ok {
+		msg, _ = ToString(m)
+	}
+	re := NewRuntimeError(code, msg, ErrFailStatement)
+	if pm, ok := ev.Value[ErrorKeyPosition].(*MapValue); ok && pm != nil {
+		line, _ := ToInt64(pm.Value["line"])
+		col, _ := ToInt64(pm.Value["column"])
+		file, _ := ToString(pm.Value["file"])
+		if line > 0 {
+			re.Position = &types.Position{Line: int(line), Column: int(col), File: file}
+		}
+	}
+	return re
 }
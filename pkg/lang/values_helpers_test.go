@@ -1,12 +1,13 @@
 // filename: pkg/lang/values_helpers_test.go
 // NeuroScript Version: 0.8.0
-// File version: 8
-// Purpose: Added tests for numeric slice wrapping and to verify the lang.Wrap error message.
-// nlines: 177
+// File version: 9
+// Purpose: Added tests for numeric slice wrapping and to verify the lang.Wrap error message. Integers wrap to IntValue.
+// nlines: 231
 // risk_rating: MEDIUM
 package lang
 
 import (
+	"encoding/json"
 	"reflect"
 	"strings" // Added import
 	"testing"
@@ -29,8 +30,11 @@ func TestWrap(t *testing.T) {
 	}{
 		{"nil", nil, NilValue{}, false},
 		{"string", "hello", StringValue{"hello"}, false},
-		{"int", 123, NumberValue{123}, false},
+		{"int", 123, IntValue{123}, false},
+		{"int64 above 2^53", int64(1<<53 + 1), IntValue{1<<53 + 1}, false},
 		{"float64", 3.14, NumberValue{3.14}, false},
+		{"json.Number int", json.Number("9007199254740993"), IntValue{9007199254740993}, false},
+		{"json.Number float", json.Number("3.0"), NumberValue{3}, false},
 		{"bool", true, BoolValue{true}, false},
 		{"[]byte", []byte("bytes"), BytesValue{[]byte("bytes")}, false},
 		{"time.Time", time.Unix(0, 0).UTC(), TimedateValue{time.Unix(0, 0).UTC()}, false},
@@ -55,7 +59,7 @@ func TestWrap(t *testing.T) {
 		{
 			name:     "[]any",
 			input:    []any{"a", 1},
-			expected: ListValue{[]Value{StringValue{"a"}, NumberValue{1}}},
+			expected: ListValue{[]Value{StringValue{"a"}, IntValue{1}}},
 			hasError: false,
 		},
 		{
@@ -65,7 +69,7 @@ func TestWrap(t *testing.T) {
 				"b": "two",
 			},
 			expected: MapValue{Value: map[string]Value{
-				"a": NumberValue{1},
+				"a": IntValue{1},
 				"b": StringValue{"two"},
 			}},
 			hasError: false,
@@ -74,13 +78,13 @@ func TestWrap(t *testing.T) {
 		{
 			name:     "[]int", // Was "unsupported type", now supported
 			input:    []int{1, 2},
-			expected: ListValue{[]Value{NumberValue{1}, NumberValue{2}}},
+			expected: ListValue{[]Value{IntValue{1}, IntValue{2}}},
 			hasError: false,
 		},
 		{
 			name:     "[]int64",
 			input:    []int64{10, 20},
-			expected: ListValue{[]Value{NumberValue{10}, NumberValue{20}}},
+			expected: ListValue{[]Value{IntValue{10}, IntValue{20}}},
 			hasError: false,
 		},
 		{
@@ -92,7 +96,7 @@ func TestWrap(t *testing.T) {
 		{
 			name:     "[]uint",
 			input:    []uint{5, 6},
-			expected: ListValue{[]Value{NumberValue{5}, NumberValue{6}}},
+			expected: ListValue{[]Value{IntValue{5}, IntValue{6}}},
 			hasError: false,
 		},
 		{
@@ -156,6 +160,7 @@ func TestUnwrap(t *testing.T) {
 		{"BytesValue", BytesValue{[]byte("bytes")}, []byte("bytes")},
 		{"BoolValue", BoolValue{true}, true},
 		{"NumberValue", NumberValue{123}, float64(123)},
+		{"IntValue", IntValue{123}, int64(123)},
 		{"TimedateValue", TimedateValue{time.Unix(0, 0)}, time.Unix(0, 0)},
 		{"FuzzyValue", FuzzyValue{0.5}, 0.5},
 		{"ListValue", ListValue{[]Value{StringValue{"a"}, NumberValue{1}}}, []any{"a", float64(1)}},
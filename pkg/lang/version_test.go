@@ -0,0 +1,39 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Checks that GrammarVersion was regenerated from the current g4 header.
+// filename: pkg/lang/version_test.go
+// nlines: 39
+// risk_rating: LOW
+
+package lang
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestGrammarVersionMatchesG4Header(t *testing.T) {
+	f, err := os.Open("../antlr/NeuroScript.g4")
+	if err != nil {
+		t.Fatalf("open grammar: %v", err)
+	}
+	defer f.Close()
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		rest, ok := strings.CutPrefix(sc.Text(), "// NeuroScript Version:")
+		if !ok {
+			continue
+		}
+		fields := strings.Fields(rest)
+		if len(fields) == 0 {
+			t.Fatal("grammar header has no version")
+		}
+		if fields[0] != GrammarVersion {
+			t.Errorf("GrammarVersion = %q, grammar header says %q; run 'make generate-version'", GrammarVersion, fields[0])
+		}
+		return
+	}
+	t.Fatal("grammar has no 'NeuroScript Version:' header")
+}
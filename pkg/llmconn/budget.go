@@ -0,0 +1,128 @@
+// NeuroScript Version: 0.8.0
+// File version: 3
+// Purpose: Spend metering for LLM calls: per-call pre-checks and per-run reservations from an estimate, settled against the actual cost.
+// filename: pkg/llmconn/budget.go
+// nlines: 128
+// risk_rating: HIGH
+
+package llmconn
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/aprice2704/neuroscript/pkg/types"
+)
+
+// charsPerToken is the rough heuristic used to estimate input tokens from
+// prompt length before a call is made.
+const charsPerToken = 4
+
+// Budget is consulted before and after each provider call: the estimated
+// cost is reserved before the call and settled against the actual cost
+// after it. It is satisfied by *capability.GrantSet, so an ExecPolicy's
+// grants can be passed directly.
+type Budget interface {
+	CheckPerCallBudget(currency string, cents int) error
+	ReserveBudget(currency string, cents int) error
+	SettleBudget(currency string, reserved, cents int) error
+}
+
+// Option configures an LLMConn at construction time.
+type Option func(*LLMConn)
+
+// WithBudget meters every turn against b. Metering only applies when the
+// AgentModel declares a BudgetCurrency.
+func WithBudget(b Budget) Option {
+	return func(c *LLMConn) {
+		c.budget = b
+	}
+}
+
+// costToCents converts a cost in currency units to whole cents, rounding up
+// so that fractional spend is never under-charged.
+func costToCents(cost float64) int {
+	if cost <= 0 {
+		return 0
+	}
+	return int(math.Ceil(cost*100 - 1e-9))
+}
+
+// tokenCost prices a token count using the model's price table.
+func tokenCost(pt types.PriceTable, inputTokens, outputTokens int) float64 {
+	return (float64(inputTokens)*pt.InputPerMTok + float64(outputTokens)*pt.OutputPerMTok) / 1e6
+}
+
+// estimateTokens approximates the token count of text from its length.
+func estimateTokens(text string) int {
+	return (len(text) + charsPerToken - 1) / charsPerToken
+}
+
+// estimateCost gives a pre-call upper-bound-ish estimate of spend for a prompt:
+// the prompt's approximate input tokens plus the output cap, if any.
+func estimateCost(model *types.AgentModel, prompt string) float64 {
+	return tokenCost(model.PriceTable, estimateTokens(prompt), model.Generation.MaxOutputTokens)
+}
+
+// estimateCents is estimateCost in whole cents.
+func estimateCents(model *types.AgentModel, prompt string) int {
+	return costToCents(estimateCost(model, prompt))
+}
+
+// actualCost returns the provider-reported cost, falling back to a cost
+// computed from token counts and the model's price table. A provider that
+// reports neither (plain HTTP providers, most streams) is charged from the
+// prompt and reply lengths, and never less than the pre-call estimate, so
+// the spend ceiling still holds.
+func actualCost(model *types.AgentModel, prompt string, resp *types.AIResponse) float64 {
+	if resp.Cost > 0 {
+		return resp.Cost
+	}
+	if resp.InputTokens > 0 || resp.OutputTokens > 0 {
+		return tokenCost(model.PriceTable, resp.InputTokens, resp.OutputTokens)
+	}
+	return math.Max(estimateCost(model, prompt),
+		tokenCost(model.PriceTable, estimateTokens(prompt), estimateTokens(resp.TextContent)))
+}
+
+// preCheckBudget refuses a call whose estimated cost would break either the
+// per-call limit or the remaining per-run budget, and otherwise reserves the
+// estimate so that concurrent calls cannot overrun the budget together. It
+// returns the reserved cents, which chargeBudget or releaseBudget settles.
+func (c *LLMConn) preCheckBudget(prompt string) (int, error) {
+	if c.budget == nil || c.model.BudgetCurrency == "" {
+		return 0, nil
+	}
+	cur := c.model.BudgetCurrency
+	est := estimateCents(c.model, prompt)
+	if err := c.budget.CheckPerCallBudget(cur, est); err != nil {
+		return 0, fmt.Errorf("%w: estimated %d %s cents exceeds per-call budget", err, est, cur)
+	}
+	if err := c.budget.ReserveBudget(cur, est); err != nil {
+		return 0, fmt.Errorf("%w: estimated %d %s cents exceeds remaining per-run budget", err, est, cur)
+	}
+	return est, nil
+}
+
+// chargeBudget replaces the reservation of a completed call with its actual
+// cost. Spend that breaks the per-run limit is still recorded, since the
+// money is already gone, so every later turn is refused by preCheckBudget.
+func (c *LLMConn) chargeBudget(reserved int, cost float64) error {
+	if c.budget == nil || c.model.BudgetCurrency == "" {
+		return nil
+	}
+	cur := c.model.BudgetCurrency
+	cents := costToCents(cost)
+	if err := c.budget.SettleBudget(cur, reserved, cents); err != nil {
+		return fmt.Errorf("%w: call cost %d %s cents exhausted the per-run budget", err, cents, cur)
+	}
+	return nil
+}
+
+// releaseBudget drops the reservation of a call that was not completed.
+func (c *LLMConn) releaseBudget(reserved int) {
+	if c.budget == nil || c.model.BudgetCurrency == "" {
+		return
+	}
+	_ = c.budget.SettleBudget(c.model.BudgetCurrency, reserved, 0)
+}
@@ -0,0 +1,219 @@
+// NeuroScript Version: 0.8.0
+// File version: 3
+// Purpose: Tests spend metering of LLMConn against a capability GrantSet.
+// filename: pkg/llmconn/budget_test.go
+
+package llmconn
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/aeiou"
+	"github.com/aprice2704/neuroscript/pkg/capability"
+	"github.com/aprice2704/neuroscript/pkg/provider"
+	"github.com/aprice2704/neuroscript/pkg/types"
+)
+
+func TestCostToCents(t *testing.T) {
+	cases := map[float64]int{0: 0, -1: 0, 0.01: 1, 0.011: 2, 1.5: 150}
+	for in, want := range cases {
+		if got := costToCents(in); got != want {
+			t.Errorf("costToCents(%v) = %d, want %d", in, got, want)
+		}
+	}
+}
+
+func TestLLMConn_Budget(t *testing.T) {
+	env := &aeiou.Envelope{UserData: "{}", Actions: "command\nendcommand"}
+	newModel := func() *types.AgentModel {
+		return &types.AgentModel{
+			Name:           "budget-model",
+			BudgetCurrency: "CAD",
+			MaxTurns:       10,
+			// 1 CAD per million tokens each way: 10k output tokens == 1 cent.
+			PriceTable: types.PriceTable{InputPerMTok: 1, OutputPerMTok: 1},
+		}
+	}
+
+	t.Run("Charges provider-reported cost to the per-run counter", func(t *testing.T) {
+		gs := capability.NewGrantSet(nil, capability.Limits{BudgetPerRunCents: map[string]int{"CAD": 100}})
+		mock := &capturingMockProvider{responseToReturn: &provider.AIResponse{TextContent: "x", Cost: 0.25}}
+		conn, _ := New(newModel(), mock, nil, WithBudget(&gs))
+		if _, err := conn.Converse(context.Background(), env); err != nil {
+			t.Fatalf("Converse failed: %v", err)
+		}
+		if got := gs.Counters.BudgetSpentCents["CAD"]; got != 25 {
+			t.Errorf("spent = %d, want 25", got)
+		}
+	})
+
+	t.Run("Prices token counts when the provider reports no cost", func(t *testing.T) {
+		gs := capability.NewGrantSet(nil, capability.Limits{})
+		mock := &capturingMockProvider{responseToReturn: &provider.AIResponse{TextContent: "x", InputTokens: 20000, OutputTokens: 30000}}
+		conn, _ := New(newModel(), mock, nil, WithBudget(&gs))
+		if _, err := conn.Converse(context.Background(), env); err != nil {
+			t.Fatalf("Converse failed: %v", err)
+		}
+		if got := gs.Counters.BudgetSpentCents["CAD"]; got != 5 {
+			t.Errorf("spent = %d, want 5", got)
+		}
+		if conn.TotalCost() != 0.05 {
+			t.Errorf("TotalCost = %v, want 0.05", conn.TotalCost())
+		}
+	})
+
+	t.Run("Estimates spend when the provider reports no usage", func(t *testing.T) {
+		gs := capability.NewGrantSet(nil, capability.Limits{})
+		model := newModel()
+		model.PriceTable = types.PriceTable{InputPerMTok: 100, OutputPerMTok: 100}
+		reply := strings.Repeat("x", 40000) // about 10k output tokens
+		mock := &capturingMockProvider{responseToReturn: &provider.AIResponse{TextContent: reply}}
+		conn, _ := New(model, mock, nil, WithBudget(&gs))
+		if _, err := conn.Converse(context.Background(), env); err != nil {
+			t.Fatalf("Converse failed: %v", err)
+		}
+		want := costToCents(tokenCost(model.PriceTable, estimateTokens(mock.lastRequest.Prompt), 10000))
+		if got := gs.Counters.BudgetSpentCents["CAD"]; got != want || got == 0 {
+			t.Errorf("spent = %d, want %d", got, want)
+		}
+
+		// A short reply is still charged the pre-call estimate, output cap included.
+		gs = capability.NewGrantSet(nil, capability.Limits{})
+		model.Generation.MaxOutputTokens = 50000
+		mock = &capturingMockProvider{responseToReturn: &provider.AIResponse{TextContent: "x"}}
+		conn, _ = New(model, mock, nil, WithBudget(&gs))
+		if _, err := conn.Converse(context.Background(), env); err != nil {
+			t.Fatalf("Converse failed: %v", err)
+		}
+		if got, want := gs.Counters.BudgetSpentCents["CAD"], estimateCents(model, mock.lastRequest.Prompt); got != want {
+			t.Errorf("spent = %d, want the pre-call estimate %d", got, want)
+		}
+	})
+
+	t.Run("Fails the turn that exhausts the per-run budget and refuses the next", func(t *testing.T) {
+		gs := capability.NewGrantSet(nil, capability.Limits{BudgetPerRunCents: map[string]int{"CAD": 30}})
+		mock := &capturingMockProvider{responseToReturn: &provider.AIResponse{TextContent: "x", Cost: 0.20}}
+		conn, _ := New(newModel(), mock, nil, WithBudget(&gs))
+		if _, err := conn.Converse(context.Background(), env); err != nil {
+			t.Fatalf("first Converse failed: %v", err)
+		}
+		if _, err := conn.Converse(context.Background(), env); !errors.Is(err, capability.ErrBudgetExceeded) {
+			t.Fatalf("second Converse: expected ErrBudgetExceeded, got %v", err)
+		}
+		if got := gs.Counters.BudgetSpentCents["CAD"]; got != 40 {
+			t.Errorf("overspend should still be recorded: spent = %d, want 40", got)
+		}
+		mock.lastRequest = nil
+		if _, err := conn.Converse(context.Background(), env); !errors.Is(err, capability.ErrBudgetExceeded) {
+			t.Fatalf("third Converse: expected ErrBudgetExceeded, got %v", err)
+		}
+		if mock.lastRequest != nil {
+			t.Error("provider must not be called once the per-run budget is exhausted")
+		}
+	})
+
+	t.Run("Per-call pre-check refuses an expensive estimate", func(t *testing.T) {
+		gs := capability.NewGrantSet(nil, capability.Limits{BudgetPerCallCents: map[string]int{"CAD": 1}})
+		model := newModel()
+		model.Generation.MaxOutputTokens = 50000 // estimate: at least 5 cents of output
+		mock := &capturingMockProvider{}
+		conn, _ := New(model, mock, nil, WithBudget(&gs))
+		if _, err := conn.Converse(context.Background(), env); !errors.Is(err, capability.ErrBudgetExceeded) {
+			t.Fatalf("expected ErrBudgetExceeded, got %v", err)
+		}
+		if mock.lastRequest != nil {
+			t.Error("provider must not be called when the per-call pre-check fails")
+		}
+	})
+
+	t.Run("Models without a currency are not metered", func(t *testing.T) {
+		gs := capability.NewGrantSet(nil, capability.Limits{BudgetPerRunCents: map[string]int{"CAD": 1}})
+		model := newModel()
+		model.BudgetCurrency = ""
+		mock := &capturingMockProvider{responseToReturn: &provider.AIResponse{TextContent: "x", Cost: 5}}
+		conn, _ := New(model, mock, nil, WithBudget(&gs))
+		if _, err := conn.Converse(context.Background(), env); err != nil {
+			t.Fatalf("Converse failed: %v", err)
+		}
+		if got := gs.Counters.BudgetSpentCents["CAD"]; got != 0 {
+			t.Errorf("spent = %d, want 0", got)
+		}
+	})
+
+	t.Run("Concurrent calls reserve their estimates", func(t *testing.T) {
+		gs := capability.NewGrantSet(nil, capability.Limits{BudgetPerRunCents: map[string]int{"CAD": 30}})
+		model := newModel()
+		model.Generation.MaxOutputTokens = 100000 // estimate: just over 10 cents
+		est := 0
+		mock := &blockingProvider{entered: make(chan string), release: make(chan struct{})}
+
+		const callers = 5
+		errs := make(chan error, callers)
+		for n := 0; n < callers; n++ {
+			conn, _ := New(model, mock, nil, WithBudget(&gs))
+			go func() {
+				_, err := conn.Converse(context.Background(), env)
+				errs <- err
+			}()
+		}
+		// Every caller either reaches the provider or is refused; hold the
+		// admitted ones inside the call until all have been decided.
+		admitted, refused := 0, 0
+		for admitted+refused < callers {
+			select {
+			case prompt := <-mock.entered:
+				est = estimateCents(model, prompt)
+				admitted++
+			case err := <-errs:
+				if !errors.Is(err, capability.ErrBudgetExceeded) {
+					t.Fatalf("expected ErrBudgetExceeded for a refused call, got %v", err)
+				}
+				refused++
+			}
+		}
+		if want := 30 / est; admitted != want {
+			t.Errorf("%d calls reached the provider, want %d (30 cents / %d estimated)", admitted, want, est)
+		}
+		close(mock.release)
+		for n := 0; n < admitted; n++ {
+			if err := <-errs; err != nil {
+				t.Errorf("admitted call failed: %v", err)
+			}
+		}
+		usage := gs.Snapshot()
+		if got := usage.BudgetSpentCents["CAD"]; got != admitted*5 {
+			t.Errorf("spent = %d, want %d", got, admitted*5)
+		}
+		if len(usage.BudgetReservedCents) != 0 {
+			t.Errorf("reservations left after all calls settled: %v", usage.BudgetReservedCents)
+		}
+	})
+
+	t.Run("A failed call releases its reservation", func(t *testing.T) {
+		gs := capability.NewGrantSet(nil, capability.Limits{BudgetPerRunCents: map[string]int{"CAD": 30}})
+		conn, _ := New(newModel(), &capturingMockProvider{errorToReturn: errors.New("boom")}, nil, WithBudget(&gs))
+		if _, err := conn.Converse(context.Background(), env); err == nil {
+			t.Fatal("expected the provider error")
+		}
+		usage := gs.Snapshot()
+		if len(usage.BudgetReservedCents) != 0 || usage.BudgetSpentCents["CAD"] != 0 {
+			t.Errorf("a failed call left spend %v and reservations %v", usage.BudgetSpentCents, usage.BudgetReservedCents)
+		}
+	})
+}
+
+// blockingProvider reports each request's prompt on entered and answers,
+// at a cost of 5 cents, once release is closed.
+type blockingProvider struct {
+	entered chan string
+	release chan struct{}
+}
+
+func (p *blockingProvider) Chat(ctx context.Context, req provider.AIRequest) (*provider.AIResponse, error) {
+	p.entered <- req.Prompt
+	<-p.release
+	return &provider.AIResponse{TextContent: "x", Cost: 0.05}, nil
+}
@@ -1,6 +1,7 @@
 // NeuroScript Version: 0.7.2
-// File version: 3
+// File version: 7
 // Purpose: Corrects the test failures by providing a valid AEIOU envelope and using a provider mock that correctly simulates a failure within the conversation loop.
+// Latest change: Checks that the provider never receives a revealed secret in the prompt.
 // filename: pkg/llmconn/emitter_test.go
 
 package llmconn
@@ -8,6 +9,7 @@ package llmconn
 import (
 	"context"
 	"errors"
+	"strings"
 	"sync"
 	"testing"
 
@@ -15,6 +17,7 @@ import (
 	"github.com/aprice2704/neuroscript/pkg/interfaces"
 	"github.com/aprice2704/neuroscript/pkg/provider"
 	"github.com/aprice2704/neuroscript/pkg/provider/test"
+	"github.com/aprice2704/neuroscript/pkg/secret"
 	"github.com/aprice2704/neuroscript/pkg/types"
 )
 
@@ -107,6 +110,55 @@ func TestLLMConn_Emitter(t *testing.T) {
 	})
 }
 
+// mockTokenEmitter additionally records streamed chunks.
+type mockTokenEmitter struct {
+	mockEmitter
+	deltas []string
+}
+
+func (m *mockTokenEmitter) EmitLLMCallChunk(info interfaces.LLMCallChunkInfo) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if info.CallID == m.lastCallID && info.Index == len(m.deltas) {
+		m.deltas = append(m.deltas, info.Delta)
+	}
+}
+
+func TestLLMConn_StreamsToTokenEmitter(t *testing.T) {
+	ctx := context.Background()
+	model := &types.AgentModel{Name: "test-model"}
+	env := &aeiou.Envelope{UserData: "tell me about a large language model", Actions: "command endcommand"}
+
+	plain, _ := New(model, test.New(), &mockEmitter{})
+	want, err := plain.Converse(ctx, env)
+	if err != nil {
+		t.Fatalf("non-streaming Converse() failed: %v", err)
+	}
+
+	emitter := &mockTokenEmitter{}
+	conn, _ := New(model, test.New(), emitter)
+	got, err := conn.Converse(ctx, env)
+	if err != nil {
+		t.Fatalf("streaming Converse() failed: %v", err)
+	}
+	if got.TextContent != want.TextContent {
+		t.Errorf("assembled response differs from Chat.\nGot:  %q\nWant: %q", got.TextContent, want.TextContent)
+	}
+	if len(emitter.deltas) < 2 || strings.Join(emitter.deltas, "") != got.TextContent {
+		t.Errorf("expected several deltas concatenating to the response, got %d", len(emitter.deltas))
+	}
+	if emitter.succeeded != 1 {
+		t.Errorf("Expected EmitLLMCallSucceeded once, got %d", emitter.succeeded)
+	}
+
+	// A provider without streaming support is called through Chat.
+	failing := &mockTokenEmitter{}
+	conn, _ = New(model, &mockFailingProvider{err: errors.New("boom")}, failing)
+	if _, err := conn.Converse(ctx, env); err == nil || len(failing.deltas) != 0 || failing.failed != 1 {
+		t.Errorf("non-streaming provider: err=%v deltas=%d failed=%d", err, len(failing.deltas), failing.failed)
+	}
+}
+
 // mockFailingProvider is a simple provider that always returns an error.
 type mockFailingProvider struct {
 	err error
@@ -115,3 +167,105 @@ type mockFailingProvider struct {
 func (p *mockFailingProvider) Chat(ctx context.Context, req provider.AIRequest) (*provider.AIResponse, error) {
 	return nil, p.err
 }
+
+// payloadEmitter records the published payloads.
+type payloadEmitter struct {
+	mockTokenEmitter
+	prompts  []string
+	response string
+	errText  string
+}
+
+func (m *payloadEmitter) EmitLLMCallStarted(info interfaces.LLMCallStartInfo) {
+	m.mockEmitter.EmitLLMCallStarted(info)
+	m.prompts = append(m.prompts, info.Request.Prompt)
+}
+
+func (m *payloadEmitter) EmitLLMCallSucceeded(info interfaces.LLMCallSuccessInfo) {
+	m.prompts = append(m.prompts, info.Request.Prompt)
+	m.response = info.Response.TextContent
+}
+
+func (m *payloadEmitter) EmitLLMCallFailed(info interfaces.LLMCallFailureInfo) {
+	m.prompts = append(m.prompts, info.Request.Prompt)
+	m.errText = info.Err.Error()
+}
+
+func TestLLMConn_RedactsEmittedPayloads(t *testing.T) {
+	ctx := context.Background()
+	model := &types.AgentModel{Name: "test-model"}
+	env := &aeiou.Envelope{UserData: "tell me about a large language model", Actions: "command endcommand"}
+	redact := secret.NewRedactor()
+	redact.Add("neural")
+
+	emitter := &payloadEmitter{}
+	conn, _ := New(model, test.New(), emitter, WithRedactor(redact))
+	resp, err := conn.Converse(ctx, env)
+	if err != nil {
+		t.Fatalf("Converse() failed: %v", err)
+	}
+	if !strings.Contains(resp.TextContent, "neural") {
+		t.Fatalf("the caller must get the unredacted response, got %q", resp.TextContent)
+	}
+	published := append(append([]string{emitter.response}, emitter.prompts...), emitter.deltas...)
+	if len(emitter.prompts) != 2 || len(emitter.deltas) == 0 {
+		t.Fatalf("expected start, success and chunk payloads, got prompts=%d deltas=%d", len(emitter.prompts), len(emitter.deltas))
+	}
+	for _, s := range published {
+		if strings.Contains(s, "neural") {
+			t.Errorf("emitted payload leaks the secret: %q", s)
+		}
+	}
+
+	sentinel := errors.New("key neural rejected")
+	failing := &payloadEmitter{}
+	conn, _ = New(model, &mockFailingProvider{err: sentinel}, failing, WithRedactor(redact))
+	if _, err := conn.Converse(ctx, env); !errors.Is(err, sentinel) {
+		t.Fatalf("expected the provider error, got %v", err)
+	}
+	if strings.Contains(failing.errText, "neural") || !strings.Contains(failing.errText, "[REDACTED]") {
+		t.Errorf("failure payload error not redacted: %q", failing.errText)
+	}
+}
+
+func TestLLMConn_RedactsSecretsSplitAcrossChunks(t *testing.T) {
+	ctx := context.Background()
+	model := &types.AgentModel{Name: "test-model"}
+	env := &aeiou.Envelope{UserData: "tell me about a large language model", Actions: "command endcommand"}
+	// The test provider streams word by word, so this secret spans three chunks.
+	redact := secret.NewRedactor()
+	redact.Add("language model is")
+
+	emitter := &mockTokenEmitter{}
+	conn, _ := New(model, test.New(), emitter, WithRedactor(redact))
+	resp, err := conn.Converse(ctx, env)
+	if err != nil {
+		t.Fatalf("Converse() failed: %v", err)
+	}
+	published := strings.Join(emitter.deltas, "")
+	if strings.Contains(published, "language model is") {
+		t.Errorf("streamed chunks leak the secret: %q", emitter.deltas)
+	}
+	if want := redact.Redact(resp.TextContent); published != want {
+		t.Errorf("streamed chunks = %q, want the scrubbed response %q", published, want)
+	}
+}
+
+func TestLLMConn_ScrubsSecretsFromPrompt(t *testing.T) {
+	model := &types.AgentModel{Name: "test-model"}
+	env := &aeiou.Envelope{UserData: "the key is hunter2", Actions: "command endcommand"}
+	redact := secret.NewRedactor()
+	redact.Add("hunter2")
+
+	prov := &capturingMockProvider{}
+	conn, _ := New(model, prov, nil, WithRedactor(redact))
+	if _, err := conn.Converse(context.Background(), env); err != nil {
+		t.Fatalf("Converse() failed: %v", err)
+	}
+	if prov.lastRequest == nil {
+		t.Fatal("the provider was not called")
+	}
+	if strings.Contains(prov.lastRequest.Prompt, "hunter2") || !strings.Contains(prov.lastRequest.Prompt, "[REDACTED]") {
+		t.Errorf("the provider received an unscrubbed prompt: %q", prov.lastRequest.Prompt)
+	}
+}
@@ -1,5 +1,5 @@
 // NeuroScript Version: 0.7.0
-// File version: 2
+// File version: 3
 // Purpose: Defines sentinel errors for the llmconn package.
 // filename: pkg/llmconn/errors.go
 // nlines: 15
@@ -14,4 +14,5 @@ var (
 	ErrProviderNotSet   = errors.New("AIProvider cannot be nil")
 	ErrLoopNotPermitted = errors.New("agent model configuration does not permit loops")
 	ErrMaxTurnsExceeded = errors.New("maximum number of turns exceeded for this loop")
+	ErrCapsuleNotFound  = errors.New("bootstrap capsule not found in default store")
 )
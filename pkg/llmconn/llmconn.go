@@ -1,7 +1,7 @@
 // NeuroScript Version: 0.7.2
-// File version: 16
+// File version: 28
 // Purpose: Updates imports to use the neutral Emitter interface from the new ns_interfaces package.
-// Latest change: Replaced capsule.DefaultRegistry() with capsule.DefaultStore().
+// Latest change: The composed prompt is scrubbed of revealed secrets before it is sent to the provider.
 // filename: pkg/llmconn/llmconn.go
 
 package llmconn
@@ -24,6 +24,9 @@ type LLMConn struct {
 	model    *types.AgentModel
 	provider provider.AIProvider
 	emitter  interfaces.Emitter // Bus-agnostic event emitter from neutral package
+	budget   Budget             // Optional spend meter; nil means unmetered
+	netMeter NetMeter           // Optional network meter; nil means unmetered
+	redact   Redactor           // Optional secret scrubber for emitted payloads
 
 	// State for the current loop
 	turnCount    int
@@ -34,7 +37,7 @@ type LLMConn struct {
 
 // New creates and initializes a new LLMConn.
 // If a non-nil emitter is provided, it will be used to send telemetry.
-func New(model *types.AgentModel, provider provider.AIProvider, emitter interfaces.Emitter) (*LLMConn, error) {
+func New(model *types.AgentModel, provider provider.AIProvider, emitter interfaces.Emitter, opts ...Option) (*LLMConn, error) {
 	if model == nil {
 		return nil, ErrModelNotSet
 	}
@@ -42,13 +45,17 @@ func New(model *types.AgentModel, provider provider.AIProvider, emitter interfac
 		return nil, ErrProviderNotSet
 	}
 
-	return &LLMConn{
+	c := &LLMConn{
 		model:        model,
 		provider:     provider,
 		emitter:      emitter, // Store the emitter
 		turnCount:    0,
 		lastActivity: time.Now(),
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
 }
 
 // Converse implements the Connector interface.
@@ -66,11 +73,15 @@ func (c *LLMConn) Converse(ctx context.Context, input *aeiou.Envelope) (*provide
 	}
 
 	if c.turnCount == 1 {
-		var capsuleName string
-		if c.model.Tools.ToolLoopPermitted {
-			capsuleName = "capsule/bootstrap_agentic"
-		} else {
-			capsuleName = "capsule/bootstrap_oneshot"
+		// An explicit capsule (from the model config or an 'ask ... with'
+		// override) wins over the default bootstrap selection.
+		capsuleName := c.model.SystemCapsule
+		if capsuleName == "" {
+			if c.model.Tools.ToolLoopPermitted {
+				capsuleName = "capsule/bootstrap_agentic"
+			} else {
+				capsuleName = "capsule/bootstrap_oneshot"
+			}
 		}
 
 		// --- THE FIX ---
@@ -78,10 +89,21 @@ func (c *LLMConn) Converse(ctx context.Context, input *aeiou.Envelope) (*provide
 		// --- END FIX ---
 		cap, ok := reg.GetLatest(capsuleName)
 		if !ok {
-			return nil, fmt.Errorf("latest bootstrap capsule not found in default store: %s", capsuleName)
+			return nil, fmt.Errorf("%w: %s", ErrCapsuleNotFound, capsuleName)
 		}
 		prompt = cap.Content + "\n\n" + prompt
 	}
+	// A revealed secret never leaves for the provider.
+	prompt = c.redactString(prompt)
+
+	reserved, err := c.preCheckBudget(prompt)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.meterRequest(prompt); err != nil {
+		c.releaseBudget(reserved)
+		return nil, err
+	}
 
 	req := provider.AIRequest{
 		AgentModelName: string(c.model.Name),
@@ -91,35 +113,101 @@ func (c *LLMConn) Converse(ctx context.Context, input *aeiou.Envelope) (*provide
 		APIKey:         c.model.APIKey,
 		Prompt:         prompt,
 		Temperature:    c.model.Generation.Temperature,
-		Stream:         false,
-		Timeout:        30 * time.Second,
+
+		MaxOutputTokens:   c.model.Generation.MaxOutputTokens,
+		StopSequences:     c.model.Generation.StopSequences,
+		ResponseFormat:    c.model.Generation.ResponseFormat,
+		TopP:              c.model.Generation.TopP,
+		TopK:              c.model.Generation.TopK,
+		PresencePenalty:   c.model.Generation.PresencePenalty,
+		FrequencyPenalty:  c.model.Generation.FrequencyPenalty,
+		RepetitionPenalty: c.model.Generation.RepetitionPenalty,
+		Seed:              c.model.Generation.Seed,
+		LogProbs:          c.model.Generation.LogProbs,
+		Explicit:          c.model.Generation.Explicit,
+		Stream:            c.canStream(),
+		Timeout:           c.callTimeout(),
+		ProviderParams:    c.model.Params,
 	}
 
 	// --- Event Emission Logic ---
 	callID := uuid.NewString()
 	start := time.Now()
 	if c.emitter != nil {
-		c.emitter.EmitLLMCallStarted(interfaces.LLMCallStartInfo{Ctx: ctx, CallID: callID, Request: req, Start: start})
+		c.emitter.EmitLLMCallStarted(interfaces.LLMCallStartInfo{Ctx: ctx, CallID: callID, Request: c.redactRequest(req), Start: start})
 	}
 
-	resp, err := c.provider.Chat(ctx, req)
+	resp, err := c.callWithRetry(ctx, req, callID)
 	latency := time.Since(start)
 
 	if err != nil {
+		c.releaseBudget(reserved)
 		if c.emitter != nil {
-			c.emitter.EmitLLMCallFailed(interfaces.LLMCallFailureInfo{Ctx: ctx, CallID: callID, Request: req, Err: err, Latency: latency})
+			c.emitter.EmitLLMCallFailed(interfaces.LLMCallFailureInfo{Ctx: ctx, CallID: callID, Request: c.redactRequest(req), Err: c.redactError(err), Latency: latency})
 		}
 		return nil, fmt.Errorf("provider chat failed on turn %d: %w", c.turnCount, err)
 	}
 
+	// Providers that do not report cost are priced from the model's table.
+	if resp.Cost <= 0 {
+		resp.Cost = actualCost(c.model, prompt, resp)
+	}
+
 	if c.emitter != nil {
-		c.emitter.EmitLLMCallSucceeded(interfaces.LLMCallSuccessInfo{Ctx: ctx, CallID: callID, Request: req, Response: *resp, Latency: latency})
+		c.emitter.EmitLLMCallSucceeded(interfaces.LLMCallSuccessInfo{Ctx: ctx, CallID: callID, Request: c.redactRequest(req), Response: c.redactResponse(*resp), Latency: latency})
 	}
 	// --- End Event Emission Logic ---
 
 	c.totalTokens += resp.InputTokens + resp.OutputTokens
 	c.totalCost += resp.Cost
 
+	if err := c.chargeBudget(reserved, resp.Cost); err != nil {
+		return nil, err
+	}
+	if err := c.meterResponse(resp.TextContent); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// canStream reports whether responses should be streamed: only when the
+// provider can stream and the emitter wants partial tokens.
+func (c *LLMConn) canStream() bool {
+	if _, ok := c.provider.(provider.StreamingProvider); !ok {
+		return false
+	}
+	_, ok := c.emitter.(interfaces.TokenEmitter)
+	return ok
+}
+
+// chatStream runs a streaming request, forwarding each delta to the emitter
+// and returning the assembled response, so callers (and the AEIOU parser)
+// see exactly what a non-streaming Chat would have returned. Chunks carry the
+// attempt number, so a host can drop the partial output of an attempt that
+// failed and was retried.
+func (c *LLMConn) chatStream(ctx context.Context, req provider.AIRequest, callID string, attempt int) (*provider.AIResponse, error) {
+	sp := c.provider.(provider.StreamingProvider)
+	te := c.emitter.(interfaces.TokenEmitter)
+	stream, err := sp.ChatStream(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	index := 0
+	pub := c.redactRequest(req)
+	emit := func(delta string) {
+		if delta == "" {
+			return
+		}
+		te.EmitLLMCallChunk(interfaces.LLMCallChunkInfo{Ctx: ctx, CallID: callID, Request: pub, Attempt: attempt, Index: index, Delta: delta})
+		index++
+	}
+	scrub := &chunkRedactor{r: c.redact}
+	resp, err := provider.Collect(ctx, stream, func(delta string) { emit(scrub.push(delta)) })
+	if err != nil {
+		return nil, err
+	}
+	emit(scrub.flush())
 	return resp, nil
 }
 
@@ -0,0 +1,48 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Meters provider traffic against the ExecPolicy's network call and byte limits.
+// filename: pkg/llmconn/netmeter.go
+// nlines: 50
+// risk_rating: MEDIUM
+
+package llmconn
+
+import "fmt"
+
+// NetMeter counts provider round-trips and their payload sizes. It is
+// satisfied by *capability.GrantSet.
+type NetMeter interface {
+	CountNet(bytes int64) error
+	AddNetBytes(bytes int64) error
+}
+
+// WithNetMeter meters every provider call against m: one network call plus
+// the prompt bytes before sending, and the response bytes on return.
+func WithNetMeter(m NetMeter) Option {
+	return func(c *LLMConn) {
+		c.netMeter = m
+	}
+}
+
+// meterRequest counts the outgoing call, refusing it if the call or byte
+// limits are already exhausted.
+func (c *LLMConn) meterRequest(prompt string) error {
+	if c.netMeter == nil {
+		return nil
+	}
+	if err := c.netMeter.CountNet(int64(len(prompt))); err != nil {
+		return fmt.Errorf("%w: provider call refused (%d request bytes)", err, len(prompt))
+	}
+	return nil
+}
+
+// meterResponse charges the bytes received from the provider.
+func (c *LLMConn) meterResponse(text string) error {
+	if c.netMeter == nil {
+		return nil
+	}
+	if err := c.netMeter.AddNetBytes(int64(len(text))); err != nil {
+		return fmt.Errorf("%w: provider response of %d bytes exceeds the network byte limit", err, len(text))
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+// NeuroScript Version: 0.8.0
+// File version: 4
+// Purpose: Scrubs revealed secrets from prompts sent to the provider and from the requests, responses, streamed deltas and errors handed to the emitter.
+// filename: pkg/llmconn/redact.go
+// nlines: 88
+// risk_rating: MEDIUM
+
+package llmconn
+
+import (
+	"github.com/aprice2704/neuroscript/pkg/provider"
+	"github.com/aprice2704/neuroscript/pkg/secret"
+)
+
+// Redactor scrubs revealed secrets from text. Holdback reports how much of
+// the end of a text could be the start of a secret, so streamed output can
+// be held back until it is known not to be. *secret.Redactor satisfies it.
+type Redactor interface {
+	Redact(s string) string
+	Holdback(s string) int
+}
+
+// WithRedactor scrubs revealed secrets from the prompt before it is sent to
+// the provider, and passes every response, streamed delta and error text
+// through r before it reaches the emitter.
+func WithRedactor(r Redactor) Option {
+	return func(c *LLMConn) {
+		c.redact = r
+	}
+}
+
+func (c *LLMConn) redactString(s string) string {
+	if c.redact == nil {
+		return s
+	}
+	return c.redact.Redact(s)
+}
+
+// chunkRedactor scrubs a stream of deltas. A secret may be split across
+// deltas, so the end of the text seen so far is held back while it could
+// still be the start of one.
+type chunkRedactor struct {
+	r       Redactor
+	pending string
+}
+
+// push adds delta to the stream and returns the scrubbed text that can be
+// published now, possibly empty.
+func (s *chunkRedactor) push(delta string) string {
+	if s.r == nil {
+		return delta
+	}
+	text := s.r.Redact(s.pending + delta)
+	cut := len(text) - s.r.Holdback(text)
+	s.pending = text[cut:]
+	return text[:cut]
+}
+
+// flush returns the scrubbed remainder once the stream has ended.
+func (s *chunkRedactor) flush() string {
+	if s.r == nil {
+		return ""
+	}
+	out := s.r.Redact(s.pending)
+	s.pending = ""
+	return out
+}
+
+// redactRequest returns a copy of req safe to publish.
+func (c *LLMConn) redactRequest(req provider.AIRequest) provider.AIRequest {
+	req.Prompt = c.redactString(req.Prompt)
+	return req
+}
+
+// redactResponse returns a copy of resp safe to publish.
+func (c *LLMConn) redactResponse(resp provider.AIResponse) provider.AIResponse {
+	resp.TextContent = c.redactString(resp.TextContent)
+	return resp
+}
+
+// redactError returns err with its message scrubbed, or err itself when
+// nothing needed removing.
+func (c *LLMConn) redactError(err error) error {
+	if c.redact == nil {
+		return err
+	}
+	return secret.RedactError(err, c.redact.Redact)
+}
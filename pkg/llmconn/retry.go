@@ -0,0 +1,110 @@
+// NeuroScript Version: 0.8.0
+// File version: 5
+// Purpose: Per-attempt timeouts and retries with exponential backoff and jitter for provider calls.
+// filename: pkg/llmconn/retry.go
+// nlines: 110
+// risk_rating: MEDIUM
+
+package llmconn
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	"github.com/aprice2704/neuroscript/pkg/interfaces"
+	"github.com/aprice2704/neuroscript/pkg/provider"
+)
+
+const (
+	defaultCallTimeout     = 30 * time.Second
+	defaultRetryBackoff    = 500 * time.Millisecond
+	defaultRetryMaxBackoff = 30 * time.Second
+)
+
+// callTimeout returns the per-attempt timeout configured on the model.
+func (c *LLMConn) callTimeout() time.Duration {
+	if c.model.TimeoutSeconds > 0 {
+		return time.Duration(c.model.TimeoutSeconds) * time.Second
+	}
+	return defaultCallTimeout
+}
+
+// retryDelay computes the wait before retry number attempt (1-based):
+// exponential growth from the model's base backoff, capped, with up to 50%
+// downward jitter so many agents backing off together do not retry in
+// lockstep. A longer server-requested delay (Retry-After) wins, up to the
+// cap; ok is false when the server asks for more than the cap, and the call
+// should fail rather than wait that long.
+func (c *LLMConn) retryDelay(attempt int, retryAfter time.Duration) (delay time.Duration, ok bool) {
+	base := defaultRetryBackoff
+	if c.model.RetryBackoffMs > 0 {
+		base = time.Duration(c.model.RetryBackoffMs) * time.Millisecond
+	}
+	ceiling := defaultRetryMaxBackoff
+	if c.model.RetryMaxBackoffMs > 0 {
+		ceiling = time.Duration(c.model.RetryMaxBackoffMs) * time.Millisecond
+	}
+	if retryAfter > ceiling {
+		return 0, false
+	}
+
+	delay = base
+	for i := 1; i < attempt && delay < ceiling; i++ {
+		delay *= 2
+	}
+	if delay > ceiling {
+		delay = ceiling
+	}
+	if half := int64(delay / 2); half > 0 {
+		delay = time.Duration(half + rand.Int64N(half+1))
+	}
+	if retryAfter > delay {
+		delay = retryAfter
+	}
+	return delay, true
+}
+
+// callWithRetry runs the provider call, retrying transient failures up to the
+// model's MaxRetries. Each attempt gets its own timeout; cancelling ctx stops
+// both the current attempt and any pending wait.
+func (c *LLMConn) callWithRetry(ctx context.Context, req provider.AIRequest, callID string) (*provider.AIResponse, error) {
+	for attempt := 1; ; attempt++ {
+		attemptCtx, cancel := context.WithTimeout(ctx, req.Timeout)
+		resp, err := c.callOnce(attemptCtx, req, callID, attempt)
+		cancel()
+		if err == nil {
+			return resp, nil
+		}
+		if ctx.Err() != nil || attempt > c.model.MaxRetries {
+			return nil, err
+		}
+		retryable, retryAfter := provider.IsRetryable(err)
+		if !retryable {
+			return nil, err
+		}
+
+		delay, ok := c.retryDelay(attempt, retryAfter)
+		if !ok {
+			return nil, err
+		}
+		if re, ok := c.emitter.(interfaces.RetryEmitter); ok {
+			re.EmitLLMCallRetry(interfaces.LLMCallRetryInfo{Ctx: ctx, CallID: callID, Request: c.redactRequest(req), Attempt: attempt, Err: c.redactError(err), Delay: delay})
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, err
+		case <-timer.C:
+		}
+	}
+}
+
+// callOnce makes a single attempt, streaming when the connection supports it.
+func (c *LLMConn) callOnce(ctx context.Context, req provider.AIRequest, callID string, attempt int) (*provider.AIResponse, error) {
+	if req.Stream {
+		return c.chatStream(ctx, req, callID, attempt)
+	}
+	return c.provider.Chat(ctx, req)
+}
@@ -0,0 +1,199 @@
+// NeuroScript Version: 0.8.0
+// File version: 3
+// Purpose: Tests retry classification, backoff, retry events and per-attempt stream chunks in LLMConn.Converse.
+// filename: pkg/llmconn/retry_test.go
+// nlines: 199
+// risk_rating: LOW
+
+package llmconn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aprice2704/neuroscript/pkg/aeiou"
+	"github.com/aprice2704/neuroscript/pkg/interfaces"
+	"github.com/aprice2704/neuroscript/pkg/provider"
+	"github.com/aprice2704/neuroscript/pkg/types"
+)
+
+// flakyProvider fails with the given errors, in order, and then succeeds.
+type flakyProvider struct {
+	errs  []error
+	calls int
+}
+
+func (p *flakyProvider) Chat(ctx context.Context, req provider.AIRequest) (*provider.AIResponse, error) {
+	p.calls++
+	if p.calls <= len(p.errs) {
+		return nil, p.errs[p.calls-1]
+	}
+	return &provider.AIResponse{TextContent: "ok"}, nil
+}
+
+// retryRecorder is an Emitter that also records retry events.
+type retryRecorder struct {
+	mockEmitter
+	retries []interfaces.LLMCallRetryInfo
+}
+
+func (r *retryRecorder) EmitLLMCallRetry(info interfaces.LLMCallRetryInfo) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.retries = append(r.retries, info)
+}
+
+func status(code int) error {
+	return &provider.StatusError{StatusCode: code, Message: http.StatusText(code)}
+}
+
+func TestConverse_RetriesTransientErrors(t *testing.T) {
+	ctx := context.Background()
+	env := &aeiou.Envelope{UserData: "test", Actions: "command endcommand"}
+	model := &types.AgentModel{Name: "retrying", MaxRetries: 2, RetryBackoffMs: 1}
+
+	t.Run("recovers within the retry budget", func(t *testing.T) {
+		prov := &flakyProvider{errs: []error{status(http.StatusServiceUnavailable), status(http.StatusTooManyRequests)}}
+		rec := &retryRecorder{}
+		conn, _ := New(model, prov, rec)
+		resp, err := conn.Converse(ctx, env)
+		if err != nil {
+			t.Fatalf("Converse() failed: %v", err)
+		}
+		if resp.TextContent != "ok" || prov.calls != 3 {
+			t.Errorf("got %q after %d calls, want ok after 3", resp.TextContent, prov.calls)
+		}
+		if len(rec.retries) != 2 || rec.retries[0].Attempt != 1 || rec.retries[1].Attempt != 2 {
+			t.Errorf("unexpected retry events: %+v", rec.retries)
+		}
+		if rec.succeeded != 1 || rec.failed != 0 {
+			t.Errorf("succeeded=%d failed=%d, want 1 and 0", rec.succeeded, rec.failed)
+		}
+	})
+
+	t.Run("gives up after MaxRetries", func(t *testing.T) {
+		prov := &flakyProvider{errs: []error{status(502), status(502), status(502)}}
+		rec := &retryRecorder{}
+		conn, _ := New(model, prov, rec)
+		_, err := conn.Converse(ctx, env)
+		var se *provider.StatusError
+		if !errors.As(err, &se) || se.StatusCode != 502 {
+			t.Fatalf("expected the last StatusError, got %v", err)
+		}
+		if prov.calls != 3 || len(rec.retries) != 2 || rec.failed != 1 {
+			t.Errorf("calls=%d retries=%d failed=%d, want 3, 2 and 1", prov.calls, len(rec.retries), rec.failed)
+		}
+	})
+
+	t.Run("does not retry permanent errors", func(t *testing.T) {
+		prov := &flakyProvider{errs: []error{status(http.StatusBadRequest)}}
+		conn, _ := New(model, prov, nil)
+		if _, err := conn.Converse(ctx, env); err == nil || prov.calls != 1 {
+			t.Errorf("err=%v calls=%d, want an error after 1 call", err, prov.calls)
+		}
+	})
+
+	t.Run("fails rather than wait past the backoff cap", func(t *testing.T) {
+		slow := &provider.StatusError{StatusCode: http.StatusTooManyRequests, RetryAfter: time.Hour}
+		prov := &flakyProvider{errs: []error{slow}}
+		rec := &retryRecorder{}
+		conn, _ := New(model, prov, rec)
+		if _, err := conn.Converse(ctx, env); !errors.Is(err, slow) || prov.calls != 1 || len(rec.retries) != 0 {
+			t.Errorf("err=%v calls=%d retries=%d, want the 429 after 1 call and no retries", err, prov.calls, len(rec.retries))
+		}
+	})
+
+	t.Run("zero MaxRetries fails on first error", func(t *testing.T) {
+		prov := &flakyProvider{errs: []error{status(http.StatusServiceUnavailable)}}
+		conn, _ := New(&types.AgentModel{Name: "no-retries"}, prov, nil)
+		if _, err := conn.Converse(ctx, env); err == nil || prov.calls != 1 {
+			t.Errorf("err=%v calls=%d, want an error after 1 call", err, prov.calls)
+		}
+	})
+}
+
+// flakyStreamer streams one partial reply that fails, then a whole one.
+type flakyStreamer struct {
+	calls int
+}
+
+func (p *flakyStreamer) Chat(ctx context.Context, req provider.AIRequest) (*provider.AIResponse, error) {
+	return nil, errors.New("Chat should not be called")
+}
+
+func (p *flakyStreamer) ChatStream(ctx context.Context, req provider.AIRequest) (<-chan provider.StreamChunk, error) {
+	p.calls++
+	out := make(chan provider.StreamChunk, 3)
+	if p.calls == 1 {
+		out <- provider.StreamChunk{Delta: "lost "}
+		out <- provider.StreamChunk{Err: status(http.StatusServiceUnavailable)}
+	} else {
+		out <- provider.StreamChunk{Delta: "kept "}
+		out <- provider.StreamChunk{Delta: "reply"}
+	}
+	close(out)
+	return out, nil
+}
+
+// chunkRecorder records every chunk with its attempt.
+type chunkRecorder struct {
+	retryRecorder
+	chunks []interfaces.LLMCallChunkInfo
+}
+
+func (r *chunkRecorder) EmitLLMCallChunk(info interfaces.LLMCallChunkInfo) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.chunks = append(r.chunks, info)
+}
+
+func TestConverse_StreamChunksCarryTheAttempt(t *testing.T) {
+	env := &aeiou.Envelope{UserData: "test", Actions: "command endcommand"}
+	rec := &chunkRecorder{}
+	conn, _ := New(&types.AgentModel{Name: "streaming", MaxRetries: 1, RetryBackoffMs: 1}, &flakyStreamer{}, rec)
+	resp, err := conn.Converse(context.Background(), env)
+	if err != nil {
+		t.Fatalf("Converse() failed: %v", err)
+	}
+	if resp.TextContent != "kept reply" {
+		t.Errorf("got %q, want the retried attempt's text", resp.TextContent)
+	}
+	var got []string
+	for _, c := range rec.chunks {
+		got = append(got, fmt.Sprintf("%d/%d/%s", c.Attempt, c.Index, c.Delta))
+	}
+	if want := "[1/0/lost  2/0/kept  2/1/reply]"; fmt.Sprint(got) != want {
+		t.Errorf("got chunks %v, want %s", got, want)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	conn := &LLMConn{model: &types.AgentModel{RetryBackoffMs: 100, RetryMaxBackoffMs: 1000}}
+	for attempt, max := range map[int]time.Duration{1: 100, 2: 200, 3: 400, 4: 800, 5: 1000, 9: 1000} {
+		max *= time.Millisecond
+		for n := 0; n < 20; n++ {
+			if d, ok := conn.retryDelay(attempt, 0); !ok || d < max/2 || d > max {
+				t.Fatalf("retryDelay(%d) = %v, %t, want within [%v, %v]", attempt, d, ok, max/2, max)
+			}
+		}
+	}
+	if d, ok := conn.retryDelay(1, 800*time.Millisecond); !ok || d != 800*time.Millisecond {
+		t.Errorf("Retry-After should win over backoff, got %v, %t", d, ok)
+	}
+	if d, ok := conn.retryDelay(1, 5*time.Second); ok {
+		t.Errorf("a Retry-After beyond the cap should not be waited for, got %v", d)
+	}
+}
+
+func TestCallTimeout_PerModel(t *testing.T) {
+	if d := (&LLMConn{model: &types.AgentModel{}}).callTimeout(); d != defaultCallTimeout {
+		t.Errorf("default timeout = %v, want %v", d, defaultCallTimeout)
+	}
+	if d := (&LLMConn{model: &types.AgentModel{TimeoutSeconds: 7}}).callTimeout(); d != 7*time.Second {
+		t.Errorf("configured timeout = %v, want 7s", d)
+	}
+}
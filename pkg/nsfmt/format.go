@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.8.0
-// File version: 12
-// Purpose: BUGFIX: Passes line prefix length into formatExpression to enable context-aware line wrapping.
+// File version: 17
+// Purpose: Formats 'else if' chains, switch statements, try blocks, 'raise event' and event handler guards.
 // filename: pkg/nsfmt/format.go
-// nlines: 295
+// nlines: 535
 
 package nsfmt
 
@@ -13,6 +13,8 @@ import (
 	"strings"
 
 	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/canon"
+	"github.com/aprice2704/neuroscript/pkg/lang"
 	"github.com/aprice2704/neuroscript/pkg/logging"
 	"github.com/aprice2704/neuroscript/pkg/parser"
 )
@@ -172,7 +174,14 @@ func (f *formatter) formatProcedureSignature(proc *ast.Procedure) string {
 	if len(proc.OptionalParams) > 0 {
 		optNames := make([]string, len(proc.OptionalParams))
 		for i, p := range proc.OptionalParams {
-			optNames[i] = p.Name // Note: We don't format default values here yet
+			optNames[i] = p.Name
+			// A nil default is the same as no default.
+			if _, isNil := p.Default.(lang.NilValue); p.Default == nil || isNil {
+				continue
+			}
+			if node, err := canon.ValueToNode(p.Default); err == nil {
+				optNames[i] += " = " + f.formatExpression(node.(ast.Expression), 0)
+			}
 		}
 		parts = append(parts, fmt.Sprintf("optional %s", strings.Join(optNames, ", ")))
 	}
@@ -262,6 +271,9 @@ func (f *formatter) formatEvent(event *ast.OnEventDecl) {
 	var sig strings.Builder
 	// Pass prefixLen=0; event name is just a string, not a wrapping list
 	sig.WriteString(f.formatExpression(event.EventNameExpr, 0))
+	if event.GuardExpr != nil {
+		sig.WriteString(" where " + f.formatExpression(event.GuardExpr, 0))
+	}
 	if event.HandlerName != "" {
 		sig.WriteString(fmt.Sprintf(" named %q", event.HandlerName))
 	}
@@ -297,6 +309,64 @@ func (f *formatter) formatStepList(steps []ast.Step) {
 	}
 }
 
+// formatSwitch writes a switch statement with its cases one level in and
+// the case bodies one level further.
+func (f *formatter) formatSwitch(sw *ast.SwitchStmt, indentLen int) {
+	prefixStr := "switch "
+	f.writeLine(fmt.Sprintf("%s%s", prefixStr, f.formatExpression(sw.Subject, indentLen+len(prefixStr))))
+	f.indent++
+	for _, c := range sw.Cases {
+		vals := make([]string, len(c.Values))
+		for i, v := range c.Values {
+			vals[i] = f.formatExpression(v, 0)
+		}
+		head := "case "
+		switch c.CaseKind {
+		case ast.CaseIn:
+			head = "case in "
+		case ast.CaseTypeOf:
+			head = "case typeof "
+		}
+		f.writeLine(head + strings.Join(vals, ", "))
+		f.indent++
+		f.formatStepList(c.Body)
+		f.indent--
+	}
+	if sw.Default != nil {
+		f.writeLine("default")
+		f.indent++
+		f.formatStepList(sw.Default)
+		f.indent--
+	}
+	f.indent--
+	f.writeLine("endswitch")
+}
+
+// formatTry writes a try statement; its clause keywords line up with 'try'.
+func (f *formatter) formatTry(t *ast.TryStmt) {
+	f.writeLine("try")
+	f.indent++
+	f.formatStepList(t.Body)
+	f.indent--
+	if t.Catch != nil {
+		if t.CatchVar != "" {
+			f.writeLine("catch " + t.CatchVar)
+		} else {
+			f.writeLine("catch")
+		}
+		f.indent++
+		f.formatStepList(t.Catch)
+		f.indent--
+	}
+	if t.Finally != nil {
+		f.writeLine("finally")
+		f.indent++
+		f.formatStepList(t.Finally)
+		f.indent--
+	}
+	f.writeLine("endtry")
+}
+
 func (f *formatter) formatStep(step *ast.Step) {
 	f.formatComments(step.Comments, true)
 	indentLen := f.indent * len(indentString)
@@ -321,14 +391,33 @@ func (f *formatter) formatStep(step *ast.Step) {
 		f.indent++
 		f.formatStepList(step.Body)
 		f.indent--
-		if step.ElseBody != nil && len(step.ElseBody) > 0 {
+		elseBody := step.ElseBody
+		// An else branch holding nothing but another if is written as 'else if'.
+		for len(elseBody) == 1 && elseBody[0].Type == "if" {
+			nested := &elseBody[0]
+			f.formatComments(nested.Comments, true)
+			prefixStr := "else if "
+			condStr := f.formatExpression(nested.Cond, indentLen+len(prefixStr))
+			f.writeLine(fmt.Sprintf("%s%s", prefixStr, condStr))
+			f.indent++
+			f.formatStepList(nested.Body)
+			f.indent--
+			elseBody = nested.ElseBody
+		}
+		if len(elseBody) > 0 {
 			f.writeLine("else")
 			f.indent++
-			f.formatStepList(step.ElseBody)
+			f.formatStepList(elseBody)
 			f.indent--
 		}
 		f.writeLine("endif")
 
+	case "switch":
+		f.formatSwitch(step.SwitchStmt, indentLen)
+
+	case "try":
+		f.formatTry(step.TryStmt)
+
 	case "for":
 		prefixStr := fmt.Sprintf("for each %s in ", step.LoopVarName)
 		prefixLen := indentLen + len(prefixStr)
@@ -390,6 +479,15 @@ func (f *formatter) formatStep(step *ast.Step) {
 			f.writeLine("fail")
 		}
 
+	case "raise":
+		line := fmt.Sprintf("raise event %s", f.formatExpression(step.Values[0], 0))
+		if len(step.Values) > 1 {
+			withPrefix := " with "
+			prefixLen := indentLen + len(line) + len(withPrefix)
+			line += withPrefix + f.formatExpression(step.Values[1], prefixLen)
+		}
+		f.writeLine(line)
+
 	case "ask":
 		line := fmt.Sprintf("ask %s, %s", f.formatExpression(step.AskStmt.AgentModelExpr, 0), f.formatExpression(step.AskStmt.PromptExpr, 0))
 		if step.AskStmt.WithOptions != nil {
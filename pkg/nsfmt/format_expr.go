@@ -1,14 +1,15 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 17
+// :: fileVersion: 20
 // :: description: Formatter correctly renders InterpolatedStringNode by letting String() natively preserve boundaries.
-// :: latestChange: Added handling for ast.InterpolatedStringNode in formatExpression.
+// :: latestChange: Formats dotted member access and slices.
 // :: filename: pkg/nsfmt/format_expr.go
 // :: serialization: go
 
 package nsfmt
 
 import (
+	"bytes"
 	"fmt"
 	"sort"
 	"strings"
@@ -87,7 +88,10 @@ func (f *formatter) formatExpression(expr ast.Expression, prefixLen int) string
 
 		// Format Left
 		leftStr := f.formatExpression(n.Left, 0)
-		if leftOp, ok := n.Left.(*ast.BinaryOpNode); ok {
+		if _, ok := n.Left.(*ast.LambdaNode); ok {
+			// An unwrapped literal body would swallow the operator.
+			leftStr = fmt.Sprintf("(%s)", leftStr)
+		} else if leftOp, ok := n.Left.(*ast.BinaryOpNode); ok {
 			leftPrec := getPrecedence(leftOp.Operator)
 			// Wrap if child has lower precedence
 			if leftPrec < currentPrec {
@@ -139,19 +143,52 @@ func (f *formatter) formatExpression(expr ast.Expression, prefixLen int) string
 	case *ast.ElementAccessNode:
 		// The object gets the prefix, as it's the start of the expression
 		obj := f.formatExpression(n.Collection, prefixLen)
+		if key, ok := n.Accessor.(*ast.StringLiteralNode); ok && n.Type == ast.DotAccess {
+			return fmt.Sprintf("%s.%s", obj, key.Value)
+		}
 		// The index is "inside" the brackets, pass 0
 		index := f.formatExpression(n.Accessor, 0)
 		return fmt.Sprintf("%s[%s]", obj, index)
 
+	case *ast.SliceNode:
+		obj := f.formatExpression(n.Collection, prefixLen)
+		var start, end string
+		if n.Start != nil {
+			start = f.formatExpression(n.Start, 0)
+		}
+		if n.End != nil {
+			end = f.formatExpression(n.End, 0)
+		}
+		return fmt.Sprintf("%s[%s:%s]", obj, start, end)
+
 	case *ast.CallableExprNode:
 		return f.formatCallable(n, prefixLen) // Pass prefix
 
+	case *ast.LambdaNode:
+		return f.formatLambda(n, prefixLen)
+
 	default:
 		// Fallback for any other expression types
 		return expr.TestString()
 	}
 }
 
+// formatLambda formats a function literal. A statement body goes on the
+// following lines, one level deeper than the line the literal starts on.
+func (f *formatter) formatLambda(n *ast.LambdaNode, prefixLen int) string {
+	head := fmt.Sprintf("func(%s) means", strings.Join(n.Params, ", "))
+	if n.Body != nil {
+		return head + " " + f.formatExpression(n.Body, prefixLen+len(head)+1)
+	}
+
+	body := &formatter{builder: &bytes.Buffer{}, indent: f.indent + 1}
+	body.formatStepList(n.Steps)
+	for _, eh := range n.ErrorHandlers {
+		body.formatErrorHandler(eh)
+	}
+	return head + "\n" + body.builder.String() + strings.Repeat(indentString, f.indent) + "endfunc"
+}
+
 // formatListLiteral formats a list. It tries to fit on one line,
 // but switches to multi-line if it's too long.
 func (f *formatter) formatListLiteral(list *ast.ListLiteralNode, prefixLen int) string {
@@ -261,7 +298,7 @@ func (f *formatter) formatMapLiteral(m *ast.MapLiteralNode, prefixLen int) strin
 func (f *formatter) formatCallable(n *ast.CallableExprNode, prefixLen int) string {
 	// The callee target gets the prefix, as it's the start
 	callee := f.formatExpression(&n.Target, prefixLen)
-	if len(n.Arguments) == 0 {
+	if len(n.Arguments) == 0 && len(n.NamedArgs) == 0 {
 		return fmt.Sprintf("%s()", callee)
 	}
 
@@ -270,10 +307,13 @@ func (f *formatter) formatCallable(n *ast.CallableExprNode, prefixLen int) strin
 	bSingle.WriteString(callee)
 	bSingle.WriteString("(")
 
-	argStrings := make([]string, len(n.Arguments))
-	for i, arg := range n.Arguments {
+	argStrings := make([]string, 0, len(n.Arguments)+len(n.NamedArgs))
+	for _, arg := range n.Arguments {
 		// Sub-expressions pass 0 for prefix
-		argStrings[i] = f.formatExpression(arg, 0)
+		argStrings = append(argStrings, f.formatExpression(arg, 0))
+	}
+	for _, named := range n.NamedArgs {
+		argStrings = append(argStrings, named.Name+": "+f.formatExpression(named.Value, 0))
 	}
 	bSingle.WriteString(strings.Join(argStrings, ", "))
 
@@ -295,13 +335,11 @@ func (f *formatter) formatCallable(n *ast.CallableExprNode, prefixLen int) strin
 
 	bMulti.WriteString(callee)
 	bMulti.WriteString("( \\\n")
-	for i, arg := range n.Arguments {
+	for i, arg := range argStrings {
 		bMulti.WriteString(itemIndentStr)
-		// Pass prefix for args in multi-line mode?
-		// No, they are on their own lines, pass 0.
-		bMulti.WriteString(f.formatExpression(arg, 0))
+		bMulti.WriteString(arg)
 		// FIX: Only add comma if it's not the last element
-		if i < len(n.Arguments)-1 {
+		if i < len(argStrings)-1 {
 			bMulti.WriteString(", \\\n")
 		} else {
 			bMulti.WriteString(" \\\n") // No trailing comma
@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.8.0
-// File version: 8
-// Purpose: BUGFIX: Updates TestFormat_String_MultiLine to expect valid triple-backtick syntax for multi-line output.
+// File version: 9
+// Purpose: Adds formatting tests for dotted member access and slices.
 // filename: pkg/nsfmt/format_expr_test.go
-// nlines: 187
+// nlines: 200
 
 package nsfmt
 
@@ -179,3 +179,22 @@ func main() means
 endfunc`
 	assertFormat(t, input, expected)
 }
+
+func TestFormat_MemberAccessAndSlices(t *testing.T) {
+	input := `
+func main() means
+    set a = cfg.items[ 1 : 3 ]
+    set b = name[:5]
+    set c = xs[-2:]
+    set d = m["k"].v[ : ]
+endfunc
+`
+	expected := `
+func main() means
+    set a = cfg.items[1:3]
+    set b = name[:5]
+    set c = xs[-2:]
+    set d = m["k"].v[:]
+endfunc`
+	assertFormat(t, input, expected)
+}
@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.8.0
-// File version: 13
-// Purpose: BUGFIX: Corrects test expectations to match actual formatter output (no parens on if/while) and fixes invalid long-signature test input.
+// File version: 18
+// Purpose: Adds coverage for try/catch/finally blocks and event handler guards.
 // filename: pkg/nsfmt/format_test.go
-// nlines: 255
+// nlines: 437
 
 package nsfmt
 
@@ -267,3 +267,171 @@ func long_sig( \
 endfunc`
 	assertFormat(t, input, expected)
 }
+
+func TestFormat_NamedArgsAndDefaults(t *testing.T) {
+	input := `
+func scale(needs n optional factor=2,label = "x", ratio = 2.0) means
+	set r = scale(n,factor:3,   label: "y")
+endfunc
+`
+	expected := `
+func scale(needs n optional factor = 2, label = "x", ratio = 2.0) means
+    set r = scale(n, factor: 3, label: "y")
+endfunc`
+	assertFormat(t, input, expected)
+}
+
+func TestFormat_FunctionLiterals(t *testing.T) {
+	input := `
+func main() means
+	set double = func(x) means   x*2
+	set none = func() means 1
+	if true
+		set pick = func(a,b) means
+			if a > b
+				return a
+			endif
+			on error do
+				clear_error
+			endon
+			return b
+		endfunc
+	endif
+	return tool.list.Map([1, 2], func(v) means v + 1)
+endfunc
+`
+	expected := `
+func main() means
+    set double = func(x) means x * 2
+    set none = func() means 1
+    if true
+        set pick = func(a, b) means
+            if a > b
+                return a
+            endif
+            return b
+            on error do
+                clear_error
+            endon
+        endfunc
+    endif
+    return tool.list.Map([1, 2], func(v) means v + 1)
+endfunc`
+	assertFormat(t, input, expected)
+}
+
+func TestFormat_ElseIfAndSwitch(t *testing.T) {
+	input := `
+func main(needs x) means
+	if x == 1
+		emit "one"
+	else
+		if x == 2
+			emit "two"
+		else if x == 3
+			emit "three"
+		else
+			emit "many"
+		endif
+	endif
+	switch x
+	case 1,2
+	emit "small"
+	case in [3, 4]
+	emit "medium"
+	case typeof "string"
+	emit "text"
+	default
+	emit "other"
+	endswitch
+endfunc
+`
+	expected := `
+func main(needs x) means
+    if x == 1
+        emit "one"
+    else if x == 2
+        emit "two"
+    else if x == 3
+        emit "three"
+    else
+        emit "many"
+    endif
+    switch x
+        case 1, 2
+            emit "small"
+        case in [3, 4]
+            emit "medium"
+        case typeof "string"
+            emit "text"
+        default
+            emit "other"
+    endswitch
+endfunc`
+	assertFormat(t, input, expected)
+}
+
+func TestFormat_TryCatchFinally(t *testing.T) {
+	input := `
+func main() means
+	try
+	call tool.fs.Read("a.txt")
+	catch   err
+	emit err["message"]
+	fail err
+	finally
+	emit "done"
+	endtry
+	try
+	emit "x"
+	catch
+	emit "ignored"
+	endtry
+endfunc
+`
+	expected := `
+func main() means
+    try
+        call tool.fs.Read("a.txt")
+    catch err
+        emit err["message"]
+        fail err
+    finally
+        emit "done"
+    endtry
+    try
+        emit "x"
+    catch
+        emit "ignored"
+    endtry
+endfunc`
+	assertFormat(t, input, expected)
+}
+
+func TestFormat_RaiseEvent(t *testing.T) {
+	input := `
+func main() means
+	raise   event "orders.created"   with {"id": 1}
+	raise event "orders." + kind
+endfunc
+`
+	expected := `
+func main() means
+    raise event "orders.created" with {"id": 1}
+    raise event "orders." + kind
+endfunc`
+	assertFormat(t, input, expected)
+}
+
+func TestFormat_EventGuard(t *testing.T) {
+	input := `
+on event   "fdm.node.*"   where {"project": "p1"}   as ev do
+	emit ev
+endon
+`
+	expected := `
+on event "fdm.node.*" where {"project": "p1"} as ev do
+    emit ev
+endon`
+	assertFormat(t, input, expected)
+}
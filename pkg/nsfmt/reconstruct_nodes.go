@@ -1,5 +1,5 @@
 // NeuroScript Version: 0.6.0
-// File version: 18
+// File version: 19
 // Purpose: Implemented precise newline calculation in the rendering loop to correctly preserve spacing between top-level blocks.
 // filename: pkg/nsfmt/reconstructor_nodes.go
 // nlines: 191
@@ -189,7 +189,7 @@ func (r *reconstructor) reconstructExpression(expr ast.Expression) string {
 	case *ast.VariableNode:
 		return n.Name
 	case *ast.NumberLiteralNode:
-		return fmt.Sprintf("%g", n.Value)
+		return n.String()
 	case *ast.StringLiteralNode:
 		return fmt.Sprintf("%q", n.Value)
 	case *ast.NilLiteralNode:
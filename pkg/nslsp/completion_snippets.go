@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.7.0
-// File version: 4
-// Purpose: FEAT: Defines all built-in code snippets for LSP completion. Added ask and whisper. FIX: Removed non-existent InsertTextFormat field. FIX: Expanded func snippet to include optional and returns.
+// File version: 6
+// Purpose: FEAT: Defines all built-in code snippets for LSP completion. Added try/catch/finally.
 // filename: pkg/nslsp/completion_snippets.go
-// nlines: 99
+// nlines: 184
 
 package nslsp
 
@@ -33,6 +33,32 @@ if ${1:condition}
 else
 	${0}
 endif
+`
+	snippetIfElseIf = `
+if ${1:condition}
+	${2}
+else if ${3:condition}
+	${4}
+else
+	${0}
+endif
+`
+	snippetSwitch = `
+switch ${1:value}
+	case ${2:"a"}
+		${3}
+	default
+		${0}
+endswitch
+`
+	snippetTry = `
+try
+	${1}
+catch ${2:err}
+	${3}
+finally
+	${0}
+endtry
 `
 	snippetFor = `
 for each ${1:item} in ${2:list}
@@ -89,6 +115,27 @@ func (s *Server) getSnippetCompletions() *lsp.CompletionList {
 			Documentation: "Creates an if...else...endif block.",
 			InsertText:    strings.TrimSpace(snippetIfElse),
 		},
+		{
+			Label:         "ifelseif",
+			Kind:          lsp.CIKSnippet,
+			Detail:        "If/Else if/Else block snippet",
+			Documentation: "Creates an if...else if...else...endif chain.",
+			InsertText:    strings.TrimSpace(snippetIfElseIf),
+		},
+		{
+			Label:         "switch",
+			Kind:          lsp.CIKSnippet,
+			Detail:        "Switch block snippet",
+			Documentation: "Creates a switch...case...default...endswitch block.",
+			InsertText:    strings.TrimSpace(snippetSwitch),
+		},
+		{
+			Label:         "try",
+			Kind:          lsp.CIKSnippet,
+			Detail:        "Try block snippet",
+			Documentation: "Creates a try...catch...finally...endtry block.",
+			InsertText:    strings.TrimSpace(snippetTry),
+		},
 		{
 			Label:         "for",
 			Kind:          lsp.CIKSnippet,
@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.7.0
-// File version: 13
-// Purpose: Provides end-to-end tests for completion. FEAT: Added test for built-in function and snippet completion.
+// File version: 15
+// Purpose: Provides end-to-end tests for completion. FEAT: Added test for built-in function and snippet completion, including switch and try.
 // filename: pkg/nslsp/completion_test.go
-// nlines: 247
+// nlines: 279
 // risk_rating: MEDIUM
 
 package nslsp
@@ -228,6 +228,8 @@ func TestHandleTextDocumentCompletion_General(t *testing.T) {
 	foundFuncSnippet := false
 	foundForSnippet := false
 	foundAskSnippet := false
+	foundSwitchSnippet := false
+	foundTrySnippet := false
 
 	for _, item := range completionList.Items {
 		if item.Label == "len" && item.Kind == lsp.CIKFunction {
@@ -245,6 +247,12 @@ func TestHandleTextDocumentCompletion_General(t *testing.T) {
 		if item.Label == "ask" && item.Kind == lsp.CIKSnippet {
 			foundAskSnippet = true
 		}
+		if item.Label == "switch" && item.Kind == lsp.CIKSnippet {
+			foundSwitchSnippet = true
+		}
+		if item.Label == "try" && item.Kind == lsp.CIKSnippet {
+			foundTrySnippet = true
+		}
 	}
 
 	if !foundLen {
@@ -262,4 +270,10 @@ func TestHandleTextDocumentCompletion_General(t *testing.T) {
 	if !foundAskSnippet {
 		t.Error("Expected to find 'ask' (snippet) in general completions")
 	}
+	if !foundSwitchSnippet {
+		t.Error("Expected to find 'switch' (snippet) in general completions")
+	}
+	if !foundTrySnippet {
+		t.Error("Expected to find 'try' (snippet) in general completions")
+	}
 }
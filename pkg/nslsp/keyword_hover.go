@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.7.0
-// File version: 1
-// Purpose: FEAT: Defines hover documentation for all major structural keywords.
+// File version: 5
+// Purpose: FEAT: Defines hover documentation for all major structural keywords, including else-if, switch, try, raise and where.
 // filename: pkg/nslsp/keyword_hover.go
-// nlines: 104
+// nlines: 135
 
 package nslsp
 
@@ -31,12 +31,40 @@ var KeywordDocs = map[int]KeywordDocInfo{
 		Description: "Defines a top-level, executable block of code. This is the main entry point for a script that performs actions.",
 	},
 	gen.NeuroScriptLexerKW_ON: {
-		Signature:   "on event <name_expr> as <var> do ... endon\non error do ... endon",
-		Description: "Defines an event handler. It can be used to subscribe to a named event or to define a local error handler for a function or command block.",
+		Signature:   "on event <name_expr> [where <guard>] as <var> do ... endon\non error do ... endon",
+		Description: "Defines an event handler. It can be used to subscribe to a named event, or to every event matching a name ending in `*`, or to define a local error handler for a function or command block.",
 	},
 	gen.NeuroScriptLexerKW_IF: {
-		Signature:   "if <condition> ... else ... endif",
-		Description: "A conditional block. The `else` block is optional.",
+		Signature:   "if <condition> ... else if <condition> ... else ... endif",
+		Description: "A conditional block. Any number of `else if` branches and a final `else` are optional; one `endif` closes the whole chain.",
+	},
+	gen.NeuroScriptLexerKW_ELSE: {
+		Signature:   "else\nelse if <condition>",
+		Description: "Starts the fallback branch of an `if`, or another condition to test when the earlier ones were false.",
+	},
+	gen.NeuroScriptLexerKW_SWITCH: {
+		Signature:   "switch <expr> case ... default ... endswitch",
+		Description: "Evaluates the subject once and runs the first matching `case`, or `default` if none match. There is no fall-through.",
+	},
+	gen.NeuroScriptLexerKW_CASE: {
+		Signature:   "case <value>, <value>\ncase in <list_or_map>\ncase typeof <type_name>",
+		Description: "A branch of a `switch`. Matches if the subject equals one of the values, is an element of the list (or a key of the map), or has one of the named types.",
+	},
+	gen.NeuroScriptLexerKW_DEFAULT: {
+		Signature:   "default",
+		Description: "The branch of a `switch` that runs when no `case` matches. Optional; it must come after every `case`.",
+	},
+	gen.NeuroScriptLexerKW_TRY: {
+		Signature:   "try ... catch <var> ... finally ... endtry",
+		Description: "Runs a block and handles its errors. `catch` receives the error as an error value; `finally` always runs, whether the block completes, returns, breaks or fails. At least one of the two is required.",
+	},
+	gen.NeuroScriptLexerKW_CATCH: {
+		Signature:   "catch\ncatch <var>",
+		Description: "The branch of a `try` that runs when its block fails. The optional variable holds an error value with `code`, `message` and `position`; `fail <var>` rethrows it.",
+	},
+	gen.NeuroScriptLexerKW_FINALLY: {
+		Signature:   "finally",
+		Description: "The branch of a `try` that always runs last. An error or `return` inside it replaces the outcome of the `try` block and `catch`.",
 	},
 	gen.NeuroScriptLexerKW_FOR: {
 		Signature:   "for each <var> in <list_or_map> ... endfor",
@@ -67,12 +95,20 @@ var KeywordDocs = map[int]KeywordDocInfo{
 		Description: "Exits the current function and returns one or more values. `return` with no values is also valid.",
 	},
 	gen.NeuroScriptLexerKW_EMIT: {
-		Signature:   "emit <event_expr>",
-		Description: "Emits a named event to be caught by an `on event` handler.",
+		Signature:   "emit <value>",
+		Description: "Sends a value to the host's output. To deliver an event to `on event` handlers, use `raise event`.",
+	},
+	gen.NeuroScriptLexerKW_RAISE: {
+		Signature:   "raise event <name_expr>\nraise event <name_expr> with <payload_map>",
+		Description: "Delivers an event to this interpreter's `on event` handlers, which run before the next statement. Requires the `bus:write:<name>` grant; the raising procedure is recorded as the event's source.",
+	},
+	gen.NeuroScriptLexerKW_WHERE: {
+		Signature:   "on event <name_expr> where <guard> ... do",
+		Description: "Filters the events an `on event` handler receives. A map guard such as `{\"project\": \"p1\", \"queue\": [\"q1\", \"q2\"]}` must match the event payload (a list lists alternatives); any other guard must be truthy. Guards are checked before the handler starts and cannot call tools or procedures.",
 	},
 	gen.NeuroScriptLexerKW_FAIL: {
-		Signature:   "fail <error_message>",
-		Description: "Stops execution and raises a runtime error with the given message. This will trigger an `on error` handler if one is defined in scope.",
+		Signature:   "fail <error_message>\nfail <caught_error>",
+		Description: "Stops execution and raises a runtime error with the given message, or rethrows an error caught by `catch`. This will trigger a surrounding `catch` or an `on error` handler if one is defined in scope.",
 	},
 }
 
@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.7.0
-// File version: 1
+// File version: 3
 // Purpose: Refactored: Contains struct definitions for semantic listeners and helpers.
 // filename: pkg/nslsp/semantic_listeners.go
-// nlines: 70
+// nlines: 67
 
 package nslsp
 
@@ -35,8 +35,8 @@ func (l *symbolCollectorListener) EnterProcedure_definition(ctx *gen.Procedure_d
 		if needs := sig.Needs_clause(0); needs != nil && needs.Param_list() != nil {
 			needsCount = len(needs.Param_list().AllIDENTIFIER())
 		}
-		if optional := sig.Optional_clause(0); optional != nil && optional.Param_list() != nil {
-			optionalCount = len(optional.Param_list().AllIDENTIFIER())
+		if optional := sig.Optional_clause(0); optional != nil && optional.Optional_param_list() != nil {
+			optionalCount = len(optional.Optional_param_list().AllOptional_param())
 		}
 	}
 	token := ctx.IDENTIFIER().GetSymbol()
@@ -52,7 +52,8 @@ type validationListener struct {
 	*gen.BaseNeuroScriptListener
 	semanticAnalyzer *SemanticAnalyzer
 	diagnostics      []lsp.Diagnostic
-	initializedVars  map[string]bool // Tracks variables in the current scope
+	initializedVars  map[string]bool   // Tracks variables in the current scope
+	outerScopes      []map[string]bool // Scopes suspended while inside function literals
 }
 
 // lspRangeFromToken is a helper to create an lsp.Range from an ANTLR token.
@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 8
+// :: fileVersion: 10
 // :: description: Restored "Procedure defined in another file" Information diagnostic, guarded by isDebug flag, to satisfy tests.
-// :: latestChange: Support built-in functions that do not use the call_target grammar rule (e.g. keywords like len, sin).
+// :: latestChange: Calls through a variable (a function value) are not checked as procedure calls.
 // :: filename: pkg/nslsp/semantic_validate_calls.go
 // :: serialization: go
 
@@ -43,6 +43,12 @@ func (l *validationListener) EnterCallable_expr(ctx *gen.Callable_exprContext) {
 		return
 	}
 
+	// A variable in scope shadows procedures: this calls the function it holds,
+	// whose arity is only known at run time.
+	if l.initializedVars[procName] {
+		return
+	}
+
 	l.validateProcedureCall(ctx, procName)
 }
 
@@ -77,11 +83,7 @@ func (l *validationListener) validateToolCall(ctx *gen.Callable_exprContext) {
 		return
 	}
 	spec = impl.Spec
-	argList := ctx.Expression_list_opt()
-	actualArgCount := 0
-	if argList != nil && argList.Expression_list() != nil {
-		actualArgCount = len(argList.Expression_list().AllExpression())
-	}
+	actualArgCount := callArgCount(ctx)
 	token := ctx.Call_target().GetStart()
 
 	// Calculate min/max args and validate against the range.
@@ -120,10 +122,7 @@ func (l *validationListener) validateToolCall(ctx *gen.Callable_exprContext) {
 }
 
 func (l *validationListener) validateProcedureCall(ctx *gen.Callable_exprContext, procName string) {
-	actualArgCount := 0
-	if ctx.Expression_list_opt() != nil && ctx.Expression_list_opt().Expression_list() != nil {
-		actualArgCount = len(ctx.Expression_list_opt().Expression_list().AllExpression())
-	}
+	actualArgCount := callArgCount(ctx)
 
 	token := ctx.GetStart()
 	if ctx.Call_target() != nil {
@@ -190,3 +189,11 @@ func (l *validationListener) validateProcedureCall(ctx *gen.Callable_exprContext
 		}
 	}
 }
+
+// callArgCount counts positional and named arguments alike.
+func callArgCount(ctx *gen.Callable_exprContext) int {
+	if argList := ctx.Argument_list_opt(); argList != nil && argList.Argument_list() != nil {
+		return len(argList.Argument_list().AllArgument())
+	}
+	return 0
+}
@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 6
+// :: fileVersion: 8
 // :: description: Refactored: Contains all validation listener logic for variable scope, initialization tracking, and read validation.
-// :: latestChange: Injected self and system_error_message into globally initialized scope.
+// :: latestChange: Function literals get their own scope holding the enclosing names and their parameters.
 // :: filename: pkg/nslsp/semantic_validate_vars.go
 // :: serialization: go
 
@@ -68,9 +68,9 @@ func (l *validationListener) EnterProcedure_definition(ctx *gen.Procedure_defini
 				l.initializedVars[ident.GetText()] = true
 			}
 		}
-		if optional := sig.Optional_clause(0); optional != nil && optional.Param_list() != nil {
-			for _, ident := range optional.Param_list().AllIDENTIFIER() {
-				l.initializedVars[ident.GetText()] = true
+		if optional := sig.Optional_clause(0); optional != nil && optional.Optional_param_list() != nil {
+			for _, param := range optional.Optional_param_list().AllOptional_param() {
+				l.initializedVars[param.IDENTIFIER().GetText()] = true
 			}
 		}
 	}
@@ -100,6 +100,32 @@ func (l *validationListener) ExitEvent_handler(ctx *gen.Event_handlerContext) {
 	l.initializedVars = nil
 }
 
+// EnterLambda_expr opens the scope of a function literal: everything set so
+// far, which the literal captures, plus its parameters. Names it sets stay
+// inside it.
+func (l *validationListener) EnterLambda_expr(ctx *gen.Lambda_exprContext) {
+	l.outerScopes = append(l.outerScopes, l.initializedVars)
+	if l.initializedVars == nil {
+		return
+	}
+	scope := make(map[string]bool, len(l.initializedVars))
+	for name := range l.initializedVars {
+		scope[name] = true
+	}
+	if params := ctx.Param_list(); params != nil {
+		for _, ident := range params.AllIDENTIFIER() {
+			scope[ident.GetText()] = true
+		}
+	}
+	l.initializedVars = scope
+}
+
+func (l *validationListener) ExitLambda_expr(ctx *gen.Lambda_exprContext) {
+	last := len(l.outerScopes) - 1
+	l.initializedVars = l.outerScopes[last]
+	l.outerScopes = l.outerScopes[:last]
+}
+
 // --- Variable Write Tracking ---
 
 func (l *validationListener) EnterSet_statement(ctx *gen.Set_statementContext) {
@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 4
+// :: fileVersion: 5
 // :: description: Provides tests for the uninitialized variable check.
-// :: latestChange: Moved test environment setup inside t.Run to prevent state leakage and Heisenfails.
+// :: latestChange: Cases for function literal parameters, captured names and calls through variables.
 // :: filename: pkg/nslsp/semantic_vars_test.go
 // :: serialization: go
 
@@ -122,6 +122,20 @@ func TestSemanticAnalyzer_UninitializedVars(t *testing.T) {
 			expectedSeverity: 0,
 			expectedCount:    0,
 		},
+		{
+			name:             "Valid: Function literal params, captures and call through variable",
+			script:           "func M() means\n  set n = 2\n  set f = func(x) means x * n\n  set y = f(3)\nendfunc",
+			expectedCode:     nil,
+			expectedSeverity: 0,
+			expectedCount:    0,
+		},
+		{
+			name:             "Invalid: Function literal param used outside it",
+			script:           "func M() means\n  set f = func(x) means x\n  set y = x\nendfunc",
+			expectedCode:     string(DiagCodeUninitializedVar),
+			expectedSeverity: lsp.Warning,
+			expectedCount:    1,
+		},
 	}
 
 	for _, tc := range testCases {
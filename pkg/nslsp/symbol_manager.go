@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 13
+// :: fileVersion: 14
 // :: description: Upgrades SymbolManager with robust URI encoding and signature formatting.
-// :: latestChange: FIX: Use net/url for URI construction. Re-apply signature parentheses fix.
+// :: latestChange: Optional parameters now come from optional_param_list (they may carry defaults).
 // :: filename: pkg/nslsp/symbol_manager.go
 // :: serialization: go
 package nslsp
@@ -210,9 +210,9 @@ func extractArgsAndSignature(ctx *gen.Procedure_definitionContext) (int, int, st
 			needsCount = len(params)
 			parts = append(parts, "needs "+strings.Join(params, ", "))
 		}
-		if optional := sig.Optional_clause(0); optional != nil && optional.Param_list() != nil {
+		if optional := sig.Optional_clause(0); optional != nil && optional.Optional_param_list() != nil {
 			var params []string
-			for _, p := range optional.Param_list().AllIDENTIFIER() {
+			for _, p := range optional.Optional_param_list().AllOptional_param() {
 				params = append(params, p.GetText())
 			}
 			optionalCount = len(params)
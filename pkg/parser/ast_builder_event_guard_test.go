@@ -0,0 +1,50 @@
+// filename: pkg/parser/ast_builder_event_guard_test.go
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Tests AST construction for the optional 'where' guard of event handlers.
+
+package parser
+
+import (
+	"testing"
+)
+
+func TestEventHandler_Guard(t *testing.T) {
+	prog := testParseAndBuild(t, `
+on event "fdm.node.*" where {"project": "p1"} named "nodes" as ev do
+	emit ev
+endon
+
+on event "jobs" where ev.payload[0].Payload.priority > 5 as ev do
+	emit ev
+endon
+
+on event "ticks" do
+	emit "tick"
+endon
+`)
+	if len(prog.Events) != 3 {
+		t.Fatalf("expected 3 handlers, got %d", len(prog.Events))
+	}
+	first := prog.Events[0]
+	if first.GuardExpr == nil || first.EventNameExpr.String() != `"fdm.node.*"` {
+		t.Errorf("expected a guard and the name as the name expression, got %+v", first)
+	}
+	if first.HandlerName != "nodes" || first.EventVarName != "ev" {
+		t.Errorf("expected the name and variable to survive the guard, got %q and %q", first.HandlerName, first.EventVarName)
+	}
+	if prog.Events[1].GuardExpr == nil {
+		t.Error("expected an expression guard on the second handler")
+	}
+	if prog.Events[2].GuardExpr != nil {
+		t.Errorf("expected no guard on the third handler, got %s", prog.Events[2].GuardExpr)
+	}
+}
+
+func TestEventHandler_GuardNeedsExpression(t *testing.T) {
+	testForParserError(t, `
+on event "jobs" where do
+	emit "x"
+endon
+`)
+}
@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.8.0
-// File version: 28
-// Purpose: Allows 'on error' handlers within command blocks and corrects the generated step type to 'on_error'.
+// File version: 30
+// Purpose: Attaches 'on error' handlers inside a function literal to the literal rather than the enclosing block. Builds the optional 'where' guard of event handlers.
 // filename: pkg/parser/ast_builder_events.go
-// nlines: 104
+// nlines: 140
 // risk_rating: HIGH
 
 package parser
@@ -41,6 +41,20 @@ func (l *neuroScriptListenerImpl) ExitEvent_handler(c *gen.Event_handlerContext)
 		return
 	}
 
+	var guard ast.Expression
+	if c.KW_WHERE() != nil {
+		guardVal, ok := l.pop()
+		if !ok {
+			l.addError(c, "stack underflow in event_handler: could not pop 'where' guard")
+			return
+		}
+		guard, ok = guardVal.(ast.Expression)
+		if !ok {
+			l.addError(c, "internal error in event_handler: 'where' guard is not an ast.Expression, but %T", guardVal)
+			return
+		}
+	}
+
 	eventNameVal, ok := l.pop()
 	if !ok {
 		l.addError(c, "stack underflow in event_handler: could not pop event name")
@@ -64,6 +78,7 @@ func (l *neuroScriptListenerImpl) ExitEvent_handler(c *gen.Event_handlerContext)
 	}
 
 	onEvent.EventNameExpr = eventName
+	onEvent.GuardExpr = guard
 	onEvent.Body = body
 	SetEndPos(onEvent, c.GetStop())
 
@@ -111,7 +126,11 @@ func (l *neuroScriptListenerImpl) ExitError_handler(c *gen.Error_handlerContext)
 	SetEndPos(handlerStep, c.GetStop())
 
 	// FIX: Allow 'on error' handlers inside both procedures and command blocks.
-	if l.currentProc != nil {
+	// A handler inside a function literal belongs to the literal.
+	if n := len(l.lambdaStack); n > 0 {
+		lambda := l.lambdaStack[n-1].node
+		lambda.ErrorHandlers = append(lambda.ErrorHandlers, handlerStep)
+	} else if l.currentProc != nil {
 		l.currentProc.ErrorHandlers = append(l.currentProc.ErrorHandlers, handlerStep)
 	} else if l.currentCommand != nil {
 		l.currentCommand.ErrorHandlers = append(l.currentCommand.ErrorHandlers, handlerStep)
@@ -1,6 +1,6 @@
 // NeuroScript Version: 0.7.2
-// File version: 6
-// Purpose: Ensures ElseBody is initialized to a non-nil empty slice for consistency, preventing diffs in canonicalization.
+// File version: 7
+// Purpose: Builds 'else if' chains as an if step nested in the else body.
 // filename: pkg/parser/ast_builder_if_else.go
 
 package parser
@@ -13,7 +13,7 @@ import (
 
 func (l *neuroScriptListenerImpl) ExitIf_statement(c *gen.If_statementContext) {
 	var elseBody []ast.Step
-	if c.KW_ELSE() != nil {
+	if c.Else_clause() != nil {
 		val, ok := l.pop()
 		if !ok {
 			l.addError(c, "internal error in if_statement: could not pop else body")
@@ -63,3 +63,59 @@ func (l *neuroScriptListenerImpl) ExitIf_statement(c *gen.If_statementContext) {
 	SetEndPos(&step, c.KW_ENDIF().GetSymbol())
 	l.addStep(step)
 }
+
+// ExitElse_clause handles both 'else' forms. A plain 'else' leaves its body on the
+// stack for the enclosing if. An 'else if' builds the nested if step and pushes it
+// as a one-step else body, so a chain needs only a single 'endif'.
+func (l *neuroScriptListenerImpl) ExitElse_clause(c *gen.Else_clauseContext) {
+	if c.KW_IF() == nil {
+		return
+	}
+
+	elseBody := make([]ast.Step, 0)
+	if c.Else_clause() != nil {
+		val, ok := l.pop()
+		if !ok {
+			l.addError(c, "internal error in else_clause: could not pop else body")
+			return
+		}
+		elseBody, ok = val.([]ast.Step)
+		if !ok {
+			l.addError(c, "internal error in else_clause: else body is not a []ast.Step, but %T", val)
+			return
+		}
+	}
+
+	val, ok := l.pop()
+	if !ok {
+		l.addError(c, "internal error in else_clause: could not pop if body")
+		return
+	}
+	ifBody, ok := val.([]ast.Step)
+	if !ok {
+		l.addError(c, "internal error in else_clause: if body is not a []ast.Step, but %T", val)
+		return
+	}
+
+	condVal, ok := l.pop()
+	if !ok {
+		l.addError(c, "internal error in else_clause: could not pop condition")
+		return
+	}
+	cond, ok := condVal.(ast.Expression)
+	if !ok {
+		l.addError(c, "internal error in else_clause: condition is not an ast.Expression, but %T", condVal)
+		return
+	}
+
+	pos := tokenToPosition(c.KW_IF().GetSymbol())
+	step := ast.Step{
+		BaseNode: ast.BaseNode{StartPos: &pos, NodeKind: types.KindStep},
+		Type:     "if",
+		Cond:     cond,
+		Body:     ifBody,
+		ElseBody: elseBody,
+	}
+	SetEndPos(&step, c.GetStop())
+	l.push([]ast.Step{step})
+}
@@ -0,0 +1,82 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Builds LambdaNode for function literals ('func(x) means x * 2').
+// filename: pkg/parser/ast_builder_lambda.go
+// nlines: 82
+// risk_rating: MEDIUM
+
+package parser
+
+import (
+	gen "github.com/aprice2704/neuroscript/pkg/antlr/generated"
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/types"
+)
+
+// lambdaContext tracks a function literal whose body is being built. Loops
+// around the literal do not extend into its body, so 'break' and 'continue'
+// there are checked against the literal's own loops.
+type lambdaContext struct {
+	node           *ast.LambdaNode
+	savedLoopDepth int
+}
+
+func (l *neuroScriptListenerImpl) EnterLambda_expr(ctx *gen.Lambda_exprContext) {
+	l.logDebugAST(">>> Enter Lambda_expr")
+	node := newNode(&ast.LambdaNode{Params: []string{}}, ctx.KW_FUNC().GetSymbol(), types.KindLambda)
+	l.lambdaStack = append(l.lambdaStack, &lambdaContext{node: node, savedLoopDepth: l.loopDepth})
+	l.loopDepth = 0
+}
+
+func (l *neuroScriptListenerImpl) ExitLambda_expr(ctx *gen.Lambda_exprContext) {
+	l.logDebugAST("<<< Exit Lambda_expr")
+	last := len(l.lambdaStack) - 1
+	lc := l.lambdaStack[last]
+	l.lambdaStack = l.lambdaStack[:last]
+	l.loopDepth = lc.savedLoopDepth
+	node := lc.node
+
+	bodyRaw, ok := l.pop()
+	if !ok {
+		l.addError(ctx, "stack underflow: could not pop function literal body")
+		l.push(newNode(&ast.ErrorNode{Message: "Stack underflow (func literal)"}, ctx.GetStart(), types.KindUnknown))
+		return
+	}
+	if ctx.Expression() != nil {
+		body, isExpr := bodyRaw.(ast.Expression)
+		if !isExpr {
+			l.addError(ctx, "internal error: function literal body is not an ast.Expression (got %T)", bodyRaw)
+			l.push(newNode(&ast.ErrorNode{Message: "Type error (func literal)"}, ctx.GetStart(), types.KindUnknown))
+			return
+		}
+		node.Body = body
+		SetEndPos(node, ctx.GetStop())
+	} else {
+		steps, isSteps := bodyRaw.([]ast.Step)
+		if !isSteps {
+			l.addError(ctx, "internal error: function literal body is not []ast.Step (got %T)", bodyRaw)
+			l.push(newNode(&ast.ErrorNode{Message: "Type error (func literal)"}, ctx.GetStart(), types.KindUnknown))
+			return
+		}
+		node.Steps = steps
+		SetEndPos(node, ctx.KW_ENDFUNC().GetSymbol())
+	}
+
+	if ctx.Param_list() != nil {
+		paramsRaw, ok := l.pop()
+		params, isParams := paramsRaw.([]string)
+		if !ok || !isParams {
+			l.addError(ctx, "internal error: function literal parameters are not []string (got %T)", paramsRaw)
+		} else {
+			node.Params = params
+		}
+	}
+	seen := make(map[string]bool, len(node.Params))
+	for _, p := range node.Params {
+		if seen[p] {
+			l.addError(ctx, "duplicate parameter '%s' in function literal", p)
+		}
+		seen[p] = true
+	}
+	l.push(node)
+}
@@ -1,14 +1,15 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 15
+// :: fileVersion: 16
 // :: description: Constructs InterpolatedStringNode instead of binary op chains, preserving lexical boundaries.
-// :: latestChange: buildInterpolatedString now returns an InterpolatedStringNode and preserves formatting symbols via PlaceholderNode.
+// :: latestChange: parseNumber yields int64 for integer literals and float64 for literals with a fraction or exponent.
 // :: filename: pkg/parser/ast_builder_literals.go
 // :: serialization: go
 
 package parser
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -186,10 +187,24 @@ func (l *neuroScriptListenerImpl) ExitNil_literal(ctx *gen.Nil_literalContext) {
 	l.logDebugAST("   << Exit NilLiteral, Pushed Node: %T", node)
 }
 
-func parseNumber(numStr string) (float64, error) {
+// parseNumber converts a NUMBER_LIT token. Literals made only of digits are
+// integers (int64); a fraction or exponent makes a float64, so 3 and 3.0
+// are different literals. Integer literals that overflow int64 are errors
+// rather than being silently rounded to a float.
+func parseNumber(numStr string) (interface{}, error) {
+	if !strings.ContainsAny(numStr, ".eE") {
+		iVal, err := strconv.ParseInt(numStr, 10, 64)
+		if err != nil {
+			if errors.Is(err, strconv.ErrRange) {
+				return nil, fmt.Errorf("integer literal %s overflows int64", numStr)
+			}
+			return nil, fmt.Errorf("invalid number literal: %q", numStr)
+		}
+		return iVal, nil
+	}
 	fVal, err := strconv.ParseFloat(numStr, 64)
 	if err != nil {
-		return 0, fmt.Errorf("invalid number literal: %q", numStr)
+		return nil, fmt.Errorf("invalid number literal: %q", numStr)
 	}
 	return fVal, nil
 }
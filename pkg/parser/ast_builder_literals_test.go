@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 7
+// :: fileVersion: 8
 // :: description: Comprehensive tests for parsing literals with UI safety overrides. Added severe edge-case tests.
-// :: latestChange: Added tests for adjacent placeholders, unclosed tags, and non-interpolated raw strings.
+// :: latestChange: Integer literals are int64; added float and overflow cases.
 // :: filename: pkg/parser/ast_builder_literals_test.go
 // :: serialization: go
 
@@ -13,6 +13,7 @@ import (
 	"testing"
 
 	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/logging"
 )
 
 func TestLiteralParsing(t *testing.T) {
@@ -38,8 +39,26 @@ func TestLiteralParsing(t *testing.T) {
 		expr := getExpr(`func Test() means
 			emit 123
 		endfunc`)
-		if num, ok := expr.(*ast.NumberLiteralNode); !ok || num.Value != 123.0 {
-			t.Errorf("Expected NumberLiteralNode with value 123.0, got %T with value %v", expr, num)
+		if num, ok := expr.(*ast.NumberLiteralNode); !ok || num.Value != int64(123) {
+			t.Errorf("Expected NumberLiteralNode with int64 value 123, got %T with value %v", expr, num)
+		}
+
+		expr = getExpr(`func Test() means
+			emit 1.5e2
+		endfunc`)
+		if num, ok := expr.(*ast.NumberLiteralNode); !ok || num.Value != 150.0 {
+			t.Errorf("Expected NumberLiteralNode with float64 value 150, got %T with value %v", expr, num)
+		}
+	})
+
+	t.Run("Integer Literal Overflow", func(t *testing.T) {
+		script := "func Test() means\n\temit 9223372036854775808\nendfunc"
+		tree, err := NewParserAPI(logging.NewNoOpLogger()).Parse(script)
+		if err == nil {
+			_, _, err = NewASTBuilder(logging.NewNoOpLogger()).Build(tree)
+		}
+		if err == nil {
+			t.Error("Expected an error for an integer literal that overflows int64")
 		}
 	})
 
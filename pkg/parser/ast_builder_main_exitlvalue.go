@@ -1,5 +1,5 @@
 // NeuroScript Version: 0.7.2
-// File version: 23.0.0
+// File version: 24.0.0
 // Purpose: Sets the end position on the LValueNode to ensure all nodes have valid positions.
 // filename: pkg/parser/ast_builder_main_exitlvalue.go
 //
@@ -9,7 +9,6 @@
 package parser
 
 import (
-	"fmt"
 	"reflect"
 
 	"github.com/antlr4-go/antlr/v4"
@@ -145,7 +144,7 @@ func (l *neuroScriptListenerImpl) ExitLvalue(ctx *gen.LvalueContext) {
 func nodeText(n any) string { // ← was ast.Node
 	switch v := n.(type) {
 	case *ast.NumberLiteralNode:
-		return fmt.Sprintf("%g", v.Value)
+		return v.String()
 	case *ast.StringLiteralNode:
 		return v.Value
 	case *ast.VariableNode:
@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.8.0
-// File version: 26
-// Purpose: Corrected a bug where converting a VariableNode to an LValueNode copied the wrong NodeKind.
+// File version: 27
+// Purpose: Tracks enclosing function literals while building their bodies.
 // filename: pkg/parser/ast_builder_nslistener.go
-// nlines: 165
+// nlines: 195
 // risk_rating: MEDIUM
 
 package parser
@@ -29,6 +29,7 @@ type neuroScriptListenerImpl struct {
 	currentProc               *ast.Procedure
 	currentCommand            *ast.CommandNode
 	currentEvent              *ast.OnEventDecl
+	lambdaStack               []*lambdaContext
 	ValueStack                []interface{}
 	blockStack                []*blockContext
 	logger                    interfaces.Logger
@@ -1,8 +1,8 @@
 // filename: pkg/parser/ast_builder_operators.go
 // NeuroScript Version: 0.5.2
-// File version: 24
-// Purpose: Added handling for all built-in math functions (sin, cos, tan, etc.) to the Callable_expr rule, fixing the comprehensive grammar test failure.
-// nlines: 215
+// File version: 27
+// Purpose: Builds dotted member access and slices in accessor expressions.
+// nlines: 596
 // risk_rating: HIGH
 
 package parser
@@ -312,16 +312,18 @@ func (l *neuroScriptListenerImpl) EnterAccessor_expr(ctx *gen.Accessor_exprConte
 	l.logDebugAST("--- Enter Accessor_expr: %q", ctx.GetText())
 }
 
-// ExitAccessor_expr
+// ExitAccessor_expr applies the '[...]' subscripts and '.field' accesses to
+// the primary, left to right. Only subscripts leave values on the stack;
+// field names are read from the IDENTIFIER tokens.
 func (l *neuroScriptListenerImpl) ExitAccessor_expr(ctx *gen.Accessor_exprContext) {
 	l.logDebugAST("--- Exit Accessor_expr: %q", ctx.GetText())
-	numAccessors := len(ctx.AllLBRACK())
-	if numAccessors == 0 {
+	numSubscripts := len(ctx.AllSubscript())
+	if numSubscripts == 0 && len(ctx.AllDOT()) == 0 {
 		return
 	}
 
-	accessorExprs := make([]ast.Expression, numAccessors)
-	for i := numAccessors - 1; i >= 0; i-- {
+	subscripts := make([]ast.Expression, numSubscripts)
+	for i := numSubscripts - 1; i >= 0; i-- {
 		accessorRaw, ok := l.pop()
 		if !ok {
 			l.addError(ctx, "Stack error popping accessor expression %d", i)
@@ -334,7 +336,7 @@ func (l *neuroScriptListenerImpl) ExitAccessor_expr(ctx *gen.Accessor_exprContex
 			l.push(newNode(&ast.ErrorNode{Message: "Type error (accessor expr)"}, ctx.LBRACK(i).GetSymbol(), types.KindUnknown))
 			return
 		}
-		accessorExprs[i] = accessorExpr
+		subscripts[i] = accessorExpr
 	}
 
 	collectionRaw, ok := l.pop()
@@ -351,18 +353,71 @@ func (l *neuroScriptListenerImpl) ExitAccessor_expr(ctx *gen.Accessor_exprContex
 	}
 
 	currentCollectionResult := collectionExpr
-	for i := 0; i < numAccessors; i++ {
-		node := &ast.ElementAccessNode{
-			Collection: currentCollectionResult,
-			Accessor:   accessorExprs[i],
+	nextSubscript := 0
+	var dotToken antlr.Token
+	for _, child := range ctx.GetChildren() {
+		term, isTerm := child.(antlr.TerminalNode)
+		if !isTerm {
+			continue
+		}
+		switch term.GetSymbol().GetTokenType() {
+		case gen.NeuroScriptParserLBRACK:
+			accessor := subscripts[nextSubscript]
+			nextSubscript++
+			// ExitSubscript leaves slices without their collection.
+			if slice, isSlice := accessor.(*ast.SliceNode); isSlice && slice.Collection == nil {
+				slice.Collection = currentCollectionResult
+				currentCollectionResult = newNode(slice, term.GetSymbol(), types.KindSlice)
+				continue
+			}
+			node := &ast.ElementAccessNode{Collection: currentCollectionResult, Accessor: accessor}
+			l.logDebugAST("      Constructed ast.ElementAccessNode: [Coll: %T Acc: %T]", node.Collection, node.Accessor)
+			currentCollectionResult = newNode(node, term.GetSymbol(), types.KindElementAccess)
+		case gen.NeuroScriptParserDOT:
+			dotToken = term.GetSymbol()
+		case gen.NeuroScriptParserIDENTIFIER:
+			fieldTok := term.GetSymbol()
+			key := newNode(&ast.StringLiteralNode{Value: fieldTok.GetText()}, fieldTok, types.KindStringLiteral)
+			node := &ast.ElementAccessNode{Collection: currentCollectionResult, Accessor: key, Type: ast.DotAccess}
+			l.logDebugAST("      Constructed ast.ElementAccessNode (member): [Coll: %T Field: %s]", node.Collection, key.Value)
+			currentCollectionResult = newNode(node, dotToken, types.KindMemberAccess)
 		}
-		l.logDebugAST("      Constructed ast.ElementAccessNode: [Coll: %T Acc: %T]", node.Collection, node.Accessor)
-		currentCollectionResult = newNode(node, ctx.LBRACK(i).GetSymbol(), types.KindElementAccess)
 	}
 	l.push(currentCollectionResult)
 	l.logDebugAST("      Final Accessor_expr result pushed: %T", currentCollectionResult)
 }
 
+// ExitSubscript turns 'start:end' into a SliceNode whose collection is filled
+// in by ExitAccessor_expr. A plain index is left on the stack as it is.
+func (l *neuroScriptListenerImpl) ExitSubscript(ctx *gen.SubscriptContext) {
+	colon := ctx.COLON()
+	if colon == nil {
+		return
+	}
+	bounds := ctx.AllExpression()
+	popped, ok := l.popN(len(bounds))
+	if !ok {
+		l.addError(ctx, "Stack error popping slice bounds")
+		l.push(newNode(&ast.ErrorNode{Message: "Stack error (slice bounds)"}, colon.GetSymbol(), types.KindUnknown))
+		return
+	}
+	slice := &ast.SliceNode{}
+	for i, raw := range popped {
+		bound, isExpr := raw.(ast.Expression)
+		if !isExpr {
+			l.addError(ctx, "Slice bound is not an ast.Expression (type %T)", raw)
+			l.push(newNode(&ast.ErrorNode{Message: "Type error (slice bound)"}, colon.GetSymbol(), types.KindUnknown))
+			return
+		}
+		if bounds[i].GetStart().GetTokenIndex() < colon.GetSymbol().GetTokenIndex() {
+			slice.Start = bound
+		} else {
+			slice.End = bound
+		}
+	}
+	l.push(slice)
+}
+
 // buildCallTargetFromContext constructs a ast.CallTarget AST node from an ICall_targetContext.
 func (l *neuroScriptListenerImpl) buildCallTargetFromContext(ctx gen.ICall_targetContext) *ast.CallTarget {
 	l.logDebugAST("      -> buildCallTargetFromContext: %s", ctx.GetText())
@@ -407,14 +462,40 @@ func (l *neuroScriptListenerImpl) ExitCall_target(ctx *gen.Call_targetContext) {
 	l.logDebugAST("      Pushed *ast.CallTarget to stack: IsTool=%t, Name=%s", targetNode.IsTool, targetNode.Name)
 }
 
+// ExitArgument wraps a 'name: value' argument in a NamedArgNode. Positional
+// arguments are left on the stack as plain expressions. The name is the
+// argument's first token, which may be an identifier or a keyword.
+func (l *neuroScriptListenerImpl) ExitArgument(ctx *gen.ArgumentContext) {
+	if ctx.COLON() == nil {
+		return
+	}
+	nameTok := ctx.GetStart()
+	valRaw, ok := l.pop()
+	if !ok {
+		l.addError(ctx, "stack underflow in named argument '%s'", nameTok.GetText())
+		l.push(newNode(&ast.ErrorNode{Message: "Stack error (named arg)"}, ctx.GetStart(), types.KindUnknown))
+		return
+	}
+	valueExpr, ok := valRaw.(ast.Expression)
+	if !ok {
+		l.addError(ctx, "named argument '%s' value is not an ast.Expression (type %T)", nameTok.GetText(), valRaw)
+		l.push(newNode(&ast.ErrorNode{Message: "Type error (named arg)"}, ctx.GetStart(), types.KindUnknown))
+		return
+	}
+	named := &ast.NamedArgNode{Name: nameTok.GetText(), Value: valueExpr}
+	l.push(newNode(named, nameTok, types.KindNamedArg))
+}
+
 // ExitCallable_expr
 func (l *neuroScriptListenerImpl) ExitCallable_expr(ctx *gen.Callable_exprContext) {
 	l.logDebugAST("--- Exit Callable_expr: %q", ctx.GetText())
 
 	var args []ast.Expression
-	if exprListOptCtx := ctx.Expression_list_opt(); exprListOptCtx != nil {
-		if exprListCtx := exprListOptCtx.Expression_list(); exprListCtx != nil {
-			numArgs := len(exprListCtx.AllExpression())
+	var namedArgs []*ast.NamedArgNode
+	if argListOptCtx := ctx.Argument_list_opt(); argListOptCtx != nil {
+		if argListCtx := argListOptCtx.Argument_list(); argListCtx != nil {
+			argCtxs := argListCtx.AllArgument()
+			numArgs := len(argCtxs)
 			if numArgs > 0 {
 				argsRaw, ok := l.popN(numArgs)
 				if !ok {
@@ -422,15 +503,26 @@ func (l *neuroScriptListenerImpl) ExitCallable_expr(ctx *gen.Callable_exprContex
 					l.push(newNode(&ast.ErrorNode{Message: "Stack error (call args)"}, ctx.GetStart(), types.KindUnknown))
 					return
 				}
-				args = make([]ast.Expression, numArgs)
+				seen := make(map[string]bool)
 				for i := 0; i < numArgs; i++ {
+					if named, isNamed := argsRaw[i].(*ast.NamedArgNode); isNamed {
+						if seen[named.Name] {
+							l.addError(argCtxs[i], "duplicate named argument '%s' in call %q", named.Name, ctx.GetText())
+						}
+						seen[named.Name] = true
+						namedArgs = append(namedArgs, named)
+						continue
+					}
 					argExpr, isExpr := argsRaw[i].(ast.Expression)
 					if !isExpr {
 						l.addError(ctx, "Argument %d for call %q is not an ast.Expression (type %T)", i+1, ctx.GetText(), argsRaw[i])
 						l.push(newNode(&ast.ErrorNode{Message: "Type error (call arg)"}, ctx.GetStart(), types.KindUnknown))
 						return
 					}
-					args[i] = argExpr
+					if len(namedArgs) > 0 {
+						l.addError(argCtxs[i], "positional argument %d follows a named argument in call %q", i+1, ctx.GetText())
+					}
+					args = append(args, argExpr)
 				}
 			}
 		}
@@ -497,6 +589,7 @@ func (l *neuroScriptListenerImpl) ExitCallable_expr(ctx *gen.Callable_exprContex
 	node := &ast.CallableExprNode{
 		Target:    finalTargetNode,
 		Arguments: args,
+		NamedArgs: namedArgs,
 	}
 	l.push(newNode(node, token, types.KindCallableExpr))
 	l.logDebugAST("      Constructed and Pushed ast.CallableExprNode: Target=%s, Args=%d", node.Target.Name, len(node.Arguments))
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/types"
 )
 
 func TestOperatorPrecedence(t *testing.T) {
@@ -31,3 +32,44 @@ func TestOperatorPrecedence(t *testing.T) {
 		t.Errorf("Expected RHS operator to be '*', got '%s'", rhs.Operator)
 	}
 }
+
+func TestAccessorChain_MemberAndSlices(t *testing.T) {
+	cases := map[string]string{
+		`a.b.c`:       `a.b.c`,
+		`a.b[0].c`:    `a.b[0].c`,
+		`xs[1:3]`:     `xs[1:3]`,
+		`s[:5]`:       `s[:5]`,
+		`s[2:]`:       `s[2:]`,
+		`s[:]`:        `s[:]`,
+		`xs[-1]`:      `xs[(- 1)]`,
+		`xs[-2:][0]`:  `xs[(- 2):][0]`,
+		`m["k"].v[1]`: `m["k"].v[1]`,
+	}
+	for src, want := range cases {
+		expr := parseExpression(t, src)
+		if got := expr.TestString(); got != want {
+			t.Errorf("%s: got %s, want %s", src, got, want)
+		}
+	}
+}
+
+func TestAccessorChain_NodeShapes(t *testing.T) {
+	expr := parseExpression(t, "cfg.items[1:]")
+	slice, ok := expr.(*ast.SliceNode)
+	if !ok {
+		t.Fatalf("expected a SliceNode, got %T", expr)
+	}
+	if slice.End != nil || slice.Start == nil {
+		t.Errorf("expected only a start bound, got start=%v end=%v", slice.Start, slice.End)
+	}
+	if slice.Kind() != types.KindSlice {
+		t.Errorf("expected KindSlice, got %v", slice.Kind())
+	}
+	member, ok := slice.Collection.(*ast.ElementAccessNode)
+	if !ok || member.Type != ast.DotAccess || member.Kind() != types.KindMemberAccess {
+		t.Fatalf("expected a member access collection, got %#v", slice.Collection)
+	}
+	if key, ok := member.Accessor.(*ast.StringLiteralNode); !ok || key.Value != "items" {
+		t.Errorf("expected field 'items', got %#v", member.Accessor)
+	}
+}
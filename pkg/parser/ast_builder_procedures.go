@@ -1,13 +1,17 @@
 // NeuroScript Version: 0.7.2
-// File version: 22
-// Purpose: Enforces that all slice/map fields on a Procedure are initialized to non-nil, empty collections for canonical consistency.
+// File version: 23
+// Purpose: Builds 'optional' parameters with constant default values ('optional x = 1').
 // filename: pkg/parser/ast_builder_procedures.go
 package parser
 
 import (
+	"fmt"
+	"math"
+
 	"github.com/antlr4-go/antlr/v4"
 	gen "github.com/aprice2704/neuroscript/pkg/antlr/generated"
 	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/lang"
 	"github.com/aprice2704/neuroscript/pkg/types"
 )
 
@@ -97,31 +101,111 @@ func (l *neuroScriptListenerImpl) ExitNeeds_clause(ctx *gen.Needs_clauseContext)
 	l.currentProc.RequiredParams = append(l.currentProc.RequiredParams, params...)
 }
 
+// ExitOptional_param builds a ParamSpec. A default, if given, must be a
+// constant and is folded to its value here so calls never re-evaluate it.
+func (l *neuroScriptListenerImpl) ExitOptional_param(ctx *gen.Optional_paramContext) {
+	identNode := ctx.IDENTIFIER()
+	param := &ast.ParamSpec{Name: identNode.GetText()}
+	newNode(param, identNode.GetSymbol(), types.KindVariable)
+
+	if ctx.ASSIGN() != nil {
+		valRaw, ok := l.pop()
+		if !ok {
+			l.addError(ctx, "stack underflow reading default for optional parameter '%s'", param.Name)
+		} else if expr, isExpr := valRaw.(ast.Expression); !isExpr {
+			l.addError(ctx, "default for optional parameter '%s' is not an ast.Expression (type %T)", param.Name, valRaw)
+		} else if val, err := constantValue(expr); err != nil {
+			l.addError(ctx, "default for optional parameter '%s' must be a constant: %v", param.Name, err)
+		} else {
+			param.Default = val
+		}
+	}
+	l.push(param)
+}
+
 func (l *neuroScriptListenerImpl) ExitOptional_clause(ctx *gen.Optional_clauseContext) {
 	if l.currentProc == nil {
 		l.addError(ctx, "found 'optional' clause outside of a procedure definition")
 		return
 	}
-	val, ok := l.pop()
+	numParams := len(ctx.Optional_param_list().AllOptional_param())
+	vals, ok := l.popN(numParams)
 	if !ok {
 		l.addError(ctx, "stack underflow reading params for 'optional' clause")
 		return
 	}
-	if _, ok := val.([]string); !ok {
-		l.addError(ctx, "internal error: 'optional' clause expected []string from stack, got %T", val)
-		return
+	for _, val := range vals {
+		param, ok := val.(*ast.ParamSpec)
+		if !ok {
+			l.addError(ctx, "internal error: 'optional' clause expected *ast.ParamSpec from stack, got %T", val)
+			return
+		}
+		l.currentProc.OptionalParams = append(l.currentProc.OptionalParams, param)
 	}
+}
 
-	paramListCtx := ctx.Param_list()
-	if paramListCtx == nil {
-		return
+// constantValue folds a literal expression to its value. Literals, negated
+// numbers, and lists and maps built only from those are constant.
+func constantValue(expr ast.Expression) (lang.Value, error) {
+	switch n := expr.(type) {
+	case *ast.StringLiteralNode:
+		return lang.StringValue{Value: n.Value}, nil
+	case *ast.NumberLiteralNode:
+		return numberLiteralValue(n.Value)
+	case *ast.BooleanLiteralNode:
+		return lang.BoolValue{Value: n.Value}, nil
+	case *ast.NilLiteralNode:
+		return lang.NilValue{}, nil
+	case *ast.UnaryOpNode:
+		num, isNum := n.Operand.(*ast.NumberLiteralNode)
+		if n.Operator != "-" || !isNum {
+			break
+		}
+		switch v := num.Value.(type) {
+		case int64:
+			if v == math.MinInt64 {
+				return nil, fmt.Errorf("integer overflow negating %d", v)
+			}
+			return lang.IntValue{Value: -v}, nil
+		case float64:
+			return lang.NumberValue{Value: -v}, nil
+		}
+	case *ast.ListLiteralNode:
+		items := make([]lang.Value, len(n.Elements))
+		for i, elem := range n.Elements {
+			val, err := constantValue(elem)
+			if err != nil {
+				return nil, err
+			}
+			items[i] = val
+		}
+		return lang.ListValue{Value: items}, nil
+	case *ast.MapLiteralNode:
+		entries := make(map[string]lang.Value, len(n.Entries))
+		for _, entry := range n.Entries {
+			key, isStr := entry.Key.(*ast.StringLiteralNode)
+			if !isStr {
+				return nil, fmt.Errorf("map key must be a string literal, got %T", entry.Key)
+			}
+			val, err := constantValue(entry.Value)
+			if err != nil {
+				return nil, err
+			}
+			entries[key.Value] = val
+		}
+		return lang.MapValue{Value: entries}, nil
 	}
+	return nil, fmt.Errorf("%T is not a constant expression", expr)
+}
 
-	for _, identNode := range paramListCtx.AllIDENTIFIER() {
-		param := &ast.ParamSpec{Name: identNode.GetText()}
-		newNode(param, identNode.GetSymbol(), types.KindVariable)
-		l.currentProc.OptionalParams = append(l.currentProc.OptionalParams, param)
+func numberLiteralValue(v interface{}) (lang.Value, error) {
+	switch num := v.(type) {
+	case int64:
+		return lang.IntValue{Value: num}, nil
+	case float64:
+		return lang.NumberValue{Value: num}, nil
 	}
+	return nil, fmt.Errorf("number literal holds %T", v)
 }
 
 func (l *neuroScriptListenerImpl) ExitReturns_clause(ctx *gen.Returns_clauseContext) {
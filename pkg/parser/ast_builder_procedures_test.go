@@ -1,8 +1,8 @@
 // filename: pkg/parser/ast_builder_procedures_test.go
 // NeuroScript Version: 0.5.2
-// File version: 1
-// Purpose: Provides test coverage for procedure definitions and signatures.
-// nlines: 75
+// File version: 2
+// Purpose: Provides test coverage for procedure definitions and signatures, including optional parameter defaults.
+// nlines: 124
 // risk_rating: LOW
 
 package parser
@@ -10,6 +10,8 @@ package parser
 import (
 	"reflect"
 	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/lang"
 )
 
 func TestProcedureDefinitionParsing(t *testing.T) {
@@ -75,4 +77,43 @@ func TestProcedureDefinitionParsing(t *testing.T) {
 		`
 		testForBuilderError(t, script, "duplicate procedure definition: 'MyProc'")
 	})
+
+	t.Run("Optional params with constant defaults", func(t *testing.T) {
+		script := `
+			func Defaults(needs a optional b = 2, c, d = -1.5, e = ["x", {"k": true}]) means
+				emit a
+			endfunc
+		`
+		prog := testParseAndBuild(t, script)
+		proc := prog.Procedures["Defaults"]
+		if proc == nil {
+			t.Fatal("Procedure 'Defaults' not found")
+		}
+		want := []lang.Value{
+			lang.IntValue{Value: 2},
+			nil,
+			lang.NumberValue{Value: -1.5},
+			lang.ListValue{Value: []lang.Value{
+				lang.StringValue{Value: "x"},
+				lang.MapValue{Value: map[string]lang.Value{"k": lang.BoolValue{Value: true}}},
+			}},
+		}
+		if len(proc.OptionalParams) != len(want) {
+			t.Fatalf("Expected %d optional params, got %d", len(want), len(proc.OptionalParams))
+		}
+		for i, param := range proc.OptionalParams {
+			if !reflect.DeepEqual(param.Default, want[i]) {
+				t.Errorf("param %q: expected default %#v, got %#v", param.Name, want[i], param.Default)
+			}
+		}
+	})
+
+	t.Run("Non-constant default is a builder error", func(t *testing.T) {
+		script := `
+			func BadDefault(optional b = x + 1) means
+				emit b
+			endfunc
+		`
+		testForBuilderError(t, script, "default for optional parameter 'b' must be a constant")
+	})
 }
@@ -0,0 +1,59 @@
+// filename: pkg/parser/ast_builder_raise_test.go
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Tests AST construction for 'raise event' in procedures, handlers and command blocks.
+
+package parser
+
+import (
+	"testing"
+)
+
+func TestRaiseStatement_Values(t *testing.T) {
+	script := `
+func TestProc() means
+	raise event "orders.created" with {"id": 1}
+	raise event "orders." + kind
+endfunc
+`
+	body := parseStringToProcedureBodyNodes(t, script, "TestProc")
+	if len(body) != 2 {
+		t.Fatalf("expected 2 steps, got %d", len(body))
+	}
+	for i, want := range []int{2, 1} {
+		if body[i].Type != "raise" || len(body[i].Values) != want {
+			t.Errorf("step %d: expected a raise step with %d values, got %+v", i, want, body[i])
+		}
+	}
+	if got := body[0].Values[0].String(); got != `"orders.created"` {
+		t.Errorf("expected the event name first, got %s", got)
+	}
+}
+
+func TestRaiseStatement_InHandlersAndCommands(t *testing.T) {
+	prog := testParseAndBuild(t, `
+on event "a" do
+	raise event "b"
+endon
+`)
+	if len(prog.Events) != 1 || len(prog.Events[0].Body) != 1 || prog.Events[0].Body[0].Type != "raise" {
+		t.Errorf("expected the handler body to be a single raise step, got %+v", prog.Events)
+	}
+
+	prog = testParseAndBuild(t, `
+command
+	raise event "a" with {}
+endcommand
+`)
+	if len(prog.Commands) != 1 || len(prog.Commands[0].Body) != 1 || prog.Commands[0].Body[0].Type != "raise" {
+		t.Errorf("expected the command body to be a single raise step, got %+v", prog.Commands)
+	}
+}
+
+func TestRaiseStatement_NeedsEventKeyword(t *testing.T) {
+	testForParserError(t, `
+func TestProc() means
+	raise "orders.created"
+endfunc
+`)
+}
@@ -1,6 +1,6 @@
 // NeuroScript Version: 0.8.0
-// File version: 31
-// Purpose: Corrected a bug where converting an LValueNode to a VariableNode in a return statement copied the wrong NodeKind.
+// File version: 32
+// Purpose: Corrected a bug where converting an LValueNode to a VariableNode in a return statement copied the wrong NodeKind. Builds 'raise event' steps.
 // filename: pkg/parser/ast_builder_statements.go
 // nlines: 250+
 // risk_rating: HIGH
@@ -204,6 +204,36 @@ func (l *neuroScriptListenerImpl) ExitFail_statement(c *gen.Fail_statementContex
 	l.addStep(step)
 }
 
+// ExitRaise_statement builds a "raise" step: raise event <name_expr> [with <payload_expr>].
+// Values holds the name followed by the payload, if any.
+func (l *neuroScriptListenerImpl) ExitRaise_statement(c *gen.Raise_statementContext) {
+	n := len(c.AllExpression())
+	popped, ok := l.popN(n)
+	if !ok {
+		l.addError(c, "internal error in raise_statement: could not pop values")
+		return
+	}
+	values := make([]ast.Expression, n)
+	for idx, val := range popped {
+		expr, ok := val.(ast.Expression)
+		if !ok {
+			l.addError(c, "internal error in raise_statement: value is not an ast.Expression, but %T", val)
+			return
+		}
+		values[idx] = expr
+	}
+
+	pos := tokenToPosition(c.GetStart())
+	step := ast.Step{
+		BaseNode: ast.BaseNode{StartPos: &pos, NodeKind: types.KindStep},
+		Type:     "raise",
+		Values:   values,
+	}
+	step.Comments = l.associateCommentsToNode(&step)
+	SetEndPos(&step, c.GetStop())
+	l.addStep(step)
+}
+
 // ExitAsk_stmt handles the new AI 'ask' statement: ask <agent_model_expr>, <prompt_expr> [with <options>] [into <lvalue>]
 func (l *neuroScriptListenerImpl) ExitAsk_stmt(c *gen.Ask_stmtContext) {
 	l.logDebugAST("ExitAsk_stmt: Building AI ask step.")
@@ -0,0 +1,119 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Builds SwitchStmt steps from 'switch' / 'case' / 'default' / 'endswitch' blocks.
+// filename: pkg/parser/ast_builder_switch.go
+// nlines: 119
+// risk_rating: MEDIUM
+
+package parser
+
+import (
+	gen "github.com/aprice2704/neuroscript/pkg/antlr/generated"
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/types"
+)
+
+// ExitSwitch_case pops the case body and its match values and pushes a *ast.SwitchCase
+// for the enclosing switch statement to collect.
+func (l *neuroScriptListenerImpl) ExitSwitch_case(c *gen.Switch_caseContext) {
+	val, ok := l.pop()
+	if !ok {
+		l.addError(c, "internal error in switch_case: could not pop case body")
+		return
+	}
+	body, ok := val.([]ast.Step)
+	if !ok {
+		l.addError(c, "internal error in switch_case: case body is not a []ast.Step, but %T", val)
+		return
+	}
+
+	kind := ast.CaseValues
+	numValues := 1
+	switch {
+	case c.KW_IN() != nil:
+		kind = ast.CaseIn
+	case c.KW_TYPEOF() != nil:
+		kind = ast.CaseTypeOf
+		numValues = len(c.Expression_list().AllExpression())
+	default:
+		numValues = len(c.Expression_list().AllExpression())
+	}
+
+	popped, ok := l.popN(numValues)
+	if !ok {
+		l.addError(c, "internal error in switch_case: could not pop case values")
+		return
+	}
+	values := make([]ast.Expression, 0, numValues)
+	for _, v := range popped {
+		expr, ok := v.(ast.Expression)
+		if !ok {
+			l.addError(c, "internal error in switch_case: case value is not an ast.Expression, but %T", v)
+			return
+		}
+		values = append(values, expr)
+	}
+
+	node := newNode(&ast.SwitchCase{CaseKind: kind, Values: values, Body: body}, c.KW_CASE().GetSymbol(), types.KindSwitchCase)
+	SetEndPos(node, c.GetStop())
+	l.push(node)
+}
+
+// ExitSwitch_statement assembles the subject, cases and optional default branch
+// into a 'switch' step.
+func (l *neuroScriptListenerImpl) ExitSwitch_statement(c *gen.Switch_statementContext) {
+	var defaultBody []ast.Step
+	if c.KW_DEFAULT() != nil {
+		val, ok := l.pop()
+		if !ok {
+			l.addError(c, "internal error in switch_statement: could not pop default body")
+			return
+		}
+		defaultBody, ok = val.([]ast.Step)
+		if !ok {
+			l.addError(c, "internal error in switch_statement: default body is not a []ast.Step, but %T", val)
+			return
+		}
+	}
+
+	numCases := len(c.AllSwitch_case())
+	cases := make([]*ast.SwitchCase, 0, numCases)
+	if numCases > 0 {
+		popped, ok := l.popN(numCases)
+		if !ok {
+			l.addError(c, "internal error in switch_statement: could not pop cases")
+			return
+		}
+		for _, v := range popped {
+			sc, ok := v.(*ast.SwitchCase)
+			if !ok {
+				l.addError(c, "internal error in switch_statement: case is not a *ast.SwitchCase, but %T", v)
+				return
+			}
+			cases = append(cases, sc)
+		}
+	}
+
+	subjVal, ok := l.pop()
+	if !ok {
+		l.addError(c, "internal error in switch_statement: could not pop subject")
+		return
+	}
+	subject, ok := subjVal.(ast.Expression)
+	if !ok {
+		l.addError(c, "internal error in switch_statement: subject is not an ast.Expression, but %T", subjVal)
+		return
+	}
+
+	sw := newNode(&ast.SwitchStmt{Subject: subject, Cases: cases, Default: defaultBody}, c.KW_SWITCH().GetSymbol(), types.KindSwitchStmt)
+	SetEndPos(sw, c.KW_ENDSWITCH().GetSymbol())
+
+	pos := tokenToPosition(c.GetStart())
+	step := ast.Step{
+		BaseNode:   ast.BaseNode{StartPos: &pos, NodeKind: types.KindStep},
+		Type:       "switch",
+		SwitchStmt: sw,
+	}
+	SetEndPos(&step, c.KW_ENDSWITCH().GetSymbol())
+	l.addStep(step)
+}
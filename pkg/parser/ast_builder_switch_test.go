@@ -0,0 +1,139 @@
+// filename: pkg/parser/ast_builder_switch_test.go
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Tests AST construction for 'else if' chains and switch statements.
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/ast"
+)
+
+func TestIfThenElse_ElseIfChain(t *testing.T) {
+	script := `
+func TestProc() means
+	if x == 1
+		emit "one"
+	else if x == 2
+		emit "two"
+	else if x == 3
+		emit "three"
+	else
+		emit "other"
+	endif
+endfunc
+`
+	ifStep := getIfStepFromTestProc(t, script)
+	expectEmitStepWithString(t, ifStep.Body, 0, "one", "Body")
+
+	want := []string{"two", "three"}
+	cur := ifStep
+	for i, w := range want {
+		if len(cur.ElseBody) != 1 || cur.ElseBody[0].Type != "if" {
+			t.Fatalf("link %d: expected ElseBody to hold a single nested if, got %+v", i, cur.ElseBody)
+		}
+		cur = &cur.ElseBody[0]
+		expectEmitStepWithString(t, cur.Body, 0, w, "Body")
+	}
+	expectEmitStepWithString(t, cur.ElseBody, 0, "other", "ElseBody")
+}
+
+func TestIfThenElse_ElseIfWithoutElse(t *testing.T) {
+	script := `
+func TestProc() means
+	if x == 1
+		emit "one"
+	else if x == 2
+		emit "two"
+	endif
+endfunc
+`
+	ifStep := getIfStepFromTestProc(t, script)
+	if len(ifStep.ElseBody) != 1 {
+		t.Fatalf("expected 1 else step, got %d", len(ifStep.ElseBody))
+	}
+	nested := ifStep.ElseBody[0]
+	if nested.ElseBody == nil || len(nested.ElseBody) != 0 {
+		t.Errorf("expected nested if to have an empty, non-nil ElseBody, got %#v", nested.ElseBody)
+	}
+}
+
+func TestSwitchStatement_AllCaseKinds(t *testing.T) {
+	script := `
+func TestProc() means
+	switch ev["kind"]
+		case "start", "resume"
+			emit "go"
+		case in ["stop", "halt"]
+			emit "stop"
+		case typeof "number"
+			emit "num"
+		default
+			emit "unknown"
+	endswitch
+endfunc
+`
+	body := parseStringToProcedureBodyNodes(t, script, "TestProc")
+	if len(body) != 1 || body[0].Type != "switch" {
+		t.Fatalf("expected a single switch step, got %+v", body)
+	}
+	sw := body[0].SwitchStmt
+	if sw == nil || sw.Subject == nil {
+		t.Fatalf("switch step is missing its SwitchStmt or subject")
+	}
+	if len(sw.Cases) != 3 {
+		t.Fatalf("expected 3 cases, got %d", len(sw.Cases))
+	}
+
+	tests := []struct {
+		kind    ast.SwitchCaseKind
+		nValues int
+		emitted string
+	}{
+		{ast.CaseValues, 2, "go"},
+		{ast.CaseIn, 1, "stop"},
+		{ast.CaseTypeOf, 1, "num"},
+	}
+	for i, tt := range tests {
+		c := sw.Cases[i]
+		if c.CaseKind != tt.kind {
+			t.Errorf("case %d: expected kind %d, got %d", i, tt.kind, c.CaseKind)
+		}
+		if len(c.Values) != tt.nValues {
+			t.Errorf("case %d: expected %d values, got %d", i, tt.nValues, len(c.Values))
+		}
+		expectEmitStepWithString(t, c.Body, 0, tt.emitted, "Case")
+	}
+	expectEmitStepWithString(t, sw.Default, 0, "unknown", "Default")
+}
+
+func TestSwitchStatement_NoDefault(t *testing.T) {
+	script := `
+func TestProc() means
+	switch x
+		case 1
+			emit "one"
+	endswitch
+	emit "after"
+endfunc
+`
+	body := parseStringToProcedureBodyNodes(t, script, "TestProc")
+	if len(body) != 2 || body[0].Type != "switch" {
+		t.Fatalf("expected switch followed by emit, got %+v", body)
+	}
+	if body[0].SwitchStmt.Default != nil {
+		t.Errorf("expected nil Default, got %v", body[0].SwitchStmt.Default)
+	}
+}
+
+func TestSwitchStatement_CaseWithoutBodyIsError(t *testing.T) {
+	testForParserError(t, `
+func TestProc() means
+	switch x
+		case 1
+	endswitch
+endfunc
+`)
+}
@@ -1,7 +1,7 @@
 // filename: pkg/parser/ast_builder_terminators.go
 // NeuroScript Version: 0.5.2
-// File version: 2
-// Purpose: Refactored primary expression node creation to use the newNode helper.
+// File version: 3
+// Purpose: Function literals pass through ExitPrimary like calls and literals.
 package parser
 
 import (
@@ -25,9 +25,9 @@ func (l *neuroScriptListenerImpl) ExitExpression(ctx *gen.ExpressionContext) {
 // ExitPrimary handles the base cases of expressions.
 func (l *neuroScriptListenerImpl) ExitPrimary(ctx *gen.PrimaryContext) {
 	l.logDebugAST("--- Exit Primary: %q", ctx.GetText())
-	if ctx.Literal() != nil || ctx.Placeholder() != nil || ctx.Callable_expr() != nil || ctx.LPAREN() != nil {
+	if ctx.Literal() != nil || ctx.Placeholder() != nil || ctx.Callable_expr() != nil || ctx.LPAREN() != nil || ctx.Lambda_expr() != nil {
 		// Value already pushed by the corresponding child Exit* method.
-		l.logDebugAST("    Primary is Literal, Placeholder, Call, or Parenthesized (Pass through)")
+		l.logDebugAST("    Primary is Literal, Placeholder, Call, Parenthesized or Function literal (Pass through)")
 		return
 	}
 
@@ -0,0 +1,77 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Builds TryStmt steps from 'try' / 'catch' / 'finally' / 'endtry' blocks.
+// filename: pkg/parser/ast_builder_try.go
+// nlines: 77
+// risk_rating: MEDIUM
+
+package parser
+
+import (
+	gen "github.com/aprice2704/neuroscript/pkg/antlr/generated"
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/types"
+)
+
+// ExitTry_statement pops the try body and the optional catch and finally
+// bodies and assembles them into a 'try' step. The grammar makes both
+// clauses optional; a try with neither is rejected here.
+func (l *neuroScriptListenerImpl) ExitTry_statement(c *gen.Try_statementContext) {
+	hasCatch := c.KW_CATCH() != nil
+	hasFinally := c.KW_FINALLY() != nil
+	if !hasCatch && !hasFinally {
+		l.addError(c, "'try' block needs a 'catch' or a 'finally' clause")
+	}
+
+	numBodies := len(c.AllNon_empty_statement_list())
+	want := 1
+	if hasCatch {
+		want++
+	}
+	if hasFinally {
+		want++
+	}
+	if numBodies != want {
+		l.addError(c, "internal error in try_statement: expected %d block bodies, got %d", want, numBodies)
+		return
+	}
+	popped, ok := l.popN(numBodies)
+	if !ok {
+		l.addError(c, "internal error in try_statement: could not pop block bodies")
+		return
+	}
+	bodies := make([][]ast.Step, 0, numBodies)
+	for _, v := range popped {
+		body, ok := v.([]ast.Step)
+		if !ok {
+			l.addError(c, "internal error in try_statement: block body is not a []ast.Step, but %T", v)
+			return
+		}
+		bodies = append(bodies, body)
+	}
+
+	try := &ast.TryStmt{Body: bodies[0]}
+	next := 1
+	if hasCatch {
+		if id := c.IDENTIFIER(); id != nil {
+			try.CatchVar = id.GetText()
+		}
+		try.Catch = bodies[next]
+		next++
+	}
+	if hasFinally {
+		try.Finally = bodies[next]
+	}
+
+	newNode(try, c.KW_TRY().GetSymbol(), types.KindTryStmt)
+	SetEndPos(try, c.KW_ENDTRY().GetSymbol())
+
+	pos := tokenToPosition(c.GetStart())
+	step := ast.Step{
+		BaseNode: ast.BaseNode{StartPos: &pos, NodeKind: types.KindStep},
+		Type:     "try",
+		TryStmt:  try,
+	}
+	SetEndPos(&step, c.KW_ENDTRY().GetSymbol())
+	l.addStep(step)
+}
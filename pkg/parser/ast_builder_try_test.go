@@ -0,0 +1,77 @@
+// filename: pkg/parser/ast_builder_try_test.go
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Tests AST construction for try/catch/finally blocks.
+
+package parser
+
+import (
+	"testing"
+)
+
+func TestTryStatement_CatchAndFinally(t *testing.T) {
+	script := `
+func TestProc() means
+	try
+		emit "body"
+	catch err
+		emit "caught"
+	finally
+		emit "cleanup"
+	endtry
+endfunc
+`
+	body := parseStringToProcedureBodyNodes(t, script, "TestProc")
+	if len(body) != 1 || body[0].Type != "try" {
+		t.Fatalf("expected a single try step, got %+v", body)
+	}
+	try := body[0].TryStmt
+	if try == nil {
+		t.Fatal("try step is missing its TryStmt")
+	}
+	if try.CatchVar != "err" {
+		t.Errorf("expected catch variable 'err', got %q", try.CatchVar)
+	}
+	expectEmitStepWithString(t, try.Body, 0, "body", "Body")
+	expectEmitStepWithString(t, try.Catch, 0, "caught", "Catch")
+	expectEmitStepWithString(t, try.Finally, 0, "cleanup", "Finally")
+}
+
+func TestTryStatement_OptionalClauses(t *testing.T) {
+	script := `
+func TestProc() means
+	try
+		emit "a"
+	catch
+		emit "b"
+	endtry
+	try
+		emit "c"
+	finally
+		emit "d"
+	endtry
+endfunc
+`
+	body := parseStringToProcedureBodyNodes(t, script, "TestProc")
+	if len(body) != 2 {
+		t.Fatalf("expected 2 try steps, got %d", len(body))
+	}
+	first, second := body[0].TryStmt, body[1].TryStmt
+	if first.CatchVar != "" || first.Catch == nil || first.Finally != nil {
+		t.Errorf("unexpected shape for catch-only try: %+v", first)
+	}
+	if second.Catch != nil || second.Finally == nil {
+		t.Errorf("unexpected shape for finally-only try: %+v", second)
+	}
+	expectEmitStepWithString(t, second.Finally, 0, "d", "Finally")
+}
+
+func TestTryStatement_NeedsCatchOrFinally(t *testing.T) {
+	testForBuilderError(t, `
+func TestProc() means
+	try
+		emit "a"
+	endtry
+endfunc
+`, "needs a 'catch' or a 'finally' clause")
+}
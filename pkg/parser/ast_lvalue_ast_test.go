@@ -28,10 +28,10 @@ endfunc`
 		Identifier: "a",
 		Accessors: []*ast.AccessorNode{
 			{Type: ast.DotAccess, Key: &ast.StringLiteralNode{Value: "b"}},
-			{Type: ast.BracketAccess, Key: &ast.NumberLiteralNode{Value: float64(0)}},
+			{Type: ast.BracketAccess, Key: &ast.NumberLiteralNode{Value: int64(0)}},
 			{Type: ast.BracketAccess, Key: &ast.StringLiteralNode{Value: "c"}},
 			{Type: ast.DotAccess, Key: &ast.StringLiteralNode{Value: "d"}},
-			{Type: ast.BracketAccess, Key: &ast.NumberLiteralNode{Value: float64(1)}},
+			{Type: ast.BracketAccess, Key: &ast.NumberLiteralNode{Value: int64(1)}},
 		},
 	}
 
@@ -3,6 +3,8 @@ package parser
 
 import (
 	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/ast"
 )
 
 func TestCallStatement(t *testing.T) {
@@ -25,3 +27,59 @@ func TestCallStatement(t *testing.T) {
 		testForParserError(t, script)
 	})
 }
+
+func TestNamedCallArguments(t *testing.T) {
+	t.Run("Positional then named", func(t *testing.T) {
+		expr := parseExpression(t, `tool.fs.read("a.txt", encoding: "utf8", limit: 10)`)
+		call, ok := expr.(*ast.CallableExprNode)
+		if !ok {
+			t.Fatalf("Expected *ast.CallableExprNode, got %T", expr)
+		}
+		if len(call.Arguments) != 1 {
+			t.Fatalf("Expected 1 positional argument, got %d", len(call.Arguments))
+		}
+		if len(call.NamedArgs) != 2 {
+			t.Fatalf("Expected 2 named arguments, got %d", len(call.NamedArgs))
+		}
+		if call.NamedArgs[0].Name != "encoding" || call.NamedArgs[1].Name != "limit" {
+			t.Errorf("Unexpected named argument order: %s, %s", call.NamedArgs[0].Name, call.NamedArgs[1].Name)
+		}
+		if got := call.String(); got != `tool.fs.read("a.txt", encoding: "utf8", limit: 10)` {
+			t.Errorf("Unexpected String(): %s", got)
+		}
+	})
+
+	t.Run("Keywords as names", func(t *testing.T) {
+		expr := parseExpression(t, `tool.list.Get([1, 2], 5, default: 9)`)
+		call, ok := expr.(*ast.CallableExprNode)
+		if !ok {
+			t.Fatalf("Expected *ast.CallableExprNode, got %T", expr)
+		}
+		if len(call.Arguments) != 2 || len(call.NamedArgs) != 1 || call.NamedArgs[0].Name != "default" {
+			t.Fatalf("Expected 2 positional and a 'default' named argument, got %s", call.String())
+		}
+		expr = parseExpression(t, `tool.timer.Schedule(event: "tick", every: "1m", if: true)`)
+		call, _ = expr.(*ast.CallableExprNode)
+		if call == nil || len(call.NamedArgs) != 3 || call.NamedArgs[0].Name != "event" || call.NamedArgs[2].Name != "if" {
+			t.Errorf("Unexpected keyword-named arguments: %v", expr)
+		}
+	})
+
+	t.Run("Positional after named is a builder error", func(t *testing.T) {
+		script := `
+			func MyFunc() means
+				call greet(name: "x", 1)
+			endfunc
+		`
+		testForBuilderError(t, script, "positional argument 2 follows a named argument")
+	})
+
+	t.Run("Duplicate name is a builder error", func(t *testing.T) {
+		script := `
+			func MyFunc() means
+				call greet(name: "x", name: "y")
+			endfunc
+		`
+		testForBuilderError(t, script, "duplicate named argument 'name'")
+	})
+}
@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.7.2
-// File version: 9
+// File version: 11
 // Purpose: Re-instates EquateEmpty workaround to fix round-trip test failure. The AST builder and canon package are not yet consistent on nil vs. empty slices.
 // filename: pkg/parser/canon_roundtrip_test.go
-// nlines: 73
+// nlines: 99
 // risk_rating: HIGH
 
 package parser_test
@@ -36,9 +36,24 @@ func main(returns result) means
 		endif
 	endfor
 	call tool.Log(total)
+	set scaled = scale(total, factor: 3)
+	set double = func(x) means x * 2
+	set pick = func(a, b) means
+		if a > b
+			return a
+		endif
+		on error do
+			clear_error
+		endon
+		return b
+	endfunc
 	return total
 endfunc
 
+func scale(needs n optional factor = 2, label = "x" returns r) means
+	return n * factor
+endfunc
+
 on event "system.startup" do
 	emit "System is starting up!"
 endon
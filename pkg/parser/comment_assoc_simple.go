@@ -1,8 +1,8 @@
 // filename: pkg/parser/comment_assoc_simple.go
 // NeuroScript Version: 0.8.0
-// File version: 116
+// File version: 118
 // Purpose: A new, robust comment attachment algorithm that assigns floating comments to the *next* node they precede.
-// nlines: 115
+// nlines: 146
 
 package parser
 
@@ -81,6 +81,23 @@ func AttachCommentsSimple(program *ast.Program, ts antlr.TokenStream) {
 			for i := range v.ElseBody {
 				walk(&v.ElseBody[i])
 			}
+			if v.SwitchStmt != nil {
+				for _, c := range v.SwitchStmt.Cases {
+					for i := range c.Body {
+						walk(&c.Body[i])
+					}
+				}
+				for i := range v.SwitchStmt.Default {
+					walk(&v.SwitchStmt.Default[i])
+				}
+			}
+			if v.TryStmt != nil {
+				for _, block := range [][]ast.Step{v.TryStmt.Body, v.TryStmt.Catch, v.TryStmt.Finally} {
+					for i := range block {
+						walk(&block[i])
+					}
+				}
+			}
 		}
 	}
 	walk(program)
@@ -1,15 +1,119 @@
 // NeuroScript Version: 0.8.0
-// File version: 1
-// Purpose: Defines sentinel errors for the provider package.
+// File version: 3
+// Purpose: Defines sentinel errors for the provider package. Adds StatusError and retryable-error classification.
 // filename: pkg/provider/errors.go
-// nlines: 12
-// risk_rating: LOW
+// nlines: 119
+// risk_rating: MEDIUM
 
 package provider
 
-import "errors"
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
 
 var (
 	// ErrProviderNotFound is returned when a requested provider is not registered.
 	ErrProviderNotFound = errors.New("provider not found")
 )
+
+// transientNetErrors are connection failures worth retrying: the server was
+// briefly unreachable, or dropped the connection before the response ended.
+var transientNetErrors = []error{
+	syscall.ECONNREFUSED,
+	syscall.ECONNRESET,
+	syscall.ECONNABORTED,
+	io.ErrUnexpectedEOF,
+}
+
+// StatusError is returned by HTTP-based providers when the API answers with a
+// non-OK status. It lets callers decide whether the call is worth retrying.
+type StatusError struct {
+	StatusCode int
+	// RetryAfter is the delay requested by the server's Retry-After header, or zero.
+	RetryAfter time.Duration
+	// Message is the full, provider-formatted error text.
+	Message string
+}
+
+func (e *StatusError) Error() string { return e.Message }
+
+// Retryable reports whether the status indicates a transient failure:
+// 408 Request Timeout, 429 Too Many Requests, or any 5xx except 501.
+func (e *StatusError) Retryable() bool {
+	switch {
+	case e.StatusCode == http.StatusRequestTimeout, e.StatusCode == http.StatusTooManyRequests:
+		return true
+	case e.StatusCode == http.StatusNotImplemented:
+		return false
+	default:
+		return e.StatusCode >= 500 && e.StatusCode <= 599
+	}
+}
+
+// NewStatusError builds a StatusError from an HTTP response, reading its
+// Retry-After header. msg is used verbatim as the error text.
+func NewStatusError(resp *http.Response, msg string) *StatusError {
+	return &StatusError{
+		StatusCode: resp.StatusCode,
+		RetryAfter: ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+		Message:    msg,
+	}
+}
+
+// ParseRetryAfter interprets a Retry-After header value, given either as a
+// number of seconds or as an HTTP date. Invalid or past values yield zero.
+func ParseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(value); err == nil {
+		if secs <= 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		if d := at.Sub(now); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// IsRetryable classifies err as transient or permanent. Retryable errors are
+// retryable StatusErrors, deadline expiries, network timeouts, refused,
+// reset or aborted connections, and responses cut short (io.ErrUnexpectedEOF),
+// including a connection dropped in the middle of a stream. The second
+// result is the server-requested delay, if any. Cancellation (as opposed to
+// a deadline) is never retryable.
+func IsRetryable(err error) (bool, time.Duration) {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false, 0
+	}
+	var se *StatusError
+	if errors.As(err, &se) {
+		return se.Retryable(), se.RetryAfter
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true, 0
+	}
+	var ne net.Error
+	if errors.As(err, &ne) && ne.Timeout() {
+		return true, 0
+	}
+	for _, transient := range transientNetErrors {
+		if errors.Is(err, transient) {
+			return true, 0
+		}
+	}
+	return false, 0
+}
@@ -0,0 +1,69 @@
+// NeuroScript Version: 0.8.0
+// File version: 2
+// Purpose: Tests Retry-After parsing and retryable-error classification.
+// filename: pkg/provider/errors_test.go
+// nlines: 69
+// risk_rating: LOW
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	cases := map[string]time.Duration{
+		"":                              0,
+		"7":                             7 * time.Second,
+		"-3":                            0,
+		"soon":                          0,
+		"Wed, 01 Jan 2025 12:00:30 GMT": 30 * time.Second,
+		"Wed, 01 Jan 2025 11:00:00 GMT": 0,
+	}
+	for in, want := range cases {
+		if got := ParseRetryAfter(in, now); got != want {
+			t.Errorf("ParseRetryAfter(%q) = %v, want %v", in, got, want)
+		}
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"3"}}}
+	throttled := fmt.Errorf("wrapped: %w", NewStatusError(resp, "slow down"))
+
+	cases := []struct {
+		name      string
+		err       error
+		retryable bool
+		after     time.Duration
+	}{
+		{"429 with Retry-After", throttled, true, 3 * time.Second},
+		{"503", &StatusError{StatusCode: 503}, true, 0},
+		{"501", &StatusError{StatusCode: 501}, false, 0},
+		{"400", &StatusError{StatusCode: 400}, false, 0},
+		{"deadline", fmt.Errorf("call: %w", context.DeadlineExceeded), true, 0},
+		{"cancelled", context.Canceled, false, 0},
+		{"connection refused", &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}, true, 0},
+		{"connection reset", &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}, true, 0},
+		{"bare ECONNRESET", fmt.Errorf("stream: %w", syscall.ECONNRESET), true, 0},
+		{"unexpected EOF", fmt.Errorf("reading stream: %w", io.ErrUnexpectedEOF), true, 0},
+		{"clean EOF", io.EOF, false, 0},
+		{"other", errors.New("bad envelope"), false, 0},
+	}
+	for _, tc := range cases {
+		ok, after := IsRetryable(tc.err)
+		if ok != tc.retryable || after != tc.after {
+			t.Errorf("%s: IsRetryable() = %v, %v; want %v, %v", tc.name, ok, after, tc.retryable, tc.after)
+		}
+	}
+}
@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.7.0
-// File version: 11
-// Purpose: Updated to import pkg/types and use types.AIRequest/types.AIResponse, fixing the interface implementation.
+// File version: 14
+// Purpose: Maps the request's generation settings onto Gemini's generationConfig and warns about unsupported ones.
 // filename: pkg/provider/google/google.go
-// nlines: 174
+// nlines: 239
 // risk_rating: MEDIUM
 
 package google
@@ -14,9 +14,11 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"reflect"
 	"strings"
 
 	"github.com/aprice2704/neuroscript/pkg/aeiou"
+	"github.com/aprice2704/neuroscript/pkg/provider"
 	"github.com/aprice2704/neuroscript/pkg/types" // IMPORT FIX
 )
 
@@ -33,7 +35,23 @@ func New() *Provider {
 // --- Gemini API Request/Response Structures ---
 
 type geminiRequest struct {
-	Contents []geminiContent `json:"contents"`
+	Contents         []geminiContent         `json:"contents"`
+	GenerationConfig *geminiGenerationConfig `json:"generationConfig,omitempty"`
+}
+
+// geminiGenerationConfig mirrors Gemini's GenerationConfig. Pointers let
+// unset values be omitted so the API applies its own defaults.
+type geminiGenerationConfig struct {
+	Temperature      *float64 `json:"temperature,omitempty"`
+	TopP             *float64 `json:"topP,omitempty"`
+	TopK             *int     `json:"topK,omitempty"`
+	MaxOutputTokens  *int     `json:"maxOutputTokens,omitempty"`
+	StopSequences    []string `json:"stopSequences,omitempty"`
+	PresencePenalty  *float64 `json:"presencePenalty,omitempty"`
+	FrequencyPenalty *float64 `json:"frequencyPenalty,omitempty"`
+	Seed             *int64   `json:"seed,omitempty"`
+	ResponseLogprobs bool     `json:"responseLogprobs,omitempty"`
+	ResponseMimeType string   `json:"responseMimeType,omitempty"`
 }
 type geminiContent struct {
 	Parts []geminiPart `json:"parts"`
@@ -121,11 +139,7 @@ func (p *Provider) Chat(ctx context.Context, req types.AIRequest) (*types.AIResp
 	// The base URL should not also include it.
 	url := fmt.Sprintf("%s%s:generateContent?key=%s", apiBaseURL, req.ModelName, req.APIKey)
 
-	requestPayload := geminiRequest{
-		Contents: []geminiContent{
-			{Parts: []geminiPart{{Text: req.Prompt}}},
-		},
-	}
+	requestPayload, warnings := buildGeminiRequest(req)
 	bodyBytes, err := json.Marshal(requestPayload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal request body: %w", err)
@@ -151,9 +165,9 @@ func (p *Provider) Chat(ctx context.Context, req types.AIRequest) (*types.AIResp
 	if resp.StatusCode != http.StatusOK {
 		var geminiResp geminiResponse
 		if json.Unmarshal(respBody, &geminiResp) == nil && geminiResp.Error != nil {
-			return nil, fmt.Errorf("google api error: %s (status %d)", geminiResp.Error.Message, resp.StatusCode)
+			return nil, provider.NewStatusError(resp, fmt.Sprintf("google api error: %s (status %d)", geminiResp.Error.Message, resp.StatusCode))
 		}
-		return nil, fmt.Errorf("google api returned non-ok status '%s' with body: %s", resp.Status, string(respBody))
+		return nil, provider.NewStatusError(resp, fmt.Sprintf("google api returned non-ok status '%s' with body: %s", resp.Status, string(respBody)))
 	}
 
 	var geminiResp geminiResponse
@@ -171,5 +185,55 @@ func (p *Provider) Chat(ctx context.Context, req types.AIRequest) (*types.AIResp
 		TextContent:  textContent,
 		InputTokens:  geminiResp.UsageMetadata.PromptTokenCount,
 		OutputTokens: geminiResp.UsageMetadata.CandidatesTokenCount,
+		Warnings:     warnings,
 	}, nil
 }
+
+// buildGeminiRequest maps an AIRequest onto the Gemini request body. Settings
+// Gemini has no equivalent for are returned as warnings rather than errors.
+func buildGeminiRequest(req types.AIRequest) (geminiRequest, []string) {
+	var warnings []string
+	gc := &geminiGenerationConfig{
+		StopSequences:    req.StopSequences,
+		Seed:             req.Seed,
+		ResponseLogprobs: req.LogProbs,
+	}
+	if req.Sends(types.GenTemperature) {
+		gc.Temperature = &req.Temperature
+	}
+	if req.Sends(types.GenTopP) {
+		gc.TopP = &req.TopP
+	}
+	if req.Sends(types.GenTopK) {
+		gc.TopK = &req.TopK
+	}
+	if req.MaxOutputTokens != 0 {
+		gc.MaxOutputTokens = &req.MaxOutputTokens
+	}
+	if req.Sends(types.GenPresencePenalty) {
+		gc.PresencePenalty = &req.PresencePenalty
+	}
+	if req.Sends(types.GenFrequencyPenalty) {
+		gc.FrequencyPenalty = &req.FrequencyPenalty
+	}
+	switch req.ResponseFormat {
+	case "", types.ResponseFormatText:
+	case types.ResponseFormatJSON:
+		gc.ResponseMimeType = "application/json"
+	default:
+		warnings = append(warnings, fmt.Sprintf("google: response_format %q is not supported and was ignored", req.ResponseFormat))
+	}
+	if req.Sends(types.GenRepetitionPenalty) {
+		warnings = append(warnings, "google: repetition_penalty is not supported and was ignored")
+	}
+
+	out := geminiRequest{
+		Contents: []geminiContent{
+			{Parts: []geminiPart{{Text: req.Prompt}}},
+		},
+	}
+	if !reflect.DeepEqual(*gc, geminiGenerationConfig{}) {
+		out.GenerationConfig = gc
+	}
+	return out, warnings
+}
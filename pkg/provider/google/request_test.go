@@ -0,0 +1,71 @@
+// NeuroScript Version: 0.8.0
+// File version: 2
+// Purpose: Tests mapping of AIRequest generation settings onto the Gemini request.
+// filename: pkg/provider/google/request_test.go
+// nlines: 71
+// risk_rating: LOW
+
+package google
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/types"
+)
+
+func TestBuildGeminiRequest_GenerationConfig(t *testing.T) {
+	seed := int64(42)
+	req := types.AIRequest{
+		Prompt:          "hi",
+		Temperature:     0.3,
+		TopP:            0.9,
+		TopK:            40,
+		MaxOutputTokens: 256,
+		StopSequences:   []string{"END"},
+		Seed:            &seed,
+		ResponseFormat:  types.ResponseFormatJSON,
+	}
+	gr, warnings := buildGeminiRequest(req)
+	if len(warnings) != 0 {
+		t.Errorf("unexpected warnings: %v", warnings)
+	}
+	body, _ := json.Marshal(gr)
+	for _, want := range []string{
+		`"temperature":0.3`, `"topP":0.9`, `"topK":40`, `"maxOutputTokens":256`,
+		`"stopSequences":["END"]`, `"seed":42`, `"responseMimeType":"application/json"`,
+	} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("request body missing %s: %s", want, body)
+		}
+	}
+}
+
+func TestBuildGeminiRequest_DefaultsAndWarnings(t *testing.T) {
+	gr, warnings := buildGeminiRequest(types.AIRequest{Prompt: "hi"})
+	if gr.GenerationConfig != nil || len(warnings) != 0 {
+		t.Errorf("zero request should send no generationConfig, got %+v, %v", gr.GenerationConfig, warnings)
+	}
+
+	gr, warnings = buildGeminiRequest(types.AIRequest{Prompt: "hi", RepetitionPenalty: 1.1})
+	if gr.GenerationConfig != nil {
+		t.Errorf("unsupported setting should not produce a generationConfig: %+v", gr.GenerationConfig)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "repetition_penalty") {
+		t.Errorf("expected a repetition_penalty warning, got %v", warnings)
+	}
+}
+
+func TestBuildGeminiRequest_ExplicitZeros(t *testing.T) {
+	gr, _ := buildGeminiRequest(types.AIRequest{Prompt: "hi", Explicit: types.GenTemperature | types.GenTopK})
+	body, _ := json.Marshal(gr)
+	for _, want := range []string{`"temperature":0`, `"topK":0`} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("explicit zero missing %s: %s", want, body)
+		}
+	}
+	if strings.Contains(string(body), "topP") {
+		t.Errorf("an unset topP was sent: %s", body)
+	}
+}
@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.8.0
-// File version: 2
-// Purpose: Corrected to parse the 'generic_http' block from the provider params map.
+// File version: 4
+// Purpose: Adds the optional streaming and token usage settings to the 'generic_http' block.
 // filename: pkg/provider/httpprovider/config.go
-// nlines: 70
+// nlines: 90
 // risk_rating: MEDIUM
 
 package httpprovider
@@ -33,6 +33,22 @@ type httpProviderConfig struct {
 	// ErrorPath is a JMESPath/JSONPath string to extract an error message from
 	// a non-200 response body. e.g., "error.message"
 	ErrorPath string `mapstructure:"api_error_path"`
+
+	// StreamDeltaPath is a JMESPath string that extracts the text delta from
+	// each streamed event, e.g. "choices[0].delta.content". Streaming is only
+	// used when it is set; otherwise ChatStream falls back to Chat.
+	StreamDeltaPath string `mapstructure:"api_stream_delta_path"`
+	// StreamBodyTemplate replaces BodyTemplate for streaming requests, so it
+	// can carry the API's stream switch (e.g. "stream": true). Optional.
+	StreamBodyTemplate any `mapstructure:"api_stream_body_template"`
+
+	// InputTokensPath and OutputTokensPath are JMESPath strings that extract
+	// the token counts from a response, e.g. "usage.prompt_tokens". In a
+	// stream they are read from every event, and the last count found (usually
+	// in the final usage event) wins. Optional; without them calls are priced
+	// from an estimate.
+	InputTokensPath  string `mapstructure:"api_input_tokens_path"`
+	OutputTokensPath string `mapstructure:"api_output_tokens_path"`
 }
 
 // extractConfig parses the httpProviderConfig from the provider params map.
@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.8.0
-// File version: 1
-// Purpose: Provides helpers for request interpolation and response parsing for the http.Provider.
+// File version: 4
+// Purpose: Provides helpers for request interpolation and response parsing for the http.Provider. Adds token usage extraction.
 // filename: pkg/provider/httpprovider/helpers.go
-// nlines: 150
+// nlines: 278
 // risk_rating: HIGH
 
 package httpprovider
@@ -12,11 +12,98 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aprice2704/neuroscript/pkg/types"
 	"github.com/jmespath/go-jmespath"
 )
 
+// generationTokens maps each generation-setting token to the AgentModel
+// setting it carries, for use in warnings.
+var generationTokens = []struct{ token, setting string }{
+	{"TEMPERATURE", "temperature"},
+	{"TOP_P", "top_p"},
+	{"TOP_K", "top_k"},
+	{"MAX_OUTPUT_TOKENS", "max_output_tokens"},
+	{"STOP_SEQUENCES", "stop_sequences"},
+	{"PRESENCE_PENALTY", "presence_penalty"},
+	{"FREQUENCY_PENALTY", "frequency_penalty"},
+	{"REPETITION_PENALTY", "repetition_penalty"},
+	{"SEED", "seed"},
+	{"LOG_PROBS", "log_probs"},
+	{"RESPONSE_FORMAT", "response_format"},
+}
+
+// tokenValues builds the interpolation context for a request. MODEL, APIKEY
+// and PROMPT are always present; generation settings are present only when
+// set (see AIRequest.Sends), keeping their native types (numbers, bools,
+// string lists).
+func tokenValues(req types.AIRequest) map[string]any {
+	ctx := map[string]any{
+		"MODEL":  req.ModelName,
+		"APIKEY": req.APIKey,
+		"PROMPT": req.Prompt, // This is the full AEIOU envelope
+	}
+	set := func(token string, f types.GenerationFields, v any) {
+		if req.Sends(f) {
+			ctx[token] = v
+		}
+	}
+	set("TEMPERATURE", types.GenTemperature, req.Temperature)
+	set("TOP_P", types.GenTopP, req.TopP)
+	set("TOP_K", types.GenTopK, req.TopK)
+	set("PRESENCE_PENALTY", types.GenPresencePenalty, req.PresencePenalty)
+	set("FREQUENCY_PENALTY", types.GenFrequencyPenalty, req.FrequencyPenalty)
+	set("REPETITION_PENALTY", types.GenRepetitionPenalty, req.RepetitionPenalty)
+	if req.MaxOutputTokens != 0 {
+		ctx["MAX_OUTPUT_TOKENS"] = req.MaxOutputTokens
+	}
+	if len(req.StopSequences) > 0 {
+		ctx["STOP_SEQUENCES"] = req.StopSequences
+	}
+	if req.Seed != nil {
+		ctx["SEED"] = *req.Seed
+	}
+	if req.LogProbs {
+		ctx["LOG_PROBS"] = true
+	}
+	if req.ResponseFormat != "" {
+		ctx["RESPONSE_FORMAT"] = string(req.ResponseFormat)
+	}
+	return ctx
+}
+
+// unusedSettingWarnings reports generation settings that are set on the
+// request but have no token anywhere in the body template, so the API never
+// receives them.
+func unusedSettingWarnings(bodyTemplate any, ctx map[string]any) []string {
+	raw, _ := json.Marshal(bodyTemplate)
+	var warnings []string
+	for _, gt := range generationTokens {
+		if _, set := ctx[gt.token]; !set {
+			continue
+		}
+		if !strings.Contains(string(raw), "{"+gt.token+"}") {
+			warnings = append(warnings, fmt.Sprintf("httpprovider: %s is set but the body template has no {%s} token; it was ignored", gt.setting, gt.token))
+		}
+	}
+	return warnings
+}
+
+// isToken reports whether name is a token understood by the interpolator.
+func isToken(name string) bool {
+	switch name {
+	case "MODEL", "APIKEY", "PROMPT":
+		return true
+	}
+	for _, gt := range generationTokens {
+		if gt.token == name {
+			return true
+		}
+	}
+	return false
+}
+
 // buildRequestHeaders interpolates token values into a map of headers.
-func buildRequestHeaders(headerTemplate map[string]string, ctx map[string]string) map[string]string {
+func buildRequestHeaders(headerTemplate map[string]string, ctx map[string]any) map[string]string {
 	headers := make(map[string]string, len(headerTemplate))
 	for k, v := range headerTemplate {
 		headers[k] = interpolateString(v, ctx)
@@ -25,64 +112,92 @@ func buildRequestHeaders(headerTemplate map[string]string, ctx map[string]string
 }
 
 // buildRequestBody interpolates token values into the body template and marshals it to JSON.
-func buildRequestBody(bodyTemplate any, ctx map[string]string) ([]byte, error) {
-	interpolatedBody, err := interpolateRecursive(bodyTemplate, ctx)
+func buildRequestBody(bodyTemplate any, ctx map[string]any) ([]byte, error) {
+	interpolatedBody, _, err := interpolateRecursive(bodyTemplate, ctx)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrInterpolation, err)
 	}
 	return json.Marshal(interpolatedBody)
 }
 
+// tokenText renders a token value for embedding inside a larger string.
+// Lists are rendered as JSON; unset tokens render as the empty string.
+func tokenText(v any) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case []string:
+		b, _ := json.Marshal(t)
+		return string(b)
+	default:
+		return fmt.Sprint(t)
+	}
+}
+
 // interpolateString replaces all known tokens in a single string.
 // e.g., "Bearer {APIKEY}" -> "Bearer sk-123..."
-func interpolateString(s string, ctx map[string]string) string {
-	for token, value := range ctx {
-		placeholder := fmt.Sprintf("{%s}", token)
+func interpolateString(s string, ctx map[string]any) string {
+	if !strings.Contains(s, "{") {
+		return s
+	}
+	for _, token := range []string{"MODEL", "APIKEY", "PROMPT"} {
 		// Use strings.Replace instead of template engines for simplicity
 		// and to avoid issues with JSON string literal escaping.
-		s = strings.ReplaceAll(s, placeholder, value)
+		s = strings.ReplaceAll(s, "{"+token+"}", tokenText(ctx[token]))
+	}
+	for _, gt := range generationTokens {
+		s = strings.ReplaceAll(s, "{"+gt.token+"}", tokenText(ctx[gt.token]))
 	}
 	return s
 }
 
-// interpolateRecursive walks a nested structure (map/slice) and interpolates all string values.
-func interpolateRecursive(data any, ctx map[string]string) (any, error) {
+// interpolateRecursive walks a nested structure (map/slice) and interpolates
+// all string values. A string that is exactly one token is replaced by the
+// token's typed value, so "{TOP_K}" becomes a JSON number and "{PROMPT}" keeps
+// the prompt verbatim. If that token is unset, keep is false and the
+// enclosing map entry or list element is dropped, letting one template list
+// every setting while sending only the ones configured.
+func interpolateRecursive(data any, ctx map[string]any) (value any, keep bool, err error) {
 	switch v := data.(type) {
 	case string:
-		// Check for a special case: if the string *is* the prompt token,
-		// we must respect the prompt's type (e.g., it might be a JSON string).
-		// We only JSON-escape the prompt if it's part of a larger string.
-		if v == "{PROMPT}" {
-			return ctx["PROMPT"], nil
+		if len(v) > 2 && v[0] == '{' && v[len(v)-1] == '}' && isToken(v[1:len(v)-1]) {
+			val, ok := ctx[v[1:len(v)-1]]
+			return val, ok, nil
 		}
 		// Otherwise, just do simple string replacement.
-		return interpolateString(v, ctx), nil
+		return interpolateString(v, ctx), true, nil
 
 	case map[string]any:
 		newMap := make(map[string]any, len(v))
 		for key, val := range v {
-			interpolatedVal, err := interpolateRecursive(val, ctx)
+			interpolatedVal, keep, err := interpolateRecursive(val, ctx)
 			if err != nil {
-				return nil, err
+				return nil, false, err
+			}
+			if keep {
+				newMap[key] = interpolatedVal
 			}
-			newMap[key] = interpolatedVal
 		}
-		return newMap, nil
+		return newMap, true, nil
 
 	case []any:
-		newSlice := make([]any, len(v))
-		for i, val := range v {
-			interpolatedVal, err := interpolateRecursive(val, ctx)
+		newSlice := make([]any, 0, len(v))
+		for _, val := range v {
+			interpolatedVal, keep, err := interpolateRecursive(val, ctx)
 			if err != nil {
-				return nil, err
+				return nil, false, err
+			}
+			if keep {
+				newSlice = append(newSlice, interpolatedVal)
 			}
-			newSlice[i] = interpolatedVal
 		}
-		return newSlice, nil
+		return newSlice, true, nil
 
 	default:
 		// Pass through other types (bool, number, nil) unchanged.
-		return data, nil
+		return data, true, nil
 	}
 }
 
@@ -111,6 +226,33 @@ func extractResponseText(data any, path string) (string, error) {
 	return strResult, nil
 }
 
+// extractTokenCount reads a token count at path from a parsed JSON response.
+// It reports false if path is empty or does not lead to a number.
+func extractTokenCount(data any, path string) (int, bool) {
+	if path == "" {
+		return 0, false
+	}
+	result, err := jmespath.Search(path, data)
+	if err != nil {
+		return 0, false
+	}
+	n, ok := result.(float64)
+	if !ok || n < 0 {
+		return 0, false
+	}
+	return int(n), true
+}
+
+// readUsage copies the token counts found in data into resp.
+func readUsage(data any, config *httpProviderConfig, resp *types.AIResponse) {
+	if n, ok := extractTokenCount(data, config.InputTokensPath); ok {
+		resp.InputTokens = n
+	}
+	if n, ok := extractTokenCount(data, config.OutputTokensPath); ok {
+		resp.OutputTokens = n
+	}
+}
+
 // parseErrorResponse attempts to find a structured error message in a non-200 response.
 func parseErrorResponse(body []byte, path string) string {
 	if path == "" {
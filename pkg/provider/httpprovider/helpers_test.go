@@ -0,0 +1,84 @@
+// NeuroScript Version: 0.8.0
+// File version: 2
+// Purpose: Tests typed generation tokens, omission of unset settings and unused-setting warnings.
+// filename: pkg/provider/httpprovider/helpers_test.go
+// nlines: 84
+// risk_rating: LOW
+
+package httpprovider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/types"
+)
+
+func TestBuildRequestBody_TypedTokens(t *testing.T) {
+	template := map[string]any{
+		"model":  "{MODEL}",
+		"prompt": "{PROMPT}",
+		"options": map[string]any{
+			"temperature": "{TEMPERATURE}",
+			"top_k":       "{TOP_K}",
+			"top_p":       "{TOP_P}",
+			"stop":        "{STOP_SEQUENCES}",
+			"seed":        "{SEED}",
+		},
+		"note": "k={TOP_K} p={TOP_P}",
+	}
+	seed := int64(7)
+	ctx := tokenValues(types.AIRequest{ModelName: "m", Prompt: "hi", TopK: 5, Temperature: 0.5, StopSequences: []string{"x"}, Seed: &seed})
+	raw, err := buildRequestBody(template, ctx)
+	if err != nil {
+		t.Fatalf("buildRequestBody() failed: %v", err)
+	}
+	var got map[string]any
+	_ = json.Unmarshal(raw, &got)
+	want := map[string]any{
+		"model":   "m",
+		"prompt":  "hi",
+		"options": map[string]any{"temperature": 0.5, "top_k": 5.0, "stop": []any{"x"}, "seed": 7.0},
+		"note":    "k=5 p=",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("body mismatch:\n got  %v\n want %v", got, want)
+	}
+}
+
+func TestTokenValues_ExplicitZeros(t *testing.T) {
+	ctx := tokenValues(types.AIRequest{Prompt: "hi", Explicit: types.GenTemperature})
+	if v, ok := ctx["TEMPERATURE"]; !ok || v != 0.0 {
+		t.Errorf("TEMPERATURE = %v, %v; want an explicit 0", v, ok)
+	}
+	if _, ok := ctx["TOP_P"]; ok {
+		t.Error("an unset TOP_P should not be a token value")
+	}
+}
+
+func TestChat_WarnsOnUnusedSettings(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "TOP_P") {
+			t.Errorf("unset token leaked into body: %s", body)
+		}
+		w.Write([]byte(`{"text":"ok"}`))
+	}))
+	defer srv.Close()
+
+	req := streamRequest(srv.URL, "")
+	req.TopK = 3
+	resp, err := New().Chat(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Chat() failed: %v", err)
+	}
+	if len(resp.Warnings) != 1 || !strings.Contains(resp.Warnings[0], "top_k") {
+		t.Errorf("expected a top_k warning, got %v", resp.Warnings)
+	}
+}
@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.8.0
-// File version: 2
-// Purpose: Corrected to use req.ProviderParams and req.ModelName, fixing compiler errors.
+// File version: 6
+// Purpose: Generic HTTP provider. Generation settings are interpolated as typed tokens; unused ones are reported as warnings. Token usage is read from configured paths.
 // filename: pkg/provider/httpprovider/httpprovider.go
-// nlines: 108
+// nlines: 141
 // risk_rating: HIGH
 
 package httpprovider
@@ -16,6 +16,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/aprice2704/neuroscript/pkg/provider"
 	"github.com/aprice2704/neuroscript/pkg/types"
 )
 
@@ -57,31 +58,10 @@ func (p *Provider) Chat(ctx context.Context, req types.AIRequest) (*types.AIResp
 		return nil, fmt.Errorf("httpprovider: %w", err)
 	}
 
-	// 2. Build the interpolation context
-	//    This is the FIX for "req.Model.Model undefined".
-	interpContext := map[string]string{
-		"MODEL":  req.ModelName,
-		"APIKEY": req.APIKey,
-		"PROMPT": req.Prompt, // This is the full AEIOU envelope
-	}
-
-	// 3. Build Request Body
-	// We pass the raw template and let the helper handle interpolation.
-	bodyBytes, err := buildRequestBody(config.BodyTemplate, interpContext)
-	if err != nil {
-		return nil, fmt.Errorf("httpprovider: failed to build request body: %w", err)
-	}
-
-	// 4. Build Request Headers
-	headers := buildRequestHeaders(config.Headers, interpContext)
-
-	// 5. Create and Execute HTTP Request
-	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, config.URL, bytes.NewReader(bodyBytes))
+	// 2-5. Build and execute the HTTP request.
+	httpReq, warnings, err := newHTTPRequest(ctx, req, config, config.BodyTemplate)
 	if err != nil {
-		return nil, fmt.Errorf("httpprovider: failed to create http request: %w", err)
-	}
-	for k, v := range headers {
-		httpReq.Header.Set(k, v)
+		return nil, err
 	}
 
 	resp, err := p.Client.Do(httpReq)
@@ -96,13 +76,7 @@ func (p *Provider) Chat(ctx context.Context, req types.AIRequest) (*types.AIResp
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		// Try to parse a standard error message from the provider
-		errMsg := parseErrorResponse(respBody, config.ErrorPath)
-		if errMsg != "" {
-			return nil, fmt.Errorf("httpprovider: api error: %s (status %d)", errMsg, resp.StatusCode)
-		}
-		// Fallback
-		return nil, fmt.Errorf("httpprovider: api returned non-ok status '%s' with body: %s", resp.Status, string(respBody))
+		return nil, statusError(resp, respBody, config.ErrorPath)
 	}
 
 	// 6. Parse the successful response
@@ -119,9 +93,49 @@ func (p *Provider) Chat(ctx context.Context, req types.AIRequest) (*types.AIResp
 		// We don't return an error here, as we *did* get a response.
 	}
 
-	// 8. Return the standard AIResponse
-	// TODO: Add token counting extraction if paths are provided in config
-	return &types.AIResponse{
+	// 8. Return the standard AIResponse, with token counts when configured
+	out := &types.AIResponse{
 		TextContent: strings.TrimSpace(textContent),
-	}, nil
+		Warnings:    warnings,
+	}
+	readUsage(data, config, out)
+	return out, nil
+}
+
+// newHTTPRequest interpolates the request template and headers and builds
+// the POST request. It also returns warnings for generation settings the
+// template does not use.
+func newHTTPRequest(ctx context.Context, req types.AIRequest, config *httpProviderConfig, bodyTemplate any) (*http.Request, []string, error) {
+	// Build the interpolation context
+	interpContext := tokenValues(req)
+
+	// Build Request Body
+	// We pass the raw template and let the helper handle interpolation.
+	bodyBytes, err := buildRequestBody(bodyTemplate, interpContext)
+	if err != nil {
+		return nil, nil, fmt.Errorf("httpprovider: failed to build request body: %w", err)
+	}
+
+	// Build Request Headers
+	headers := buildRequestHeaders(config.Headers, interpContext)
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, config.URL, bytes.NewReader(bodyBytes))
+	if err != nil {
+		return nil, nil, fmt.Errorf("httpprovider: failed to create http request: %w", err)
+	}
+	for k, v := range headers {
+		httpReq.Header.Set(k, v)
+	}
+	return httpReq, unusedSettingWarnings(bodyTemplate, interpContext), nil
+}
+
+// statusError describes a non-OK response, preferring a structured error
+// message from the provider. Its Retryable method and RetryAfter field let
+// callers retry transient failures.
+func statusError(resp *http.Response, respBody []byte, errorPath string) *provider.StatusError {
+	if errMsg := parseErrorResponse(respBody, errorPath); errMsg != "" {
+		return provider.NewStatusError(resp, fmt.Sprintf("httpprovider: api error: %s (status %d)", errMsg, resp.StatusCode))
+	}
+	// Fallback
+	return provider.NewStatusError(resp, fmt.Sprintf("httpprovider: api returned non-ok status '%s' with body: %s", resp.Status, string(respBody)))
 }
@@ -0,0 +1,163 @@
+// NeuroScript Version: 0.8.0
+// File version: 4
+// Purpose: Implements ChatStream for the generic HTTP provider over SSE or newline-delimited JSON.
+// filename: pkg/provider/httpprovider/stream.go
+// nlines: 163
+// risk_rating: HIGH
+
+package httpprovider
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/aprice2704/neuroscript/pkg/provider"
+	"github.com/aprice2704/neuroscript/pkg/types"
+	"github.com/jmespath/go-jmespath"
+)
+
+// maxStreamLine bounds a single SSE/NDJSON line.
+const maxStreamLine = 1 << 20
+
+var _ provider.StreamingProvider = (*Provider)(nil)
+
+// ChatStream sends a streaming request and emits one chunk per event. Both
+// Server-Sent Events ("data: {...}" lines, terminated by "data: [DONE]" or EOF)
+// and chunked newline-delimited JSON are accepted. The text of each event is
+// taken from 'api_stream_delta_path'; events where it is absent are skipped.
+// The final chunk carries the whole text, trimmed as Chat trims it, and any
+// token counts found through the usage paths. If no delta path is configured, the request is made with Chat and its
+// whole response is delivered as a single chunk.
+func (p *Provider) ChatStream(ctx context.Context, req types.AIRequest) (<-chan provider.StreamChunk, error) {
+	config, err := extractConfig(req.ProviderParams, req.AgentModelName)
+	if err != nil {
+		return nil, fmt.Errorf("httpprovider: %w", err)
+	}
+	if config.StreamDeltaPath == "" {
+		resp, err := p.Chat(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		out := make(chan provider.StreamChunk, 1)
+		out <- provider.StreamChunk{Delta: resp.TextContent, Final: resp}
+		close(out)
+		return out, nil
+	}
+
+	bodyTemplate := config.StreamBodyTemplate
+	if bodyTemplate == nil {
+		bodyTemplate = config.BodyTemplate
+	}
+	httpReq, warnings, err := newHTTPRequest(ctx, req, config, bodyTemplate)
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Accept", "text/event-stream, application/x-ndjson, application/json")
+
+	resp, err := p.Client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("httpprovider: http request failed: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, statusError(resp, respBody, config.ErrorPath)
+	}
+
+	out := make(chan provider.StreamChunk)
+	go func() {
+		defer close(out)
+		defer resp.Body.Close()
+		send := func(chunk provider.StreamChunk) bool {
+			select {
+			case out <- chunk:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		var text strings.Builder
+		final := &types.AIResponse{Warnings: warnings}
+		scanner := bufio.NewScanner(resp.Body)
+		scanner.Buffer(make([]byte, 0, 64*1024), maxStreamLine)
+		for scanner.Scan() {
+			payload, done := streamPayload(scanner.Bytes())
+			if done {
+				break
+			}
+			if payload == nil {
+				continue
+			}
+			var data any
+			if err := json.Unmarshal(payload, &data); err != nil {
+				send(provider.StreamChunk{Err: fmt.Errorf("httpprovider: %w: invalid stream event: %v", ErrResponseFormat, err)})
+				return
+			}
+			readUsage(data, config, final)
+			delta, err := extractStreamDelta(data, config.StreamDeltaPath)
+			if err != nil {
+				send(provider.StreamChunk{Err: err})
+				return
+			}
+			if delta == "" {
+				continue
+			}
+			text.WriteString(delta)
+			if !send(provider.StreamChunk{Delta: delta}) {
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil && ctx.Err() == nil {
+			send(provider.StreamChunk{Err: fmt.Errorf("httpprovider: reading stream: %w", err)})
+			return
+		}
+		final.TextContent = strings.TrimSpace(text.String())
+		send(provider.StreamChunk{Final: final})
+	}()
+	return out, nil
+}
+
+// streamPayload extracts the JSON payload from one line of an SSE or NDJSON
+// stream. It returns nil for lines that carry no data, and done=true for the
+// SSE "[DONE]" sentinel.
+func streamPayload(line []byte) (payload []byte, done bool) {
+	line = bytes.TrimSpace(line)
+	if len(line) == 0 || line[0] == ':' {
+		return nil, false // blank separator or SSE comment
+	}
+	if data, ok := bytes.CutPrefix(line, []byte("data:")); ok {
+		data = bytes.TrimSpace(data)
+		if string(data) == "[DONE]" {
+			return nil, true
+		}
+		return data, false
+	}
+	if line[0] != '{' && line[0] != '[' {
+		return nil, false // other SSE fields: event:, id:, retry:
+	}
+	return line, false
+}
+
+// extractStreamDelta returns the text at path in one decoded event, or "" if
+// the event has none (role announcements, usage records and the like).
+func extractStreamDelta(data any, path string) (string, error) {
+	result, err := jmespath.Search(path, data)
+	if err != nil {
+		return "", fmt.Errorf("httpprovider: %w: jmespath search failed for path '%s': %v", ErrResponseFormat, path, err)
+	}
+	switch v := result.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	default:
+		return fmt.Sprintf("%v", v), nil
+	}
+}
@@ -0,0 +1,187 @@
+// NeuroScript Version: 0.8.0
+// File version: 3
+// Purpose: Tests ChatStream against SSE and newline-delimited JSON servers, including usage, trimming and dropped connections.
+// filename: pkg/provider/httpprovider/stream_test.go
+// nlines: 187
+// risk_rating: LOW
+
+package httpprovider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/provider"
+	"github.com/aprice2704/neuroscript/pkg/types"
+)
+
+func streamRequest(url, deltaPath string) types.AIRequest {
+	cfg := map[string]any{
+		"api_url":                  url,
+		"api_body_template":        map[string]any{"model": "{MODEL}", "prompt": "{PROMPT}"},
+		"api_stream_body_template": map[string]any{"model": "{MODEL}", "prompt": "{PROMPT}", "stream": true},
+		"api_response_path":        "text",
+		"api_error_path":           "error.message",
+	}
+	if deltaPath != "" {
+		cfg["api_stream_delta_path"] = deltaPath
+	}
+	return types.AIRequest{
+		AgentModelName: "streamer",
+		ModelName:      "m1",
+		Prompt:         "hello",
+		ProviderParams: map[string]any{configKey: cfg},
+	}
+}
+
+func collect(t *testing.T, p *Provider, req types.AIRequest) (string, int) {
+	t.Helper()
+	stream, err := p.ChatStream(context.Background(), req)
+	if err != nil {
+		t.Fatalf("ChatStream() failed: %v", err)
+	}
+	n := 0
+	resp, err := provider.Collect(context.Background(), stream, func(string) { n++ })
+	if err != nil {
+		t.Fatalf("Collect() failed: %v", err)
+	}
+	return resp.TextContent, n
+}
+
+func TestChatStream_SSE(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), `"stream":true`) {
+			t.Errorf("stream body template not used: %s", body)
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, ": keep-alive\n\n")
+		fmt.Fprint(w, "data: {\"choices\":[{\"delta\":{\"role\":\"assistant\"}}]}\n\n")
+		for _, tok := range []string{"Hel", "lo, ", "world"} {
+			fmt.Fprintf(w, "event: message\ndata: {\"choices\":[{\"delta\":{\"content\":%q}}]}\n\n", tok)
+			w.(http.Flusher).Flush()
+		}
+		fmt.Fprint(w, "data: [DONE]\n\n")
+		fmt.Fprint(w, "data: {\"choices\":[{\"delta\":{\"content\":\"ignored\"}}]}\n\n")
+	}))
+	defer srv.Close()
+
+	text, n := collect(t, New(), streamRequest(srv.URL, "choices[0].delta.content"))
+	if text != "Hello, world" || n != 3 {
+		t.Errorf("got %q in %d chunks, want %q in 3", text, n, "Hello, world")
+	}
+}
+
+func TestChatStream_NDJSON(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		fmt.Fprintln(w, `{"message":{"content":"one "},"done":false}`)
+		fmt.Fprintln(w, `{"message":{"content":"two"},"done":false}`)
+		fmt.Fprintln(w, `{"done":true}`)
+	}))
+	defer srv.Close()
+
+	if text, n := collect(t, New(), streamRequest(srv.URL, "message.content")); text != "one two" || n != 2 {
+		t.Errorf("got %q in %d chunks, want %q in 2", text, n, "one two")
+	}
+}
+
+func TestChatStream_FallbackAndErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"error":{"message":"slow down"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"text":"whole answer"}`)
+	}))
+	defer srv.Close()
+
+	// Without a delta path the provider answers with Chat in a single chunk.
+	if text, n := collect(t, New(), streamRequest(srv.URL, "")); text != "whole answer" || n != 1 {
+		t.Errorf("fallback got %q in %d chunks, want %q in 1", text, n, "whole answer")
+	}
+
+	_, err := New().ChatStream(context.Background(), streamRequest(srv.URL+"/fail", "choices[0].delta.content"))
+	if err == nil || !strings.Contains(err.Error(), "slow down") {
+		t.Errorf("expected the API error message, got %v", err)
+	}
+}
+
+// TestChatStream_DroppedConnectionIsRetryable cuts the stream off after the
+// first event, either by closing mid-body or with a TCP reset, and expects a
+// retryable error rather than a silently truncated answer.
+func TestChatStream_DroppedConnectionIsRetryable(t *testing.T) {
+	for _, reset := range []bool{false, true} {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/event-stream")
+			fmt.Fprint(w, "data: {\"choices\":[{\"delta\":{\"content\":\"Hel\"}}]}\n\n")
+			w.(http.Flusher).Flush()
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				t.Errorf("Hijack() failed: %v", err)
+				return
+			}
+			if tcp, ok := conn.(*net.TCPConn); ok && reset {
+				_ = tcp.SetLinger(0) // close with RST instead of FIN
+			}
+			conn.Close()
+		}))
+
+		stream, err := New().ChatStream(context.Background(), streamRequest(srv.URL, "choices[0].delta.content"))
+		if err != nil {
+			t.Fatalf("reset=%v: ChatStream() failed: %v", reset, err)
+		}
+		_, err = provider.Collect(context.Background(), stream, func(string) {})
+		if err == nil {
+			t.Errorf("reset=%v: a dropped stream was reported as complete", reset)
+		} else if ok, _ := provider.IsRetryable(err); !ok {
+			t.Errorf("reset=%v: IsRetryable(%v) = false, want true", reset, err)
+		}
+		srv.Close()
+	}
+}
+
+func TestChatStream_UsageAndTrimMatchChat(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), `"stream":true`) {
+			fmt.Fprint(w, `{"text":"  whole answer\n","usage":{"in":12,"out":3}}`)
+			return
+		}
+		fmt.Fprint(w, "data: {\"delta\":\"  whole \"}\n\n")
+		fmt.Fprint(w, "data: {\"delta\":\"answer\\n\"}\n\n")
+		fmt.Fprint(w, "data: {\"usage\":{\"in\":12,\"out\":3}}\n\n")
+		fmt.Fprint(w, "data: [DONE]\n\n")
+	}))
+	defer srv.Close()
+
+	req := streamRequest(srv.URL, "delta")
+	cfg := req.ProviderParams[configKey].(map[string]any)
+	cfg["api_input_tokens_path"] = "usage.in"
+	cfg["api_output_tokens_path"] = "usage.out"
+
+	chat, err := New().Chat(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Chat() failed: %v", err)
+	}
+	stream, err := New().ChatStream(context.Background(), req)
+	if err != nil {
+		t.Fatalf("ChatStream() failed: %v", err)
+	}
+	streamed, err := provider.Collect(context.Background(), stream, nil)
+	if err != nil {
+		t.Fatalf("Collect() failed: %v", err)
+	}
+	for name, resp := range map[string]*types.AIResponse{"Chat": chat, "ChatStream": streamed} {
+		if resp.TextContent != "whole answer" || resp.InputTokens != 12 || resp.OutputTokens != 3 {
+			t.Errorf("%s: got %q with %d/%d tokens, want %q with 12/3", name, resp.TextContent, resp.InputTokens, resp.OutputTokens, "whole answer")
+		}
+	}
+}
@@ -1,14 +1,15 @@
 // NeuroScript Version: 0.8.0
-// File version: 3
-// Purpose: Added type aliases for types.AIRequest/AIResponse to fix compiler errors during refactor.
+// File version: 4
+// Purpose: Adds the optional StreamingProvider interface, StreamChunk and the Collect helper.
 // filename: pkg/provider/provider.go
-// nlines: 26
+// nlines: 95
 // risk_rating: HIGH
 
 package provider
 
 import (
 	"context"
+	"strings"
 
 	"github.com/aprice2704/neuroscript/pkg/types"
 )
@@ -29,6 +30,60 @@ type AIResponse = types.AIResponse
 type AIProvider interface {
 	// Chat sends a non-streaming request to the provider.
 	Chat(ctx context.Context, req types.AIRequest) (*types.AIResponse, error)
-	// ChatStream sends a streaming request to the provider. (Future implementation)
-	// ChatStream(ctx context.Context, req types.AIRequest) (<-chan StreamChunk, error)
+}
+
+// StreamingProvider is implemented by providers that can deliver a response
+// incrementally. Callers discover it with a type assertion and fall back to
+// Chat when it is absent.
+type StreamingProvider interface {
+	AIProvider
+	// ChatStream starts a streaming request. The provider sends zero or more
+	// chunks carrying text deltas and then closes the channel. A chunk with a
+	// non-nil Err is always the last one. Providers must stop sending and
+	// close the channel when ctx is cancelled.
+	ChatStream(ctx context.Context, req types.AIRequest) (<-chan StreamChunk, error)
+}
+
+// StreamChunk is one increment of a streamed response.
+type StreamChunk struct {
+	// Delta is the newly generated text, if any.
+	Delta string
+	// Final, if set, carries end-of-stream metadata such as token counts and
+	// cost. If its TextContent is empty, the concatenated deltas are used.
+	Final *types.AIResponse
+	// Err reports a failure part-way through the stream.
+	Err error
+}
+
+// Collect drains a stream into a single AIResponse, calling onDelta (if
+// non-nil) for every non-empty delta as it arrives. It returns the first
+// error carried by the stream, or ctx.Err() if ctx ends first.
+func Collect(ctx context.Context, stream <-chan StreamChunk, onDelta func(delta string)) (*types.AIResponse, error) {
+	var text strings.Builder
+	resp := &types.AIResponse{}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case chunk, ok := <-stream:
+			if !ok {
+				if resp.TextContent == "" {
+					resp.TextContent = text.String()
+				}
+				return resp, nil
+			}
+			if chunk.Err != nil {
+				return nil, chunk.Err
+			}
+			if chunk.Delta != "" {
+				text.WriteString(chunk.Delta)
+				if onDelta != nil {
+					onDelta(chunk.Delta)
+				}
+			}
+			if chunk.Final != nil {
+				*resp = *chunk.Final
+			}
+		}
+	}
 }
@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.7.0
-// File version: 21
-// Purpose: Replaces obsolete 'tool.aeiou.magic' call with '<<<LOOP:DONE>>>' signal.
+// File version: 22
+// Purpose: Adds ChatStream, which replays the canned response as word-sized chunks.
 // filename: pkg/provider/test/test.go
-// nlines: 90
+// nlines: 120
 // risk_rating: LOW
 
 package test
@@ -17,9 +17,12 @@ import (
 	"github.com/aprice2704/neuroscript/pkg/provider"
 )
 
-// Provider implements the provider.AIProvider interface for testing purposes.
+// Provider implements the provider.AIProvider and provider.StreamingProvider
+// interfaces for testing purposes.
 type Provider struct{}
 
+var _ provider.StreamingProvider = (*Provider)(nil)
+
 // New creates a new instance of the test AI provider.
 func New() *Provider {
 	return &Provider{}
@@ -91,3 +94,27 @@ func (p *Provider) Chat(ctx context.Context, req provider.AIRequest) (*provider.
 		TextContent: finalResponse,
 	}, nil
 }
+
+// ChatStream produces the same response as Chat, delivered as a sequence of
+// word-sized deltas whose concatenation is exactly Chat's TextContent.
+func (p *Provider) ChatStream(ctx context.Context, req provider.AIRequest) (<-chan provider.StreamChunk, error) {
+	resp, err := p.Chat(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	out := make(chan provider.StreamChunk)
+	go func() {
+		defer close(out)
+		for _, word := range strings.SplitAfter(resp.TextContent, " ") {
+			if word == "" {
+				continue
+			}
+			select {
+			case out <- provider.StreamChunk{Delta: word}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
@@ -1,6 +1,6 @@
 // NeuroScript Version: 0.7.0
-// File version: 6
-// Purpose: Updated tests to confirm the provider correctly ignores prepended text and finds the final valid envelope in the prompt.
+// File version: 7
+// Purpose: Adds a test that ChatStream reassembles to exactly the Chat response.
 // filename: pkg/provider/test/test_test.go
 // nlines: 100
 // risk_rating: LOW
@@ -123,3 +123,33 @@ func TestTestProvider_WrapResponseInAEIOU(t *testing.T) {
 		t.Error("ACTIONS section is missing the 'command' keyword.")
 	}
 }
+
+// TestTestProvider_ChatStream verifies that the streamed chunks concatenate
+// to exactly the non-streaming response.
+func TestTestProvider_ChatStream(t *testing.T) {
+	p := New()
+	ctx := context.Background()
+	env := &aeiou.Envelope{UserData: "what is a large language model?", Actions: "command endcommand"}
+	prompt, _ := env.Compose()
+	req := provider.AIRequest{Prompt: prompt}
+
+	want, err := p.Chat(ctx, req)
+	if err != nil {
+		t.Fatalf("Chat failed: %v", err)
+	}
+	stream, err := p.ChatStream(ctx, req)
+	if err != nil {
+		t.Fatalf("ChatStream failed: %v", err)
+	}
+	chunks := 0
+	got, err := provider.Collect(ctx, stream, func(string) { chunks++ })
+	if err != nil {
+		t.Fatalf("Collect failed: %v", err)
+	}
+	if got.TextContent != want.TextContent {
+		t.Errorf("streamed text differs from Chat.\nGot:  %q\nWant: %q", got.TextContent, want.TextContent)
+	}
+	if chunks < 2 {
+		t.Errorf("expected the response in several chunks, got %d", chunks)
+	}
+}
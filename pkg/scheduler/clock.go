@@ -0,0 +1,139 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: The Clock abstraction used by the scheduler: the system clock, and a fake clock that tests advance by hand.
+// filename: pkg/scheduler/clock.go
+// nlines: 139
+// risk_rating: MEDIUM
+
+package scheduler
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock tells the time and runs functions later.
+type Clock interface {
+	Now() time.Time
+	// AfterFunc calls f once d has elapsed. The returned Timer can stop it.
+	AfterFunc(d time.Duration, f func()) Timer
+}
+
+// Timer is a pending AfterFunc call.
+type Timer interface {
+	// Stop prevents the call if it has not happened yet and reports whether
+	// it did so.
+	Stop() bool
+}
+
+type systemClock struct{}
+
+// SystemClock returns the Clock backed by the time package.
+func SystemClock() Clock { return systemClock{} }
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+func (systemClock) AfterFunc(d time.Duration, f func()) Timer { return time.AfterFunc(d, f) }
+
+// FakeClock is a Clock that only moves when Advance or Set is called. Due
+// functions run on the caller's goroutine, earliest first, so tests see every
+// firing before Advance returns.
+type FakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	seq     uint64
+	waiting []*fakeTimer
+}
+
+type fakeTimer struct {
+	clock *FakeClock
+	at    time.Time
+	seq   uint64 // breaks ties between timers due at the same instant
+	f     func()
+}
+
+// NewFakeClock returns a FakeClock reading start.
+func NewFakeClock(start time.Time) *FakeClock {
+	return &FakeClock{now: start}
+}
+
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *FakeClock) AfterFunc(d time.Duration, f func()) Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.seq++
+	t := &fakeTimer{clock: c, at: c.now.Add(d), seq: c.seq, f: f}
+	c.waiting = append(c.waiting, t)
+	return t
+}
+
+func (t *fakeTimer) Stop() bool {
+	c := t.clock
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for idx, w := range c.waiting {
+		if w == t {
+			c.waiting = append(c.waiting[:idx], c.waiting[idx+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Advance moves the clock forward by d, running every function that falls
+// due on the way at the moment it is due. Functions scheduled by those
+// functions run too if they fall due before the new time.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.Set(c.Now().Add(d))
+}
+
+// Set moves the clock to t, as Advance does. Setting an earlier time only
+// changes what Now reports.
+func (c *FakeClock) Set(t time.Time) {
+	for {
+		c.mu.Lock()
+		next := c.popDueLocked(t)
+		if next == nil {
+			c.now = t
+			c.mu.Unlock()
+			return
+		}
+		if next.at.After(c.now) {
+			c.now = next.at
+		}
+		c.mu.Unlock()
+		next.f()
+	}
+}
+
+// popDueLocked removes and returns the earliest timer due by t, or nil.
+func (c *FakeClock) popDueLocked(t time.Time) *fakeTimer {
+	if len(c.waiting) == 0 {
+		return nil
+	}
+	sort.Slice(c.waiting, func(a, b int) bool {
+		if !c.waiting[a].at.Equal(c.waiting[b].at) {
+			return c.waiting[a].at.Before(c.waiting[b].at)
+		}
+		return c.waiting[a].seq < c.waiting[b].seq
+	})
+	if c.waiting[0].at.After(t) {
+		return nil
+	}
+	next := c.waiting[0]
+	c.waiting = c.waiting[1:]
+	return next
+}
+
+// Pending reports how many functions are waiting to run.
+func (c *FakeClock) Pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.waiting)
+}
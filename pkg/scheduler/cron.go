@@ -0,0 +1,183 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Parses standard five-field cron expressions and computes their next firing time.
+// filename: pkg/scheduler/cron.go
+// nlines: 183
+// risk_rating: MEDIUM
+
+package scheduler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cron is a parsed cron expression: minute, hour, day of month, month and
+// day of week, as in crontab(5).
+type Cron struct {
+	expr              string
+	minute, hour, dom uint64
+	month, dow        uint64
+	domStar, dowStar  bool // an unrestricted field does not take part in the day match
+}
+
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var (
+	monthNames = []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+	dayNames   = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+)
+
+// ParseCron parses a five-field cron expression. Fields accept '*', numbers,
+// ranges 'a-b', steps '*/n' and 'a-b/n', and comma-separated lists; months
+// and weekdays also accept three-letter names, and Sunday is 0 or 7. The
+// descriptors @yearly, @monthly, @weekly, @daily and @hourly are understood.
+func ParseCron(expr string) (*Cron, error) {
+	spec := strings.TrimSpace(expr)
+	if d, ok := cronDescriptors[strings.ToLower(spec)]; ok {
+		spec = d
+	}
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("%w '%s': expected 5 fields, got %d", ErrInvalidCron, expr, len(fields))
+	}
+	c := &Cron{expr: expr}
+	var err error
+	if c.minute, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return nil, fmt.Errorf("%w '%s': minute: %v", ErrInvalidCron, expr, err)
+	}
+	if c.hour, err = parseCronField(fields[1], 0, 23, nil); err != nil {
+		return nil, fmt.Errorf("%w '%s': hour: %v", ErrInvalidCron, expr, err)
+	}
+	if c.dom, err = parseCronField(fields[2], 1, 31, nil); err != nil {
+		return nil, fmt.Errorf("%w '%s': day of month: %v", ErrInvalidCron, expr, err)
+	}
+	if c.month, err = parseCronField(fields[3], 1, 12, monthNames); err != nil {
+		return nil, fmt.Errorf("%w '%s': month: %v", ErrInvalidCron, expr, err)
+	}
+	if c.dow, err = parseCronField(fields[4], 0, 7, dayNames); err != nil {
+		return nil, fmt.Errorf("%w '%s': day of week: %v", ErrInvalidCron, expr, err)
+	}
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1 // 7 is another name for Sunday
+	}
+	c.domStar = strings.HasPrefix(fields[2], "*")
+	c.dowStar = strings.HasPrefix(fields[4], "*")
+	return c, nil
+}
+
+// String returns the expression as it was given.
+func (c *Cron) String() string { return c.expr }
+
+// parseCronField returns the set of values a field allows as a bit mask.
+// names, if given, spell the values from min upwards.
+func parseCronField(field string, min, max int, names []string) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rng, step := part, 1
+		if slash := strings.IndexByte(part, '/'); slash >= 0 {
+			rng = part[:slash]
+			n, err := strconv.Atoi(part[slash+1:])
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("bad step in '%s'", part)
+			}
+			step = n
+		}
+		lo, hi := min, max
+		switch {
+		case rng == "*":
+		case strings.Contains(rng, "-"):
+			dash := strings.IndexByte(rng, '-')
+			var err error
+			if lo, err = cronValue(rng[:dash], min, max, names); err != nil {
+				return 0, err
+			}
+			if hi, err = cronValue(rng[dash+1:], min, max, names); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("empty range '%s'", rng)
+			}
+		default:
+			v, err := cronValue(rng, min, max, names)
+			if err != nil {
+				return 0, err
+			}
+			lo = v
+			if step == 1 {
+				hi = v
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func cronValue(s string, min, max int, names []string) (int, error) {
+	for idx, name := range names {
+		if strings.EqualFold(s, name) {
+			return min + idx, nil
+		}
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("'%s' is not a number", s)
+	}
+	if v < min || v > max {
+		return 0, fmt.Errorf("%d is outside %d-%d", v, min, max)
+	}
+	return v, nil
+}
+
+// maxCronSearch bounds the search for the next firing so impossible
+// expressions such as "0 0 31 2 *" give up instead of looping.
+const maxCronSearch = 5 * 366 * 24 * time.Hour
+
+// Next returns the first minute strictly after t that the expression
+// matches, in t's location, or the zero time if there is none.
+func (c *Cron) Next(t time.Time) time.Time {
+	limit := t.Add(maxCronSearch)
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	for t.Before(limit) {
+		switch {
+		case c.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !c.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case c.hour&(1<<uint(t.Hour())) == 0:
+			next := time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			if !next.After(t) {
+				next = t.Add(time.Hour) // the repeated hour when clocks go back
+			}
+			t = next
+		case c.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// dayMatches applies cron's day rule: when both day fields are restricted,
+// either may match.
+func (c *Cron) dayMatches(t time.Time) bool {
+	domOK := c.dom&(1<<uint(t.Day())) != 0
+	dowOK := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return domOK && dowOK
+	}
+	return domOK || dowOK
+}
@@ -0,0 +1,74 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Tests cron expression parsing and next-firing computation.
+// filename: pkg/scheduler/cron_test.go
+// nlines: 74
+// risk_rating: LOW
+
+package scheduler
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCron_Next(t *testing.T) {
+	// 2026-03-14 is a Saturday.
+	from := time.Date(2026, 3, 14, 10, 7, 30, 0, time.UTC)
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2026, 3, 14, 10, 8, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2026, 3, 14, 10, 15, 0, 0, time.UTC)},
+		{"5 * * * *", time.Date(2026, 3, 14, 11, 5, 0, 0, time.UTC)},
+		{"0 9-17/4 * * *", time.Date(2026, 3, 14, 13, 0, 0, 0, time.UTC)},
+		{"30 8 * * mon-fri", time.Date(2026, 3, 16, 8, 30, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1,15 * *", time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{"0 12 1 * 1", time.Date(2026, 3, 16, 12, 0, 0, 0, time.UTC)}, // day of month OR day of week
+		{"0 0 29 feb *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2026, 3, 14, 11, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range tests {
+		c, err := ParseCron(tc.expr)
+		if err != nil {
+			t.Errorf("ParseCron(%q): %v", tc.expr, err)
+			continue
+		}
+		if got := c.Next(from); !got.Equal(tc.want) {
+			t.Errorf("%q: next after %s is %s, want %s", tc.expr, from, got, tc.want)
+		}
+	}
+}
+
+func TestCron_NextIsStrictlyLater(t *testing.T) {
+	c, err := ParseCron("0 * * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	on := time.Date(2026, 1, 1, 5, 0, 0, 0, time.UTC)
+	if got, want := c.Next(on), on.Add(time.Hour); !got.Equal(want) {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestCron_NeverMatches(t *testing.T) {
+	c, err := ParseCron("0 0 31 2 *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := c.Next(time.Now()); !got.IsZero() {
+		t.Errorf("got %s, want the zero time", got)
+	}
+}
+
+func TestParseCron_Errors(t *testing.T) {
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "*/0 * * * *", "5-1 * * * *", "x * * * *", "@often"} {
+		if _, err := ParseCron(expr); !errors.Is(err, ErrInvalidCron) {
+			t.Errorf("ParseCron(%q): got %v, want ErrInvalidCron", expr, err)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Defines sentinel errors for the timer scheduler.
+// filename: pkg/scheduler/errors.go
+// nlines: 17
+// risk_rating: LOW
+
+package scheduler
+
+import "errors"
+
+var (
+	ErrStopped       = errors.New("scheduler is stopped")
+	ErrInvalidSpec   = errors.New("invalid timer specification")
+	ErrInvalidCron   = errors.New("invalid cron expression")
+	ErrTooManyTimers = errors.New("too many active timers")
+)
@@ -0,0 +1,306 @@
+// NeuroScript Version: 0.8.0
+// File version: 2
+// Purpose: Runs one-shot, interval and cron timers that fire named events through a callback.
+// filename: pkg/scheduler/scheduler.go
+// nlines: 306
+// risk_rating: HIGH
+
+// Package scheduler keeps the timers behind 'tool.timer.Schedule'. Each timer
+// names an event; when it falls due the scheduler hands the event to a
+// FireFunc, which the interpreter uses to feed its EventManager.
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aprice2704/neuroscript/pkg/lang"
+)
+
+// Kind says how a timer repeats.
+type Kind string
+
+const (
+	KindAfter Kind = "after" // fires once, after a delay
+	KindEvery Kind = "every" // fires at a fixed interval
+	KindCron  Kind = "cron"  // fires whenever a cron expression matches
+)
+
+// DefaultMaxTimers bounds the active timers of a zero Config.
+const DefaultMaxTimers = 1000
+
+// DefaultMinInterval is the shortest 'every' interval a zero Config accepts,
+// so a script cannot schedule a timer that floods the event bus.
+const DefaultMinInterval = time.Second
+
+// Spec describes a timer to schedule.
+type Spec struct {
+	// ID names the timer. Scheduling an ID that is already active replaces
+	// that timer; an empty ID is assigned one.
+	ID      string
+	Event   string
+	Kind    Kind
+	Delay   time.Duration // KindAfter: the wait; KindEvery: the interval
+	Cron    string        // KindCron: the expression
+	Payload lang.Value    // may be nil
+}
+
+// Info describes an active timer, or one firing.
+type Info struct {
+	ID      string
+	Event   string
+	Kind    Kind
+	Spec    string    // the delay, interval or cron expression as text
+	Next    time.Time // when the timer fires next; while firing, when it was due
+	Fired   uint64
+	Skipped uint64 // firings missed because the previous one was still running
+}
+
+// FireFunc delivers a due timer's event.
+type FireFunc func(info Info, payload lang.Value)
+
+// Config configures a Scheduler. The zero value uses the system clock.
+type Config struct {
+	Clock     Clock
+	MaxTimers int
+	// MinInterval is the shortest interval a KindEvery timer may use.
+	// Zero means DefaultMinInterval.
+	MinInterval time.Duration
+}
+
+// Scheduler owns a set of timers. Firings are delivered one at a time.
+type Scheduler struct {
+	clock       Clock
+	maxTimers   int
+	minInterval time.Duration
+	fire        FireFunc
+	fireMu      sync.Mutex // serialises calls to fire
+	inflight    sync.WaitGroup
+
+	mu      sync.Mutex
+	timers  map[string]*entry
+	nextID  uint64
+	stopped bool
+}
+
+type entry struct {
+	info    Info
+	every   time.Duration
+	cron    *Cron
+	payload lang.Value
+	timer   Timer
+	firing  bool
+}
+
+// New returns a running Scheduler that calls fire for every due timer.
+func New(cfg Config, fire FireFunc) *Scheduler {
+	if cfg.Clock == nil {
+		cfg.Clock = SystemClock()
+	}
+	if cfg.MaxTimers <= 0 {
+		cfg.MaxTimers = DefaultMaxTimers
+	}
+	if cfg.MinInterval <= 0 {
+		cfg.MinInterval = DefaultMinInterval
+	}
+	return &Scheduler{
+		clock:       cfg.Clock,
+		maxTimers:   cfg.MaxTimers,
+		minInterval: cfg.MinInterval,
+		fire:        fire,
+		timers:      make(map[string]*entry),
+	}
+}
+
+// Clock returns the clock the scheduler runs on.
+func (s *Scheduler) Clock() Clock { return s.clock }
+
+// Schedule adds a timer and returns its description.
+func (s *Scheduler) Schedule(spec Spec) (Info, error) {
+	if spec.Event == "" {
+		return Info{}, fmt.Errorf("%w: an event name is required", ErrInvalidSpec)
+	}
+	e := &entry{
+		info:    Info{ID: spec.ID, Event: spec.Event, Kind: spec.Kind},
+		payload: spec.Payload,
+	}
+	switch spec.Kind {
+	case KindAfter:
+		if spec.Delay < 0 {
+			return Info{}, fmt.Errorf("%w: delay cannot be negative, got %s", ErrInvalidSpec, spec.Delay)
+		}
+		e.info.Spec = spec.Delay.String()
+	case KindEvery:
+		if spec.Delay <= 0 {
+			return Info{}, fmt.Errorf("%w: interval must be positive, got %s", ErrInvalidSpec, spec.Delay)
+		}
+		if spec.Delay < s.minInterval {
+			return Info{}, fmt.Errorf("%w: interval %s is below the minimum of %s", ErrInvalidSpec, spec.Delay, s.minInterval)
+		}
+		e.every = spec.Delay
+		e.info.Spec = spec.Delay.String()
+	case KindCron:
+		c, err := ParseCron(spec.Cron)
+		if err != nil {
+			return Info{}, err
+		}
+		e.cron = c
+		e.info.Spec = c.String()
+	default:
+		return Info{}, fmt.Errorf("%w: unknown kind '%s'", ErrInvalidSpec, spec.Kind)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stopped {
+		return Info{}, ErrStopped
+	}
+	old := s.timers[e.info.ID]
+	if old == nil && len(s.timers) >= s.maxTimers {
+		return Info{}, fmt.Errorf("%w: the limit is %d", ErrTooManyTimers, s.maxTimers)
+	}
+
+	now := s.clock.Now()
+	switch e.info.Kind {
+	case KindAfter:
+		e.info.Next = now.Add(spec.Delay)
+	case KindEvery:
+		e.info.Next = now.Add(e.every)
+	case KindCron:
+		if e.info.Next = e.cron.Next(now); e.info.Next.IsZero() {
+			return Info{}, fmt.Errorf("%w '%s': it never matches", ErrInvalidCron, spec.Cron)
+		}
+	}
+	if e.info.ID == "" {
+		s.nextID++
+		e.info.ID = fmt.Sprintf("timer-%d", s.nextID)
+	}
+	if old != nil {
+		old.timer.Stop()
+	}
+	s.timers[e.info.ID] = e
+	s.armLocked(e, now)
+	return e.info, nil
+}
+
+func (s *Scheduler) armLocked(e *entry, now time.Time) {
+	e.timer = s.clock.AfterFunc(e.info.Next.Sub(now), func() { s.fireEntry(e) })
+}
+
+// fireEntry runs when e falls due. The next firing is armed before the
+// event is delivered, so a handler may cancel the timer that woke it.
+func (s *Scheduler) fireEntry(e *entry) {
+	s.mu.Lock()
+	if s.stopped || s.timers[e.info.ID] != e {
+		s.mu.Unlock()
+		return
+	}
+	now := s.clock.Now()
+	info := e.info
+	skip := e.firing
+	if skip {
+		e.info.Skipped++
+	} else {
+		e.info.Fired++
+		info.Fired = e.info.Fired
+		e.firing = true
+	}
+	switch e.info.Kind {
+	case KindAfter:
+		delete(s.timers, e.info.ID)
+	case KindEvery:
+		next := e.info.Next.Add(e.every)
+		if !next.After(now) {
+			next = now.Add(e.every) // fell behind; do not fire a burst to catch up
+		}
+		e.info.Next = next
+		s.armLocked(e, now)
+	case KindCron:
+		if e.info.Next = e.cron.Next(now); e.info.Next.IsZero() {
+			delete(s.timers, e.info.ID)
+		} else {
+			s.armLocked(e, now)
+		}
+	}
+	if skip {
+		s.mu.Unlock()
+		return
+	}
+	s.inflight.Add(1)
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		e.firing = false
+		s.mu.Unlock()
+		s.inflight.Done()
+	}()
+	s.fireMu.Lock()
+	defer s.fireMu.Unlock()
+	s.fire(info, e.payload)
+}
+
+// Cancel removes a timer and reports whether it was active.
+func (s *Scheduler) Cancel(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.timers[id]
+	if !ok {
+		return false
+	}
+	e.timer.Stop()
+	delete(s.timers, id)
+	return true
+}
+
+// List describes the active timers, soonest first.
+func (s *Scheduler) List() []Info {
+	s.mu.Lock()
+	infos := make([]Info, 0, len(s.timers))
+	for _, e := range s.timers {
+		infos = append(infos, e.info)
+	}
+	s.mu.Unlock()
+	sort.Slice(infos, func(a, b int) bool {
+		if !infos[a].Next.Equal(infos[b].Next) {
+			return infos[a].Next.Before(infos[b].Next)
+		}
+		return infos[a].ID < infos[b].ID
+	})
+	return infos
+}
+
+// Stop cancels every timer and refuses new ones, then waits for firings
+// already under way. It returns ctx.Err() if ctx ends first. Stop must not
+// be called from a handler the scheduler is firing.
+func (s *Scheduler) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	s.stopped = true
+	for id, e := range s.timers {
+		e.timer.Stop()
+		delete(s.timers, id)
+	}
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.inflight.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Stopped reports whether Stop has been called.
+func (s *Scheduler) Stopped() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stopped
+}
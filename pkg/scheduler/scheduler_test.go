@@ -0,0 +1,192 @@
+// NeuroScript Version: 0.8.0
+// File version: 2
+// Purpose: Tests one-shot, interval and cron timers on a fake clock, together with cancel, replace and stop.
+// filename: pkg/scheduler/scheduler_test.go
+// nlines: 192
+// risk_rating: LOW
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aprice2704/neuroscript/pkg/lang"
+)
+
+var epoch = time.Date(2026, 3, 14, 10, 0, 0, 0, time.UTC)
+
+type firing struct {
+	info Info
+	at   time.Time
+}
+
+func newTestScheduler(t *testing.T, maxTimers int) (*Scheduler, *FakeClock, *[]firing) {
+	t.Helper()
+	clock := NewFakeClock(epoch)
+	var fired []firing
+	s := New(Config{Clock: clock, MaxTimers: maxTimers}, func(info Info, payload lang.Value) {
+		fired = append(fired, firing{info: info, at: clock.Now()})
+	})
+	return s, clock, &fired
+}
+
+func mustSchedule(t *testing.T, s *Scheduler, spec Spec) Info {
+	t.Helper()
+	info, err := s.Schedule(spec)
+	if err != nil {
+		t.Fatalf("Schedule(%+v): %v", spec, err)
+	}
+	return info
+}
+
+func TestScheduler_After(t *testing.T) {
+	s, clock, fired := newTestScheduler(t, 0)
+	info := mustSchedule(t, s, Spec{Event: "ping", Kind: KindAfter, Delay: 5 * time.Second})
+	if info.ID != "timer-1" || !info.Next.Equal(epoch.Add(5*time.Second)) {
+		t.Fatalf("unexpected info %+v", info)
+	}
+
+	clock.Advance(4 * time.Second)
+	if len(*fired) != 0 {
+		t.Fatalf("fired early: %+v", *fired)
+	}
+	clock.Advance(time.Second)
+	if len(*fired) != 1 || (*fired)[0].info.Event != "ping" || (*fired)[0].info.Fired != 1 {
+		t.Fatalf("unexpected firings %+v", *fired)
+	}
+	if timers := s.List(); len(timers) != 0 {
+		t.Errorf("a one-shot timer is still listed: %+v", timers)
+	}
+}
+
+func TestScheduler_Every(t *testing.T) {
+	s, clock, fired := newTestScheduler(t, 0)
+	mustSchedule(t, s, Spec{Event: "poll", Kind: KindEvery, Delay: time.Minute})
+	clock.Advance(3*time.Minute + 30*time.Second)
+
+	var got []string
+	for _, f := range *fired {
+		got = append(got, fmt.Sprintf("%d@%s", f.info.Fired, f.at.Format("15:04:05")))
+	}
+	if want := "[1@10:01:00 2@10:02:00 3@10:03:00]"; fmt.Sprint(got) != want {
+		t.Errorf("got firings %s, want %s", got, want)
+	}
+	timers := s.List()
+	if len(timers) != 1 || !timers[0].Next.Equal(epoch.Add(4*time.Minute)) || timers[0].Fired != 3 {
+		t.Errorf("unexpected timer state %+v", timers)
+	}
+}
+
+func TestScheduler_Cron(t *testing.T) {
+	s, clock, fired := newTestScheduler(t, 0)
+	mustSchedule(t, s, Spec{Event: "report", Kind: KindCron, Cron: "*/20 * * * *"})
+	clock.Advance(time.Hour)
+	var got []string
+	for _, f := range *fired {
+		got = append(got, f.at.Format("15:04"))
+	}
+	if want := "[10:20 10:40 11:00]"; fmt.Sprint(got) != want {
+		t.Errorf("got firings %s, want %s", got, want)
+	}
+}
+
+func TestScheduler_CancelFromFire(t *testing.T) {
+	clock := NewFakeClock(epoch)
+	var s *Scheduler
+	count := 0
+	s = New(Config{Clock: clock}, func(info Info, payload lang.Value) {
+		if count++; count == 2 {
+			s.Cancel(info.ID)
+		}
+	})
+	mustSchedule(t, s, Spec{Event: "poll", Kind: KindEvery, Delay: time.Second})
+	clock.Advance(10 * time.Second)
+	if count != 2 {
+		t.Errorf("fired %d times, want 2", count)
+	}
+	if clock.Pending() != 0 {
+		t.Errorf("a cancelled timer left %d clock callbacks", clock.Pending())
+	}
+}
+
+func TestScheduler_ReplaceByID(t *testing.T) {
+	s, clock, fired := newTestScheduler(t, 0)
+	mustSchedule(t, s, Spec{ID: "poll", Event: "old", Kind: KindEvery, Delay: time.Second})
+	mustSchedule(t, s, Spec{ID: "poll", Event: "new", Kind: KindEvery, Delay: time.Minute})
+	clock.Advance(time.Minute)
+	if len(*fired) != 1 || (*fired)[0].info.Event != "new" || (*fired)[0].info.ID != "poll" {
+		t.Errorf("unexpected firings %+v", *fired)
+	}
+	if len(s.List()) != 1 {
+		t.Errorf("got %d timers, want 1", len(s.List()))
+	}
+}
+
+func TestScheduler_Invalid(t *testing.T) {
+	s, _, _ := newTestScheduler(t, 1)
+	bad := []Spec{
+		{Kind: KindAfter, Delay: time.Second},
+		{Event: "e", Kind: KindAfter, Delay: -time.Second},
+		{Event: "e", Kind: KindEvery},
+		{Event: "e", Kind: KindEvery, Delay: time.Millisecond}, // below DefaultMinInterval
+		{Event: "e", Kind: "sometimes"},
+	}
+	for _, spec := range bad {
+		if _, err := s.Schedule(spec); !errors.Is(err, ErrInvalidSpec) {
+			t.Errorf("Schedule(%+v): got %v, want ErrInvalidSpec", spec, err)
+		}
+	}
+	if _, err := s.Schedule(Spec{Event: "e", Kind: KindCron, Cron: "0 0 30 2 *"}); !errors.Is(err, ErrInvalidCron) {
+		t.Errorf("got %v, want ErrInvalidCron for a cron that never matches", err)
+	}
+
+	mustSchedule(t, s, Spec{Event: "e", Kind: KindAfter, Delay: time.Second})
+	if _, err := s.Schedule(Spec{Event: "e", Kind: KindAfter, Delay: time.Second}); !errors.Is(err, ErrTooManyTimers) {
+		t.Errorf("got %v, want ErrTooManyTimers", err)
+	}
+}
+
+func TestScheduler_Stop(t *testing.T) {
+	s, clock, fired := newTestScheduler(t, 0)
+	mustSchedule(t, s, Spec{Event: "poll", Kind: KindEvery, Delay: time.Second})
+	if err := s.Stop(context.Background()); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+	clock.Advance(time.Minute)
+	if len(*fired) != 0 {
+		t.Errorf("a stopped scheduler fired %+v", *fired)
+	}
+	if _, err := s.Schedule(Spec{Event: "poll", Kind: KindAfter}); !errors.Is(err, ErrStopped) {
+		t.Errorf("got %v, want ErrStopped", err)
+	}
+	if !s.Stopped() {
+		t.Error("Stopped() = false after Stop")
+	}
+}
+
+func TestScheduler_SkipsWhileFiring(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{}, 1)
+	s := New(Config{MinInterval: time.Millisecond}, func(info Info, payload lang.Value) {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-release
+	})
+	mustSchedule(t, s, Spec{Event: "slow", Kind: KindEvery, Delay: 5 * time.Millisecond})
+	<-started
+	time.Sleep(30 * time.Millisecond) // several intervals pass while the first firing runs
+	timers := s.List()
+	close(release)
+	if err := s.Stop(context.Background()); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+	if len(timers) != 1 || timers[0].Fired != 1 || timers[0].Skipped == 0 {
+		t.Errorf("got %+v, want one firing and some skipped", timers)
+	}
+}
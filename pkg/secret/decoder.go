@@ -1,38 +1,129 @@
-// NeuroScript Version: 0.5.2
-// File version: 1
-// Purpose: Defines the secret decoding logic stub.
+// NeuroScript Version: 0.8.0
+// File version: 2
+// Purpose: Defines the secret decoding logic. Implements 'age' (X25519) and 'sealedbox' (NaCl anonymous box) decryption.
 // filename: pkg/secret/decoder.go
-// nlines: 30
+// nlines: 95
 // risk_rating: HIGH
 
 package secret
 
 import (
+	"bytes"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"io"
+	"strings"
 
+	"filippo.io/age"
 	"github.com/aprice2704/neuroscript/pkg/ast"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/nacl/box"
 )
 
-// ErrSecretUnsupported is returned when the encryption type of a secret is not supported.
-var ErrSecretUnsupported = errors.New("unsupported secret encryption type")
+// Supported values for SecretRef.Enc.
+const (
+	EncNone      = "none"
+	EncAge       = "age"
+	EncSealedBox = "sealedbox"
+)
+
+var (
+	// ErrSecretUnsupported is returned when the encryption type of a secret is not supported.
+	ErrSecretUnsupported = errors.New("unsupported secret encryption type")
+	// ErrSecretKey is returned when the key needed to decrypt a secret is missing or malformed.
+	ErrSecretKey = errors.New("invalid or missing secret key")
+	// ErrSecretDecrypt is returned when a payload cannot be decrypted with the supplied key.
+	ErrSecretDecrypt = errors.New("secret decryption failed")
+)
 
 // Decode decrypts the value of a secret reference based on its encryption type.
-// For now, it only supports unencrypted ("none") secrets.
-// The `priv` argument is a placeholder for the private key needed for decryption.
+//   - "none":      Raw is the secret itself; priv is ignored.
+//   - "age":       priv holds one or more age X25519 identities (AGE-SECRET-KEY-1...),
+//     in the same format as an age key file.
+//   - "sealedbox": priv is a 32-byte Curve25519 private key, either raw or base64 encoded.
+//
+// Errors never include the payload or the key material.
 func Decode(ref *ast.SecretRef, priv []byte) (string, error) {
 	if ref == nil {
 		return "", fmt.Errorf("cannot decode a nil secret reference")
 	}
 
 	switch ref.Enc {
-	case "none":
+	case EncNone:
 		// For "none", the raw value is the secret itself.
 		return string(ref.Raw), nil
-	case "age", "sealedbox":
-		// These are placeholders for future implementation.
-		return "", fmt.Errorf("%w: %s", ErrSecretUnsupported, ref.Enc)
+	case EncAge:
+		return decodeAge(ref, priv)
+	case EncSealedBox:
+		return decodeSealedBox(ref, priv)
 	default:
 		return "", fmt.Errorf("%w: '%s'", ErrSecretUnsupported, ref.Enc)
 	}
 }
+
+func decodeAge(ref *ast.SecretRef, priv []byte) (string, error) {
+	if len(bytes.TrimSpace(priv)) == 0 {
+		return "", fmt.Errorf("%w: no age identity supplied for secret %q", ErrSecretKey, ref.Path)
+	}
+	identities, err := age.ParseIdentities(bytes.NewReader(priv))
+	if err != nil {
+		return "", fmt.Errorf("%w: cannot parse age identity for secret %q", ErrSecretKey, ref.Path)
+	}
+	r, err := age.Decrypt(bytes.NewReader(ref.Raw), identities...)
+	if err != nil {
+		return "", fmt.Errorf("%w: age: secret %q: %v", ErrSecretDecrypt, ref.Path, err)
+	}
+	plain, err := io.ReadAll(r)
+	if err != nil {
+		return "", fmt.Errorf("%w: age: secret %q: %v", ErrSecretDecrypt, ref.Path, err)
+	}
+	return string(plain), nil
+}
+
+func decodeSealedBox(ref *ast.SecretRef, priv []byte) (string, error) {
+	privKey, err := parseBoxKey(priv)
+	if err != nil {
+		return "", fmt.Errorf("%w: sealedbox private key for secret %q: %v", ErrSecretKey, ref.Path, err)
+	}
+	pubBytes, err := curve25519.X25519(privKey[:], curve25519.Basepoint)
+	if err != nil {
+		return "", fmt.Errorf("%w: cannot derive sealedbox public key for secret %q", ErrSecretKey, ref.Path)
+	}
+	var pubKey [32]byte
+	copy(pubKey[:], pubBytes)
+
+	plain, ok := box.OpenAnonymous(nil, ref.Raw, &pubKey, privKey)
+	if !ok {
+		return "", fmt.Errorf("%w: sealedbox: secret %q", ErrSecretDecrypt, ref.Path)
+	}
+	return string(plain), nil
+}
+
+// parseBoxKey accepts a Curve25519 key as 32 raw bytes or as standard base64
+// text. In text form, blank lines and lines starting with '#' are ignored, so
+// the output of 'ng secret keygen' can be used as a key file directly.
+func parseBoxKey(b []byte) (*[32]byte, error) {
+	var key [32]byte
+	if len(b) == 32 {
+		copy(key[:], b)
+		return &key, nil
+	}
+	var text string
+	for _, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") {
+			text = line
+			break
+		}
+	}
+	decoded, err := base64.StdEncoding.DecodeString(text)
+	if err != nil {
+		return nil, errors.New("key is neither 32 raw bytes nor base64")
+	}
+	if len(decoded) != 32 {
+		return nil, fmt.Errorf("key must be 32 bytes, got %d", len(decoded))
+	}
+	copy(key[:], decoded)
+	return &key, nil
+}
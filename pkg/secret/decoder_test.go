@@ -1,6 +1,6 @@
-// NeuroScript Version: 0.5.2
-// File version: 1
-// Purpose: Provides tests for the secret decoding logic.
+// NeuroScript Version: 0.8.0
+// File version: 2
+// Purpose: Provides tests for the secret decoding logic, including age and sealedbox round trips.
 // filename: pkg/secret/decoder_test.go
 // nlines: 60
 // risk_rating: HIGH
@@ -11,6 +11,7 @@ import (
 	"errors"
 	"testing"
 
+	"filippo.io/age"
 	"github.com/aprice2704/neuroscript/pkg/ast"
 )
 
@@ -31,33 +32,60 @@ func TestDecode(t *testing.T) {
 		}
 	})
 
-	t.Run("unsupported encryption type 'age'", func(t *testing.T) {
-		ref := &ast.SecretRef{
-			Enc: "age",
-			Raw: []byte("some-encrypted-data"),
+	t.Run("round trip for 'age' encryption", func(t *testing.T) {
+		id, err := age.GenerateX25519Identity()
+		if err != nil {
+			t.Fatalf("GenerateX25519Identity() failed: %v", err)
+		}
+		raw, err := Encrypt(EncAge, id.Recipient().String(), []byte("age-secret"))
+		if err != nil {
+			t.Fatalf("Encrypt() with 'age' failed: %v", err)
 		}
+		ref := &ast.SecretRef{Path: "prod/key", Enc: EncAge, Raw: raw}
 
-		_, err := Decode(ref, nil)
-		if err == nil {
-			t.Fatal("Expected an error for unsupported encryption type 'age', but got nil")
+		decoded, err := Decode(ref, []byte(id.String()+"\n"))
+		if err != nil {
+			t.Fatalf("Decode() with 'age' encryption failed unexpectedly: %v", err)
 		}
-		if !errors.Is(err, ErrSecretUnsupported) {
-			t.Errorf("Expected error to be ErrSecretUnsupported, but got: %v", err)
+		if decoded != "age-secret" {
+			t.Errorf("Expected decoded secret to be 'age-secret', but got '%s'", decoded)
+		}
+
+		other, _ := age.GenerateX25519Identity()
+		if _, err := Decode(ref, []byte(other.String())); !errors.Is(err, ErrSecretDecrypt) {
+			t.Errorf("Expected ErrSecretDecrypt with the wrong identity, but got: %v", err)
+		}
+		if _, err := Decode(ref, nil); !errors.Is(err, ErrSecretKey) {
+			t.Errorf("Expected ErrSecretKey with no identity, but got: %v", err)
 		}
 	})
 
-	t.Run("unsupported encryption type 'sealedbox'", func(t *testing.T) {
-		ref := &ast.SecretRef{
-			Enc: "sealedbox",
-			Raw: []byte("some-other-encrypted-data"),
+	t.Run("round trip for 'sealedbox' encryption", func(t *testing.T) {
+		pub, priv, err := GenerateSealedBoxKey()
+		if err != nil {
+			t.Fatalf("GenerateSealedBoxKey() failed: %v", err)
+		}
+		raw, err := Encrypt(EncSealedBox, pub, []byte("box-secret"))
+		if err != nil {
+			t.Fatalf("Encrypt() with 'sealedbox' failed: %v", err)
 		}
+		ref := &ast.SecretRef{Path: "prod/key", Enc: EncSealedBox, Raw: raw}
 
-		_, err := Decode(ref, nil)
-		if err == nil {
-			t.Fatal("Expected an error for unsupported encryption type 'sealedbox', but got nil")
+		keyFile := "# public key: " + pub + "\n" + priv + "\n"
+		decoded, err := Decode(ref, []byte(keyFile))
+		if err != nil {
+			t.Fatalf("Decode() with 'sealedbox' encryption failed unexpectedly: %v", err)
 		}
-		if !errors.Is(err, ErrSecretUnsupported) {
-			t.Errorf("Expected error to be ErrSecretUnsupported, but got: %v", err)
+		if decoded != "box-secret" {
+			t.Errorf("Expected decoded secret to be 'box-secret', but got '%s'", decoded)
+		}
+
+		_, otherPriv, _ := GenerateSealedBoxKey()
+		if _, err := Decode(ref, []byte(otherPriv)); !errors.Is(err, ErrSecretDecrypt) {
+			t.Errorf("Expected ErrSecretDecrypt with the wrong key, but got: %v", err)
+		}
+		if _, err := Decode(ref, []byte("short")); !errors.Is(err, ErrSecretKey) {
+			t.Errorf("Expected ErrSecretKey with a malformed key, but got: %v", err)
 		}
 	})
 
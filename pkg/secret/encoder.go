@@ -0,0 +1,107 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Encrypts secret payloads for 'age' and 'sealedbox' and converts SecretRefs to and from their text form.
+// filename: pkg/secret/encoder.go
+// nlines: 120
+// risk_rating: HIGH
+
+package secret
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"filippo.io/age"
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"golang.org/x/crypto/nacl/box"
+)
+
+// RefPrefix starts the text form of a secret reference:
+//
+//	nssecret:<enc>:<path>:<base64 payload>
+//
+// The path may contain ':'; the payload is always the last field.
+const RefPrefix = "nssecret:"
+
+// ErrMalformedRef is returned by ParseRef for text that is not a valid secret reference.
+var ErrMalformedRef = errors.New("malformed secret reference")
+
+// Encrypt produces the Raw payload of a SecretRef for the given encryption type.
+//   - "none":      plaintext is stored as-is; recipient is ignored.
+//   - "age":       recipient is an age X25519 recipient (age1...).
+//   - "sealedbox": recipient is a base64 Curve25519 public key.
+func Encrypt(enc, recipient string, plaintext []byte) ([]byte, error) {
+	switch enc {
+	case EncNone:
+		return append([]byte(nil), plaintext...), nil
+	case EncAge:
+		rcpt, err := age.ParseX25519Recipient(strings.TrimSpace(recipient))
+		if err != nil {
+			return nil, fmt.Errorf("%w: cannot parse age recipient: %v", ErrSecretKey, err)
+		}
+		var out bytes.Buffer
+		w, err := age.Encrypt(&out, rcpt)
+		if err != nil {
+			return nil, fmt.Errorf("age encrypt: %w", err)
+		}
+		if _, err := w.Write(plaintext); err != nil {
+			return nil, fmt.Errorf("age encrypt: %w", err)
+		}
+		if err := w.Close(); err != nil {
+			return nil, fmt.Errorf("age encrypt: %w", err)
+		}
+		return out.Bytes(), nil
+	case EncSealedBox:
+		pub, err := parseBoxKey([]byte(recipient))
+		if err != nil {
+			return nil, fmt.Errorf("%w: sealedbox public key: %v", ErrSecretKey, err)
+		}
+		sealed, err := box.SealAnonymous(nil, plaintext, pub, rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("sealedbox encrypt: %w", err)
+		}
+		return sealed, nil
+	default:
+		return nil, fmt.Errorf("%w: '%s'", ErrSecretUnsupported, enc)
+	}
+}
+
+// GenerateSealedBoxKey creates a new Curve25519 key pair for 'sealedbox'
+// secrets, returning both halves base64 encoded.
+func GenerateSealedBoxKey() (publicKey, privateKey string, err error) {
+	pub, priv, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+	return base64.StdEncoding.EncodeToString(pub[:]), base64.StdEncoding.EncodeToString(priv[:]), nil
+}
+
+// FormatRef renders a secret reference in its text form.
+func FormatRef(ref *ast.SecretRef) string {
+	return RefPrefix + ref.Enc + ":" + ref.Path + ":" + base64.StdEncoding.EncodeToString(ref.Raw)
+}
+
+// ParseRef parses the text form produced by FormatRef.
+func ParseRef(s string) (*ast.SecretRef, error) {
+	rest, ok := strings.CutPrefix(strings.TrimSpace(s), RefPrefix)
+	if !ok {
+		return nil, fmt.Errorf("%w: missing %q prefix", ErrMalformedRef, RefPrefix)
+	}
+	enc, rest, ok := strings.Cut(rest, ":")
+	if !ok || enc == "" {
+		return nil, fmt.Errorf("%w: missing encryption type", ErrMalformedRef)
+	}
+	idx := strings.LastIndex(rest, ":")
+	if idx <= 0 {
+		return nil, fmt.Errorf("%w: missing path or payload", ErrMalformedRef)
+	}
+	raw, err := base64.StdEncoding.DecodeString(rest[idx+1:])
+	if err != nil {
+		return nil, fmt.Errorf("%w: payload is not base64", ErrMalformedRef)
+	}
+	return &ast.SecretRef{Path: rest[:idx], Enc: enc, Raw: raw}, nil
+}
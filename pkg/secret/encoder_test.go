@@ -0,0 +1,83 @@
+// NeuroScript Version: 0.8.0
+// File version: 4
+// Purpose: Provides tests for secret reference formatting and redaction.
+// filename: pkg/secret/encoder_test.go
+// nlines: 83
+// risk_rating: HIGH
+
+package secret
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/ast"
+)
+
+func TestRefText(t *testing.T) {
+	ref := &ast.SecretRef{Path: "prod:db/main", Enc: EncSealedBox, Raw: []byte{0, 1, 2, 250}}
+	parsed, err := ParseRef(FormatRef(ref))
+	if err != nil {
+		t.Fatalf("ParseRef(FormatRef()) failed: %v", err)
+	}
+	if parsed.Path != ref.Path || parsed.Enc != ref.Enc || string(parsed.Raw) != string(ref.Raw) {
+		t.Errorf("Round trip mismatch: got %+v, want %+v", parsed, ref)
+	}
+
+	for _, bad := range []string{"", "secret:age:p:AAAA", "nssecret:age", "nssecret:age:AAAA", "nssecret:age:p:!!"} {
+		if _, err := ParseRef(bad); !errors.Is(err, ErrMalformedRef) {
+			t.Errorf("ParseRef(%q): expected ErrMalformedRef, got %v", bad, err)
+		}
+	}
+}
+
+func TestRedactor(t *testing.T) {
+	var nilR *Redactor
+	nilR.Add("abc") // must not panic
+	if got := nilR.Redact("abc"); got != "abc" || !nilR.Empty() {
+		t.Errorf("nil Redactor should pass text through, got %q", got)
+	}
+
+	r := NewRedactor()
+	r.Add("")
+	if !r.Empty() {
+		t.Fatal("Empty values must not be registered")
+	}
+	r.Add("hunter2")
+	r.Add("hunter2-extended")
+	got := r.Redact("a=hunter2-extended b=hunter2")
+	if want := "a=" + Redacted + " b=" + Redacted; got != want {
+		t.Errorf("Redact() = %q, want %q", got, want)
+	}
+}
+
+func TestRedactError(t *testing.T) {
+	r := NewRedactor()
+	r.Add("hunter2")
+	sentinel := errors.New("bad password hunter2")
+
+	err := RedactError(sentinel, r.Redact)
+	if err.Error() != "bad password "+Redacted || !errors.Is(err, sentinel) {
+		t.Errorf("RedactError() = %v; want a scrubbed message wrapping the original", err)
+	}
+	clean := errors.New("nothing secret")
+	if RedactError(clean, r.Redact) != clean || RedactError(nil, r.Redact) != nil {
+		t.Error("RedactError must return errors without secrets unchanged")
+	}
+}
+
+func TestRedactorHoldback(t *testing.T) {
+	r := NewRedactor()
+	r.Add("hunter2")
+	r.Add("hush")
+	cases := map[string]int{"": 0, "abc": 0, "say hu": 2, "say hunt": 4, "say hus": 3, "say hunter2": 0, "h": 1}
+	for in, want := range cases {
+		if got := r.Holdback(in); got != want {
+			t.Errorf("Holdback(%q) = %d, want %d", in, got, want)
+		}
+	}
+	var nilR *Redactor
+	if nilR.Holdback("hu") != 0 {
+		t.Error("a nil Redactor must hold nothing back")
+	}
+}
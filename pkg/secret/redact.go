@@ -0,0 +1,118 @@
+// NeuroScript Version: 0.8.0
+// File version: 4
+// Purpose: Tracks decrypted secret values so they can be scrubbed from emit output, transcripts, logs and errors.
+// filename: pkg/secret/redact.go
+// nlines: 118
+// risk_rating: HIGH
+
+package secret
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Redacted replaces every occurrence of a revealed secret.
+const Redacted = "[REDACTED]"
+
+// Redactor remembers every secret value revealed during a run. It is safe for
+// concurrent use, and a nil Redactor redacts nothing.
+type Redactor struct {
+	mu     sync.RWMutex
+	values map[string]struct{}
+}
+
+// NewRedactor returns an empty Redactor.
+func NewRedactor() *Redactor {
+	return &Redactor{values: make(map[string]struct{})}
+}
+
+// Add registers a revealed value. Empty values are ignored, and so is every
+// value added to a nil Redactor; callers that must not leak a value check
+// for a Redactor before revealing it.
+func (r *Redactor) Add(v string) {
+	if r == nil || v == "" {
+		return
+	}
+	r.mu.Lock()
+	r.values[v] = struct{}{}
+	r.mu.Unlock()
+}
+
+// Empty reports whether no values have been registered.
+func (r *Redactor) Empty() bool {
+	if r == nil {
+		return true
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.values) == 0
+}
+
+// Redact returns s with every registered value replaced by Redacted.
+// Longer values are replaced first so that a secret containing another
+// secret is scrubbed in full.
+func (r *Redactor) Redact(s string) string {
+	if r == nil {
+		return s
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if len(r.values) == 0 || s == "" {
+		return s
+	}
+	vals := make([]string, 0, len(r.values))
+	for v := range r.values {
+		vals = append(vals, v)
+	}
+	sort.Slice(vals, func(a, b int) bool { return len(vals[a]) > len(vals[b]) })
+	for _, v := range vals {
+		s = strings.ReplaceAll(s, v, Redacted)
+	}
+	return s
+}
+
+// Holdback returns the length of the longest suffix of s that is the start,
+// but not the whole, of a registered value. Text streamed out in pieces keeps
+// that suffix back until the next piece shows whether a secret follows.
+func (r *Redactor) Holdback(s string) int {
+	if r == nil {
+		return 0
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	hold := 0
+	for v := range r.values {
+		for n := min(len(v)-1, len(s)); n > hold; n-- {
+			if strings.HasSuffix(s, v[:n]) {
+				hold = n
+				break
+			}
+		}
+	}
+	return hold
+}
+
+// RedactedError presents a scrubbed message while keeping the original error
+// reachable for errors.Is and errors.As.
+type RedactedError struct {
+	Msg string
+	Err error
+}
+
+func (e *RedactedError) Error() string { return e.Msg }
+func (e *RedactedError) Unwrap() error { return e.Err }
+
+// RedactError returns err with its message passed through redact, or err
+// itself when nothing needed removing.
+func RedactError(err error, redact func(string) string) error {
+	if err == nil || redact == nil {
+		return err
+	}
+	msg := err.Error()
+	if red := redact(msg); red != msg {
+		return &RedactedError{Msg: red, Err: err}
+	}
+	return err
+}
@@ -1,5 +1,5 @@
 // NeuroScript Version: 0.3.0
-// File version: 6
+// File version: 7
 // Purpose: Implements the Select tool. Correctly handles both string and array-form paths.
 // filename: pkg/tool/ai/select.go
 // nlines: 81
@@ -83,7 +83,9 @@ func buildPathFromArray(arr []interface{}) (json_lite.Path, error) {
 				return nil, fmt.Errorf("%w: path segment cannot be an empty string", lang.ErrInvalidArgument)
 			}
 			p = append(p, json_lite.PathSegment{Key: v, IsKey: true})
-		case float64: // Numbers from NeuroScript will be float64
+		case int64: // Integer literals from NeuroScript
+			p = append(p, json_lite.PathSegment{Index: int(v), IsKey: false})
+		case float64:
 			p = append(p, json_lite.PathSegment{Index: int(v), IsKey: false})
 		case int: // Also handle int for robustness
 			p = append(p, json_lite.PathSegment{Index: v, IsKey: false})
@@ -1,5 +1,5 @@
 // NeuroScript Version: 0.3.1
-// File version: 0.0.4 // Corrected lang.NewRuntimeError calls with standard ErrorCodes/Sentinels.
+// File version: 0.0.5 // Meter bytes hashed against the policy's FS limit.
 // nlines: 89
 // risk_rating: LOW
 // filename: pkg/tool/fs/tools_fs_hash.go
@@ -87,6 +87,10 @@ func toolFileHash(interpreter tool.Runtime, args []interface{}) (interface{}, er
 		return "", lang.NewRuntimeError(lang.ErrorCodePathTypeMismatch, errMsg, lang.ErrPathNotFile)
 	}
 
+	if err := tool.MeterFSBytes(interpreter, stat.Size()); err != nil {
+		return "", err
+	}
+
 	// Hash the file content
 	hasher := sha256.New()
 	_, copyErr := io.Copy(hasher, file)
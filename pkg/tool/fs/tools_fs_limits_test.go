@@ -0,0 +1,37 @@
+// filename: pkg/tool/fs/tools_fs_limits_test.go
+package fs_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/capability"
+	"github.com/aprice2704/neuroscript/pkg/tool/fs"
+	"github.com/aprice2704/neuroscript/pkg/types"
+)
+
+func TestFsByteLimits(t *testing.T) {
+	interp := newFsTestInterpreter(t)
+	interp.ExecPolicy.Grants.Limits.FSMaxBytes = 10
+
+	write, _ := interp.ToolRegistry().GetTool(types.MakeFullName(fs.Group, "Write"))
+	read, _ := interp.ToolRegistry().GetTool(types.MakeFullName(fs.Group, "Read"))
+
+	if _, err := write.Func(interp, []interface{}{"a.txt", "123456"}); err != nil {
+		t.Fatalf("first write should fit within the limit: %v", err)
+	}
+	if _, err := write.Func(interp, []interface{}{"b.txt", "123456"}); !errors.Is(err, capability.ErrFSExceeded) {
+		t.Fatalf("second write: expected ErrFSExceeded, got %v", err)
+	}
+	if _, statErr := os.Stat(filepath.Join(interp.SandboxDir(), "b.txt")); !os.IsNotExist(statErr) {
+		t.Error("an over-limit write must not create the file")
+	}
+	if _, err := read.Func(interp, []interface{}{"a.txt"}); !errors.Is(err, capability.ErrFSExceeded) {
+		t.Fatalf("read: expected ErrFSExceeded, got %v", err)
+	}
+	if got := interp.Usage().FSBytes; got != 6 {
+		t.Errorf("FSBytes = %d, want 6", got)
+	}
+}
@@ -1,5 +1,5 @@
 // NeuroScript Version: 0.3.1
-// File version: 0.0.3 // Add explicit empty path check. Handle "is a directory" error.
+// File version: 0.0.4 // Meter bytes read against the policy's FS limit.
 // nlines: 70 // Approximate
 // risk_rating: MEDIUM
 // filename: pkg/tool/fs/tools_fs_read.go
@@ -75,6 +75,10 @@ func toolReadFile(interpreter tool.Runtime, args []interface{}) (interface{}, er
 		return "", lang.NewRuntimeError(lang.ErrorCodeIOFailed, errMsg, errors.Join(lang.ErrIOFailed, err))
 	}
 
+	if err := tool.MeterFSBytes(interpreter, int64(len(contentBytes))); err != nil {
+		return "", err
+	}
+
 	// Success
 	content := string(contentBytes)
 	// interpreter.GetLogger().Debug("Tool: ReadFile successful", "file_path", relPath, "bytes_read", len(contentBytes))
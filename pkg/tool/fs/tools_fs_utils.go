@@ -1,5 +1,5 @@
 // NeuroScript Version: 0.3.1
-// File version: 0.0.4 // Corrected lang.NewRuntimeError calls with standard ErrorCodes/Sentinels.
+// File version: 0.0.5 // Meter bytes read against the policy's FS limit.
 // nlines: 77
 // risk_rating: LOW
 // filename: pkg/tool/fs/tools_fs_utils.go
@@ -57,6 +57,9 @@ func toolLineCountFile(interpreter tool.Runtime, args []interface{}) (interface{
 		// For other I/O errors, use ErrorCodeIOFailed and wrap the specific error
 		return int64(-1), lang.NewRuntimeError(lang.ErrorCodeIOFailed, fmt.Sprintf("LineCountFile: error reading file '%s'", filePath), errors.Join(lang.ErrIOFailed, readErr))
 	}
+	if err := tool.MeterFSBytes(interpreter, int64(len(contentBytes))); err != nil {
+		return int64(-1), err
+	}
 
 	content := string(contentBytes)
 	if len(content) == 0 {
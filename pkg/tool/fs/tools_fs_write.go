@@ -1,6 +1,6 @@
 // NeuroScript Version: 0.4.0
-// File version: 7
-// Purpose: Added toolAppendFile and a shared writeFileHelper to implement FS.Append functionality. Corrected error handling for writing to a directory.
+// File version: 8
+// Purpose: Added toolAppendFile and a shared writeFileHelper to implement FS.Append functionality. Meters bytes written against the policy's FS limit.
 // nlines: 105
 // risk_rating: MEDIUM
 // filename: pkg/tool/fs/tools_fs_write.go
@@ -53,6 +53,11 @@ func writeFileHelper(interpreter tool.Runtime, args []interface{}, append bool)
 		return nil, lang.NewRuntimeError(lang.ErrorCodeIOFailed, fmt.Sprintf("failed to create parent directory for '%s'", relPath), errors.Join(lang.ErrCannotCreateDir, err))
 	}
 
+	// Meter before touching the file so an over-limit write has no effect.
+	if err := tool.MeterFSBytes(interpreter, int64(len(content))); err != nil {
+		return nil, err
+	}
+
 	var file *os.File
 	var err error
 
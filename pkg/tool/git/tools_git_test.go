@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 0
-// :: fileVersion: 13
+// :: fileVersion: 14
 // :: description: Tests for the Git toolset. Removed manual registration to fix duplicate key errors.
-// :: latestChange: Removed manual registration loop in newGitTestInterpreter and switched to blank import.
+// :: latestChange: Added a test that Pull and Push count against the policy's network call limit.
 // :: filename: pkg/tool/git/tools_git_test.go
 // :: serialization: go
 package git_test
@@ -15,9 +15,11 @@ import (
 	"testing"
 
 	"github.com/aprice2704/neuroscript/pkg/api/testharness"
+	"github.com/aprice2704/neuroscript/pkg/capability"
 	"github.com/aprice2704/neuroscript/pkg/interpreter"
 	"github.com/aprice2704/neuroscript/pkg/lang"
 	"github.com/aprice2704/neuroscript/pkg/logging"
+	"github.com/aprice2704/neuroscript/pkg/policy"
 	"github.com/aprice2704/neuroscript/pkg/testutil"
 	"github.com/aprice2704/neuroscript/pkg/tool"
 	_ "github.com/aprice2704/neuroscript/pkg/tool/git" // Import for side-effects (registration)
@@ -278,3 +280,27 @@ func TestToolGitResetValidation(t *testing.T) {
 		wantToolErrIs: lang.ErrArgumentMismatch,
 	})
 }
+
+func TestToolGitNetworkCallsAreMetered(t *testing.T) {
+	execPolicy := policy.NewBuilder(policy.ContextConfig).
+		Allow("tool.git.*").
+		Grant("fs:read,write:*").
+		Grant("net:read,write:*").
+		Grant("shell:execute:git").
+		LimitNet(1, 0).
+		Build()
+	hc := testharness.NewTestHostContext(logging.NewTestLogger(t))
+	interp := interpreter.NewInterpreter(interpreter.WithHostContext(hc), testutil.NewTestSandbox(t), interpreter.WithExecPolicy(execPolicy))
+	setupGitRepo(t, interp.SandboxDir())
+
+	args := []lang.Value{lang.StringValue{Value: "."}}
+	// The repository has no remote, so the first pull fails in git, but it
+	// has still used the only network call the policy allows.
+	_, _ = interp.ToolRegistry().CallFromInterpreter(interp, "tool.git.Pull", args)
+	if _, err := interp.ToolRegistry().CallFromInterpreter(interp, "tool.git.Push", args); !errors.Is(err, capability.ErrNetExceeded) {
+		t.Fatalf("second network call: expected ErrNetExceeded, got %v", err)
+	}
+	if usage := interp.GetGrantSet().Snapshot(); usage.NetCalls != 1 {
+		t.Errorf("NetCalls = %d, want 1", usage.NetCalls)
+	}
+}
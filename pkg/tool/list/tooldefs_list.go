@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.3.1
-// File version: 0.1.4
-// Purpose: Added AppendInPlace tool specification.
+// File version: 0.1.5
+// Purpose: Added the higher-order Map, Filter, Reduce and SortBy tool specifications.
 // filename: pkg/tool/list/tooldefs_list.go
-// nlines: 167
+// nlines: 241
 // risk_rating: MEDIUM
 
 package list
@@ -181,4 +181,61 @@ var listToolsToRegister = []tool.ToolImplementation{
 		Example:         `tool.List.IsEmpty([]) // returns true\ntool.List.IsEmpty([1]) // returns false`,
 		ErrorConditions: "None expected.",
 	}, Func: toolListIsEmpty},
+	{Spec: tool.ToolSpec{
+		Name:        "Map",
+		Group:       group,
+		Description: "Returns a *new* list holding the result of calling a function on each element.",
+		Category:    "List Operations",
+		Args: []tool.ArgSpec{
+			{Name: "list", Type: tool.ArgTypeSliceAny, Required: true, Description: "The list to map over."},
+			{Name: "fn", Type: tool.ArgTypeAny, Required: true, Description: "A function of one argument, usually a function literal."},
+		},
+		ReturnType:      tool.ArgTypeSliceAny,
+		ReturnHelp:      "Returns a new list of the same length where each element is fn(element). The original list is not modified.",
+		Example:         `tool.List.Map([1, 2, 3], func(x) means x * 2) // returns [2, 4, 6]`,
+		ErrorConditions: "Returns ErrArgumentMismatch if 'fn' is not a function. Any error raised by 'fn' stops the map and is returned.",
+	}, Func: toolListMap},
+	{Spec: tool.ToolSpec{
+		Name:        "Filter",
+		Group:       group,
+		Description: "Returns a *new* list holding the elements for which a function returns a truthy value.",
+		Category:    "List Operations",
+		Args: []tool.ArgSpec{
+			{Name: "list", Type: tool.ArgTypeSliceAny, Required: true, Description: "The list to filter."},
+			{Name: "fn", Type: tool.ArgTypeAny, Required: true, Description: "A predicate function of one argument."},
+		},
+		ReturnType:      tool.ArgTypeSliceAny,
+		ReturnHelp:      "Returns a new list of the elements for which fn(element) is truthy, in their original order. The original list is not modified.",
+		Example:         `tool.List.Filter([1, 2, 3, 4], func(x) means x % 2 == 0) // returns [2, 4]`,
+		ErrorConditions: "Returns ErrArgumentMismatch if 'fn' is not a function. Any error raised by 'fn' stops the filter and is returned.",
+	}, Func: toolListFilter},
+	{Spec: tool.ToolSpec{
+		Name:        "Reduce",
+		Group:       group,
+		Description: "Folds a list into a single value by calling a function with the running result and each element in turn.",
+		Category:    "List Operations",
+		Args: []tool.ArgSpec{
+			{Name: "list", Type: tool.ArgTypeSliceAny, Required: true, Description: "The list to reduce."},
+			{Name: "fn", Type: tool.ArgTypeAny, Required: true, Description: "A function of two arguments: the running result and the element."},
+			{Name: "initial", Type: tool.ArgTypeAny, Required: true, Description: "The starting value of the running result."},
+		},
+		ReturnType:      tool.ArgTypeAny,
+		ReturnHelp:      "Returns the final running result. For an empty list this is 'initial'.",
+		Example:         `tool.List.Reduce([1, 2, 3], func(acc, x) means acc + x, 0) // returns 6`,
+		ErrorConditions: "Returns ErrArgumentMismatch if 'fn' is not a function. Any error raised by 'fn' stops the reduction and is returned.",
+	}, Func: toolListReduce},
+	{Spec: tool.ToolSpec{
+		Name:        "SortBy",
+		Group:       group,
+		Description: "Returns a *new* list sorted by the key a function returns for each element. The sort is stable.",
+		Category:    "List Operations",
+		Args: []tool.ArgSpec{
+			{Name: "list", Type: tool.ArgTypeSliceAny, Required: true, Description: "The list to sort."},
+			{Name: "fn", Type: tool.ArgTypeAny, Required: true, Description: "A function of one argument returning a number or string key."},
+		},
+		ReturnType:      tool.ArgTypeSliceAny,
+		ReturnHelp:      "Returns a new list ordered by ascending key; elements with equal keys keep their original order. The original list is not modified.",
+		Example:         `tool.List.SortBy(people, func(p) means p["age"])`,
+		ErrorConditions: "Returns ErrArgumentMismatch if 'fn' is not a function, and ErrListCannotSortMixedTypes if the keys are not all numbers or all strings.",
+	}, Func: toolListSortBy},
 }
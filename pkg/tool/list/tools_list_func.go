@@ -0,0 +1,174 @@
+// NeuroScript Version: 0.8.0
+// File version: 2
+// Purpose: Higher-order list tools (Map, Filter, Reduce, SortBy) that call back into script functions.
+// filename: pkg/tool/list/tools_list_func.go
+// nlines: 174
+// risk_rating: MEDIUM
+
+package list
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/tool"
+)
+
+// functionRuntime is a local interface matching the interpreter method that
+// calls a script function value. This avoids importing pkg/interpreter.
+type functionRuntime interface {
+	CallFunction(fn lang.Callable, args []lang.Value) (lang.Value, error)
+}
+
+// callbackArgs checks the list and function arguments shared by the
+// higher-order tools and returns a caller for the function.
+func callbackArgs(rt tool.Runtime, toolName string, args []interface{}) ([]interface{}, func(...interface{}) (lang.Value, error), error) {
+	list, ok := args[0].([]interface{})
+	if !ok {
+		return nil, nil, lang.NewRuntimeError(lang.ErrorCodeArgMismatch, fmt.Sprintf("%s expects a list for the first argument", toolName), lang.ErrArgumentMismatch)
+	}
+	fn, ok := args[1].(lang.Callable)
+	if !ok || fn == nil {
+		return nil, nil, lang.NewRuntimeError(lang.ErrorCodeArgMismatch, fmt.Sprintf("%s expects a function for the second argument, got %T", toolName, args[1]), lang.ErrArgumentMismatch)
+	}
+	fr, ok := rt.(functionRuntime)
+	if !ok {
+		if wrapper, isWrapper := rt.(tool.Wrapper); isWrapper {
+			fr, ok = wrapper.Unwrap().(functionRuntime)
+		}
+	}
+	if !ok {
+		return nil, nil, lang.NewRuntimeError(lang.ErrorCodeNotImplemented, fmt.Sprintf("%s: this runtime cannot call function values", toolName), lang.ErrFeatureNotImplemented)
+	}
+	call := func(raw ...interface{}) (lang.Value, error) {
+		vals := make([]lang.Value, len(raw))
+		for i, r := range raw {
+			v, err := lang.Wrap(r)
+			if err != nil {
+				return nil, fmt.Errorf("%s: wrapping argument: %w", toolName, err)
+			}
+			vals[i] = v
+		}
+		return fr.CallFunction(fn, vals)
+	}
+	return list, call, nil
+}
+
+func toolListMap(rt tool.Runtime, args []interface{}) (interface{}, error) {
+	list, call, err := callbackArgs(rt, "Map", args)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]interface{}, len(list))
+	for i, elem := range list {
+		v, err := call(elem)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = lang.Unwrap(v)
+	}
+	return out, nil
+}
+
+func toolListFilter(rt tool.Runtime, args []interface{}) (interface{}, error) {
+	list, call, err := callbackArgs(rt, "Filter", args)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]interface{}, 0, len(list))
+	for _, elem := range list {
+		v, err := call(elem)
+		if err != nil {
+			return nil, err
+		}
+		if v != nil && v.IsTruthy() {
+			out = append(out, elem)
+		}
+	}
+	return out, nil
+}
+
+func toolListReduce(rt tool.Runtime, args []interface{}) (interface{}, error) {
+	list, call, err := callbackArgs(rt, "Reduce", args)
+	if err != nil {
+		return nil, err
+	}
+	acc := args[2]
+	for _, elem := range list {
+		v, err := call(acc, elem)
+		if err != nil {
+			return nil, err
+		}
+		acc = lang.Unwrap(v)
+	}
+	return acc, nil
+}
+
+// toolListSortBy sorts by the key the function returns for each element.
+// Keys must be all numbers or all strings; the sort is stable.
+func toolListSortBy(rt tool.Runtime, args []interface{}) (interface{}, error) {
+	list, call, err := callbackArgs(rt, "SortBy", args)
+	if err != nil {
+		return nil, err
+	}
+	type keyed struct {
+		elem  interface{}
+		num   float64
+		isInt bool
+		int   int64
+		str   string
+	}
+	items := make([]keyed, len(list))
+	keyType := ""
+	for i, elem := range list {
+		v, err := call(elem)
+		if err != nil {
+			return nil, err
+		}
+		items[i].elem = elem
+		thisType := ""
+		switch k := lang.Unwrap(v).(type) {
+		case string:
+			thisType, items[i].str = "string", k
+		case int64:
+			thisType, items[i].isInt, items[i].int = "number", true, k
+		default:
+			f, ok := lang.ToFloat64(k)
+			if !ok {
+				return nil, lang.NewRuntimeError(lang.ErrorCodeType, fmt.Sprintf("SortBy: sort key must be a number or string, got %T", k), lang.ErrListCannotSortMixedTypes)
+			}
+			thisType, items[i].num = "number", f
+		}
+		if keyType != "" && keyType != thisType {
+			return nil, lang.NewRuntimeError(lang.ErrorCodeType, "SortBy: cannot sort by mixed string and number keys", lang.ErrListCannotSortMixedTypes)
+		}
+		keyType = thisType
+	}
+	// Integer keys are compared exactly, against each other and against
+	// floats, rather than being rounded to float64.
+	numLess := func(a, b keyed) bool {
+		switch {
+		case a.isInt && b.isInt:
+			return a.int < b.int
+		case a.isInt:
+			c, _ := lang.CompareIntFloat(a.int, b.num)
+			return c < 0
+		case b.isInt:
+			c, _ := lang.CompareIntFloat(b.int, a.num)
+			return c > 0
+		}
+		return a.num < b.num
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		if keyType == "string" {
+			return items[i].str < items[j].str
+		}
+		return numLess(items[i], items[j])
+	})
+	out := make([]interface{}, len(items))
+	for i, it := range items {
+		out[i] = it.elem
+	}
+	return out, nil
+}
@@ -0,0 +1,86 @@
+// NeuroScript Version: 0.8.0
+// File version: 2
+// Purpose: Tests the higher-order list tools with function literals built by the interpreter.
+// filename: pkg/tool/list/tools_list_func_test.go
+// nlines: 86
+// risk_rating: LOW
+
+package list
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/interpreter"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/logging"
+)
+
+// lambda returns the callable for 'func(params) means body'.
+func lambda(t *testing.T, body ast.Expression, params ...string) lang.Callable {
+	t.Helper()
+	hostCtx := &interpreter.HostContext{
+		Logger: logging.NewTestLogger(t),
+		Stdout: &bytes.Buffer{},
+		Stdin:  &bytes.Buffer{},
+		Stderr: &bytes.Buffer{},
+	}
+	fv, err := interpreter.NewInterpreter(interpreter.WithHostContext(hostCtx)).NewClosure(&ast.LambdaNode{Params: params, Body: body})
+	if err != nil {
+		t.Fatalf("NewClosure: %v", err)
+	}
+	return fv.(lang.FunctionValue).Value
+}
+
+func binOp(op string, left, right ast.Expression) ast.Expression {
+	return &ast.BinaryOpNode{Left: left, Operator: op, Right: right}
+}
+
+func v(name string) ast.Expression { return &ast.VariableNode{Name: name} }
+
+func num(n float64) ast.Expression { return &ast.NumberLiteralNode{Value: n} }
+
+func TestToolListMap(t *testing.T) {
+	double := lambda(t, binOp("*", v("x"), num(2)), "x")
+	testListTool(t, "Map", []testCase{
+		{Name: "Map_Double", Args: []interface{}{[]interface{}{float64(1), float64(2)}, double}, Expected: []interface{}{float64(2), float64(4)}},
+		{Name: "Map_Empty", Args: []interface{}{[]interface{}{}, double}, Expected: []interface{}{}},
+		{Name: "Map_Not_A_Function", Args: []interface{}{[]interface{}{float64(1)}, "double"}, ExpectedErrIs: lang.ErrArgumentMismatch},
+		{Name: "Map_Wrong_Arity", Args: []interface{}{[]interface{}{float64(1)}, lambda(t, v("a"), "a", "b")}, ExpectedErrIs: lang.ErrArgumentMismatch},
+	})
+}
+
+func TestToolListFilter(t *testing.T) {
+	even := lambda(t, binOp("==", binOp("%", v("x"), num(2)), num(0)), "x")
+	testListTool(t, "Filter", []testCase{
+		{Name: "Filter_Even", Args: []interface{}{[]interface{}{float64(1), float64(2), float64(3), float64(4)}, even}, Expected: []interface{}{float64(2), float64(4)}},
+		{Name: "Filter_None", Args: []interface{}{[]interface{}{float64(1)}, even}, Expected: []interface{}{}},
+		{Name: "Filter_Not_A_List", Args: []interface{}{"x", even}, ExpectedErrIs: lang.ErrArgumentMismatch},
+	})
+}
+
+func TestToolListReduce(t *testing.T) {
+	sum := lambda(t, binOp("+", v("acc"), v("x")), "acc", "x")
+	testListTool(t, "Reduce", []testCase{
+		{Name: "Reduce_Sum", Args: []interface{}{[]interface{}{float64(1), float64(2), float64(3)}, sum, float64(10)}, Expected: float64(16)},
+		{Name: "Reduce_Empty", Args: []interface{}{[]interface{}{}, sum, "start"}, Expected: "start"},
+		{Name: "Reduce_Concat", Args: []interface{}{[]interface{}{"a", "b"}, sum, ""}, Expected: "ab"},
+	})
+}
+
+func TestToolListSortBy(t *testing.T) {
+	age := lambda(t, &ast.ElementAccessNode{Collection: v("p"), Accessor: &ast.StringLiteralNode{Value: "age"}}, "p")
+	ann := map[string]interface{}{"name": "ann", "age": float64(40)}
+	bob := map[string]interface{}{"name": "bob", "age": float64(30)}
+	cat := map[string]interface{}{"name": "cat", "age": float64(40)}
+	identity := lambda(t, v("x"), "x")
+	testListTool(t, "SortBy", []testCase{
+		{Name: "SortBy_Stable", Args: []interface{}{[]interface{}{ann, bob, cat}, age}, Expected: []interface{}{bob, ann, cat}},
+		{Name: "SortBy_Strings", Args: []interface{}{[]interface{}{"b", "c", "a"}, identity}, Expected: []interface{}{"a", "b", "c"}},
+		{Name: "SortBy_Large_Ints", Args: []interface{}{[]interface{}{int64(1<<53 + 1), int64(1 << 53)}, identity}, Expected: []interface{}{int64(1 << 53), int64(1<<53 + 1)}},
+		{Name: "SortBy_Int_And_Float", Args: []interface{}{[]interface{}{int64(1<<53 + 1), float64(1 << 53), int64(-1)}, identity}, Expected: []interface{}{int64(-1), float64(1 << 53), int64(1<<53 + 1)}},
+		{Name: "SortBy_Mixed_Keys", Args: []interface{}{[]interface{}{"b", float64(1)}, identity}, ExpectedErrIs: lang.ErrListCannotSortMixedTypes},
+		{Name: "SortBy_Bool_Keys", Args: []interface{}{[]interface{}{true, false}, identity}, ExpectedErrIs: lang.ErrListCannotSortMixedTypes},
+	})
+}
@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.3.1
-// File version: 0.1.1
+// File version: 0.1.2
 // Purpose: Populated Category, Example, ReturnHelp, and ErrorConditions for existing math tool specs.
 // filename: pkg/tool/maths/tooldefs_math.go
-// nlines: 80
+// nlines: 122
 // risk_rating: MEDIUM
 
 package maths
@@ -83,6 +83,23 @@ var mathToolsToRegister = []tool.ToolImplementation{
 		},
 		Func: toolDivide, // From tools_math.go
 	},
+	{
+		Spec: tool.ToolSpec{
+			Name:        "IntDiv",
+			Group:       group,
+			Description: "Calculates the integer quotient of two integers (num1 / num2), truncated toward zero. Handles division by zero.",
+			Category:    "Math Operations",
+			Args: []tool.ArgSpec{
+				{Name: "num1", Type: tool.ArgTypeInt, Required: true, Description: "The dividend (must be integer)."},
+				{Name: "num2", Type: tool.ArgTypeInt, Required: true, Description: "The divisor (must be integer)."},
+			},
+			ReturnType:      tool.ArgTypeInt,
+			ReturnHelp:      "Returns num1 / num2 truncated toward zero as an int64. The '/' operator is true division and returns a float when the division is inexact.",
+			Example:         `tool.math.IntDiv(20, 3) // returns 6`,
+			ErrorConditions: "Returns 'ErrDivisionByZero' if num2 is 0, and 'ErrIntegerOverflow' for the minimum int64 divided by -1. Returns an 'ErrInternalTool' if arguments cannot be processed as int64 (should be caught by validation).",
+		},
+		Func: toolIntDiv, // From tools_math.go
+	},
 	{
 		Spec: tool.ToolSpec{
 			Name:        "Modulo",
@@ -1,13 +1,14 @@
 // NeuroScript Version: 0.3.1
-// File version: 0.1.3
+// File version: 0.1.4
 // Return ErrDivisionByZero sentinel directly. Corrected GetLogger check. Made logging more robust.
-// nlines: 65
+// nlines: 115
 // risk_rating: MEDIUM
 // filename: pkg/tool/maths/tools_math.go
 package maths
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/aprice2704/neuroscript/pkg/lang"
 	"github.com/aprice2704/neuroscript/pkg/tool"
@@ -72,6 +73,27 @@ func toolDivide(interpreter tool.Runtime, args []interface{}) (interface{}, erro
 	return result, nil
 }
 
+// toolIntDiv divides integers, truncating toward zero like Go's '/'. The
+// '/' operator is true division; scripts that want the quotient use this.
+func toolIntDiv(interpreter tool.Runtime, args []interface{}) (interface{}, error) {
+	num1, ok1 := args[0].(int64)
+	num2, ok2 := args[1].(int64)
+	if !ok1 || !ok2 {
+		return nil, fmt.Errorf("%w: arguments not int64. Got %T and %T", lang.ErrInternalTool, args[0], args[1])
+	}
+	if num2 == 0 {
+		return nil, lang.ErrDivisionByZero
+	}
+	if num1 == math.MinInt64 && num2 == -1 {
+		return nil, lang.NewRuntimeError(lang.ErrorCodeIntegerOverflow, fmt.Sprintf("integer overflow: IntDiv(%d, %d)", num1, num2), lang.ErrIntegerOverflow)
+	}
+	result := num1 / num2
+	if logger := interpreter.GetLogger(); logger != nil {
+		logger.Debug(fmt.Sprintf("Tool: IntDiv] Calculated %v / %v = %v", num1, num2, result))
+	}
+	return result, nil
+}
+
 func toolModulo(interpreter tool.Runtime, args []interface{}) (interface{}, error) {
 	num1, ok1 := args[0].(int64)
 	num2, ok2 := args[1].(int64)
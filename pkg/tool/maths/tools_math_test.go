@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.4.0
-// File version: 1
+// File version: 2
 // Purpose: Refactored to test primitive-based tool implementations directly.
 // filename: pkg/tool/maths/tools_math_test.go
-// nlines: 110
+// nlines: 186
 // risk_rating: LOW
 
 package maths_test
@@ -162,3 +162,25 @@ func TestToolModulo(t *testing.T) {
 		testMathToolHelper(t, interp, tt)
 	}
 }
+
+func TestToolIntDiv(t *testing.T) {
+	interp, err := testutil.NewTestInterpreter(t, nil)
+	if err != nil {
+		t.Fatalf("NewTestInterpreter failed: %v", err)
+	}
+	tests := []struct {
+		name       string
+		toolName   string
+		args       []interface{}
+		wantResult interface{}
+		wantErrIs  error
+	}{
+		{name: "IntDiv Truncates", toolName: "IntDiv", args: MakeArgs(int64(20), int64(3)), wantResult: int64(6)},
+		{name: "IntDiv Truncates Toward Zero", toolName: "IntDiv", args: MakeArgs(int64(-7), int64(2)), wantResult: int64(-3)},
+		{name: "IntDiv by Zero", toolName: "IntDiv", args: MakeArgs(int64(10), int64(0)), wantErrIs: lang.ErrDivisionByZero},
+		{name: "IntDiv Overflow", toolName: "IntDiv", args: MakeArgs(int64(math.MinInt64), int64(-1)), wantErrIs: lang.ErrIntegerOverflow},
+	}
+	for _, tt := range tests {
+		testMathToolHelper(t, interp, tt)
+	}
+}
@@ -0,0 +1,92 @@
+// NeuroScript Version: 0.8.0
+// File version: 3
+// Purpose: Meters filesystem and network usage of tool calls against the ExecPolicy's limits.
+// filename: pkg/tool/meter.go
+// nlines: 92
+// risk_rating: HIGH
+
+package tool
+
+import (
+	"fmt"
+
+	"github.com/aprice2704/neuroscript/pkg/capability"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/policygate"
+	"github.com/aprice2704/neuroscript/pkg/types"
+)
+
+// meteredResources reports whether a tool's required capabilities touch the
+// filesystem and/or the network. An unscoped shell can reach both, so it
+// counts as both; tools that run one named program (scope "git") declare
+// their fs and net needs themselves.
+func meteredResources(impl ToolImplementation) (fs, net bool) {
+	for _, c := range impl.RequiredCaps {
+		switch c.Resource {
+		case capability.ResFS:
+			fs = true
+		case capability.ResNet:
+			net = true
+		case capability.ResShell:
+			if unscopedShell(c) {
+				fs, net = true, true
+			}
+		}
+	}
+	return fs, net
+}
+
+func unscopedShell(c capability.Capability) bool {
+	if len(c.Scopes) == 0 {
+		return true
+	}
+	for _, s := range c.Scopes {
+		if s == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// meterCall counts one FS and/or network call for a tool that requires those
+// resources, rejecting the call once the policy's call limits are reached.
+// This is how git's clone, pull and push are metered: they run an external
+// process whose traffic is not observable, so only their calls count.
+// FS tools also charge their bytes via MeterFSBytes; network bytes are only
+// metered for provider traffic (llmconn.WithNetMeter). shell.Execute is
+// counted the same way, against both the FS and the network call limits.
+func meterCall(rt policygate.Runtime, impl ToolImplementation) error {
+	p := rt.GetExecPolicy()
+	if p == nil {
+		return nil
+	}
+	fs, net := meteredResources(impl)
+	if fs {
+		if err := p.Grants.CountFS(0); err != nil {
+			return limitError(impl.FullName, err)
+		}
+	}
+	if net {
+		if err := p.Grants.CountNet(0); err != nil {
+			return limitError(impl.FullName, err)
+		}
+	}
+	return nil
+}
+
+// MeterFSBytes charges n filesystem bytes read or written by a tool to the
+// current run. Tools call it before handing data back (reads) or before
+// writing (writes), so an over-limit operation has no effect.
+func MeterFSBytes(rt Runtime, n int64) error {
+	if err := rt.GetGrantSet().AddFSBytes(n); err != nil {
+		return lang.NewRuntimeError(lang.ErrorCodeResourceExhaustion,
+			fmt.Sprintf("filesystem byte limit reached (%d more bytes requested)", n), err)
+	}
+	return nil
+}
+
+// limitError reports a tool call rejected by a policy resource limit.
+func limitError(name types.FullName, err error) error {
+	return lang.NewRuntimeError(lang.ErrorCodeResourceExhaustion,
+		fmt.Sprintf("tool '%s' rejected: %v", name, err), err)
+}
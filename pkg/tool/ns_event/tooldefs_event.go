@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.7.0
-// File version: 5
-// Purpose: Defines the tool specifications for handling and extracting data from event objects.
+// File version: 6
+// Purpose: Defines the tool specifications for handling event objects and for inspecting, replaying and discarding dead letters.
 // filename: pkg/tool/ns_event/tooldefs_event.go
-// nlines: 170
+// nlines: 195
 // risk_rating: MEDIUM
 package ns_event
 
@@ -127,4 +127,69 @@ var EventToolsToRegister = []tool.ToolImplementation{
 		RequiredCaps:  []capability.Capability{},
 		Effects:       []string{"readonly"},
 	},
+	{
+		Spec: tool.ToolSpec{
+			Name:        "ListDeadLetters",
+			Group:       Group,
+			Description: "Lists the events whose handlers failed after any retries, oldest first.",
+			ReturnType:  tool.ArgTypeSliceMap,
+			ReturnHelp: "One map per dead letter with 'id', 'event', 'source', 'handler', 'position', 'error', " +
+				"'error_code', 'attempts', 'first_failed' and 'last_failed' (RFC 3339).",
+			Example: `set letters = tool.ns_event.ListDeadLetters()`,
+		},
+		Func:          toolListDeadLetters,
+		RequiresTrust: false,
+		RequiredCaps:  []capability.Capability{capability.New(capability.ResBus, capability.VerbRead)},
+		Effects:       []string{"readonly"},
+	},
+	{
+		Spec: tool.ToolSpec{
+			Name:        "GetDeadLetter",
+			Group:       Group,
+			Description: "Returns one dead letter, including the event its handler failed on.",
+			Args: []tool.ArgSpec{
+				{Name: "id", Type: tool.ArgTypeString, Description: "The dead letter's ID.", Required: true},
+			},
+			ReturnType: tool.ArgTypeMap,
+			ReturnHelp: "The fields of ListDeadLetters plus 'event_object', the canonical event.",
+			Example:    `set letter = tool.ns_event.GetDeadLetter("dl-1")`,
+		},
+		Func:          toolGetDeadLetter,
+		RequiresTrust: false,
+		RequiredCaps:  []capability.Capability{capability.New(capability.ResBus, capability.VerbRead)},
+		Effects:       []string{"readonly"},
+	},
+	{
+		Spec: tool.ToolSpec{
+			Name:        "ReplayDeadLetter",
+			Group:       Group,
+			Description: "Runs the failed handler of a dead letter again on its event. The dead letter is removed if the handler succeeds.",
+			Args: []tool.ArgSpec{
+				{Name: "id", Type: tool.ArgTypeString, Description: "The dead letter's ID.", Required: true},
+			},
+			ReturnType: tool.ArgTypeBool,
+			ReturnHelp: "True if the handler succeeded; false if it failed again, in which case the dead letter records the new error.",
+			Example:    `set ok = tool.ns_event.ReplayDeadLetter("dl-1")`,
+		},
+		Func:          toolReplayDeadLetter,
+		RequiresTrust: false,
+		RequiredCaps:  []capability.Capability{capability.New(capability.ResBus, capability.VerbAdmin)},
+	},
+	{
+		Spec: tool.ToolSpec{
+			Name:        "DiscardDeadLetter",
+			Group:       Group,
+			Description: "Removes a dead letter without handling its event.",
+			Args: []tool.ArgSpec{
+				{Name: "id", Type: tool.ArgTypeString, Description: "The dead letter's ID.", Required: true},
+			},
+			ReturnType: tool.ArgTypeBool,
+			ReturnHelp: "True if the dead letter existed, false otherwise.",
+			Example:    `call tool.ns_event.DiscardDeadLetter("dl-1")`,
+		},
+		Func:          toolDiscardDeadLetter,
+		RequiresTrust: false,
+		RequiredCaps:  []capability.Capability{capability.New(capability.ResBus, capability.VerbAdmin)},
+		Effects:       []string{"idempotent"},
+	},
 }
@@ -0,0 +1,125 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Implements the dead-letter tools on top of the interpreter's dead-letter store.
+// filename: pkg/tool/ns_event/tools_deadletters.go
+// nlines: 125
+// risk_rating: MEDIUM
+package ns_event
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aprice2704/neuroscript/pkg/interfaces"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/tool"
+)
+
+// deadLetterRuntime defines the interface we expect from the runtime for
+// dead-letter operations.
+type deadLetterRuntime interface {
+	DeadLetters() interfaces.DeadLetterAdmin
+}
+
+func getDeadLetters(rt tool.Runtime) (interfaces.DeadLetterAdmin, error) {
+	interp, ok := rt.(deadLetterRuntime)
+	if !ok {
+		if wrapper, isWrapper := rt.(tool.Wrapper); isWrapper {
+			return getDeadLetters(wrapper.Unwrap())
+		}
+		return nil, lang.NewRuntimeError(lang.ErrorCodeConfiguration, "internal error: runtime does not provide a dead-letter store", lang.ErrConfiguration)
+	}
+	return interp.DeadLetters(), nil
+}
+
+func deadLetterID(toolName string, args []interface{}) (string, error) {
+	if len(args) != 1 {
+		return "", lang.NewRuntimeError(lang.ErrorCodeArgMismatch, fmt.Sprintf("%s: expected 1 argument, got %d", toolName, len(args)), lang.ErrArgumentMismatch)
+	}
+	id, ok := args[0].(string)
+	if !ok || id == "" {
+		return "", lang.NewRuntimeError(lang.ErrorCodeType, "argument 'id' must be a non-empty string", lang.ErrInvalidArgument)
+	}
+	return id, nil
+}
+
+func deadLetterToMap(dl interfaces.DeadLetter) map[string]interface{} {
+	return map[string]interface{}{
+		"id":           dl.ID,
+		"event":        dl.EventName,
+		"source":       dl.Source,
+		"handler":      dl.Handler,
+		"position":     dl.Position,
+		"error":        dl.Error,
+		"error_code":   int64(dl.ErrorCode),
+		"attempts":     int64(dl.Attempts),
+		"first_failed": dl.FirstFailed.Format(time.RFC3339),
+		"last_failed":  dl.LastFailed.Format(time.RFC3339),
+	}
+}
+
+func toolListDeadLetters(rt tool.Runtime, args []interface{}) (interface{}, error) {
+	store, err := getDeadLetters(rt)
+	if err != nil {
+		return nil, err
+	}
+	letters := store.List()
+	list := make([]interface{}, 0, len(letters))
+	for _, dl := range letters {
+		list = append(list, deadLetterToMap(dl))
+	}
+	return list, nil
+}
+
+func toolGetDeadLetter(rt tool.Runtime, args []interface{}) (interface{}, error) {
+	id, err := deadLetterID("GetDeadLetter", args)
+	if err != nil {
+		return nil, err
+	}
+	store, err := getDeadLetters(rt)
+	if err != nil {
+		return nil, err
+	}
+	dl, ok := store.Get(id)
+	if !ok {
+		return nil, lang.NewRuntimeError(lang.ErrorCodeKeyNotFound, fmt.Sprintf("dead letter '%s' not found", id), lang.ErrDeadLetterNotFound)
+	}
+	m := deadLetterToMap(dl)
+	if ev, ok := dl.Event.(lang.Value); ok {
+		m["event_object"] = lang.Unwrap(ev)
+	}
+	return m, nil
+}
+
+func toolReplayDeadLetter(rt tool.Runtime, args []interface{}) (interface{}, error) {
+	id, err := deadLetterID("ReplayDeadLetter", args)
+	if err != nil {
+		return nil, err
+	}
+	store, err := getDeadLetters(rt)
+	if err != nil {
+		return nil, err
+	}
+	if err := store.Replay(id); err != nil {
+		// Errors about the dead letter itself fail the call; a handler that
+		// fails again is reported through the return value.
+		if errors.Is(err, lang.ErrDeadLetterNotFound) || errors.Is(err, lang.ErrDeadLetterBusy) || errors.Is(err, lang.ErrDeadLetterHandlerGone) {
+			return nil, err
+		}
+		return false, nil
+	}
+	return true, nil
+}
+
+func toolDiscardDeadLetter(rt tool.Runtime, args []interface{}) (interface{}, error) {
+	id, err := deadLetterID("DiscardDeadLetter", args)
+	if err != nil {
+		return nil, err
+	}
+	store, err := getDeadLetters(rt)
+	if err != nil {
+		return nil, err
+	}
+	return store.Discard(id), nil
+}
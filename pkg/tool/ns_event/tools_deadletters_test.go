@@ -0,0 +1,164 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Tests listing, inspecting, replaying and discarding dead letters from scripts, including the bus grants they need.
+// filename: pkg/tool/ns_event/tools_deadletters_test.go
+// nlines: 164
+// risk_rating: LOW
+package ns_event_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/interfaces"
+	"github.com/aprice2704/neuroscript/pkg/interpreter"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/logging"
+	"github.com/aprice2704/neuroscript/pkg/policy"
+)
+
+const deadLetterScript = `
+on event "job" named "worker" as ev do
+	emit "job " + ev.payload[0].Payload.n
+	if healthy == false
+		fail "backend down"
+	endif
+endon
+
+func list_letters(returns letters) means
+	return tool.ns_event.ListDeadLetters()
+endfunc
+
+func get_letter(needs id returns letter) means
+	return tool.ns_event.GetDeadLetter(id)
+endfunc
+
+func replay(needs id returns ok) means
+	return tool.ns_event.ReplayDeadLetter(id)
+endfunc
+
+func discard(needs id returns ok) means
+	return tool.ns_event.DiscardDeadLetter(id)
+endfunc
+`
+
+func newDeadLetterTestInterpreter(t *testing.T, grants ...string) (*interpreter.Interpreter, *[]string) {
+	t.Helper()
+	var emitted []string
+	hostCtx := &interpreter.HostContext{
+		Logger: logging.NewTestLogger(t),
+		Stdout: io.Discard,
+		Stderr: io.Discard,
+		EmitFunc: func(v lang.Value) {
+			s, _ := lang.ToString(v)
+			emitted = append(emitted, s)
+		},
+		WhisperFunc:               func(lang.Value, lang.Value) {},
+		EventHandlerErrorCallback: func(string, string, *lang.RuntimeError) {},
+	}
+	b := policy.NewBuilder(policy.ContextNormal).Allow("*")
+	for _, g := range grants {
+		b = b.Grant(g)
+	}
+	interp := interpreter.NewInterpreter(
+		interpreter.WithHostContext(hostCtx),
+		interpreter.WithExecPolicy(b.Build()),
+	)
+	tree, err := interp.Parser().Parse(deadLetterScript)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	program, _, err := interp.ASTBuilder().Build(tree)
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	if err := interp.Load(&interfaces.Tree{Root: program}); err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if err := interp.SetInitialVariable("healthy", false); err != nil {
+		t.Fatal(err)
+	}
+	return interp, &emitted
+}
+
+func emitJob(interp *interpreter.Interpreter, n int) {
+	payload, _ := lang.Wrap(map[string]any{"n": n})
+	interp.EmitEvent("job", "test", payload)
+}
+
+func TestDeadLetterTools(t *testing.T) {
+	interp, emitted := newDeadLetterTestInterpreter(t, "bus:read:*", "bus:admin:*")
+	emitJob(interp, 1)
+	emitJob(interp, 2)
+
+	listed, err := interp.RunProcedure("list_letters")
+	if err != nil {
+		t.Fatalf("list_letters: %v", err)
+	}
+	letters := lang.Unwrap(listed).([]interface{})
+	if len(letters) != 2 {
+		t.Fatalf("got %d dead letters, want 2", len(letters))
+	}
+	first := letters[0].(map[string]interface{})
+	got := fmt.Sprintf("%v/%v/%v/%v/%v", first["id"], first["event"], first["source"], first["handler"], first["attempts"])
+	if want := "dl-1/job/test/worker/1"; got != want {
+		t.Errorf("got dead letter %s, want %s", got, want)
+	}
+
+	letter, err := interp.RunProcedure("get_letter", lang.StringValue{Value: "dl-1"})
+	if err != nil {
+		t.Fatalf("get_letter: %v", err)
+	}
+	if _, ok := lang.Unwrap(letter).(map[string]interface{})["event_object"].(map[string]interface{}); !ok {
+		t.Errorf("GetDeadLetter returned no event object: %v", letter)
+	}
+
+	ok, err := interp.RunProcedure("replay", lang.StringValue{Value: "dl-1"})
+	if err != nil || lang.Unwrap(ok) != false {
+		t.Fatalf("replay of a failing handler: got %v, %v; want false", ok, err)
+	}
+	if err := interp.SetInitialVariable("healthy", true); err != nil {
+		t.Fatal(err)
+	}
+	ok, err = interp.RunProcedure("replay", lang.StringValue{Value: "dl-1"})
+	if err != nil || lang.Unwrap(ok) != true {
+		t.Fatalf("replay: got %v, %v; want true", ok, err)
+	}
+	if want := "[job 1 job 2 job 1 job 1]"; fmt.Sprint(*emitted) != want {
+		t.Errorf("got emits %v, want %s", *emitted, want)
+	}
+
+	ok, err = interp.RunProcedure("discard", lang.StringValue{Value: "dl-2"})
+	if err != nil || lang.Unwrap(ok) != true {
+		t.Fatalf("discard: got %v, %v; want true", ok, err)
+	}
+	if n := len(interp.DeadLetters().List()); n != 0 {
+		t.Errorf("%d dead letters left, want 0", n)
+	}
+
+	if _, err := interp.RunProcedure("get_letter", lang.StringValue{Value: "dl-1"}); !errors.Is(err, lang.ErrDeadLetterNotFound) {
+		t.Errorf("get_letter of a replayed dead letter: got %v, want ErrDeadLetterNotFound", err)
+	}
+	if _, err := interp.RunProcedure("replay", lang.StringValue{Value: "dl-9"}); !errors.Is(err, lang.ErrDeadLetterNotFound) {
+		t.Errorf("replay of an unknown dead letter: got %v, want ErrDeadLetterNotFound", err)
+	}
+}
+
+func TestDeadLetterTools_Grants(t *testing.T) {
+	interp, _ := newDeadLetterTestInterpreter(t, "bus:read:*")
+	emitJob(interp, 1)
+	if _, err := interp.RunProcedure("list_letters"); err != nil {
+		t.Fatalf("list_letters with bus:read: %v", err)
+	}
+	if _, err := interp.RunProcedure("replay", lang.StringValue{Value: "dl-1"}); err == nil {
+		t.Error("replay without bus:admin should fail")
+	}
+	if _, err := interp.RunProcedure("discard", lang.StringValue{Value: "dl-1"}); err == nil {
+		t.Error("discard without bus:admin should fail")
+	}
+	if n := len(interp.DeadLetters().List()); n != 1 {
+		t.Errorf("%d dead letters left, want 1", n)
+	}
+}
@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.8.0
-// File version: 6
-// Purpose: Centralizes all tool execution policy checks with a correct two-phase validation and adds limit enforcement.
+// File version: 8
+// Purpose: Centralizes all tool execution policy checks. Tool call counts go through the GrantSet so concurrent handlers are counted safely.
 // filename: pkg/tool/policy.go
-// nlines: 83
+// nlines: 112
 // risk_rating: HIGH
 
 package tool
@@ -18,8 +18,46 @@ import (
 )
 
 // CanCall performs a full policy check for a given tool against a runtime's policy.
+// A permitted call is counted against the tool's call limit.
 func CanCall(rt policygate.Runtime, tool ToolImplementation) error {
 	p := rt.GetExecPolicy()
+	if err := checkAccess(p, tool); err != nil {
+		return err
+	}
+
+	// 4. Limit Check: Enforce tool call limits.
+	toolName := string(tool.FullName)
+	if max, ok := p.Grants.Limits.ToolMaxCalls[toolName]; ok {
+		// Increment must happen before the check.
+		count := p.Grants.AddToolCall(toolName)
+		if count > max {
+			errMsg := fmt.Sprintf("tool '%s' exceeded its call limit of %d", tool.FullName, max)
+			return lang.NewRuntimeError(lang.ErrorCodePolicy, errMsg, policy.ErrPolicy)
+		}
+	}
+
+	return nil
+}
+
+// CheckCall reports whether p would permit a call to tool, using the same
+// rules as CanCall but without touching any counters. It is intended for
+// static checks made before a script runs.
+func CheckCall(p *policy.ExecPolicy, tool ToolImplementation) error {
+	if err := checkAccess(p, tool); err != nil {
+		return err
+	}
+	toolName := string(tool.FullName)
+	if max, ok := p.Grants.Limits.ToolMaxCalls[toolName]; ok {
+		if used := p.Grants.ToolCallCount(toolName); used >= max {
+			errMsg := fmt.Sprintf("tool '%s' exceeded its call limit of %d", tool.FullName, max)
+			return lang.NewRuntimeError(lang.ErrorCodePolicy, errMsg, policy.ErrPolicy)
+		}
+	}
+	return nil
+}
+
+// checkAccess runs the trust, allow/deny and grant checks shared by CanCall and CheckCall.
+func checkAccess(p *policy.ExecPolicy, tool ToolImplementation) error {
 	if p == nil {
 		return lang.NewRuntimeError(lang.ErrorCodePolicy, "action denied: no execution policy is set", policy.ErrPolicy)
 	}
@@ -70,20 +108,5 @@ func CanCall(rt policygate.Runtime, tool ToolImplementation) error {
 		}
 	}
 
-	// 4. Limit Check: Enforce tool call limits.
-	toolName := string(tool.FullName)
-	if max, ok := p.Grants.Limits.ToolMaxCalls[toolName]; ok {
-		if p.Grants.Counters == nil {
-			p.Grants.Counters = capability.NewCounters()
-		}
-		// Increment must happen before the check.
-		count := p.Grants.Counters.ToolCalls[toolName] + 1
-		p.Grants.Counters.ToolCalls[toolName] = count
-		if count > max {
-			errMsg := fmt.Sprintf("tool '%s' exceeded its call limit of %d", tool.FullName, max)
-			return lang.NewRuntimeError(lang.ErrorCodePolicy, errMsg, policy.ErrPolicy)
-		}
-	}
-
 	return nil
 }
@@ -0,0 +1,19 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Registers the 'policy' toolset with the NeuroScript engine.
+// filename: pkg/tool/policytools/register.go
+// nlines: 16
+// risk_rating: LOW
+
+package policytools
+
+import "github.com/aprice2704/neuroscript/pkg/tool"
+
+// init runs once when the policytools package is imported. It injects this
+// toolset's registration function into the global bootstrap list.
+func init() {
+	tool.AddToolsetRegistration(
+		"policytools",
+		tool.CreateRegistrationFunc("policytools", policyToolsToRegister),
+	)
+}
@@ -0,0 +1,35 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Defines the ToolImplementation slice for the read-only policy usage tools.
+// filename: pkg/tool/policytools/tooldefs_policy.go
+// nlines: 35
+// risk_rating: LOW
+
+package policytools
+
+import (
+	"github.com/aprice2704/neuroscript/pkg/tool"
+)
+
+const group = "policy"
+
+// policyToolsToRegister contains the ToolImplementation definitions for Policy tools.
+var policyToolsToRegister = []tool.ToolImplementation{
+	{
+		Spec: tool.ToolSpec{
+			Name:        "Usage",
+			Group:       group,
+			Description: "Reports resources consumed so far under the active execution policy, alongside their limits.",
+			Category:    "Policy",
+			Args:        []tool.ArgSpec{},
+			ReturnType:  tool.ArgTypeMap,
+			ReturnHelp: "A map with keys 'net_calls', 'net_bytes', 'fs_calls', 'fs_bytes', 'budget_spent_cents' (currency -> cents), " +
+				"'tool_calls' (tool -> count) and 'limits' holding the matching maxima (0 means unlimited).",
+			Example: `set u = tool.policy.Usage()`,
+		},
+		Func:          toolPolicyUsage,
+		RequiresTrust: false,
+		RequiredCaps:  nil,
+		Effects:       []string{"readonly"},
+	},
+}
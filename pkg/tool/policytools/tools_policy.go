@@ -0,0 +1,53 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Implements the 'Policy.Usage' tool.
+// filename: pkg/tool/policytools/tools_policy.go
+// nlines: 60
+// risk_rating: LOW
+
+package policytools
+
+import (
+	"fmt"
+
+	"github.com/aprice2704/neuroscript/pkg/capability"
+	"github.com/aprice2704/neuroscript/pkg/tool"
+)
+
+// toolPolicyUsage implements the "Policy.Usage" tool function.
+func toolPolicyUsage(rt tool.Runtime, args []interface{}) (interface{}, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf("Policy.Usage() expects 0 arguments, got %d", len(args))
+	}
+	grants := rt.GetGrantSet()
+	return UsageMap(grants.Snapshot(), grants.Limits), nil
+}
+
+// UsageMap renders counters and limits as the map returned by Policy.Usage.
+func UsageMap(c capability.Counters, l capability.Limits) map[string]interface{} {
+	return map[string]interface{}{
+		"net_calls":          int64(c.NetCalls),
+		"net_bytes":          c.NetBytes,
+		"fs_calls":           int64(c.FSCalls),
+		"fs_bytes":           c.FSBytes,
+		"budget_spent_cents": intMap(c.BudgetSpentCents),
+		"tool_calls":         intMap(c.ToolCalls),
+		"limits": map[string]interface{}{
+			"net_calls":             int64(l.NetMaxCalls),
+			"net_bytes":             l.NetMaxBytes,
+			"fs_calls":              int64(l.FSMaxCalls),
+			"fs_bytes":              l.FSMaxBytes,
+			"budget_per_run_cents":  intMap(l.BudgetPerRunCents),
+			"budget_per_call_cents": intMap(l.BudgetPerCallCents),
+			"tool_calls":            intMap(l.ToolMaxCalls),
+		},
+	}
+}
+
+func intMap(in map[string]int) map[string]interface{} {
+	out := make(map[string]interface{}, len(in))
+	for k, v := range in {
+		out[k] = int64(v)
+	}
+	return out
+}
@@ -0,0 +1,59 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Provides unit tests for the 'Policy.Usage' tool implementation.
+// filename: pkg/tool/policytools/tools_policy_test.go
+// nlines: 50
+// risk_rating: LOW
+
+package policytools
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/capability"
+	"github.com/aprice2704/neuroscript/pkg/interpreter"
+	"github.com/aprice2704/neuroscript/pkg/logging"
+	"github.com/aprice2704/neuroscript/pkg/policy"
+)
+
+func TestToolPolicyUsage(t *testing.T) {
+	pol := policy.NewBuilder(policy.ContextNormal).LimitFS(5, 1000).LimitPerRunCents("CAD", 200).Build()
+	hostCtx := &interpreter.HostContext{
+		Logger: logging.NewTestLogger(t),
+		Stdout: &bytes.Buffer{},
+		Stdin:  &bytes.Buffer{},
+		Stderr: &bytes.Buffer{},
+	}
+	interp := interpreter.NewInterpreter(interpreter.WithHostContext(hostCtx), interpreter.WithExecPolicy(pol))
+	grants := interp.GetGrantSet()
+	_ = grants.CountFS(40)
+	_ = grants.ChargeBudget("CAD", 12)
+
+	out, err := toolPolicyUsage(interp, nil)
+	if err != nil {
+		t.Fatalf("toolPolicyUsage() returned error: %v", err)
+	}
+	usage := out.(map[string]interface{})
+	if usage["fs_calls"] != int64(1) || usage["fs_bytes"] != int64(40) {
+		t.Errorf("fs usage = %v calls / %v bytes, want 1 / 40", usage["fs_calls"], usage["fs_bytes"])
+	}
+	if spent := usage["budget_spent_cents"].(map[string]interface{})["CAD"]; spent != int64(12) {
+		t.Errorf("budget_spent_cents[CAD] = %v, want 12", spent)
+	}
+	limits := usage["limits"].(map[string]interface{})
+	if limits["fs_calls"] != int64(5) || limits["fs_bytes"] != int64(1000) {
+		t.Errorf("fs limits = %v / %v, want 5 / 1000", limits["fs_calls"], limits["fs_bytes"])
+	}
+
+	if _, err := toolPolicyUsage(interp, []interface{}{"extra"}); err == nil {
+		t.Error("expected an error for unexpected arguments")
+	}
+}
+
+func TestUsageMapEmpty(t *testing.T) {
+	m := UsageMap(*capability.NewCounters(), capability.Limits{})
+	if m["net_calls"] != int64(0) {
+		t.Errorf("net_calls = %v, want 0", m["net_calls"])
+	}
+}
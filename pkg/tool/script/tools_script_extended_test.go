@@ -1,6 +1,6 @@
 // NeuroScript Version: 0.8.0
-// File version: 11
-// Purpose: Fixes test failures by using the concrete interpreter which now implements the necessary interfaces, and corrects a syntax error in a test case.
+// File version: 12
+// Purpose: Script tool tests. Load counts now come back as exact int64 values.
 // filename: pkg/tool/script/tools_script_extended_test.go
 package script_test
 
@@ -132,8 +132,8 @@ var scriptTestCases = []scriptTestCase{
 		endfunc
 		`,
 		wantResult: map[string]interface{}{
-			"functions_loaded":      float64(0),
-			"event_handlers_loaded": float64(0),
+			"functions_loaded":      int64(0),
+			"event_handlers_loaded": int64(0),
 			"metadata": map[string]interface{}{
 				"title": "A file with no code",
 			},
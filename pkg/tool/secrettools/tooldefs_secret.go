@@ -0,0 +1,41 @@
+// NeuroScript Version: 0.8.0
+// File version: 3
+// Purpose: Defines the ToolImplementation slice for secret decryption tools.
+// filename: pkg/tool/secrettools/tooldefs_secret.go
+// nlines: 41
+// risk_rating: HIGH
+
+package secrettools
+
+import (
+	"github.com/aprice2704/neuroscript/pkg/capability"
+	"github.com/aprice2704/neuroscript/pkg/tool"
+)
+
+const group = "secret"
+
+// secretToolsToRegister contains the ToolImplementation definitions for Secret tools.
+var secretToolsToRegister = []tool.ToolImplementation{
+	{
+		Spec: tool.ToolSpec{
+			Name:        "Decode",
+			Group:       group,
+			Description: "Decrypts a secret reference (nssecret:<enc>:<path>:<payload>) with the host-configured key. The value is redacted from emit output, whispers, prompts sent to an AI agent, the AI transcript, errors and logs. Values the script returns to the host are passed through as-is; hosts scrub them with RedactSecrets before displaying them, as 'ng' does. Requires 'secret:read:<path>'.",
+			Category:    "Security",
+			Args: []tool.ArgSpec{
+				{Name: "ref", Type: tool.ArgTypeString, Required: true, Description: "The secret reference, as produced by 'ng secret seal'."},
+			},
+			ReturnType: tool.ArgTypeString,
+			ReturnHelp: "The decrypted secret value.",
+			Example:    `set api_key = tool.secret.Decode("nssecret:age:prod/openai:YWdlLWVuY3J5...")`,
+			ErrorConditions: "ErrorCodeArgMismatch if 'ref' is not a string; ErrorCodeInvalidValue if it is malformed or uses an unknown encryption; " +
+				"ErrorCodePolicy if the policy does not grant 'secret:read' for the path; ErrorCodeSecretDecryption if no key is configured or decryption fails.",
+		},
+		Func:          toolSecretDecode,
+		RequiresTrust: true,
+		RequiredCaps: []capability.Capability{
+			{Resource: capability.ResSecret, Verbs: []string{capability.VerbRead}},
+		},
+		Effects: []string{"readonly"},
+	},
+}
@@ -0,0 +1,57 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Implements the 'Secret.Decode' tool.
+// filename: pkg/tool/secrettools/tools_secret.go
+// nlines: 55
+// risk_rating: HIGH
+
+package secrettools
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/capability"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/secret"
+	"github.com/aprice2704/neuroscript/pkg/tool"
+)
+
+// secretDecoder is implemented by the interpreter, which holds the host's
+// decryption keys and redacts every value it reveals.
+type secretDecoder interface {
+	DecodeSecret(ref *ast.SecretRef) (string, error)
+}
+
+// toolSecretDecode implements the "Secret.Decode" tool function.
+func toolSecretDecode(rt tool.Runtime, args []interface{}) (interface{}, error) {
+	if len(args) != 1 {
+		return nil, lang.NewRuntimeError(lang.ErrorCodeArgMismatch, fmt.Sprintf("Secret.Decode: expected 1 argument (ref), got %d", len(args)), lang.ErrArgumentMismatch)
+	}
+	text, ok := args[0].(string)
+	if !ok {
+		return nil, lang.NewRuntimeError(lang.ErrorCodeArgMismatch, fmt.Sprintf("Secret.Decode: 'ref' must be a string, got %T", args[0]), lang.ErrArgumentMismatch)
+	}
+	ref, err := secret.ParseRef(text)
+	if err != nil {
+		return nil, lang.NewRuntimeError(lang.ErrorCodeInvalidValue, "Secret.Decode: invalid secret reference", err)
+	}
+	if !rt.GetGrantSet().Check(capability.New(capability.ResSecret, capability.VerbRead, ref.Path)) {
+		return nil, lang.NewRuntimeError(lang.ErrorCodePolicy,
+			fmt.Sprintf("Secret.Decode: policy does not grant 'secret:read:%s'", ref.Path), lang.ErrPolicyViolation)
+	}
+	dec, ok := rt.(secretDecoder)
+	if !ok {
+		return nil, lang.NewRuntimeError(lang.ErrorCodeConfig, "Secret.Decode: runtime cannot decode secrets", nil)
+	}
+	plain, err := dec.DecodeSecret(ref)
+	if err != nil {
+		var rtErr *lang.RuntimeError
+		if errors.As(err, &rtErr) {
+			return nil, rtErr
+		}
+		return nil, lang.NewRuntimeError(lang.ErrorCodeSecretDecryption, "Secret.Decode: decryption failed", err)
+	}
+	return plain, nil
+}
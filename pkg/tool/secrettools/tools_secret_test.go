@@ -0,0 +1,129 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Provides tests for the 'Secret.Decode' tool, its policy check and output redaction.
+// filename: pkg/tool/secrettools/tools_secret_test.go
+// nlines: 95
+// risk_rating: HIGH
+
+package secrettools
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/ast"
+	"github.com/aprice2704/neuroscript/pkg/interfaces"
+	"github.com/aprice2704/neuroscript/pkg/interpreter"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/logging"
+	"github.com/aprice2704/neuroscript/pkg/policy"
+	"github.com/aprice2704/neuroscript/pkg/secret"
+)
+
+func newSecretTestInterpreter(t *testing.T, emitted *[]string) (*interpreter.Interpreter, string) {
+	t.Helper()
+	pub, priv, err := secret.GenerateSealedBoxKey()
+	if err != nil {
+		t.Fatalf("GenerateSealedBoxKey() failed: %v", err)
+	}
+	raw, err := secret.Encrypt(secret.EncSealedBox, pub, []byte("sk-live-123"))
+	if err != nil {
+		t.Fatalf("Encrypt() failed: %v", err)
+	}
+	ref := secret.FormatRef(&ast.SecretRef{Path: "prod/openai", Enc: secret.EncSealedBox, Raw: raw})
+
+	hostCtx := &interpreter.HostContext{
+		Logger: logging.NewTestLogger(t),
+		Stdout: &bytes.Buffer{},
+		Stdin:  &bytes.Buffer{},
+		Stderr: &bytes.Buffer{},
+		EmitFunc: func(v lang.Value) {
+			*emitted = append(*emitted, v.String())
+		},
+	}
+	pol := policy.NewBuilder(policy.ContextConfig).Allow("tool.secret.decode").Grant("secret:read:prod/*").Build()
+	interp := interpreter.NewInterpreter(
+		interpreter.WithHostContext(hostCtx),
+		interpreter.WithExecPolicy(pol),
+		interpreter.WithSecretKey(secret.EncSealedBox, []byte(priv)),
+	)
+	return interp, ref
+}
+
+func TestToolSecretDecode(t *testing.T) {
+	var emitted []string
+	interp, ref := newSecretTestInterpreter(t, &emitted)
+
+	got, err := toolSecretDecode(interp, []interface{}{ref})
+	if err != nil {
+		t.Fatalf("toolSecretDecode() returned error: %v", err)
+	}
+	if got != "sk-live-123" {
+		t.Errorf("toolSecretDecode() = %v, want sk-live-123", got)
+	}
+
+	denied := strings.Replace(ref, "prod/openai", "staging/openai", 1)
+	_, err = toolSecretDecode(interp, []interface{}{denied})
+	var rtErr *lang.RuntimeError
+	if !errors.As(err, &rtErr) || rtErr.Code != lang.ErrorCodePolicy {
+		t.Errorf("expected ErrorCodePolicy for an ungranted path, got %v", err)
+	}
+
+	_, err = toolSecretDecode(interp, []interface{}{"not-a-ref"})
+	if !errors.As(err, &rtErr) || rtErr.Code != lang.ErrorCodeInvalidValue {
+		t.Errorf("expected ErrorCodeInvalidValue for a malformed ref, got %v", err)
+	}
+}
+
+func TestToolSecretDecode_MissingKey(t *testing.T) {
+	hostCtx := &interpreter.HostContext{
+		Logger: logging.NewTestLogger(t),
+		Stdout: &bytes.Buffer{},
+		Stdin:  &bytes.Buffer{},
+		Stderr: &bytes.Buffer{},
+	}
+	pol := policy.NewBuilder(policy.ContextConfig).Grant("secret:read:*").Build()
+	interp := interpreter.NewInterpreter(interpreter.WithHostContext(hostCtx), interpreter.WithExecPolicy(pol))
+	ref := secret.FormatRef(&ast.SecretRef{Path: "p", Enc: secret.EncAge, Raw: []byte("x")})
+
+	_, err := toolSecretDecode(interp, []interface{}{ref})
+	var rtErr *lang.RuntimeError
+	if !errors.As(err, &rtErr) || rtErr.Code != lang.ErrorCodeSecretDecryption {
+		t.Errorf("expected ErrorCodeSecretDecryption without a key, got %v", err)
+	}
+}
+
+func TestSecretRedactedFromEmit(t *testing.T) {
+	var emitted []string
+	interp, ref := newSecretTestInterpreter(t, &emitted)
+	if err := interp.SetInitialVariable("ref", ref); err != nil {
+		t.Fatalf("SetInitialVariable() failed: %v", err)
+	}
+
+	script := `command
+		set k = tool.secret.Decode(ref)
+		emit "key=" + k
+		emit "harmless"
+	endcommand`
+	tree, err := interp.Parser().Parse(script)
+	if err != nil {
+		t.Fatalf("Parse() failed: %v", err)
+	}
+	program, _, err := interp.ASTBuilder().Build(tree)
+	if err != nil {
+		t.Fatalf("Build() failed: %v", err)
+	}
+	if err := interp.Load(&interfaces.Tree{Root: program}); err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if _, err := interp.ExecuteCommands(); err != nil {
+		t.Fatalf("ExecuteCommands() failed: %v", err)
+	}
+
+	want := []string{"key=" + secret.Redacted, "harmless"}
+	if strings.Join(emitted, "|") != strings.Join(want, "|") {
+		t.Errorf("emitted = %q, want %q", emitted, want)
+	}
+}
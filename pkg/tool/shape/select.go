@@ -1,5 +1,5 @@
 // NeuroScript Version: 0.7.0
-// File version: 1
+// File version: 2
 // Purpose: Implements the tool.shape.Select function.
 // filename: pkg/tool/shape/select.go
 // nlines: 100
@@ -88,6 +88,8 @@ func buildPathFromArray(arr []interface{}) (json_lite.Path, error) {
 				return nil, fmt.Errorf("%w: path segment cannot be an empty string", lang.ErrInvalidArgument)
 			}
 			p = append(p, json_lite.PathSegment{Key: v, IsKey: true})
+		case int64:
+			p = append(p, json_lite.PathSegment{Index: int(v), IsKey: false})
 		case float64:
 			p = append(p, json_lite.PathSegment{Index: int(v), IsKey: false})
 		case int:
@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.5.2
-// File version: 3
-// Purpose: Added policy metadata (RequiresTrust, RequiredCaps, Effects) to the Shell.Execute tool definition.
+// File version: 4
+// Purpose: Shell.Execute requires the capability.ResShell resource, which is metered against FS and net call limits.
 // filename: pkg/tool/shell/tooldefs_shell.go
-// nlines: 45
+// nlines: 48
 // risk_rating: HIGH
 
 package shell
@@ -40,7 +40,7 @@ var shellToolsToRegister = []tool.ToolImplementation{
 		Func:          ToolExecuteCommand,
 		RequiresTrust: true,
 		RequiredCaps: []capability.Capability{
-			{Resource: "shell", Verbs: []string{"execute"}, Scopes: []string{"*"}},
+			{Resource: capability.ResShell, Verbs: []string{"execute"}, Scopes: []string{"*"}},
 		},
 		// A shell can do anything, so its effects are non-deterministic and can touch any resource.
 		Effects: []string{"readsFS", "readsNet", "readsClock", "readsRand"},
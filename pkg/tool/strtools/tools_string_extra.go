@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 0
-// :: fileVersion: 6
+// :: fileVersion: 8
 // :: description: Updated ToJsonString to accept Any type (primitives, etc), aligning with json.Marshal behavior.
-// :: latestChange: Removed restrictive type switch in toolToJsonString.
+// :: latestChange: JSON decoding moved to utils.DecodeJSON so every JSON-parsing tool keeps integers exact.
 // :: filename: pkg/tool/strtools/tools_string_extra.go
 // :: serialization: go
 
@@ -16,6 +16,7 @@ import (
 
 	"github.com/aprice2704/neuroscript/pkg/lang"
 	"github.com/aprice2704/neuroscript/pkg/tool"
+	"github.com/aprice2704/neuroscript/pkg/utils"
 )
 
 // toolBytesFromBase64 converts a base64 encoded string (representing bytes) to a UTF-8 string.
@@ -73,9 +74,8 @@ func toolParseFromJsonBase64(interpreter tool.Runtime, args []interface{}) (inte
 		return nil, lang.NewRuntimeError(lang.ErrorCodeInvalidValue, fmt.Sprintf("ParseFromJsonBase64: invalid base64 input: %v", err), lang.ErrInvalidArgument)
 	}
 
-	var parsedValue interface{}
-	// Important: Unmarshal into interface{} to handle both maps and lists dynamically.
-	if err := json.Unmarshal(byteData, &parsedValue); err != nil {
+	parsedValue, err := utils.DecodeJSON(byteData)
+	if err != nil {
 		return nil, lang.NewRuntimeError(lang.ErrorCodeInvalidValue, fmt.Sprintf("ParseFromJsonBase64: invalid JSON data: %v", err), lang.ErrInvalidArgument)
 	}
 
@@ -94,9 +94,8 @@ func toolParseJsonString(interpreter tool.Runtime, args []interface{}) (interfac
 		return nil, lang.NewRuntimeError(lang.ErrorCodeType, fmt.Sprintf("ParseJsonString: json_string argument must be a string, got %T", args[0]), lang.ErrArgumentMismatch)
 	}
 
-	var parsedValue interface{}
-	// Important: Unmarshal into interface{} to handle both maps and lists dynamically.
-	if err := json.Unmarshal([]byte(jsonString), &parsedValue); err != nil {
+	parsedValue, err := utils.DecodeJSON([]byte(jsonString))
+	if err != nil {
 		return nil, lang.NewRuntimeError(lang.ErrorCodeInvalidValue, fmt.Sprintf("ParseJsonString: invalid JSON data: %v", err), lang.ErrInvalidArgument)
 	}
 
@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 0
-// :: fileVersion: 7
+// :: fileVersion: 8
 // :: description: Tests for extra string/codec tools. Added tests for primitive types in ToJsonString.
-// :: latestChange: Added ToJsonString primitive tests.
+// :: latestChange: JSON integers now parse to int64; added a large-integer case.
 // :: filename: pkg/tool/strtools/tools_string_extra_test.go
 // :: serialization: go
 
@@ -45,7 +45,7 @@ func TestToolStringExtraCodecs(t *testing.T) {
 			name:       "ParseFromJsonBase64 List",
 			toolName:   "ParseFromJsonBase64",
 			args:       MakeArgs("WyIxIiwyLCJ0aHJlZSJd"),
-			wantResult: []interface{}{"1", float64(2), "three"},
+			wantResult: []interface{}{"1", int64(2), "three"},
 		},
 		{
 			name:       "ParseFromJsonBase64 Empty JSON Object",
@@ -74,7 +74,13 @@ func TestToolStringExtraCodecs(t *testing.T) {
 			name:       "ParseJsonString List",
 			toolName:   "ParseJsonString",
 			args:       MakeArgs(`["1",2,"three",{"nested": true}]`),
-			wantResult: []interface{}{"1", float64(2), "three", map[string]interface{}{"nested": true}},
+			wantResult: []interface{}{"1", int64(2), "three", map[string]interface{}{"nested": true}},
+		},
+		{
+			name:       "ParseJsonString Integers And Floats",
+			toolName:   "ParseJsonString",
+			args:       MakeArgs(`{"id": 9007199254740993, "ratio": 2.0, "big": 18446744073709551616}`),
+			wantResult: map[string]interface{}{"id": int64(9007199254740993), "ratio": float64(2), "big": float64(18446744073709551616)},
 		},
 		{
 			name:       "ParseJsonString Empty Map",
@@ -1,5 +1,5 @@
 // NeuroScript Version: 0.4.1
-// File version: 2
+// File version: 3
 // Purpose: Implements the Go function for the 'Time.Now' tool.
 // filename: pkg/tool/time/tools_time.go
 // nlines: 15
@@ -31,8 +31,8 @@ func adaptToolTimeSleep(interp tool.Runtime, args []interface{}) (interface{}, e
 	if err := validateTimeSleep(args); err != nil {
 		return nil, err
 	}
-	// We know from validation that args[0] is a float64.
-	durationSeconds := args[0].(float64)
+	// We know from validation that args[0] is numeric.
+	durationSeconds, _ := sleepSeconds(args[0])
 	return implTimeSleep(durationSeconds)
 }
 
@@ -49,11 +49,22 @@ func validateTimeNow(args []interface{}) error {
 	return nil
 }
 
+// sleepSeconds accepts integer and float durations.
+func sleepSeconds(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int64:
+		return float64(n), true
+	}
+	return 0, false
+}
+
 func validateTimeSleep(args []interface{}) error {
 	if len(args) != 1 {
 		return fmt.Errorf("validation error for Time.Sleep: expected 1 argument, but got %d", len(args))
 	}
-	if _, ok := args[0].(float64); !ok {
+	if _, ok := sleepSeconds(args[0]); !ok {
 		return fmt.Errorf("validation error for Time.Sleep: argument must be a number, but got %T", args[0])
 	}
 	return nil
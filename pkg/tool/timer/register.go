@@ -0,0 +1,19 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Registers the 'timer' toolset with the NeuroScript engine.
+// filename: pkg/tool/timer/register.go
+// nlines: 19
+// risk_rating: LOW
+package timer
+
+import "github.com/aprice2704/neuroscript/pkg/tool"
+
+// init() runs once when the timer package is imported. It injects this
+// toolset's registration function into the global bootstrap list kept
+// in the parent tool package.
+func init() {
+	tool.AddToolsetRegistration(
+		"timer",
+		tool.CreateRegistrationFunc("timer", TimerToolsToRegister),
+	)
+}
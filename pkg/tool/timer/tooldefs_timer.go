@@ -0,0 +1,71 @@
+// NeuroScript Version: 0.8.0
+// File version: 2
+// Purpose: Defines the tool specifications for scheduling, cancelling and listing timers that fire named events.
+// filename: pkg/tool/timer/tooldefs_timer.go
+// nlines: 71
+// risk_rating: HIGH
+package timer
+
+import (
+	"github.com/aprice2704/neuroscript/pkg/capability"
+	"github.com/aprice2704/neuroscript/pkg/tool"
+)
+
+const Group = "timer"
+
+var TimerToolsToRegister = []tool.ToolImplementation{
+	{
+		Spec: tool.ToolSpec{
+			Name:  "Schedule",
+			Group: Group,
+			Description: "Schedules a timer that raises the named event later. 'when' holds exactly one of " +
+				"'after' (a delay), 'every' (an interval) or 'cron' (a five-field cron expression). Delays and " +
+				"intervals are seconds or Go duration strings such as '90s' or '5m'. Intervals must be at least one " +
+				"second unless the host lowers the minimum. Raising the event also needs the bus:write grant for its name.",
+			Args: []tool.ArgSpec{
+				{Name: "event", Type: tool.ArgTypeString, Description: "The name of the event to raise.", Required: true},
+				{Name: "when", Type: tool.ArgTypeMap, Description: "One of {'after': d}, {'every': d} or {'cron': expr}.", Required: true},
+				{Name: "payload", Type: tool.ArgTypeMap, Description: "Optional payload for every raised event.", Required: false},
+				{Name: "id", Type: tool.ArgTypeString, Description: "Optional timer ID; an active timer with the same ID is replaced.", Required: false},
+			},
+			ReturnType: tool.ArgTypeString,
+			ReturnHelp: "The timer's ID, for use with timer.Cancel.",
+			Example:    `set poll = tool.timer.Schedule("inbox.poll", {"every": "5m"})`,
+		},
+		Func:          toolScheduleTimer,
+		RequiresTrust: false,
+		RequiredCaps:  []capability.Capability{capability.New(capability.ResTimer, capability.VerbWrite)},
+		Effects:       []string{"readsClock"},
+	},
+	{
+		Spec: tool.ToolSpec{
+			Name:        "Cancel",
+			Group:       Group,
+			Description: "Cancels a timer.",
+			Args: []tool.ArgSpec{
+				{Name: "id", Type: tool.ArgTypeString, Description: "The ID returned by timer.Schedule.", Required: true},
+			},
+			ReturnType: tool.ArgTypeBool,
+			ReturnHelp: "True if the timer was active, false otherwise.",
+			Example:    `call tool.timer.Cancel(poll)`,
+		},
+		Func:          toolCancelTimer,
+		RequiresTrust: false,
+		RequiredCaps:  []capability.Capability{capability.New(capability.ResTimer, capability.VerbWrite)},
+		Effects:       []string{"idempotent"},
+	},
+	{
+		Spec: tool.ToolSpec{
+			Name:        "List",
+			Group:       Group,
+			Description: "Lists the active timers, soonest first.",
+			ReturnType:  tool.ArgTypeSliceMap,
+			ReturnHelp:  "One map per timer with 'id', 'event', 'kind', 'spec', 'next' (RFC 3339), 'fired' and 'skipped'.",
+			Example:     `set timers = tool.timer.List()`,
+		},
+		Func:          toolListTimers,
+		RequiresTrust: false,
+		RequiredCaps:  []capability.Capability{capability.New(capability.ResTimer, capability.VerbRead)},
+		Effects:       []string{"readonly"},
+	},
+}
@@ -0,0 +1,177 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Implements the timer tools on top of the root interpreter's scheduler.
+// filename: pkg/tool/timer/tools_timer.go
+// nlines: 177
+// risk_rating: HIGH
+package timer
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aprice2704/neuroscript/pkg/capability"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/policy"
+	"github.com/aprice2704/neuroscript/pkg/scheduler"
+	"github.com/aprice2704/neuroscript/pkg/tool"
+)
+
+// timerRuntime defines the interface we expect from the runtime for timer
+// operations.
+type timerRuntime interface {
+	Scheduler() *scheduler.Scheduler
+}
+
+func getScheduler(rt tool.Runtime) (*scheduler.Scheduler, error) {
+	interp, ok := rt.(timerRuntime)
+	if !ok {
+		if wrapper, isWrapper := rt.(tool.Wrapper); isWrapper {
+			return getScheduler(wrapper.Unwrap())
+		}
+		return nil, lang.NewRuntimeError(lang.ErrorCodeConfiguration, "internal error: runtime does not provide a timer scheduler", lang.ErrConfiguration)
+	}
+	return interp.Scheduler(), nil
+}
+
+func toolScheduleTimer(rt tool.Runtime, args []interface{}) (interface{}, error) {
+	if len(args) < 2 || len(args) > 4 {
+		return nil, lang.NewRuntimeError(lang.ErrorCodeArgMismatch, fmt.Sprintf("Schedule: expected 2 to 4 arguments, got %d", len(args)), lang.ErrArgumentMismatch)
+	}
+	event, ok := args[0].(string)
+	if !ok || event == "" {
+		return nil, lang.NewRuntimeError(lang.ErrorCodeType, "argument 'event' must be a non-empty string", lang.ErrInvalidArgument)
+	}
+	when, ok := args[1].(map[string]interface{})
+	if !ok {
+		return nil, lang.NewRuntimeError(lang.ErrorCodeType, "argument 'when' must be a map", lang.ErrInvalidArgument)
+	}
+	spec, err := parseWhen(when)
+	if err != nil {
+		return nil, err
+	}
+	spec.Event = event
+
+	if len(args) > 2 && args[2] != nil {
+		payload, ok := args[2].(map[string]interface{})
+		if !ok {
+			return nil, lang.NewRuntimeError(lang.ErrorCodeType, "argument 'payload' must be a map", lang.ErrInvalidArgument)
+		}
+		if spec.Payload, err = lang.Wrap(payload); err != nil {
+			return nil, lang.NewRuntimeError(lang.ErrorCodeType, fmt.Sprintf("argument 'payload' cannot be used: %v", err), lang.ErrInvalidArgument)
+		}
+	}
+	if len(args) > 3 && args[3] != nil {
+		if spec.ID, ok = args[3].(string); !ok {
+			return nil, lang.NewRuntimeError(lang.ErrorCodeType, "argument 'id' must be a string", lang.ErrInvalidArgument)
+		}
+	}
+
+	// A timer raises its event later on the script's behalf, so it needs
+	// the same grant as 'raise event'.
+	need := capability.New(capability.ResBus, capability.VerbWrite, event)
+	if pol := rt.GetExecPolicy(); pol == nil || !pol.Grants.Check(need) {
+		return nil, lang.NewRuntimeError(lang.ErrorCodePolicy,
+			fmt.Sprintf("permission denied to schedule event '%s': requires grant %s", event, need.String()),
+			policy.ErrCapability)
+	}
+
+	sched, err := getScheduler(rt)
+	if err != nil {
+		return nil, err
+	}
+	info, err := sched.Schedule(spec)
+	if err != nil {
+		return nil, schedulerError(err)
+	}
+	return info.ID, nil
+}
+
+// parseWhen reads the 'when' map into a Spec without its event.
+func parseWhen(when map[string]interface{}) (scheduler.Spec, error) {
+	if len(when) == 1 {
+		for key, val := range when {
+			switch key {
+			case "after", "every":
+				d, err := toDuration(key, val)
+				if err != nil {
+					return scheduler.Spec{}, err
+				}
+				return scheduler.Spec{Kind: scheduler.Kind(key), Delay: d}, nil
+			case "cron":
+				expr, ok := val.(string)
+				if !ok {
+					return scheduler.Spec{}, lang.NewRuntimeError(lang.ErrorCodeType, "'cron' must be a string", lang.ErrInvalidArgument)
+				}
+				return scheduler.Spec{Kind: scheduler.KindCron, Cron: expr}, nil
+			}
+			return scheduler.Spec{}, lang.NewRuntimeError(lang.ErrorCodeArgMismatch,
+				fmt.Sprintf("unknown key '%s' in argument 'when'; expected 'after', 'every' or 'cron'", key), lang.ErrInvalidArgument)
+		}
+	}
+	return scheduler.Spec{}, lang.NewRuntimeError(lang.ErrorCodeArgMismatch,
+		"argument 'when' must hold exactly one of 'after', 'every' or 'cron'", lang.ErrInvalidArgument)
+}
+
+// toDuration accepts seconds as a number, or a Go duration string.
+func toDuration(key string, v interface{}) (time.Duration, error) {
+	switch d := v.(type) {
+	case int64:
+		return time.Duration(d) * time.Second, nil
+	case float64:
+		return time.Duration(d * float64(time.Second)), nil
+	case string:
+		parsed, err := time.ParseDuration(d)
+		if err != nil {
+			return 0, lang.NewRuntimeError(lang.ErrorCodeType, fmt.Sprintf("'%s' is not a duration: %v", key, err), lang.ErrInvalidArgument)
+		}
+		return parsed, nil
+	}
+	return 0, lang.NewRuntimeError(lang.ErrorCodeType, fmt.Sprintf("'%s' must be seconds or a duration string, got %T", key, v), lang.ErrInvalidArgument)
+}
+
+// schedulerError turns a scheduler error into a runtime error.
+func schedulerError(err error) error {
+	switch {
+	case errors.Is(err, scheduler.ErrInvalidSpec), errors.Is(err, scheduler.ErrInvalidCron):
+		return lang.NewRuntimeError(lang.ErrorCodeArgMismatch, err.Error(), errors.Join(lang.ErrInvalidArgument, err))
+	case errors.Is(err, scheduler.ErrTooManyTimers):
+		return lang.NewRuntimeError(lang.ErrorCodeResourceExhaustion, err.Error(), err)
+	default:
+		return lang.NewRuntimeError(lang.ErrorCodeConfiguration, err.Error(), err)
+	}
+}
+
+func toolCancelTimer(rt tool.Runtime, args []interface{}) (interface{}, error) {
+	id, ok := args[0].(string)
+	if !ok {
+		return nil, lang.NewRuntimeError(lang.ErrorCodeType, "argument 'id' must be a string", lang.ErrInvalidArgument)
+	}
+	sched, err := getScheduler(rt)
+	if err != nil {
+		return nil, err
+	}
+	return sched.Cancel(id), nil
+}
+
+func toolListTimers(rt tool.Runtime, args []interface{}) (interface{}, error) {
+	sched, err := getScheduler(rt)
+	if err != nil {
+		return nil, err
+	}
+	timers := sched.List()
+	list := make([]interface{}, 0, len(timers))
+	for _, t := range timers {
+		list = append(list, map[string]interface{}{
+			"id":      t.ID,
+			"event":   t.Event,
+			"kind":    string(t.Kind),
+			"spec":    t.Spec,
+			"next":    t.Next.Format(time.RFC3339),
+			"fired":   int64(t.Fired),
+			"skipped": int64(t.Skipped),
+		})
+	}
+	return list, nil
+}
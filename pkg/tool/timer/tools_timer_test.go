@@ -0,0 +1,172 @@
+// NeuroScript Version: 0.8.0
+// File version: 2
+// Purpose: Tests the timer toolset from scripts on a fake clock, including its capability gates and argument validation.
+// filename: pkg/tool/timer/tools_timer_test.go
+// nlines: 172
+// risk_rating: LOW
+package timer_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/aprice2704/neuroscript/pkg/interfaces"
+	"github.com/aprice2704/neuroscript/pkg/interpreter"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/logging"
+	"github.com/aprice2704/neuroscript/pkg/policy"
+	"github.com/aprice2704/neuroscript/pkg/scheduler"
+	_ "github.com/aprice2704/neuroscript/pkg/tool/timer"
+)
+
+const timerScript = `
+func start_polling() means
+	set id = tool.timer.Schedule("inbox.poll", {"every": "1m"}, {"inbox": "support"}, "poll")
+	set once = tool.timer.Schedule("inbox.stop", {"after": 150})
+	emit "scheduled " + id
+endfunc
+
+func list_timers(returns timers) means
+	return tool.timer.List()
+endfunc
+
+func schedule_cron() means
+	call tool.timer.Schedule("inbox.report", {"cron": "0 9 * * mon-fri"})
+endfunc
+
+func schedule_bad(needs when) means
+	call tool.timer.Schedule("inbox.poll", when)
+endfunc
+
+func schedule_other() means
+	call tool.timer.Schedule("billing.run", {"after": 1})
+endfunc
+
+on event "inbox.poll" as ev do
+	emit "poll " + ev.payload[0].Payload.inbox
+endon
+
+on event "inbox.stop" do
+	emit "stop " + tool.timer.Cancel("poll")
+endon
+`
+
+func newTimerTestInterpreter(t *testing.T, grants ...string) (*interpreter.Interpreter, *scheduler.FakeClock, *[]string) {
+	t.Helper()
+	var emitted []string
+	hostCtx := &interpreter.HostContext{
+		Logger: logging.NewTestLogger(t),
+		Stdout: io.Discard,
+		Stderr: io.Discard,
+		EmitFunc: func(v lang.Value) {
+			s, _ := lang.ToString(v)
+			emitted = append(emitted, s)
+		},
+		WhisperFunc: func(lang.Value, lang.Value) {},
+	}
+	b := policy.NewBuilder(policy.ContextNormal).Allow("*")
+	for _, g := range grants {
+		b = b.Grant(g)
+	}
+	clock := scheduler.NewFakeClock(time.Date(2026, 3, 14, 10, 0, 0, 0, time.UTC)) // a Saturday
+	interp := interpreter.NewInterpreter(
+		interpreter.WithHostContext(hostCtx),
+		interpreter.WithExecPolicy(b.Build()),
+		interpreter.WithClock(clock),
+	)
+	tree, err := interp.Parser().Parse(timerScript)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	program, _, err := interp.ASTBuilder().Build(tree)
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	if err := interp.Load(&interfaces.Tree{Root: program}); err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	return interp, clock, &emitted
+}
+
+var allGrants = []string{"timer:write:*", "timer:read:*", "bus:write:inbox.*"}
+
+func TestTimerTools_ScheduleFiresEvents(t *testing.T) {
+	interp, clock, emitted := newTimerTestInterpreter(t, allGrants...)
+	if _, err := interp.RunProcedure("start_polling"); err != nil {
+		t.Fatalf("start_polling: %v", err)
+	}
+	listed, err := interp.RunProcedure("list_timers")
+	if err != nil {
+		t.Fatalf("list_timers: %v", err)
+	}
+	var got []string
+	for _, item := range lang.Unwrap(listed).([]interface{}) {
+		m := item.(map[string]interface{})
+		got = append(got, fmt.Sprintf("%v/%v/%v/%v", m["id"], m["kind"], m["spec"], m["next"]))
+	}
+	if want := "[poll/every/1m0s/2026-03-14T10:01:00Z timer-1/after/2m30s/2026-03-14T10:02:30Z]"; fmt.Sprint(got) != want {
+		t.Errorf("got timers %s, want %s", got, want)
+	}
+
+	clock.Advance(5 * time.Minute)
+	want := "[scheduled poll poll support poll support stop true]"
+	if got := fmt.Sprint(*emitted); got != want {
+		t.Errorf("got emits %s, want %s", got, want)
+	}
+	if timers := interp.Timers(); len(timers) != 0 {
+		t.Errorf("timers left after the handler cancelled them: %+v", timers)
+	}
+}
+
+func TestTimerTools_Cron(t *testing.T) {
+	interp, _, _ := newTimerTestInterpreter(t, allGrants...)
+	if _, err := interp.RunProcedure("schedule_cron"); err != nil {
+		t.Fatalf("schedule_cron: %v", err)
+	}
+	timers := interp.Timers()
+	if len(timers) != 1 || timers[0].Next != time.Date(2026, 3, 16, 9, 0, 0, 0, time.UTC) {
+		t.Errorf("got %+v, want one timer due Monday 09:00", timers)
+	}
+}
+
+func TestTimerTools_Grants(t *testing.T) {
+	t.Run("no timer grant", func(t *testing.T) {
+		interp, _, _ := newTimerTestInterpreter(t, "bus:write:*")
+		if _, err := interp.RunProcedure("start_polling"); err == nil {
+			t.Fatal("expected scheduling without a timer grant to fail")
+		}
+	})
+	t.Run("no bus grant for the event", func(t *testing.T) {
+		interp, _, _ := newTimerTestInterpreter(t, allGrants...)
+		_, err := interp.RunProcedure("schedule_other")
+		if !errors.Is(err, policy.ErrCapability) {
+			t.Fatalf("got %v, want policy.ErrCapability", err)
+		}
+		if len(interp.Timers()) != 0 {
+			t.Error("a refused timer was scheduled")
+		}
+	})
+}
+
+func TestTimerTools_InvalidWhen(t *testing.T) {
+	interp, _, _ := newTimerTestInterpreter(t, allGrants...)
+	bad := []map[string]any{
+		{},
+		{"after": 1, "every": 1},
+		{"sometimes": 1},
+		{"every": "soon"},
+		{"every": 0},
+		{"every": "100ms"},
+		{"after": true},
+		{"cron": "* * *"},
+	}
+	for _, when := range bad {
+		arg, _ := lang.Wrap(when)
+		if _, err := interp.RunProcedure("schedule_bad", arg); !errors.Is(err, lang.ErrInvalidArgument) {
+			t.Errorf("when %v: got %v, want lang.ErrInvalidArgument", when, err)
+		}
+	}
+}
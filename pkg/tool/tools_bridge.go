@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.8.0
-// File version: 12
-// Purpose: Adds a defer/recover block to ExecuteTool for panic safety, matching CallFromInterpreter.
+// File version: 15
+// Purpose: Applies ArgSpec.DefaultValue for arguments a call leaves out. Splits ExecuteResolved out of ExecuteTool.
 // filename: pkg/tool/tools_bridge.go
-// nlines: 178
+// nlines: 202
 // risk_rating: HIGH
 
 package tool
@@ -53,6 +53,11 @@ func (r *ToolRegistryImpl) CallFromInterpreter(interp Runtime, fullname types.Fu
 		return nil, validationErr
 	}
 
+	// --- Resource Metering ---
+	if err := meterCall(interp, impl); err != nil {
+		return nil, err
+	}
+
 	// --- Runtime Selection ---
 	runtimeForTool := interp
 	if impl.IsInternal {
@@ -106,6 +111,13 @@ func (r *ToolRegistryImpl) ExecuteTool(fullname types.FullName, args map[string]
 		canonicalName := CanonicalizeToolName(string(fullname))
 		return nil, lang.NewRuntimeError(lang.ErrorCodeToolNotFound, fmt.Sprintf("tool '%s' not found", canonicalName), lang.ErrToolNotFound)
 	}
+	return r.ExecuteResolved(fullname, impl, args)
+}
+
+// ExecuteResolved runs impl, which the caller already looked up under
+// fullname with GetTool, exactly as ExecuteTool would: the policy check,
+// argument validation, metering and error handling all still apply.
+func (r *ToolRegistryImpl) ExecuteResolved(fullname types.FullName, impl ToolImplementation, args map[string]lang.Value) (lang.Value, error) {
 	if r.interpreter == nil {
 		return nil, lang.NewRuntimeError(lang.ErrorCodeConfiguration, "ToolRegistry not configured with a valid runtime context for ExecuteTool", lang.ErrConfiguration)
 	}
@@ -126,13 +138,16 @@ func (r *ToolRegistryImpl) ExecuteTool(fullname types.FullName, args map[string]
 
 	for i, spec := range impl.Spec.Args {
 		val, found := args[spec.Name]
-		if !found {
+		switch {
+		case found:
+			rawArgs[i] = lang.Unwrap(val) // Found, unwrap it
+		case spec.DefaultValue != nil:
+			rawArgs[i] = spec.DefaultValue // Not provided, use the declared default
+		default:
 			if spec.Required {
 				missingRequired = append(missingRequired, spec.Name)
 			}
 			rawArgs[i] = nil // Not provided, insert nil
-		} else {
-			rawArgs[i] = lang.Unwrap(val) // Found, unwrap it
 		}
 	}
 
@@ -148,6 +163,10 @@ func (r *ToolRegistryImpl) ExecuteTool(fullname types.FullName, args map[string]
 		return nil, validationErr // Return detailed error from validator
 	}
 
+	if err := meterCall(r.interpreter, impl); err != nil {
+		return nil, err
+	}
+
 	// --- Tool Invocation (Simplified, assumes external calls don't need runtime unwrapping) ---
 	// Note: ExecuteTool uses the registry's base interpreter context.
 	// --- [NEW] Add panic recovery ---
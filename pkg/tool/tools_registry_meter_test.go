@@ -0,0 +1,123 @@
+// NeuroScript Version: 0.8.0
+// File version: 3
+// Purpose: Tests that FS/net and shell tool calls are metered against ExecPolicy limits.
+// filename: pkg/tool/tools_registry_meter_test.go
+// nlines: 123
+// risk_rating: LOW
+
+package tool_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aprice2704/neuroscript/pkg/capability"
+	"github.com/aprice2704/neuroscript/pkg/lang"
+	"github.com/aprice2704/neuroscript/pkg/policy"
+	"github.com/aprice2704/neuroscript/pkg/tool"
+)
+
+// netTool is a sample tool that requires a network capability.
+var netTool = tool.ToolImplementation{
+	Spec: tool.ToolSpec{Name: "fetch", Group: "net"},
+	Func: func(rt tool.Runtime, args []interface{}) (interface{}, error) {
+		return "fetched", nil
+	},
+	RequiredCaps: []capability.Capability{{Resource: "net", Verbs: []string{"read"}, Scopes: []string{"*"}}},
+}
+
+// shellTool is a sample tool that can run any command.
+var shellTool = tool.ToolImplementation{
+	Spec: tool.ToolSpec{Name: "Execute", Group: "shell"},
+	Func: func(rt tool.Runtime, args []interface{}) (interface{}, error) {
+		return "ran", nil
+	},
+	RequiredCaps: []capability.Capability{{Resource: "shell", Verbs: []string{"execute"}, Scopes: []string{"*"}}},
+}
+
+func newMeteredRuntime(t *testing.T, limits capability.Limits) (*testRuntime, tool.ToolRegistry) {
+	t.Helper()
+	rt := &testRuntime{}
+	registry := tool.NewToolRegistry(rt)
+	for _, impl := range []tool.ToolImplementation{secureTool, netTool, shellTool} {
+		if _, err := registry.RegisterTool(impl); err != nil {
+			t.Fatalf("Failed to register tool: %v", err)
+		}
+	}
+	rt.registry = registry
+	rt.execPolicy = &policy.ExecPolicy{
+		Context: policy.ContextNormal,
+		Allow:   []string{"*"},
+		Grants: capability.NewGrantSet([]capability.Capability{
+			{Resource: "fs", Verbs: []string{"write"}, Scopes: []string{"*"}},
+			{Resource: "net", Verbs: []string{"read"}, Scopes: []string{"*"}},
+			{Resource: "shell", Verbs: []string{"execute"}, Scopes: []string{"*"}},
+		}, limits),
+	}
+	return rt, registry
+}
+
+func TestCallFromInterpreter_FSCallLimit(t *testing.T) {
+	rt, registry := newMeteredRuntime(t, capability.Limits{FSMaxCalls: 2})
+
+	for n := 1; n <= 2; n++ {
+		if _, err := registry.CallFromInterpreter(rt, "tool.fs.writeFile", nil); err != nil {
+			t.Fatalf("call %d should succeed: %v", n, err)
+		}
+	}
+	_, err := registry.CallFromInterpreter(rt, "tool.fs.writeFile", nil)
+	var rtErr *lang.RuntimeError
+	if !errors.As(err, &rtErr) || rtErr.Code != lang.ErrorCodeResourceExhaustion || !errors.Is(err, capability.ErrFSExceeded) {
+		t.Fatalf("third call: expected ResourceExhaustion wrapping ErrFSExceeded, got %v", err)
+	}
+	if got := rt.GetGrantSet().Counters.FSCalls; got != 2 {
+		t.Errorf("FSCalls = %d, want 2", got)
+	}
+}
+
+func TestCallFromInterpreter_NetCallLimit(t *testing.T) {
+	rt, registry := newMeteredRuntime(t, capability.Limits{NetMaxCalls: 1})
+
+	if _, err := registry.CallFromInterpreter(rt, "tool.net.fetch", nil); err != nil {
+		t.Fatalf("first call should succeed: %v", err)
+	}
+	if _, err := registry.CallFromInterpreter(rt, "tool.net.fetch", nil); !errors.Is(err, capability.ErrNetExceeded) {
+		t.Fatalf("second call: expected ErrNetExceeded, got %v", err)
+	}
+	if usage := rt.GetGrantSet().Snapshot(); usage.NetCalls != 1 {
+		t.Errorf("NetCalls = %d, want 1", usage.NetCalls)
+	}
+}
+
+func TestCallFromInterpreter_UnmeteredToolsIgnoreLimits(t *testing.T) {
+	rt, registry := newMeteredRuntime(t, capability.Limits{FSMaxCalls: 1, NetMaxCalls: 1})
+	if _, err := registry.RegisterTool(stringSliceTool); err != nil {
+		t.Fatalf("Failed to register tool: %v", err)
+	}
+	for n := 0; n < 3; n++ {
+		args := []lang.Value{lang.NewListValue([]lang.Value{lang.StringValue{Value: "a"}})}
+		if _, err := registry.CallFromInterpreter(rt, "tool.test.stringSliceCheck", args); err != nil {
+			t.Fatalf("call %d: unmetered tool should not be limited: %v", n, err)
+		}
+	}
+}
+
+func TestCallFromInterpreter_ShellCountsAgainstFSAndNetLimits(t *testing.T) {
+	for _, limits := range []capability.Limits{{FSMaxCalls: 1}, {NetMaxCalls: 1}} {
+		rt, registry := newMeteredRuntime(t, limits)
+		if _, err := registry.CallFromInterpreter(rt, "tool.shell.Execute", nil); err != nil {
+			t.Fatalf("first call should succeed: %v", err)
+		}
+		_, err := registry.CallFromInterpreter(rt, "tool.shell.Execute", nil)
+		if !errors.Is(err, capability.ErrFSExceeded) && !errors.Is(err, capability.ErrNetExceeded) {
+			t.Fatalf("limits %+v: second shell call should be rejected, got %v", limits, err)
+		}
+	}
+	rt, registry := newMeteredRuntime(t, capability.Limits{FSMaxCalls: 5})
+	if _, err := registry.CallFromInterpreter(rt, "tool.shell.Execute", nil); err != nil {
+		t.Fatalf("shell call failed: %v", err)
+	}
+	if usage := rt.GetGrantSet().Snapshot(); usage.FSCalls != 1 || usage.NetCalls != 1 {
+		t.Errorf("FSCalls, NetCalls = %d, %d; want 1, 1", usage.FSCalls, usage.NetCalls)
+	}
+}
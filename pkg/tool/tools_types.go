@@ -1,8 +1,8 @@
 // :: product: NS
 // :: majorVersion: 1
-// :: fileVersion: 30
+// :: fileVersion: 31
 // :: description: Updated Runtime interface and ArgType constants. Added recursive MapKeySpecs to ArgSpec.
-// :: latestChange: Replaced MapKeys []string with MapKeySpecs []ArgSpec to support deep metadata for map parameters.
+// :: latestChange: Added ExecuteResolved to ToolRegistry so a resolved tool can run without a second lookup.
 // :: filename: pkg/tool/tool_types.go
 // :: serialization: go
 
@@ -148,6 +148,9 @@ type ToolRegistry interface {
 	ListTools() []ToolImplementation
 	NTools() int
 	ExecuteTool(toolName types.FullName, args map[string]lang.Value) (lang.Value, error)
+	// ExecuteResolved runs a tool already looked up with GetTool, with the
+	// same checks as ExecuteTool but without the lookup.
+	ExecuteResolved(fullname types.FullName, impl ToolImplementation, args map[string]lang.Value) (lang.Value, error)
 	CallFromInterpreter(interp Runtime, fullname types.FullName, args []lang.Value) (lang.Value, error)
 	// NewViewForInterpreter creates a new registry that shares the tool definitions
 	// of the parent but is bound to a different interpreter runtime.
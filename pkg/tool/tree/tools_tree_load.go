@@ -1,14 +1,13 @@
 // NeuroScript Version: 0.6.5
-// File version: 4
+// File version: 5
 // Purpose: Corrected JSON loading to deterministically create nodes and properly use the 'type' field from the JSON object. Updated handle registration to use the new HandleRegistry API.
 // filename: pkg/tool/tree/tools_tree_load.go
-// nlines: 168
+// nlines: 164
 // risk_rating: HIGH
 
 package tree
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"sort"
@@ -38,8 +37,8 @@ func toolTreeLoadJSON(interp tool.Runtime, args []interface{}) (interface{}, err
 		)
 	}
 
-	var data interface{}
-	err := json.Unmarshal([]byte(jsonContent), &data)
+	// Integers stay exact (int64); other numbers decode as float64.
+	data, err := utils.DecodeJSON([]byte(jsonContent))
 	if err != nil {
 		return nil, lang.NewRuntimeError(lang.ErrorCodeSyntax,
 			fmt.Sprintf("%s: failed to unmarshal JSON input: %v", toolName, err),
@@ -70,7 +69,7 @@ func toolTreeLoadJSON(interp tool.Runtime, args []interface{}) (interface{}, err
 				nodeType = "array"
 			case string:
 				nodeType = "string"
-			case float64:
+			case int64, float64:
 				nodeType = "number"
 			case bool:
 				nodeType = "boolean"
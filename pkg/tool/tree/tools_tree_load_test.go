@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.6.5
-// File version: 20
+// File version: 21
 // Purpose: Corrected FindNodes call to use int64 arguments for max_depth and max_results.
 // filename: pkg/tool/tree/tools_tree_load_test.go
-// nlines: 120
+// nlines: 174
 // risk_rating: LOW
 package tree_test
 
@@ -88,6 +88,23 @@ func TestTreeToJSON(t *testing.T) {
 	})
 }
 
+func TestTreeLoadJSON_ExactIntegers(t *testing.T) {
+	testTreeToolHelper(t, "Integers above 2^53 survive a roundtrip", func(t *testing.T, interp tool.Runtime) {
+		handle, err := setupTreeWithJSON(t, interp, `{"id": 9007199254740993, "ratio": 0.5}`)
+		if err != nil {
+			t.Fatalf("setup failed: %v", err)
+		}
+		jsonResult, err := callToJSON(t, interp, handle)
+		if err != nil {
+			t.Fatalf("ToJSON failed: %v", err)
+		}
+		jsonStr, _ := jsonResult.(string)
+		if !strings.Contains(jsonStr, "9007199254740993") || !strings.Contains(jsonStr, "0.5") {
+			t.Errorf("numbers were not kept exactly: %s", jsonStr)
+		}
+	})
+}
+
 func TestTreeGetRoot(t *testing.T) {
 	testTreeToolHelper(t, "Get Root Node", func(t *testing.T, interp tool.Runtime) {
 		handle, err := setupTreeWithJSON(t, interp, simpleJSON)
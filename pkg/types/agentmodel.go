@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.7.0
-// File version: 7
-// Purpose: Adds the 'Params' map to store provider-specific configuration blocks.
+// File version: 12
+// Purpose: GenerationConfig records sampling fields set explicitly, so a zero such as temperature 0 reaches the provider.
 // filename: pkg/types/agentmodel.go
-// nlines: 85
+// nlines: 159
 // risk_rating: MEDIUM
 
 package types
@@ -42,6 +42,20 @@ type AgentModel struct {
 	MaxTurns       int            `json:"max_turns,omitempty" mapstructure:"max_turns"`
 	MaxRetries     int            `json:"max_retries,omitempty" mapstructure:"max_retries"`
 
+	// TimeoutSeconds bounds each provider call attempt. Zero means 30 seconds.
+	TimeoutSeconds int `json:"timeout_seconds,omitempty" mapstructure:"timeout_seconds"`
+	// RetryBackoffMs is the delay before the first retry; it doubles on each
+	// further retry, with jitter. Zero means 500ms.
+	RetryBackoffMs int `json:"retry_backoff_ms,omitempty" mapstructure:"retry_backoff_ms"`
+	// RetryMaxBackoffMs caps the computed backoff. Zero means 30 seconds.
+	// A longer Retry-After requested by the server is honoured up to this
+	// cap; beyond it the call fails instead of waiting.
+	RetryMaxBackoffMs int `json:"retry_max_backoff_ms,omitempty" mapstructure:"retry_max_backoff_ms"`
+
+	// SystemCapsule names the capsule prepended to the first turn of a
+	// conversation. If empty, the default bootstrap capsule is used.
+	SystemCapsule string `json:"system_capsule,omitempty" mapstructure:"system_capsule"`
+
 	// APIKey is resolved at runtime from the AccountName. It is not persisted
 	// or parsed from configuration files.
 	APIKey string `json:"-" mapstructure:"-"`
@@ -80,6 +94,55 @@ type GenerationConfig struct {
 	Seed              *int64         `json:"seed,omitempty" mapstructure:"seed"`
 	LogProbs          bool           `json:"log_probs,omitempty" mapstructure:"log_probs"`
 	ResponseFormat    ResponseFormat `json:"response_format,omitempty" mapstructure:"response_format"`
+
+	// Explicit marks the sampling fields that were set on purpose. A non-zero
+	// value is always sent to the provider; a zero value (temperature 0 for
+	// greedy decoding, say) is sent only if its field is marked here, and
+	// otherwise means "use the provider default".
+	Explicit GenerationFields `json:"-" mapstructure:"-"`
+}
+
+// GenerationFields is a set of GenerationConfig sampling fields for which
+// zero is a meaningful value.
+type GenerationFields uint8
+
+const (
+	GenTemperature GenerationFields = 1 << iota
+	GenTopP
+	GenTopK
+	GenPresencePenalty
+	GenFrequencyPenalty
+	GenRepetitionPenalty
+)
+
+// generationFieldKeys maps each field to its configuration key.
+var generationFieldKeys = []struct {
+	field GenerationFields
+	key   string
+}{
+	{GenTemperature, "temperature"},
+	{GenTopP, "top_p"},
+	{GenTopK, "top_k"},
+	{GenPresencePenalty, "presence_penalty"},
+	{GenFrequencyPenalty, "frequency_penalty"},
+	{GenRepetitionPenalty, "repetition_penalty"},
+}
+
+// Has reports whether f is in the set.
+func (s GenerationFields) Has(f GenerationFields) bool {
+	return s&f != 0
+}
+
+// GenerationFieldsIn returns the sampling fields whose configuration keys
+// (e.g. "temperature") are present in cfg, whatever their values.
+func GenerationFieldsIn(cfg map[string]any) GenerationFields {
+	var set GenerationFields
+	for _, fk := range generationFieldKeys {
+		if _, ok := cfg[fk.key]; ok {
+			set |= fk.field
+		}
+	}
+	return set
 }
 
 // ToolConfig holds parameters related to tool use.
@@ -1,8 +1,8 @@
 // :: product: FDM/NS
 // :: majorVersion: 1
-// :: fileVersion: 6
+// :: fileVersion: 11
 // :: description: Adds KindInterpolatedString for the Structured Template approach.
-// :: latestChange: Added KindInterpolatedString.
+// :: latestChange: Added KindSlice and KindMemberAccess for slices and dotted member access.
 // :: filename: pkg/types/kind.go
 // :: serialization: go
 
@@ -58,6 +58,13 @@ const (
 	KindInterpolatedString // <<< ADDED
 
 	KindComment
+	KindNamedArg
+	KindLambda
+	KindSwitchStmt
+	KindSwitchCase
+	KindTryStmt
+	KindSlice
+	KindMemberAccess
 
 	// ^^^^^^ add new kinds above this ^^^^^^^
 	// KindMarker is not a real kind. It is a sentinel value used in tests to
@@ -135,6 +142,20 @@ func (k Kind) String() string {
 		return "InterpolatedString"
 	case KindComment:
 		return "Comment"
+	case KindNamedArg:
+		return "NamedArg"
+	case KindLambda:
+		return "Lambda"
+	case KindSwitchStmt:
+		return "SwitchStmt"
+	case KindSwitchCase:
+		return "SwitchCase"
+	case KindTryStmt:
+		return "TryStmt"
+	case KindSlice:
+		return "Slice"
+	case KindMemberAccess:
+		return "MemberAccess"
 	case KindMarker:
 		return "Marker"
 	default:
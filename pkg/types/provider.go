@@ -1,8 +1,8 @@
 // NeuroScript Version: 0.8.0
-// File version: 2
-// Purpose: Adds ProviderParams map to pass generic config to providers.
+// File version: 5
+// Purpose: Carries the full GenerationConfig on AIRequest, with the explicitly set fields; adds AIResponse.Warnings for ignored settings.
 // filename: pkg/types/provider.go
-// nlines: 42
+// nlines: 85
 // risk_rating: LOW
 
 package types
@@ -22,8 +22,26 @@ type AIRequest struct {
 	APIKey         string
 	Prompt         string
 	Temperature    float64
-	Stream         bool
-	Timeout        time.Duration
+	// MaxOutputTokens caps the length of the response. Zero means provider default.
+	MaxOutputTokens int
+	StopSequences   []string
+	ResponseFormat  ResponseFormat
+
+	// Sampling and repetition controls, copied from GenerationConfig.
+	// Zero values (and a nil Seed) mean "use the provider default", unless
+	// the field is marked in Explicit; providers ask Sends.
+	TopP              float64
+	TopK              int
+	PresencePenalty   float64
+	FrequencyPenalty  float64
+	RepetitionPenalty float64
+	Seed              *int64
+	LogProbs          bool
+	// Explicit is copied from GenerationConfig.Explicit.
+	Explicit GenerationFields
+
+	Stream  bool
+	Timeout time.Duration
 
 	// ProviderParams is a direct copy of AgentModel.Params, used to pass
 	// provider-specific config (like "generic_http") to the provider.
@@ -31,11 +49,37 @@ type AIRequest struct {
 	ProviderParams map[string]any
 }
 
+// Sends reports whether the sampling field f should be sent to the provider:
+// it has a non-zero value, or it was set explicitly to zero.
+func (r AIRequest) Sends(f GenerationFields) bool {
+	if r.Explicit.Has(f) {
+		return true
+	}
+	switch f {
+	case GenTemperature:
+		return r.Temperature != 0
+	case GenTopP:
+		return r.TopP != 0
+	case GenTopK:
+		return r.TopK != 0
+	case GenPresencePenalty:
+		return r.PresencePenalty != 0
+	case GenFrequencyPenalty:
+		return r.FrequencyPenalty != 0
+	case GenRepetitionPenalty:
+		return r.RepetitionPenalty != 0
+	}
+	return false
+}
+
 // AIResponse encapsulates the response from an AI model provider.
 type AIResponse struct {
 	TextContent  string
 	InputTokens  int
 	OutputTokens int
 	Cost         float64
+	// Warnings lists request settings the provider could not honour, such as
+	// a generation parameter the API does not support. They are not errors.
+	Warnings []string
 	// Add other response fields here (e.g., raw response, finish reason)
 }
@@ -0,0 +1,53 @@
+// NeuroScript Version: 0.8.0
+// File version: 1
+// Purpose: Decodes JSON into interface{} keeping integers exact as int64, for every tool that parses JSON.
+// filename: pkg/utils/json_decode.go
+// nlines: 53
+// risk_rating: MEDIUM
+
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// DecodeJSON unmarshals a single JSON value into interface{} like
+// json.Unmarshal, except that integers decode to int64 instead of float64 so
+// IDs and counters above 2^53 survive exactly.
+func DecodeJSON(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("invalid data after top-level JSON value")
+	}
+	return resolveJSONNumbers(v), nil
+}
+
+// resolveJSONNumbers replaces every json.Number with an int64 when it is an
+// integer literal in range, and a float64 otherwise.
+func resolveJSONNumbers(v interface{}) interface{} {
+	switch t := v.(type) {
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return i
+		}
+		f, _ := t.Float64()
+		return f
+	case []interface{}:
+		for i, e := range t {
+			t[i] = resolveJSONNumbers(e)
+		}
+	case map[string]interface{}:
+		for k, e := range t {
+			t[k] = resolveJSONNumbers(e)
+		}
+	}
+	return v
+}